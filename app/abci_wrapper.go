@@ -6,71 +6,92 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 )
 
+// wrappedApp is the BaseApp ABCI of VolnixApp and MinimalVolnixApp, whose
+// state sync methods already take a context
+type wrappedApp interface {
+	Info(*abci.RequestInfo) (*abci.ResponseInfo, error)
+	Query(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error)
+	CheckTx(*abci.RequestCheckTx) (*abci.ResponseCheckTx, error)
+	InitChain(*abci.RequestInitChain) (*abci.ResponseInitChain, error)
+	PrepareProposal(*abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error)
+	ProcessProposal(*abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error)
+	FinalizeBlock(*abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error)
+	ExtendVote(context.Context, *abci.RequestExtendVote) (*abci.ResponseExtendVote, error)
+	VerifyVoteExtension(*abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error)
+	Commit() (*abci.ResponseCommit, error)
+	ListSnapshots(context.Context, *abci.RequestListSnapshots) (*abci.ResponseListSnapshots, error)
+	OfferSnapshot(context.Context, *abci.RequestOfferSnapshot) (*abci.ResponseOfferSnapshot, error)
+	LoadSnapshotChunk(context.Context, *abci.RequestLoadSnapshotChunk) (*abci.ResponseLoadSnapshotChunk, error)
+	ApplySnapshotChunk(context.Context, *abci.RequestApplySnapshotChunk) (*abci.ResponseApplySnapshotChunk, error)
+}
+
+var _ abci.Application = (*ABCIWrapper)(nil)
+
 // ABCIWrapper wraps VolnixApp to provide context-aware ABCI methods
 type ABCIWrapper struct {
-	*MinimalVolnixApp
+	wrappedApp
 }
 
 // NewABCIWrapper creates a new ABCI wrapper
-func NewABCIWrapper(app *MinimalVolnixApp) *ABCIWrapper {
-	return &ABCIWrapper{MinimalVolnixApp: app}
+func NewABCIWrapper(app wrappedApp) *ABCIWrapper {
+	return &ABCIWrapper{wrappedApp: app}
 }
 
 // CheckTx implements ABCI interface with context
 func (w *ABCIWrapper) CheckTx(ctx context.Context, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
-	resp, err := w.MinimalVolnixApp.CheckTx(req)
+	resp, err := w.wrappedApp.CheckTx(req)
 	return resp, err
 }
 
 // FinalizeBlock implements ABCI interface with context
 func (w *ABCIWrapper) FinalizeBlock(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
-	resp, err := w.MinimalVolnixApp.FinalizeBlock(req)
+	resp, err := w.wrappedApp.FinalizeBlock(req)
 	return resp, err
 }
 
 // Commit implements ABCI interface with context
 func (w *ABCIWrapper) Commit(ctx context.Context, req *abci.RequestCommit) (*abci.ResponseCommit, error) {
-	resp, err := w.MinimalVolnixApp.Commit()
+	resp, err := w.wrappedApp.Commit()
 	return resp, err
 }
 
 // Query implements ABCI interface with context
 func (w *ABCIWrapper) Query(ctx context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
-	resp, err := w.MinimalVolnixApp.Query(ctx, req)
+	resp, err := w.wrappedApp.Query(ctx, req)
 	return resp, err
 }
 
 // Info implements ABCI interface with context
 func (w *ABCIWrapper) Info(ctx context.Context, req *abci.RequestInfo) (*abci.ResponseInfo, error) {
-	resp, err := w.MinimalVolnixApp.Info(req)
+	resp, err := w.wrappedApp.Info(req)
 	return resp, err
 }
 
 // InitChain implements ABCI interface with context
 func (w *ABCIWrapper) InitChain(ctx context.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
-	return w.MinimalVolnixApp.InitChain(req)
+	return w.wrappedApp.InitChain(req)
 }
 
 // PrepareProposal implements ABCI interface with context
 func (w *ABCIWrapper) PrepareProposal(ctx context.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
-	resp, err := w.MinimalVolnixApp.PrepareProposal(req)
+	resp, err := w.wrappedApp.PrepareProposal(req)
 	return resp, err
 }
 
 // ProcessProposal implements ABCI interface with context
 func (w *ABCIWrapper) ProcessProposal(ctx context.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
-	resp, err := w.MinimalVolnixApp.ProcessProposal(req)
+	resp, err := w.wrappedApp.ProcessProposal(req)
 	return resp, err
 }
 
 // ExtendVote implements ABCI interface with context
 func (w *ABCIWrapper) ExtendVote(ctx context.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
-	resp, err := w.MinimalVolnixApp.ExtendVote(ctx, req)
+	resp, err := w.wrappedApp.ExtendVote(ctx, req)
 	return resp, err
 }
 
 // VerifyVoteExtension implements ABCI interface with context
 func (w *ABCIWrapper) VerifyVoteExtension(ctx context.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
-	resp, err := w.MinimalVolnixApp.VerifyVoteExtension(req)
+	resp, err := w.wrappedApp.VerifyVoteExtension(req)
 	return resp, err
 }
\ No newline at end of file
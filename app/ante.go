@@ -2,13 +2,175 @@ package app
 
 import (
 	"fmt"
+	"math"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	txsigning "cosmossdk.io/x/tx/signing"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	identtypes "github.com/volnix-protocol/volnix-protocol/x/ident/types"
 )
 
+// HandlerOptions are the keepers and settings the ante chain of VolnixApp is built from
+type HandlerOptions struct {
+	AccountKeeper   ante.AccountKeeper
+	BankKeeper      authtypes.BankKeeper
+	SignModeHandler *txsigning.HandlerMap
+	// SigGasConsumer charges gas per signature, ante.DefaultSigVerificationGasConsumer when nil
+	SigGasConsumer ante.SignatureVerificationGasConsumer
+	// TxFeeChecker checks the fee against the node's minimum gas prices when nil
+	// It is ignored when IdentKeeper is set
+	TxFeeChecker ante.TxFeeChecker
+	// IdentKeeper applies the fee policy of the fee payer's ident role when set
+	IdentKeeper IdentFeeKeeper
+}
+
+// IdentFeeKeeper is the part of the ident keeper the fee policy reads
+type IdentFeeKeeper interface {
+	GetParams(ctx sdk.Context) identtypes.Params
+	GetFeeRole(ctx sdk.Context, address string) identv1.Role
+	UseFreeTransaction(ctx sdk.Context, address string, gas uint64) (bool, error)
+}
+
+// NewAnteHandler returns the ante chain of VolnixApp
+//
+// Transactions are checked for well-formed messages, timeout height and memo length, charged
+// gas for their size and signatures, pay their fee from the first signer into the fee
+// collector at the minimum gas price of the payer's ident role, and must carry a valid signature of every signer with the expected sequence.
+// Signers are the addresses named by the cosmos.msg.v1.signer field of each Msg, i.e. the
+// address field the Msg handler acts on behalf of.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, fmt.Errorf("account keeper is required for the ante handler")
+	}
+	if options.BankKeeper == nil {
+		return nil, fmt.Errorf("bank keeper is required for the ante handler")
+	}
+	if options.SignModeHandler == nil {
+		return nil, fmt.Errorf("sign mode handler is required for the ante handler")
+	}
+	sigGasConsumer := options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
+	}
+	txFeeChecker := options.TxFeeChecker
+	if options.IdentKeeper != nil {
+		txFeeChecker = identityFeeChecker(options.IdentKeeper)
+	}
+
+	return sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(), // sets the gas meter, must run first
+		messagesDecorator{},
+		ante.NewExtensionOptionsDecorator(nil),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, nil, txFeeChecker),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // must run before the signature decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	), nil
+}
+
+// identityFeeChecker returns the fee checker of the identity-aware fee policy
+//
+// Transactions without a fee from an active citizen draw on the citizen's daily free quota and
+// pay nothing, unless their gas limit exceeds the MaxFreeTxGas ident param. Every other transaction must pay the minimum gas prices that the ident params set
+// for the fee payer's role; in CheckTx the node's own minimum gas prices apply when higher.
+// The checker is not called in simulation, so simulating does not use up the quota.
+func identityFeeChecker(ik IdentFeeKeeper) ante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+		}
+		fee := feeTx.GetFee()
+		gas := feeTx.GetGas()
+		payer := sdk.AccAddress(feeTx.FeePayer()).String()
+
+		if fee.IsZero() {
+			free, err := ik.UseFreeTransaction(ctx, payer, gas)
+			if err != nil {
+				return nil, 0, err
+			}
+			if free {
+				return sdk.Coins{}, 0, nil
+			}
+		}
+
+		minGasPrices := ik.GetParams(ctx).MinGasPrices(ik.GetFeeRole(ctx, payer))
+		if ctx.IsCheckTx() {
+			minGasPrices = maxGasPrices(minGasPrices, ctx.MinGasPrices())
+		}
+		if !minGasPrices.IsZero() {
+			required := make(sdk.Coins, 0, len(minGasPrices))
+			glDec := sdkmath.LegacyNewDec(int64(gas))
+			for _, gp := range minGasPrices {
+				// Round up so a fee is never below the price
+				required = append(required, sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt()))
+			}
+			if !fee.IsAnyGTE(required) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fee; got: %s required: %s", fee, required)
+			}
+		}
+
+		return fee, feePriority(fee, gas), nil
+	}
+}
+
+// maxGasPrices returns the higher price per denom, denoms of either set are kept
+func maxGasPrices(a, b sdk.DecCoins) sdk.DecCoins {
+	prices := sdk.NewDecCoins(a...)
+	for _, gp := range b {
+		if current := prices.AmountOf(gp.Denom); gp.Amount.GT(current) {
+			prices = prices.Add(sdk.NewDecCoinFromDec(gp.Denom, gp.Amount.Sub(current)))
+		}
+	}
+	return prices
+}
+
+// feePriority is the lowest gas price the fee pays across its denoms, as in the SDK fee checker
+func feePriority(fee sdk.Coins, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+	var priority int64
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.QuoRaw(int64(gas))
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+	return priority
+}
+
+// messagesDecorator runs the message checks of ImprovedAnteHandler inside the ante chain
+type messagesDecorator struct{}
+
+func (messagesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx, err := ImprovedAnteHandler(ctx, tx, simulate)
+	if err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
 // ImprovedAnteHandler provides enhanced transaction validation
-// This is an intermediate implementation that validates transaction structure
-// and basic fields without requiring full auth/bank module integration
+// It validates transaction structure and messages only; signatures, sequences, memo,
+// timeout height and fees are checked by the decorators of NewAnteHandler
 func ImprovedAnteHandler(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
 	// IMPROVED: Basic transaction structure validation
 	if tx == nil {
@@ -36,10 +198,6 @@ func ImprovedAnteHandler(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context
 		}
 	}
 
-	// IMPROVED: Validate transaction timeout height if set
-	// Note: Timeout height validation requires proper tx type implementation
-	// For now, we skip this check as it requires specific transaction types
-
 	// IMPROVED: Validate gas limit
 	gasLimit := ctx.GasMeter().Limit()
 	if gasLimit > 0 {
@@ -53,15 +211,6 @@ func ImprovedAnteHandler(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context
 		}
 	}
 
-	// IMPROVED: Check for signatures presence (basic check)
-	// Full signature verification requires auth module integration
-	// Note: Signature validation requires proper tx type implementation
-	// For now, we skip this check as it requires specific transaction types
-
-	// IMPROVED: Validate memo length if present
-	// Note: Memo validation requires proper tx type implementation
-	// For now, we skip this check as it requires specific transaction types
-
 	// IMPROVED: Log transaction validation (only in debug mode)
 	if ctx.IsCheckTx() && !simulate {
 		ctx.Logger().Debug("Transaction validated",
@@ -2,16 +2,35 @@ package app
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/proto"
 
+	sdklog "cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cosmosdb "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
 	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	consensustypes "github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+	identtypes "github.com/volnix-protocol/volnix-protocol/x/ident/types"
 )
 
 type mockTx struct {
@@ -127,3 +146,276 @@ func TestImprovedAnteHandler_GasExceeded(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "gas limit exceeded")
 }
+
+// anteTestAccount is a funded account with a secp256k1 key
+type anteTestAccount struct {
+	priv *secp256k1.PrivKey
+	addr sdk.AccAddress
+}
+
+func newAnteTestApp(t *testing.T) (*VolnixApp, EncodingConfig, sdk.Context) {
+	encoding := MakeEncodingConfig()
+	app := NewVolnixApp(sdklog.NewNopLogger(), cosmosdb.NewMemDB(), nil, encoding, cosmosdb.NewMemDB())
+	require.NoError(t, app.LoadLatestVersion())
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 10, ChainID: "volnix-1"})
+	require.NoError(t, app.authKeeper.Params.Set(ctx, authtypes.DefaultParams()))
+
+	// No minimum fees, the fee policy tests set their own
+	params := identtypes.DefaultParams()
+	params.GuestMinGasPrices = nil
+	params.CitizenMinGasPrices = nil
+	params.ValidatorMinGasPrices = nil
+	params.CitizenDailyFreeTxs = 0
+	app.identKeeper.SetParams(ctx, params)
+	return app, encoding, ctx
+}
+
+func newAnteTestAccount(t *testing.T, app *VolnixApp, ctx sdk.Context, balance sdk.Coins) anteTestAccount {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	app.authKeeper.SetAccount(ctx, app.authKeeper.NewAccountWithAddress(ctx, addr))
+	if !balance.IsZero() {
+		require.NoError(t, app.bankKeeper.MintCoins(ctx, consensustypes.ModuleName, balance))
+		require.NoError(t, app.bankKeeper.SendCoinsFromModuleToAccount(ctx, consensustypes.ModuleName, addr, balance))
+	}
+	return anteTestAccount{priv: priv, addr: addr}
+}
+
+// signTx builds a transaction signed by signer in SIGN_MODE_DIRECT
+func signTx(t *testing.T, app *VolnixApp, encoding EncodingConfig, ctx sdk.Context, signer anteTestAccount, sequence uint64, build func(client.TxBuilder), msgs ...sdk.Msg) sdk.Tx {
+	txConfig := encoding.TxConfig.SigningConfig
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(200000)
+	if build != nil {
+		build(builder)
+	}
+
+	signMode := signingtypes.SignMode_SIGN_MODE_DIRECT
+	// The first pass sets the signer info that is part of the signed bytes
+	require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   signer.priv.PubKey(),
+		Data:     &signingtypes.SingleSignatureData{SignMode: signMode},
+		Sequence: sequence,
+	}))
+	signerData := authsigning.SignerData{
+		Address:       signer.addr.String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: app.authKeeper.GetAccount(ctx, signer.addr).GetAccountNumber(),
+		Sequence:      sequence,
+		PubKey:        signer.priv.PubKey(),
+	}
+	sig, err := clienttx.SignWithPrivKey(ctx, signMode, signerData, builder, signer.priv, txConfig, sequence)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(sig))
+
+	// Round trip through the wire format like a node receiving the transaction
+	bz, err := encoding.TxConfig.TxEncoder(builder.GetTx())
+	require.NoError(t, err)
+	tx, err := encoding.TxConfig.TxDecoder(bz)
+	require.NoError(t, err)
+	return tx
+}
+
+func TestAnteHandler_ValidTx(t *testing.T) {
+	app, encoding, ctx := newAnteTestApp(t)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uwrt", 500))
+	signer := newAnteTestAccount(t, app, ctx, sdk.NewCoins(sdk.NewInt64Coin("uwrt", 1000)))
+
+	tx := signTx(t, app, encoding, ctx, signer, 0, func(b client.TxBuilder) {
+		b.SetFeeAmount(fee)
+		b.SetMemo("vote")
+		b.SetTimeoutHeight(20)
+	}, &governancev1.MsgVote{ProposalId: 1, Voter: signer.addr.String()})
+
+	_, err := app.anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// The sequence is incremented, the pubkey recorded and the fee moved to the fee collector
+	account := app.authKeeper.GetAccount(ctx, signer.addr)
+	require.Equal(t, uint64(1), account.GetSequence())
+	require.Equal(t, signer.priv.PubKey(), account.GetPubKey())
+	require.Equal(t, int64(500), app.bankKeeper.GetBalance(ctx, signer.addr, "uwrt").Amount.Int64())
+	require.Equal(t, fee, app.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
+
+	// Replaying the same transaction fails on the sequence
+	_, err = app.anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+}
+
+func TestAnteHandler_SignerBoundToMsgAddress(t *testing.T) {
+	app, encoding, ctx := newAnteTestApp(t)
+	signer := newAnteTestAccount(t, app, ctx, nil)
+	victim := newAnteTestAccount(t, app, ctx, nil)
+
+	// A transaction signed by one account cannot act for another
+	tx := signTx(t, app, encoding, ctx, signer, 0, nil, &governancev1.MsgVote{ProposalId: 1, Voter: victim.addr.String()})
+	_, err := app.anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	// Signers come from the address field each handler trusts
+	signers, _, err := encoding.Codec.GetMsgV1Signers(&lizenzv1.MsgActivateLZN{Validator: signer.addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{signer.addr}, signers)
+	signers, _, err = encoding.Codec.GetMsgV1Signers(&anteilv1.MsgPlaceOrder{Owner: victim.addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{victim.addr}, signers)
+}
+
+func TestAnteHandler_RejectsInvalidTx(t *testing.T) {
+	app, encoding, ctx := newAnteTestApp(t)
+	signer := newAnteTestAccount(t, app, ctx, sdk.NewCoins(sdk.NewInt64Coin("uwrt", 100)))
+	msg := &governancev1.MsgVote{ProposalId: 1, Voter: signer.addr.String()}
+
+	testCases := []struct {
+		name     string
+		sequence uint64
+		build    func(client.TxBuilder)
+		err      error
+	}{
+		{"wrong sequence", 3, nil, sdkerrors.ErrWrongSequence},
+		{"memo too long", 0, func(b client.TxBuilder) { b.SetMemo(strings.Repeat("m", 300)) }, sdkerrors.ErrMemoTooLarge},
+		{"timed out", 0, func(b client.TxBuilder) { b.SetTimeoutHeight(5) }, sdkerrors.ErrTxTimeoutHeight},
+		{"fee not covered", 0, func(b client.TxBuilder) { b.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uwrt", 1000))) }, sdkerrors.ErrInsufficientFunds},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := signTx(t, app, encoding, ctx, signer, tc.sequence, tc.build, msg)
+			cacheCtx, _ := ctx.CacheContext()
+			_, err := app.anteHandler(cacheCtx, tx, false)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	// A signature over other bytes is rejected
+	tx := signTx(t, app, encoding, ctx.WithChainID("other-chain"), signer, 0, nil, msg)
+	_, err := app.anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+// setFeePolicy sets role-based minimum gas prices in uwrt and the citizen free quota
+func setFeePolicy(app *VolnixApp, ctx sdk.Context, guestPrice, citizenPrice string, freeTxs uint64) {
+	params := app.identKeeper.GetParams(ctx)
+	params.GuestMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uwrt", sdkmath.LegacyMustNewDecFromStr(guestPrice)))
+	params.CitizenMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uwrt", sdkmath.LegacyMustNewDecFromStr(citizenPrice)))
+	params.CitizenDailyFreeTxs = freeTxs
+	app.identKeeper.SetParams(ctx, params)
+}
+
+func verifyCitizen(t *testing.T, app *VolnixApp, ctx sdk.Context, addr sdk.AccAddress) {
+	require.NoError(t, app.identKeeper.SetVerifiedAccount(ctx, &identv1.VerifiedAccount{
+		Address:      addr.String(),
+		Role:         identv1.Role_ROLE_CITIZEN,
+		IdentityHash: "hash-" + addr.String(),
+		IsActive:     true,
+	}))
+}
+
+func TestAnteHandler_RoleMinGasPrices(t *testing.T) {
+	app, encoding, ctx := newAnteTestApp(t)
+	// 200000 gas: guests need 2000uwrt, citizens 400uwrt
+	setFeePolicy(app, ctx, "0.01", "0.002", 0)
+	balance := sdk.NewCoins(sdk.NewInt64Coin("uwrt", 10000))
+	guest := newAnteTestAccount(t, app, ctx, balance)
+	citizen := newAnteTestAccount(t, app, ctx, balance)
+	verifyCitizen(t, app, ctx, citizen.addr)
+
+	withFee := func(amount int64) func(client.TxBuilder) {
+		return func(b client.TxBuilder) { b.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uwrt", amount))) }
+	}
+
+	// The guest price applies to unverified accounts, in CheckTx and in blocks
+	tx := signTx(t, app, encoding, ctx, guest, 0, withFee(500), &governancev1.MsgVote{ProposalId: 1, Voter: guest.addr.String()})
+	_, err := app.anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	tx = signTx(t, app, encoding, ctx, guest, 0, withFee(2000), &governancev1.MsgVote{ProposalId: 1, Voter: guest.addr.String()})
+	_, err = app.anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// Citizens pay the discounted price
+	tx = signTx(t, app, encoding, ctx, citizen, 0, withFee(500), &governancev1.MsgVote{ProposalId: 1, Voter: citizen.addr.String()})
+	_, err = app.anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(9500), app.bankKeeper.GetBalance(ctx, citizen.addr, "uwrt").Amount.Int64())
+
+	// The node's own minimum gas prices still apply in CheckTx when higher
+	checkCtx := ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("uwrt", sdkmath.LegacyMustNewDecFromStr("0.005"))))
+	tx = signTx(t, app, encoding, ctx, citizen, 1, withFee(500), &governancev1.MsgVote{ProposalId: 1, Voter: citizen.addr.String()})
+	_, err = app.anteHandler(checkCtx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
+
+func TestAnteHandler_CitizenFreeQuota(t *testing.T) {
+	app, encoding, ctx := newAnteTestApp(t)
+	setFeePolicy(app, ctx, "0.01", "0.002", 2)
+	citizen := newAnteTestAccount(t, app, ctx, nil)
+	guest := newAnteTestAccount(t, app, ctx, nil)
+	verifyCitizen(t, app, ctx, citizen.addr)
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	vote := func(signer anteTestAccount, sequence uint64) sdk.Tx {
+		return signTx(t, app, encoding, ctx, signer, sequence, nil, &governancev1.MsgVote{ProposalId: 1, Voter: signer.addr.String()})
+	}
+
+	// Transactions above MaxFreeTxGas pay the citizen price and do not use the quota
+	params := app.identKeeper.GetParams(ctx)
+	params.MaxFreeTxGas = 199999
+	app.identKeeper.SetParams(ctx, params)
+	_, err := app.anteHandler(ctx, vote(citizen, 0), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	usage, err := app.identKeeper.GetFreeTxUsage(ctx, citizen.addr.String())
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), usage.Count)
+	params.MaxFreeTxGas = 200000
+	app.identKeeper.SetParams(ctx, params)
+
+	// Transactions without a fee draw on the daily quota
+	for sequence := uint64(0); sequence < 2; sequence++ {
+		_, err := app.anteHandler(ctx, vote(citizen, sequence), false)
+		require.NoError(t, err)
+	}
+	usage, err = app.identKeeper.GetFreeTxUsage(ctx, citizen.addr.String())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), usage.Count)
+
+	// Simulation does not use the quota
+	simCtx, _ := ctx.CacheContext()
+	_, err = app.anteHandler(simCtx, vote(citizen, 2), true)
+	require.NoError(t, err)
+	usage, err = app.identKeeper.GetFreeTxUsage(simCtx, citizen.addr.String())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), usage.Count)
+
+	_, err = app.anteHandler(ctx, vote(citizen, 2), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// The quota resets the next day
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	_, err = app.anteHandler(ctx, vote(citizen, 2), false)
+	require.NoError(t, err)
+
+	// Guests have no free quota
+	_, err = app.anteHandler(ctx, vote(guest, 0), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
+
+func TestAnteHandler_GuestRateLimit(t *testing.T) {
+	app, encoding, ctx := newAnteTestApp(t)
+	params := app.identKeeper.GetParams(ctx)
+	params.GuestTxRate = sdkmath.LegacyMustNewDecFromStr("0.5")
+	app.identKeeper.SetParams(ctx, params)
+	guest := newAnteTestAccount(t, app, ctx, nil)
+	citizen := newAnteTestAccount(t, app, ctx, nil)
+	verifyCitizen(t, app, ctx, citizen.addr)
+
+	ctx = ctx.WithIsCheckTx(true)
+	for _, signer := range []anteTestAccount{guest, citizen} {
+		tx := signTx(t, app, encoding, ctx, signer, 0, nil, &governancev1.MsgVote{ProposalId: 1, Voter: signer.addr.String()})
+		_, err := app.createAnteHandler()(ctx, tx, false)
+		require.NoError(t, err)
+	}
+
+	// Guests are limited to GuestTxRate, verified accounts keep the configured rate
+	require.Equal(t, rate.Limit(0.5), app.rateLimiter.addressLimiters[guest.addr.String()].Limit())
+	require.Equal(t, rate.Limit(DefaultRateLimitConfig().PerAddrRate), app.rateLimiter.addressLimiters[citizen.addr.String()].Limit())
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/volnix-protocol/volnix-protocol/x/governance"
 	governancetypes "github.com/volnix-protocol/volnix-protocol/x/governance/types"
 	"github.com/volnix-protocol/volnix-protocol/x/ident"
+	"github.com/volnix-protocol/volnix-protocol/x/integration"
 	identtypes "github.com/volnix-protocol/volnix-protocol/x/ident/types"
 	"github.com/volnix-protocol/volnix-protocol/x/lizenz"
 	lizenztypes "github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
@@ -43,169 +44,29 @@ import (
 	consensuskeeper "github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
 	governancekeeper "github.com/volnix-protocol/volnix-protocol/x/governance/keeper"
 	identkeeper "github.com/volnix-protocol/volnix-protocol/x/ident/keeper"
+	integrationkeeper "github.com/volnix-protocol/volnix-protocol/x/integration/keeper"
+	integrationtypes "github.com/volnix-protocol/volnix-protocol/x/integration/types"
 	lizenzkeeper "github.com/volnix-protocol/volnix-protocol/x/lizenz/keeper"
 
 	// proto imports for adapters
-	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
-	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
 )
 
 // Application name
 const Name = "volnix"
 
-// LizenzKeeperAdapter adapts lizenz keeper to consensus interface
-// Converts []*lizenzv1.ActivatedLizenz to []interface{} for interface compatibility
-type LizenzKeeperAdapter struct {
-	keeper *lizenzkeeper.Keeper
+// IdentKeeperAdapterForConsensus adapts ident keeper to consensus interface
+// Allows consensus module to remove validators downgraded in ident from the validator set
+type IdentKeeperAdapterForConsensus struct {
+	keeper *identkeeper.Keeper
 }
 
-// GetAllActivatedLizenz returns all activated LZN as []interface{}
-func (a *LizenzKeeperAdapter) GetAllActivatedLizenz(ctx sdk.Context) ([]interface{}, error) {
-	lizenzs, err := a.keeper.GetAllActivatedLizenz(ctx)
+func (a *IdentKeeperAdapterForConsensus) IsValidator(ctx sdk.Context, address string) bool {
+	account, err := a.keeper.GetVerifiedAccount(ctx, address)
 	if err != nil {
-		return nil, err
-	}
-
-	// Convert []*lizenzv1.ActivatedLizenz to []interface{}
-	result := make([]interface{}, len(lizenzs))
-	for i, lizenz := range lizenzs {
-		result[i] = lizenz
-	}
-	return result, nil
-}
-
-// GetTotalActivatedLizenz returns total activated LZN
-func (a *LizenzKeeperAdapter) GetTotalActivatedLizenz(ctx sdk.Context) (string, error) {
-	return a.keeper.GetTotalActivatedLizenz(ctx)
-}
-
-// GetMOACompliance returns MOA compliance ratio
-func (a *LizenzKeeperAdapter) GetMOACompliance(ctx sdk.Context, validator string) (float64, error) {
-	return a.keeper.GetMOACompliance(ctx, validator)
-}
-
-// UpdateRewardStats updates reward statistics
-func (a *LizenzKeeperAdapter) UpdateRewardStats(ctx sdk.Context, validator string, rewardAmount uint64, blockHeight uint64, moaCompliance float64, penaltyMultiplier float64, baseReward uint64) error {
-	return a.keeper.UpdateRewardStats(ctx, validator, rewardAmount, blockHeight, moaCompliance, penaltyMultiplier, baseReward)
-}
-
-// AnteilKeeperAdapter adapts anteil keeper to consensus interface
-// Converts *anteilv1.UserPosition to interface{} for interface compatibility
-type AnteilKeeperAdapter struct {
-	keeper *anteilkeeper.Keeper
-}
-
-// GetUserPosition returns user position as interface{}
-func (a *AnteilKeeperAdapter) GetUserPosition(ctx sdk.Context, user string) (interface{}, error) {
-	return a.keeper.GetUserPosition(ctx, user)
-}
-
-// SetUserPosition sets user position
-func (a *AnteilKeeperAdapter) SetUserPosition(ctx sdk.Context, position interface{}) error {
-	// Type assert to *anteilv1.UserPosition
-	userPos, ok := position.(*anteilv1.UserPosition)
-	if !ok {
-		return fmt.Errorf("invalid position type: expected *anteilv1.UserPosition")
-	}
-	return a.keeper.SetUserPosition(ctx, userPos)
-}
-
-// UpdateUserPosition updates user position
-func (a *AnteilKeeperAdapter) UpdateUserPosition(ctx sdk.Context, user string, antBalance string, orderCount uint32) error {
-	return a.keeper.UpdateUserPosition(ctx, user, antBalance, orderCount)
-}
-
-// ConsensusKeeperAdapter adapts consensus keeper to lizenz interface
-// Converts map[string]interface{} to *consensusv1.Validator for interface compatibility
-type ConsensusKeeperAdapter struct {
-	keeper *consensuskeeper.Keeper
-}
-
-// SetValidator sets a validator from interface{} (map[string]interface{})
-func (a *ConsensusKeeperAdapter) SetValidator(ctx sdk.Context, validator interface{}) error {
-	// Type assert to map[string]interface{}
-	validatorMap, ok := validator.(map[string]interface{})
-	if !ok {
-		return fmt.Errorf("invalid validator type: expected map[string]interface{}, got %T", validator)
-	}
-
-	// Safely extract values from map
-	validatorAddr, _ := validatorMap["validator"].(string)
-	antBalance, _ := validatorMap["ant_balance"].(string)
-	status, _ := validatorMap["status"].(int)
-	lastActive, _ := validatorMap["last_active"].(*timestamppb.Timestamp)
-	lastBlockHeight, _ := validatorMap["last_block_height"].(uint64)
-	moaScore, _ := validatorMap["moa_score"].(string)
-	activityScore, _ := validatorMap["activity_score"].(string)
-	totalBlocksCreated, _ := validatorMap["total_blocks_created"].(uint64)
-	totalBurnAmount, _ := validatorMap["total_burn_amount"].(string)
-
-	// Create validator object
-	validatorObj := &consensusv1.Validator{
-		Validator:          validatorAddr,
-		AntBalance:         antBalance,
-		Status:             consensusv1.ValidatorStatus(status),
-		LastActive:         lastActive,
-		LastBlockHeight:    lastBlockHeight,
-		MoaScore:           moaScore,
-		ActivityScore:      activityScore,
-		TotalBlocksCreated: totalBlocksCreated,
-		TotalBurnAmount:    totalBurnAmount,
-	}
-
-	a.keeper.SetValidator(ctx, validatorObj)
-	return nil
-}
-
-// SetValidatorWeight sets validator weight
-func (a *ConsensusKeeperAdapter) SetValidatorWeight(ctx sdk.Context, validator, weight string) error {
-	return a.keeper.SetValidatorWeight(ctx, validator, weight)
-}
-
-// AnteilKeeperAdapterForIdent adapts anteil keeper to ident interface
-// Allows ident module to burn ANT when citizens are deactivated
-type AnteilKeeperAdapterForIdent struct {
-	keeper *anteilkeeper.Keeper
-}
-
-func (a *AnteilKeeperAdapterForIdent) BurnAntFromUser(ctx sdk.Context, user string) error {
-	return a.keeper.BurnAntFromUser(ctx, user)
-}
-
-func (a *AnteilKeeperAdapterForIdent) GetUserPosition(ctx sdk.Context, user string) (interface{}, error) {
-	return a.keeper.GetUserPosition(ctx, user)
-}
-
-// AnteilKeeperAdapterForLizenz adapts anteil keeper to lizenz interface
-// Converts map[string]interface{} to *anteilv1.UserPosition for interface compatibility
-type AnteilKeeperAdapterForLizenz struct {
-	keeper *anteilkeeper.Keeper
-}
-
-// SetUserPosition sets user position from interface{} (map[string]interface{})
-func (a *AnteilKeeperAdapterForLizenz) SetUserPosition(ctx sdk.Context, position interface{}) error {
-	// Type assert to map[string]interface{}
-	positionMap, ok := position.(map[string]interface{})
-	if !ok {
-		return fmt.Errorf("invalid position type: expected map[string]interface{}, got %T", position)
-	}
-
-	// Safely extract values from map
-	owner, _ := positionMap["owner"].(string)
-	antBalance, _ := positionMap["ant_balance"].(string)
-	lockedAnt, _ := positionMap["locked_ant"].(string)
-	availableAnt, _ := positionMap["available_ant"].(string)
-
-	// Create position object
-	positionObj := &anteilv1.UserPosition{
-		Owner:        owner,
-		AntBalance:   antBalance,
-		LockedAnt:    lockedAnt,
-		AvailableAnt: availableAnt,
+		return false
 	}
-
-	return a.keeper.SetUserPosition(ctx, positionObj)
+	return account.IsActive && account.Role == identv1.Role_ROLE_VALIDATOR
 }
 
 // BankKeeperAdapterForConsensus adapts bank keeper to consensus interface
@@ -276,6 +137,11 @@ func (a *BankKeeperAdapterForLizenz) GetBalance(ctx sdk.Context, addr sdk.AccAdd
 	return a.keeper.GetBalance(ctx, addr, denom)
 }
 
+// BurnCoins burns coins from a module account (slashed LZN)
+func (a *BankKeeperAdapterForLizenz) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	return a.keeper.BurnCoins(ctx, moduleName, amt)
+}
+
 // VolnixApp wires BaseApp with custom module keepers and services.
 type VolnixApp struct {
 	*baseapp.BaseApp
@@ -283,15 +149,16 @@ type VolnixApp struct {
 	appCodec codec.Codec
 
 	// store keys
-	keyParams     *storetypes.KVStoreKey
-	tkeyParams    *storetypes.TransientStoreKey
-	keyAuth       *storetypes.KVStoreKey
-	keyBank       *storetypes.KVStoreKey
-	keyIdent      *storetypes.KVStoreKey
-	keyLizenz     *storetypes.KVStoreKey
-	keyAnteil     *storetypes.KVStoreKey
-	keyConsensus  *storetypes.KVStoreKey
-	keyGovernance *storetypes.KVStoreKey
+	keyParams      *storetypes.KVStoreKey
+	tkeyParams     *storetypes.TransientStoreKey
+	keyAuth        *storetypes.KVStoreKey
+	keyBank        *storetypes.KVStoreKey
+	keyIdent       *storetypes.KVStoreKey
+	keyLizenz      *storetypes.KVStoreKey
+	keyAnteil      *storetypes.KVStoreKey
+	keyConsensus   *storetypes.KVStoreKey
+	keyGovernance  *storetypes.KVStoreKey
+	keyIntegration *storetypes.KVStoreKey
 
 	// keepers
 	paramsKeeper paramskeeper.Keeper
@@ -305,9 +172,22 @@ type VolnixApp struct {
 	consensusKeeper  *consensuskeeper.Keeper
 	governanceKeeper *governancekeeper.Keeper
 
+	// integrationKeeper subscribes to the cross-module hooks
+	integrationKeeper *integrationkeeper.Keeper
+
 	// module manager
 	mm *module.Manager
 
+	// sm runs the module simulations
+	sm *module.SimulationManager
+
+	// configurator holds the store migrations the modules registered
+	configurator module.Configurator
+
+	// invariants are checked every invCheckPeriod blocks, 0 disables the checks
+	invariants     *InvariantRegistry
+	invCheckPeriod uint
+
 	// IMPROVED: Upgrade manager for handling network upgrades
 	upgradeManager *UpgradeManager
 
@@ -316,6 +196,12 @@ type VolnixApp struct {
 
 	// IMPROVED: Snapshot manager for State Sync
 	snapshotManager *SnapshotManager
+
+	// blockDelayApplier hands the dynamic block time to the consensus engine
+	blockDelayApplier BlockDelayApplier
+
+	// anteHandler verifies signatures, sequences and fees after rate limiting
+	anteHandler sdk.AnteHandler
 }
 
 func NewVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore io.Writer, encoding EncodingConfig, paramStoreDB cosmosdb.DB) *VolnixApp {
@@ -346,17 +232,19 @@ func NewVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore io.Writer, en
 	keyAnteil := storetypes.NewKVStoreKey(anteiltypes.StoreKey)
 	keyConsensus := storetypes.NewKVStoreKey(consensustypes.StoreKey)
 	keyGovernance := storetypes.NewKVStoreKey(governancetypes.StoreKey)
+	keyIntegration := storetypes.NewKVStoreKey(integrationtypes.StoreKey)
 
 	// Mount stores
 	bapp.MountKVStores(map[string]*storetypes.KVStoreKey{
-		paramtypes.StoreKey:      keyParams,
-		authtypes.StoreKey:       keyAuth,
-		banktypes.StoreKey:       keyBank,
-		identtypes.StoreKey:      keyIdent,
-		lizenztypes.StoreKey:     keyLizenz,
-		anteiltypes.StoreKey:     keyAnteil,
-		consensustypes.StoreKey:  keyConsensus,
-		governancetypes.StoreKey: keyGovernance,
+		paramtypes.StoreKey:       keyParams,
+		authtypes.StoreKey:        keyAuth,
+		banktypes.StoreKey:        keyBank,
+		identtypes.StoreKey:       keyIdent,
+		lizenztypes.StoreKey:      keyLizenz,
+		anteiltypes.StoreKey:      keyAnteil,
+		consensustypes.StoreKey:   keyConsensus,
+		governancetypes.StoreKey:  keyGovernance,
+		integrationtypes.StoreKey: keyIntegration,
 	})
 	bapp.MountTransientStores(map[string]*storetypes.TransientStoreKey{
 		paramtypes.TStoreKey: tkeyParams,
@@ -378,9 +266,9 @@ func NewVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore io.Writer, en
 		authtypes.FeeCollectorName: nil,
 		banktypes.ModuleName:      nil,
 		identtypes.ModuleName:     nil,
-		lizenztypes.ModuleName:    nil,
+		lizenztypes.ModuleName:    {authtypes.Burner}, // slashed LZN is burned
 		anteiltypes.ModuleName:    nil,
-		consensustypes.ModuleName: nil,
+		consensustypes.ModuleName: {authtypes.Minter}, // block rewards are minted
 		governancetypes.ModuleName: nil,
 	}
 	authKeeper := authkeeper.NewAccountKeeper(
@@ -412,39 +300,48 @@ func NewVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore io.Writer, en
 	consensusKeeper := consensuskeeper.NewKeeper(encoding.Codec, keyConsensus, consensusSubspace)
 	governanceKeeper := governancekeeper.NewKeeper(encoding.Codec, keyGovernance, governanceSubspace)
 
-	// Set up governance keeper dependencies for parameter updates
-	// Governance can update parameters in other modules
-	governanceKeeper.SetLizenzKeeper(lizenzKeeper)
-	governanceKeeper.SetAnteilKeeper(anteilKeeper)
-	governanceKeeper.SetConsensusKeeper(consensusKeeper)
+	// Governance executes proposal messages (e.g. MsgUpdateParams) through the Msg service router
+	governanceKeeper.SetRouter(bapp.MsgServiceRouter())
 	bankAdapterForGovernance := &BankKeeperAdapterForGovernance{keeper: bankKeeper}
 	governanceKeeper.SetBankKeeper(bankAdapterForGovernance)
 
 	// Set up consensus keeper dependencies
 	// Consensus needs lizenz keeper for reward distribution and anteil keeper for ANT balances
-	// Create adapter wrappers to convert types for interface compatibility
-	lizenzAdapter := &LizenzKeeperAdapter{keeper: lizenzKeeper}
-	anteilAdapter := &AnteilKeeperAdapter{keeper: anteilKeeper}
-	consensusKeeper.SetLizenzKeeper(lizenzAdapter)
-	consensusKeeper.SetAnteilKeeper(anteilAdapter)
+	consensusKeeper.SetLizenzKeeper(lizenzKeeper)
+	consensusKeeper.SetAnteilKeeper(anteilKeeper)
+	consensusKeeper.SetIdentKeeper(&IdentKeeperAdapterForConsensus{keeper: identKeeper})
 
 	// Set ident keeper in anteil keeper for ANT distribution to citizens
 	anteilKeeper.SetIdentKeeper(identKeeper)
 	bankAdapterForConsensus := &BankKeeperAdapterForConsensus{keeper: bankKeeper}
 	consensusKeeper.SetBankKeeper(bankAdapterForConsensus)
 
-	// Lizenz needs consensus keeper for validator registration, anteil keeper for initial ANT position, and bank keeper for LZN locking
-	// Create adapter wrappers to convert types for interface compatibility
-	consensusAdapterForLizenz := &ConsensusKeeperAdapter{keeper: consensusKeeper}
-	anteilAdapterForLizenz := &AnteilKeeperAdapterForLizenz{keeper: anteilKeeper}
+	// Lizenz needs consensus keeper for validator weights and MOA, anteil keeper for MOA, and bank keeper for LZN locking
 	bankAdapterForLizenz := &BankKeeperAdapterForLizenz{keeper: bankKeeper}
-	lizenzKeeper.SetConsensusKeeper(consensusAdapterForLizenz)
-	lizenzKeeper.SetAnteilKeeper(anteilAdapterForLizenz)
+	lizenzKeeper.SetConsensusKeeper(consensusKeeper)
+	lizenzKeeper.SetAnteilKeeper(anteilKeeper)
 	lizenzKeeper.SetBankKeeper(bankAdapterForLizenz)
+	// Lizenz reads governance votes for MOA measurement
+	lizenzKeeper.SetGovernanceKeeper(governanceKeeper)
 
 	// Ident needs anteil keeper for burning ANT on citizen deactivation
-	anteilAdapterForIdent := &AnteilKeeperAdapterForIdent{keeper: anteilKeeper}
-	identKeeper.SetAnteilKeeper(anteilAdapterForIdent)
+	identKeeper.SetAnteilKeeper(anteilKeeper)
+
+	// Cross-module hooks: set before the module manager copies the keepers
+	// The integration keeper tracks validator status across ident, lizenz, anteil and consensus
+	integrationKeeper := integrationkeeper.NewKeeper(encoding.Codec, keyIntegration, identKeeper, lizenzKeeper, anteilKeeper, consensusKeeper)
+	// Lizenz serves the integration status through its ValidatorIntegration query
+	lizenzKeeper.SetIntegrationKeeper(integrationKeeper)
+	identKeeper.SetHooks(identtypes.NewMultiIdentHooks(integrationKeeper))
+	lizenzKeeper.SetHooks(lizenztypes.NewMultiLizenzHooks(
+		consensusKeeper.LizenzHooks(), // registers validators in consensus
+		anteilKeeper.LizenzHooks(),    // creates the validator's ANT position
+		integrationKeeper,
+	))
+	consensusKeeper.SetHooks(consensustypes.NewMultiConsensusHooks(
+		lizenzKeeper.ConsensusHooks(), // block creation counts as LZN activity
+		integrationKeeper,
+	))
 
 	// Interface registration temporarily disabled for CometBFT integration
 
@@ -452,15 +349,16 @@ func NewVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore io.Writer, en
 	mm := module.NewManager(
 		auth.NewAppModule(encoding.Codec, authKeeper, nil, nil),
 		bank.NewAppModule(encoding.Codec, bankKeeper, authKeeper, nil),
-		ident.NewAppModule(identKeeper),
-		lizenz.NewAppModule(lizenzKeeper),
-		anteil.NewAppModule(anteilKeeper),
-		consensus.NewConsensusAppModule(encoding.Codec, *consensusKeeper),
-		governance.NewAppModule(governanceKeeper),
+		ident.NewAppModule(encoding.Codec, identKeeper, authKeeper, bankKeeper),
+		lizenz.NewAppModule(encoding.Codec, lizenzKeeper, authKeeper, bankKeeper, identKeeper),
+		anteil.NewAppModule(encoding.Codec, anteilKeeper, authKeeper, bankKeeper, identKeeper),
+		consensus.NewConsensusAppModule(encoding.Codec, *consensusKeeper, authKeeper, bankKeeper, identKeeper, anteilKeeper),
+		governance.NewAppModule(encoding.Codec, governanceKeeper, authKeeper, bankKeeper, identKeeper),
+		integration.NewAppModule(*integrationKeeper),
 	)
 
 	// IMPROVED: Create upgrade manager
-	upgradeManager := NewUpgradeManager(logger)
+	upgradeManager := NewUpgradeManager(logger, governanceKeeper)
 
 	// IMPROVED: Create rate limiter with default configuration
 	rateLimiter := NewRateLimiter(DefaultRateLimitConfig())
@@ -478,6 +376,7 @@ func NewVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore io.Writer, en
 		keyAnteil:        keyAnteil,
 		keyConsensus:     keyConsensus,
 		keyGovernance:    keyGovernance,
+		keyIntegration:   keyIntegration,
 		paramsKeeper:     paramsKeeper,
 		authKeeper:       authKeeper,
 		bankKeeper:       bankKeeper,
@@ -486,13 +385,18 @@ func NewVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore io.Writer, en
 		anteilKeeper:     anteilKeeper,
 		consensusKeeper:  consensusKeeper,
 		governanceKeeper: governanceKeeper,
+		integrationKeeper: integrationKeeper,
 		mm:               mm,
 		upgradeManager:   upgradeManager,
 		rateLimiter:      rateLimiter,
 	}
 
 	// IMPROVED: Create snapshot manager after app is created
-	app.snapshotManager = NewSnapshotManager(app)
+	app.snapshotManager = NewSnapshotManager(bapp, logger)
+	bapp.SetPrepareCheckStater(func(ctx sdk.Context) {
+		// Runs right after every commit, with the committed height
+		app.snapshotManager.SnapshotIfDue(ctx)
+	})
 
 	// Register upgrade handlers with app reference
 	SetupUpgradeHandlers(upgradeManager, app)
@@ -506,6 +410,7 @@ func NewVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore io.Writer, en
 		anteil.AppModuleBasic{},
 		consensus.ConsensusAppModuleBasic{},
 		governance.AppModuleBasic{},
+		integration.AppModuleBasic{},
 	)
 	basicManager.RegisterInterfaces(encoding.InterfaceRegistry)
 
@@ -516,17 +421,49 @@ func NewVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore io.Writer, en
 		logger.Error("CRITICAL: Failed to register module services", "error", err)
 		panic(fmt.Errorf("failed to register module services: %w", err))
 	}
+	app.configurator = configurator
+	app.invariants = NewInvariantRegistry(mm)
+
+	// Simulations use base genesis accounts only, the other modules simulate as registered
+	app.sm = module.NewSimulationManagerFromAppModules(mm.Modules, map[string]module.AppModuleSimulation{
+		authtypes.ModuleName: auth.NewAppModule(encoding.Codec, authKeeper, RandomGenesisAccounts, nil),
+	})
+	app.sm.RegisterStoreDecoders()
+
+	// Every Msg must name its signer, the ante chain verifies the signatures of those addresses
+	if err := encoding.InterfaceRegistry.SigningContext().Validate(); err != nil {
+		panic(fmt.Errorf("invalid Msg signers: %w", err))
+	}
+	anteHandler, err := NewAnteHandler(HandlerOptions{
+		AccountKeeper:   authKeeper,
+		BankKeeper:      bankKeeper,
+		SignModeHandler: encoding.TxConfig.SigningConfig.SignModeHandler(),
+		IdentKeeper:     identKeeper,
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to create ante handler: %w", err))
+	}
+	app.anteHandler = anteHandler
+
+	// Guests are held to the stricter per-address rate set in the ident params
+	rateLimiter.SetAddressRateFunc(func(ctx sdk.Context, addr sdk.AccAddress) (float64, bool) {
+		if identKeeper.GetFeeRole(ctx, addr.String()) != identv1.Role_ROLE_GUEST {
+			return 0, false
+		}
+		r, err := identKeeper.GetParams(ctx).GuestTxRate.Float64()
+		return r, err == nil
+	})
 
 	// IMPROVED: Use AnteHandler with rate limiting support
 	bapp.SetAnteHandler(app.createAnteHandler())
 
 	// Set BeginBlocker and EndBlocker for all modules
 	bapp.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
-		// IMPROVED: Check for upgrades at the beginning of each block
+		// Apply the upgrade scheduled through governance for this height, if any
+		// Panics when the binary lacks the handler, halting the node for the new binary
 		if app.upgradeManager != nil {
 			if err := app.upgradeManager.CheckUpgradeNeeded(ctx, app); err != nil {
-				// Log error but don't fail the block
-				logger.Error("Upgrade check failed", "error", err)
+				return sdk.BeginBlock{}, fmt.Errorf("upgrade failed: %w", err)
 			}
 		}
 
@@ -551,78 +488,103 @@ func NewVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore io.Writer, en
 		if err := anteilKeeper.EndBlocker(ctx); err != nil {
 			return sdk.EndBlock{}, fmt.Errorf("anteil EndBlocker failed: %w", err)
 		}
-		if err := consensusKeeper.EndBlocker(ctx); err != nil {
+		// Consensus returns the CometBFT validator set changes derived from PoVB weights
+		validatorUpdates, err := consensusKeeper.EndBlocker(ctx)
+		if err != nil {
 			return sdk.EndBlock{}, fmt.Errorf("consensus EndBlocker failed: %w", err)
 		}
-		return sdk.EndBlock{}, nil
+		// Lizenz runs after consensus so block creator and auction records of this block are final
+		if err := lizenzKeeper.EndBlocker(ctx); err != nil {
+			return sdk.EndBlock{}, fmt.Errorf("lizenz EndBlocker failed: %w", err)
+		}
+		// Integration runs last to prune its event log after all hooks of this block fired
+		if err := integrationKeeper.EndBlocker(ctx); err != nil {
+			return sdk.EndBlock{}, fmt.Errorf("integration EndBlocker failed: %w", err)
+		}
+		// Invariants see the state after every module ended the block
+		if app.invCheckPeriod > 0 && ctx.BlockHeight()%int64(app.invCheckPeriod) == 0 {
+			app.AssertInvariants(ctx)
+		}
+		// Apply the block time derived from this block's ANT burn to the next block
+		if app.blockDelayApplier != nil {
+			app.blockDelayApplier(consensusKeeper.GetNextBlockDelay(ctx))
+		}
+		return sdk.EndBlock{ValidatorUpdates: validatorUpdates}, nil
 	})
 
-	// InitGenesis handler (v0.53 InitChainer signature)
-	bapp.SetInitChainer(func(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
-		// If AppStateBytes is empty, BaseApp will have no-op; the CLI can pass default genesis explicitly
-		// and we also support initializing from provided bytes.
-		var genesisState map[string]json.RawMessage
-		if len(req.AppStateBytes) > 0 {
-			if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
-				return nil, err
-			}
-		} else {
-			// Create default genesis state
-			genesisState = make(map[string]json.RawMessage)
-
-			// Auth and bank genesis (auth first so accounts exist for bank)
-			genesisState[authtypes.ModuleName] = encoding.Codec.MustMarshalJSON(authtypes.DefaultGenesisState())
-			genesisState[banktypes.ModuleName] = encoding.Codec.MustMarshalJSON(banktypes.DefaultGenesisState())
-			// Custom modules genesis
-			genesisState[identtypes.ModuleName] = encoding.Codec.MustMarshalJSON(ident.DefaultGenesis())
-			genesisState[lizenztypes.ModuleName] = encoding.Codec.MustMarshalJSON(lizenz.DefaultGenesis())
-			genesisState[anteiltypes.ModuleName] = encoding.Codec.MustMarshalJSON(anteil.DefaultGenesis())
-			// Consensus genesis with initial validators for ModuleManager HasABCIGenesis (non-empty validator set)
-			consensusGen := consensus.DefaultGenesis()
-			consensusGen.InitialValidators = consensustypes.AbciValidatorsToInitial(req.Validators)
-			genesisState[consensustypes.ModuleName] = encoding.Codec.MustMarshalJSON(consensusGen)
-			// Governance genesis uses JSON marshaling (not proto)
-			govGenState := governance.DefaultGenesis()
-			govGenBz, err := json.Marshal(govGenState)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal governance genesis: %w", err)
-			}
-			genesisState[governancetypes.ModuleName] = govGenBz
+	bapp.SetInitChainer(app.InitChainer)
+
+	return app
+}
+
+// InitChainer initializes the module genesis states (v0.53 InitChainer signature)
+func (app *VolnixApp) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
+	// If AppStateBytes is empty, BaseApp will have no-op; the CLI can pass default genesis explicitly
+	// and we also support initializing from provided bytes.
+	var genesisState map[string]json.RawMessage
+	if len(req.AppStateBytes) > 0 {
+		if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+			return nil, err
 		}
-		// Always inject consensus initial_validators from req.Validators so ModuleManager gets non-empty validator set
-		// (when app_state is {} we unmarshaled empty genesisState and consensus would be skipped otherwise)
-		consensusGen := consensus.DefaultGenesis()
-		if bz := genesisState[consensustypes.ModuleName]; len(bz) > 0 {
-			encoding.Codec.MustUnmarshalJSON(bz, consensusGen)
+	} else {
+		// Create default genesis state
+		genesisState = make(map[string]json.RawMessage)
+
+		// Auth and bank genesis (auth first so accounts exist for bank)
+		genesisState[authtypes.ModuleName] = app.appCodec.MustMarshalJSON(authtypes.DefaultGenesisState())
+		genesisState[banktypes.ModuleName] = app.appCodec.MustMarshalJSON(banktypes.DefaultGenesisState())
+		// Custom modules genesis
+		// Ident genesis uses JSON marshaling (not proto)
+		identGenBz, err := json.Marshal(ident.DefaultGenesis())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal ident genesis: %w", err)
 		}
+		genesisState[identtypes.ModuleName] = identGenBz
+		genesisState[lizenztypes.ModuleName] = app.appCodec.MustMarshalJSON(lizenz.DefaultGenesis())
+		genesisState[anteiltypes.ModuleName] = app.appCodec.MustMarshalJSON(anteil.DefaultGenesis())
+		// Consensus genesis with initial validators for ModuleManager HasABCIGenesis (non-empty validator set)
+		consensusGen := consensus.DefaultGenesis()
 		consensusGen.InitialValidators = consensustypes.AbciValidatorsToInitial(req.Validators)
-		genesisState[consensustypes.ModuleName] = encoding.Codec.MustMarshalJSON(consensusGen)
-
-		_, err := mm.InitGenesis(ctx, encoding.Codec, genesisState)
+		genesisState[consensustypes.ModuleName] = app.appCodec.MustMarshalJSON(consensusGen)
+		// Governance genesis uses JSON marshaling (not proto)
+		govGenState := governance.DefaultGenesis()
+		govGenBz, err := json.Marshal(govGenState)
 		if err != nil {
-			return nil, err
-		}
-
-		// CRITICAL: Return validators in ResponseInitChain
-		// CometBFT uses this to verify validator consistency during replay
-		// If validators are not returned, CometBFT will see mismatch during replay
-		// This is required for proper P2P authentication between validators
-		validators := make([]abci.ValidatorUpdate, len(req.Validators))
-		for i, val := range req.Validators {
-			validators[i] = abci.ValidatorUpdate{
-				PubKey: val.PubKey,
-				Power:  val.Power,
-			}
+			return nil, fmt.Errorf("failed to marshal governance genesis: %w", err)
 		}
+		genesisState[governancetypes.ModuleName] = govGenBz
+		genesisState[integrationtypes.ModuleName] = app.appCodec.MustMarshalJSON(integrationtypes.DefaultGenesis())
+	}
+	// Inject consensus initial_validators from req.Validators when the genesis names none,
+	// so ModuleManager gets a non-empty validator set
+	// (when app_state is {} we unmarshaled empty genesisState and consensus would be skipped otherwise)
+	consensusGen := consensus.DefaultGenesis()
+	if bz := genesisState[consensustypes.ModuleName]; len(bz) > 0 {
+		app.appCodec.MustUnmarshalJSON(bz, consensusGen)
+	}
+	if len(consensusGen.InitialValidators) == 0 {
+		consensusGen.InitialValidators = consensustypes.AbciValidatorsToInitial(req.Validators)
+		genesisState[consensustypes.ModuleName] = app.appCodec.MustMarshalJSON(consensusGen)
+	}
 
-		return &abci.ResponseInitChain{
-			Validators:      validators,
-			ConsensusParams: req.ConsensusParams,
-			AppHash:         []byte{},
-		}, nil
-	})
-
-	return app
+	res, err := app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	if err != nil {
+		return nil, err
+	}
+	// New chains start with every store at its current version
+	app.governanceKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+
+	// CRITICAL: Return validators in ResponseInitChain
+	// CometBFT uses this to verify validator consistency during replay
+	// If validators are not returned, CometBFT will see mismatch during replay
+	// This is required for proper P2P authentication between validators
+	// The set is the one the consensus genesis initialized, which is req.Validators unless the genesis names its own
+	validators := res.Validators
+	return &abci.ResponseInitChain{
+		Validators:      validators,
+		ConsensusParams: req.ConsensusParams,
+		AppHash:         []byte{},
+	}, nil
 }
 
 // ExportAppStateAndValidators exports the state of the application for a genesis file.
@@ -694,24 +656,11 @@ func GetMaccPerms() map[string][]string {
 func (app *VolnixApp) ApplySnapshotChunk(ctx context.Context, req *abci.RequestApplySnapshotChunk) (*abci.ResponseApplySnapshotChunk, error) {
 	if app.snapshotManager == nil {
 		return &abci.ResponseApplySnapshotChunk{
-			Result: abci.ResponseApplySnapshotChunk_ACCEPT,
+			Result: abci.ResponseApplySnapshotChunk_ABORT,
 		}, nil
 	}
 
-	// Apply chunk
-	chunkHash := fmt.Sprintf("%x", req.Chunk)
-	if err := app.snapshotManager.ApplyChunk(req.Index, req.Chunk, chunkHash); err != nil {
-		return &abci.ResponseApplySnapshotChunk{
-			Result:        abci.ResponseApplySnapshotChunk_RETRY,
-			RefetchChunks: []uint32{req.Index},
-		}, err
-	}
-
-	// Check if all chunks are received
-	// This is a simplified check - in production, you'd track which chunks are received
-	return &abci.ResponseApplySnapshotChunk{
-		Result: abci.ResponseApplySnapshotChunk_ACCEPT,
-	}, nil
+	return app.snapshotManager.ApplySnapshotChunkABCI(req)
 }
 
 // LoadSnapshotChunk implements the ABCI interface with context
@@ -720,26 +669,7 @@ func (app *VolnixApp) LoadSnapshotChunk(ctx context.Context, req *abci.RequestLo
 		return &abci.ResponseLoadSnapshotChunk{}, nil
 	}
 
-	// Get snapshot
-	snapshot, exists := app.snapshotManager.GetSnapshot(uint64(req.Height))
-	if !exists {
-		return &abci.ResponseLoadSnapshotChunk{}, fmt.Errorf("snapshot not found at height %d", req.Height)
-	}
-
-	// Get chunk by index
-	if req.Chunk >= snapshot.ChunkCount {
-		return &abci.ResponseLoadSnapshotChunk{}, fmt.Errorf("chunk index %d out of range (max %d)", req.Chunk, snapshot.ChunkCount-1)
-	}
-
-	chunkHash := snapshot.ChunkHashes[req.Chunk]
-	chunk, exists := app.snapshotManager.GetChunk(chunkHash)
-	if !exists {
-		return &abci.ResponseLoadSnapshotChunk{}, fmt.Errorf("chunk %s not found", chunkHash)
-	}
-
-	return &abci.ResponseLoadSnapshotChunk{
-		Chunk: chunk,
-	}, nil
+	return app.snapshotManager.LoadSnapshotChunkABCI(req)
 }
 
 // createAnteHandler creates an AnteHandler with rate limiting support
@@ -753,8 +683,8 @@ func (app *VolnixApp) createAnteHandler() sdk.AnteHandler {
 			}
 		}
 
-		// Continue with standard validation
-		return ImprovedAnteHandler(ctx, tx, simulate)
+		// Continue with message, fee and signature validation
+		return app.anteHandler(ctx, tx, simulate)
 	}
 }
 
@@ -764,22 +694,7 @@ func (app *VolnixApp) ListSnapshots(ctx context.Context, req *abci.RequestListSn
 		return &abci.ResponseListSnapshots{}, nil
 	}
 
-	snapshots := app.snapshotManager.ListSnapshots()
-	abciSnapshots := make([]*abci.Snapshot, 0, len(snapshots))
-
-	for _, snapshot := range snapshots {
-		abciSnapshots = append(abciSnapshots, &abci.Snapshot{
-			Height:   uint64(snapshot.Height),
-			Format:   snapshot.Format,
-			Chunks:   snapshot.ChunkCount,
-			Hash:     snapshot.Hash,
-			Metadata: []byte{}, // Additional metadata can be added here
-		})
-	}
-
-	return &abci.ResponseListSnapshots{
-		Snapshots: abciSnapshots,
-	}, nil
+	return app.snapshotManager.ListSnapshotsABCI(req)
 }
 
 // OfferSnapshot implements the ABCI interface with context
@@ -790,9 +705,5 @@ func (app *VolnixApp) OfferSnapshot(ctx context.Context, req *abci.RequestOfferS
 		}, nil
 	}
 
-	// Extract chunk hashes from metadata if available
-	// For now, we'll accept the snapshot and process chunks as they arrive
-	return &abci.ResponseOfferSnapshot{
-		Result: abci.ResponseOfferSnapshot_ACCEPT,
-	}, nil
+	return app.snapshotManager.OfferSnapshotABCI(req)
 }
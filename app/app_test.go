@@ -5,8 +5,13 @@ import (
 
 	sdklog "cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cosmosdb "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
+
+	consensustypes "github.com/volnix-protocol/volnix-protocol/x/consensus/types"
 )
 
 func TestMakeEncodingConfig(t *testing.T) {
@@ -20,13 +25,22 @@ func TestMakeEncodingConfig(t *testing.T) {
 
 func TestEncodingConfig_TxEncoderDecoder(t *testing.T) {
 	cfg := MakeEncodingConfig()
-	bz, err := cfg.TxConfig.TxEncoder(nil)
+	require.NotNil(t, cfg.TxConfig.SigningConfig)
+
+	builder := cfg.TxConfig.SigningConfig.NewTxBuilder()
+	builder.SetMemo("memo")
+	builder.SetGasLimit(100)
+	bz, err := cfg.TxConfig.TxEncoder(builder.GetTx())
 	require.NoError(t, err)
-	require.NotNil(t, bz)
-	require.Empty(t, bz)
+	require.NotEmpty(t, bz)
+
 	tx, err := cfg.TxConfig.TxDecoder(bz)
 	require.NoError(t, err)
-	require.Nil(t, tx)
+	require.Equal(t, "memo", tx.(sdk.TxWithMemo).GetMemo())
+
+	// Garbage is not a transaction
+	_, err = cfg.TxConfig.TxDecoder([]byte("not a tx"))
+	require.Error(t, err)
 }
 
 func TestDefaultConfig(t *testing.T) {
@@ -257,3 +271,14 @@ func TestGetMaccPerms(t *testing.T) {
 	// Currently returns empty map
 	require.Equal(t, 0, len(perms))
 }
+
+func TestVolnixApp_ConsensusModuleMintsRewards(t *testing.T) {
+	app := NewVolnixApp(sdklog.NewNopLogger(), cosmosdb.NewMemDB(), nil, MakeEncodingConfig(), cosmosdb.NewMemDB())
+	require.NoError(t, app.LoadLatestVersion())
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 1})
+
+	// Block rewards are minted into the consensus module account
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uwrt", 100))
+	require.NoError(t, app.bankKeeper.MintCoins(ctx, consensustypes.ModuleName, coins))
+	require.Equal(t, coins, app.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(consensustypes.ModuleName)))
+}
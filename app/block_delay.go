@@ -0,0 +1,30 @@
+package app
+
+import (
+	"time"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+)
+
+// BlockDelayApplier receives the delay before the next block computed by the consensus module
+type BlockDelayApplier func(delay time.Duration)
+
+// SetBlockDelayApplier sets the function that applies the dynamic block time to the consensus engine
+// It is called at the end of every block, so it must be cheap and must not block
+func (app *VolnixApp) SetBlockDelayApplier(applier BlockDelayApplier) {
+	app.blockDelayApplier = applier
+}
+
+// TimeoutCommitApplier returns a BlockDelayApplier that sets timeout_commit of a CometBFT node
+//
+// CometBFT 0.38 has no per-block delay in ResponseFinalizeBlock; the consensus state reads
+// TimeoutCommit from its config when scheduling the next height after Commit, which runs on
+// the same goroutine as FinalizeBlock, so updating the config here applies to the next block.
+// On CometBFT v1 the delay should be returned as NextBlockDelay in ResponseFinalizeBlock instead.
+func TimeoutCommitApplier(cfg *cmtcfg.ConsensusConfig) BlockDelayApplier {
+	return func(delay time.Duration) {
+		if delay > 0 {
+			cfg.TimeoutCommit = delay
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutCommitApplier(t *testing.T) {
+	cfg := cmtcfg.DefaultConsensusConfig()
+	applier := TimeoutCommitApplier(cfg)
+
+	applier(2500 * time.Millisecond)
+	require.Equal(t, 2500*time.Millisecond, cfg.TimeoutCommit)
+
+	// A zero delay keeps the configured timeout
+	applier(0)
+	require.Equal(t, 2500*time.Millisecond, cfg.TimeoutCommit)
+}
@@ -171,6 +171,11 @@ func MainnetConfig() *Config {
 	return config
 }
 
+// AppConfigPath returns the path of the app configuration in a node home
+func AppConfigPath(homeDir string) string {
+	return filepath.Join(homeDir, "config", "volnix.json")
+}
+
 // LoadConfig loads configuration from file
 func LoadConfig(configPath string) (*Config, error) {
 	// Check if config file exists
@@ -1,9 +1,15 @@
 package app
 
 import (
+	"cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/gogoproto/proto"
 )
 
 // EncodingConfig specifies the concrete encoding types to use for a given app.
@@ -14,36 +20,47 @@ type EncodingConfig struct {
 	LegacyAmino       *codec.LegacyAmino
 }
 
-// TxConfig defines minimal transaction configuration
+// TxConfig defines how transactions are encoded and signed
 type TxConfig struct {
 	TxDecoder sdk.TxDecoder
 	TxEncoder sdk.TxEncoder
+
+	// SigningConfig builds, signs and verifies protobuf transactions
+	// It is nil in minimal configs that do not decode transactions
+	SigningConfig client.TxConfig
 }
 
 // MakeEncodingConfig creates an EncodingConfig for the app.
 func MakeEncodingConfig() EncodingConfig {
-	interfaceRegistry := types.NewInterfaceRegistry()
+	// Signers of every Msg are read from its cosmos.msg.v1.signer field and decoded
+	// with the volnix bech32 prefixes
+	interfaceRegistry, err := types.NewInterfaceRegistryWithOptions(types.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          address.NewBech32Codec("volnix"),
+			ValidatorAddressCodec: address.NewBech32Codec("volnixvaloper"),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	// Crypto keys are needed to decode the signer infos of transactions
+	std.RegisterInterfaces(interfaceRegistry)
 	protoCodec := codec.NewProtoCodec(interfaceRegistry)
 	legacyAmino := codec.NewLegacyAmino()
-	
-	// Create basic tx encoder/decoder
+	std.RegisterLegacyAminoCodec(legacyAmino)
+
+	signingConfig := authtx.NewTxConfig(protoCodec, authtx.DefaultSignModes)
 	txConfig := TxConfig{
-		TxDecoder: func(txBytes []byte) (sdk.Tx, error) {
-			// Basic implementation - in real app would decode protobuf
-			return nil, nil
-		},
-		TxEncoder: func(tx sdk.Tx) ([]byte, error) {
-			// Basic implementation - in real app would encode protobuf
-			return []byte{}, nil
-		},
+		TxDecoder:     signingConfig.TxDecoder(),
+		TxEncoder:     signingConfig.TxEncoder(),
+		SigningConfig: signingConfig,
 	}
 
-	// No interface registration for minimal version
-
 	return EncodingConfig{
 		InterfaceRegistry: interfaceRegistry,
 		Codec:             protoCodec,
 		TxConfig:          txConfig,
 		LegacyAmino:       legacyAmino,
 	}
-}
\ No newline at end of file
+}
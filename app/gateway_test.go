@@ -0,0 +1,102 @@
+package app
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdklog "cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/anteil"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus"
+	"github.com/volnix-protocol/volnix-protocol/x/governance"
+	"github.com/volnix-protocol/volnix-protocol/x/ident"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz"
+)
+
+// newGatewayTestServer serves the REST gateway of the volnix query services from app's committed state
+func newGatewayTestServer(t *testing.T, app *VolnixApp) *httptest.Server {
+	grpcSrv := grpc.NewServer()
+	app.RegisterGRPCServer(grpcSrv)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = grpcSrv.Serve(listener) }()
+	t.Cleanup(grpcSrv.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	encoding := MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithGRPCClient(conn).
+		WithCodec(encoding.Codec).
+		WithInterfaceRegistry(encoding.InterfaceRegistry)
+	apiSrv := api.New(clientCtx, sdklog.NewNopLogger(), grpcSrv)
+	anteil.AppModuleBasic{}.RegisterGRPCGatewayRoutes(clientCtx, apiSrv.GRPCGatewayRouter)
+	ident.AppModuleBasic{}.RegisterGRPCGatewayRoutes(clientCtx, apiSrv.GRPCGatewayRouter)
+	lizenz.AppModuleBasic{}.RegisterGRPCGatewayRoutes(clientCtx, apiSrv.GRPCGatewayRouter)
+	consensus.ConsensusAppModuleBasic{}.RegisterGRPCGatewayRoutes(clientCtx, apiSrv.GRPCGatewayRouter)
+	governance.AppModuleBasic{}.RegisterGRPCGatewayRoutes(clientCtx, apiSrv.GRPCGatewayRouter)
+
+	server := httptest.NewServer(apiSrv.GRPCGatewayRouter)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGRPCGatewayRoutes(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	validator := abci.ValidatorUpdate{PubKey: cmtcrypto.PublicKey{Sum: &cmtcrypto.PublicKey_Ed25519{Ed25519: make([]byte, 32)}}, Power: 10}
+	_, err := app.InitChain(&abci.RequestInitChain{ChainId: app.ChainID(), InitialHeight: 1, Validators: []abci.ValidatorUpdate{validator}})
+	require.NoError(t, err)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 1})
+	require.NoError(t, app.anteilKeeper.SetOrder(ctx, &anteilv1.Order{OrderId: "order1", Owner: "owner1", AntAmount: "10", Price: "1.5", IdentityHash: "hash"}))
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	server := newGatewayTestServer(t, app)
+	get := func(path string) (int, string) {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(body)
+	}
+
+	status, body := get("/volnix/anteil/v1/order/order1")
+	require.Equal(t, http.StatusOK, status, body)
+	require.Contains(t, body, `"owner":"owner1"`)
+
+	// The anteil params are returned as a JSON string
+	for path, field := range map[string]string{
+		"/volnix/anteil/v1/params":              `"json"`,
+		"/volnix/ident/v1/params":               `"params"`,
+		"/volnix/lizenz/v1/params":              `"params"`,
+		"/volnix/consensus/v1/params":           `"params"`,
+		"/volnix/consensus/v1/halving_schedule": `"halvings"`,
+		"/volnix/governance/v1/params":          `"params"`,
+		"/volnix/governance/v1/proposals":       `"proposals"`,
+	} {
+		status, body = get(path)
+		require.Equal(t, http.StatusOK, status, path+": "+body)
+		require.Contains(t, body, field, path)
+	}
+
+	status, body = get("/volnix/anteil/v1/order/missing")
+	require.NotEqual(t, http.StatusOK, status)
+	require.Contains(t, body, "not found")
+}
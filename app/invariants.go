@@ -0,0 +1,91 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// FlagInvCheckPeriod is the start flag setting the number of blocks between invariant checks
+const FlagInvCheckPeriod = "inv-check-period"
+
+// InvariantRoute is an invariant registered by a module
+type InvariantRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.Invariant
+}
+
+// FullRoute returns the route as "module/route"
+func (r InvariantRoute) FullRoute() string {
+	return r.ModuleName + "/" + r.Route
+}
+
+// InvariantRegistry collects the module invariants, in the way x/crisis did before it was removed from the SDK
+type InvariantRegistry struct {
+	routes []InvariantRoute
+}
+
+var _ sdk.InvariantRegistry = (*InvariantRegistry)(nil)
+
+// NewInvariantRegistry registers the invariants of every module in the manager, in genesis order
+func NewInvariantRegistry(mm *module.Manager) *InvariantRegistry {
+	ir := &InvariantRegistry{}
+	for _, name := range mm.OrderInitGenesis {
+		if m, ok := mm.Modules[name].(module.HasInvariants); ok {
+			m.RegisterInvariants(ir)
+		}
+	}
+	return ir
+}
+
+// RegisterRoute adds an invariant to the registry
+func (ir *InvariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	ir.routes = append(ir.routes, InvariantRoute{ModuleName: moduleName, Route: route, Invar: invar})
+}
+
+// Routes returns the registered invariants
+func (ir *InvariantRegistry) Routes() []InvariantRoute {
+	return ir.routes
+}
+
+// InvariantResult is the outcome of one invariant
+type InvariantResult struct {
+	Route  string
+	Msg    string
+	Broken bool
+}
+
+// CheckInvariants runs every registered invariant against the state of ctx
+func (app *VolnixApp) CheckInvariants(ctx sdk.Context) []InvariantResult {
+	results := make([]InvariantResult, 0, len(app.invariants.Routes()))
+	for _, route := range app.invariants.Routes() {
+		msg, broken := route.Invar(ctx)
+		results = append(results, InvariantResult{Route: route.FullRoute(), Msg: msg, Broken: broken})
+	}
+	return results
+}
+
+// AssertInvariants halts the node with a report of the broken invariants, if any
+func (app *VolnixApp) AssertInvariants(ctx sdk.Context) {
+	var report strings.Builder
+	for _, result := range app.CheckInvariants(ctx) {
+		if result.Broken {
+			report.WriteString(result.Msg)
+		}
+	}
+	if report.Len() == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("invariant broken at height %d:\n%s", ctx.BlockHeight(), report.String())
+	app.Logger().Error(msg)
+	panic(msg)
+}
+
+// SetInvCheckPeriod sets the number of blocks between invariant checks, 0 disables them
+func (app *VolnixApp) SetInvCheckPeriod(period uint) {
+	app.invCheckPeriod = period
+}
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+func TestInvariantRegistry_Routes(t *testing.T) {
+	app := newMonitoringTestApp(t)
+
+	var routes []string
+	for _, route := range app.invariants.Routes() {
+		routes = append(routes, route.FullRoute())
+	}
+	require.Equal(t, []string{
+		"ident/unique-identity-hash",
+		"lizenz/activated-total",
+		"lizenz/validator-cap",
+		"anteil/ant-supply",
+		"governance/tallies",
+	}, routes)
+}
+
+func TestAssertInvariants_HaltsWithReport(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 7})
+	require.NoError(t, app.anteilKeeper.SetUserPosition(ctx, anteiltypes.NewUserPosition("volnix1alice", "500")))
+	app.anteilKeeper.SetAntSupply(ctx, 500)
+
+	require.NotPanics(t, func() { app.AssertInvariants(ctx) })
+	for _, result := range app.CheckInvariants(ctx) {
+		require.False(t, result.Broken, result.Msg)
+	}
+
+	// ANT written around the keeper is not in the minted supply
+	bz, err := app.appCodec.Marshal(&anteilv1.UserPosition{Owner: "volnix1bob", AntBalance: "20"})
+	require.NoError(t, err)
+	ctx.KVStore(app.keyAnteil).Set(anteiltypes.GetUserPositionKey("volnix1bob"), bz)
+
+	require.PanicsWithValue(t,
+		"invariant broken at height 7:\nanteil: ant-supply invariant\n\tsum of 2 position balances: 520\n\tANT in bond escrow: 0\n\tminted ANT: 500\n\n",
+		func() { app.AssertInvariants(ctx) })
+}
@@ -16,6 +16,9 @@ import (
 type MinimalVolnixApp struct {
 	*baseapp.BaseApp
 	appCodec codec.Codec
+
+	// Snapshot manager for State Sync
+	snapshotManager *SnapshotManager
 }
 
 // NewMinimalVolnixApp creates a minimal Volnix app for CometBFT testing
@@ -26,8 +29,9 @@ func NewMinimalVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore interf
 	bapp.SetTxEncoder(encoding.TxConfig.TxEncoder)
 
 	app := &MinimalVolnixApp{
-		BaseApp:  bapp,
-		appCodec: encoding.Codec,
+		BaseApp:         bapp,
+		appCodec:        encoding.Codec,
+		snapshotManager: NewSnapshotManager(bapp, logger),
 	}
 
 	// Set minimal ABCI handlers
@@ -43,6 +47,10 @@ func NewMinimalVolnixApp(logger sdklog.Logger, db cosmosdb.DB, traceStore interf
 		return &abci.ResponseInitChain{}, nil
 	})
 
+	bapp.SetPrepareCheckStater(func(ctx sdk.Context) {
+		app.snapshotManager.SnapshotIfDue(ctx)
+	})
+
 	// Set minimal AnteHandler
 	bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
 		return ctx, nil
@@ -72,24 +80,20 @@ func (app *MinimalVolnixApp) ModuleAccountAddrs() map[string]bool {
 
 // ApplySnapshotChunk implements the ABCI interface with context
 func (app *MinimalVolnixApp) ApplySnapshotChunk(ctx context.Context, req *abci.RequestApplySnapshotChunk) (*abci.ResponseApplySnapshotChunk, error) {
-	return &abci.ResponseApplySnapshotChunk{
-		Result: abci.ResponseApplySnapshotChunk_ACCEPT,
-	}, nil
+	return app.snapshotManager.ApplySnapshotChunkABCI(req)
 }
 
 // LoadSnapshotChunk implements the ABCI interface with context
 func (app *MinimalVolnixApp) LoadSnapshotChunk(ctx context.Context, req *abci.RequestLoadSnapshotChunk) (*abci.ResponseLoadSnapshotChunk, error) {
-	return &abci.ResponseLoadSnapshotChunk{}, nil
+	return app.snapshotManager.LoadSnapshotChunkABCI(req)
 }
 
 // ListSnapshots implements the ABCI interface with context
 func (app *MinimalVolnixApp) ListSnapshots(ctx context.Context, req *abci.RequestListSnapshots) (*abci.ResponseListSnapshots, error) {
-	return &abci.ResponseListSnapshots{}, nil
+	return app.snapshotManager.ListSnapshotsABCI(req)
 }
 
 // OfferSnapshot implements the ABCI interface with context
 func (app *MinimalVolnixApp) OfferSnapshot(ctx context.Context, req *abci.RequestOfferSnapshot) (*abci.ResponseOfferSnapshot, error) {
-	return &abci.ResponseOfferSnapshot{
-		Result: abci.ResponseOfferSnapshot_REJECT,
-	}, nil
+	return app.snapshotManager.OfferSnapshotABCI(req)
 }
@@ -63,6 +63,9 @@ func NewMinimalCometBFTServer(homeDir string, logger log.Logger) (*MinimalVolnix
 	
 	// Create minimal Volnix app
 	app := NewMinimalVolnixApp(logger, db, nil, encodingConfig)
+	if err := app.SetSnapshotOptions(DefaultSnapshotOptions(homeDir)); err != nil {
+		return nil, fmt.Errorf("failed to configure snapshots: %w", err)
+	}
 	
 	// Create CometBFT config
 	config := cmtcfg.DefaultConfig()
@@ -196,6 +199,9 @@ func (s *MinimalVolnixServer) createCometBFTNode() error {
 	// Create metrics provider (disabled for now)
 	metricsProvider := node.DefaultMetricsProvider(s.config.Instrumentation)
 	
+	// The minimal app has no consensus module, so timeout_commit stays as configured
+	// and no block delay applier is set; VolnixServer wires TimeoutCommitApplier
+
 	// Create ABCI wrapper and client creator
 	abciWrapper := NewABCIWrapper(s.app)
 	clientCreator := proxy.NewLocalClientCreator(abciWrapper)
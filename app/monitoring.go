@@ -3,35 +3,122 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+)
+
+// Prometheus metrics exported on /metrics
+var (
+	chainHeightDesc = prometheus.NewDesc("volnix_chain_height",
+		"Height of the last committed block the metrics were collected at", nil, nil)
+	validatorsDesc = prometheus.NewDesc("volnix_consensus_validators",
+		"Number of consensus validators by status", []string{"status"}, nil)
+	antBurnedDesc = prometheus.NewDesc("volnix_consensus_ant_burned_total",
+		"Total ANT burned for block creation", nil, nil)
+	halvingsDesc = prometheus.NewDesc("volnix_consensus_halvings",
+		"Number of block reward halvings so far", nil, nil)
+	rewardsEmittedDesc = prometheus.NewDesc("volnix_consensus_rewards_emitted_total",
+		"Micro WRT minted for block rewards", nil, nil)
+	rewardStakeDesc = prometheus.NewDesc("volnix_consensus_reward_stake",
+		"Activated LZN earning block rewards", nil, nil)
+	auctionsDesc = prometheus.NewDesc("volnix_anteil_auctions",
+		"Number of ANT auctions by status", []string{"status"}, nil)
+	auctionBidsDesc = prometheus.NewDesc("volnix_anteil_auction_bids",
+		"Number of bids in open ANT auctions", nil, nil)
+	auctionParticipantsDesc = prometheus.NewDesc("volnix_anteil_auction_participants",
+		"Number of distinct bidders in open ANT auctions", nil, nil)
+	openOrdersDesc = prometheus.NewDesc("volnix_anteil_open_orders",
+		"Number of open ANT orders by side", []string{"side"}, nil)
+	tradesDesc = prometheus.NewDesc("volnix_anteil_trades_total",
+		"Number of executed ANT trades", nil, nil)
+	tradeVolumeDesc = prometheus.NewDesc("volnix_anteil_trade_volume_total",
+		"ANT amount traded", nil, nil)
+	verifiedAccountsDesc = prometheus.NewDesc("volnix_ident_verified_accounts",
+		"Number of verified accounts by role", []string{"role"}, nil)
+	roleMigrationsDesc = prometheus.NewDesc("volnix_ident_role_migrations_total",
+		"Number of role migrations", nil, nil)
+	proposalsDesc = prometheus.NewDesc("volnix_governance_proposals",
+		"Number of governance proposals by status", []string{"status"}, nil)
 )
 
 // MonitoringService provides monitoring and metrics for Volnix Protocol
+// Metrics are read from the last committed state and cached for MetricsInterval.
+// mu only guards the cached snapshot; it is not held while the state is read.
 type MonitoringService struct {
-	app    *VolnixApp
-	logger log.Logger
-	server *http.Server
+	app      *VolnixApp
+	logger   log.Logger
+	config   MonitoringConfig
+	server   *http.Server
+	registry *prometheus.Registry
+
+	mu       sync.Mutex
+	snapshot *metricsSnapshot
+}
+
+// metricsSnapshot holds the metrics collected at one committed height
+type metricsSnapshot struct {
+	height      int64
+	collectedAt time.Time
+
+	validatorsByStatus map[string]int
+	totalANTBurned     float64
+	totalWeight        float64
+	halvingCount       uint64
+	rewardsEmitted     float64
+	rewardStake        uint64
+
+	auctionsByStatus    map[string]int
+	auctionBids         int
+	auctionParticipants int
+	openOrdersBySide    map[string]int
+	tradeCount          uint64
+	tradeVolume         float64
+
+	accountsByRole map[string]int
+	roleMigrations int
+
+	proposalsByStatus map[string]int
 }
 
 // NewMonitoringService creates a new monitoring service
-func NewMonitoringService(app *VolnixApp, logger log.Logger) *MonitoringService {
-	return &MonitoringService{
-		app:    app,
-		logger: logger,
+func NewMonitoringService(app *VolnixApp, logger log.Logger, config MonitoringConfig) *MonitoringService {
+	ms := &MonitoringService{
+		app:      app,
+		logger:   logger,
+		config:   config,
+		registry: prometheus.NewRegistry(),
 	}
+	ms.registry.MustRegister(ms)
+	return ms
 }
 
-// Start starts the monitoring service
+// Start starts serving the monitoring endpoints on port in the background
+// It returns once the port is bound, or with the error binding it
 func (ms *MonitoringService) Start(port string) error {
 	mux := http.NewServeMux()
 
 	// Register endpoints
 	mux.HandleFunc("/health", ms.healthHandler)
-	mux.HandleFunc("/metrics", ms.metricsHandler)
+	if ms.config.PrometheusEnabled {
+		mux.Handle("/metrics", promhttp.HandlerFor(ms.registry, promhttp.HandlerOpts{}))
+	}
 	mux.HandleFunc("/status", ms.statusHandler)
 	mux.HandleFunc("/consensus", ms.consensusHandler)
 	mux.HandleFunc("/economic", ms.economicHandler)
@@ -42,8 +129,18 @@ func (ms *MonitoringService) Start(port string) error {
 		Handler: mux,
 	}
 
+	listener, err := net.Listen("tcp", ms.server.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on monitoring port %s: %w", port, err)
+	}
+
 	ms.logger.Info("Starting monitoring service", "port", port)
-	return ms.server.ListenAndServe()
+	go func() {
+		if err := ms.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			ms.logger.Error("monitoring service stopped", "error", err)
+		}
+	}()
+	return nil
 }
 
 // Stop stops the monitoring service
@@ -56,6 +153,179 @@ func (ms *MonitoringService) Stop() error {
 	return nil
 }
 
+// Describe implements prometheus.Collector
+func (ms *MonitoringService) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		chainHeightDesc, validatorsDesc, antBurnedDesc, halvingsDesc, rewardsEmittedDesc, rewardStakeDesc,
+		auctionsDesc, auctionBidsDesc, auctionParticipantsDesc, openOrdersDesc, tradesDesc, tradeVolumeDesc,
+		verifiedAccountsDesc, roleMigrationsDesc, proposalsDesc,
+	} {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector
+func (ms *MonitoringService) Collect(ch chan<- prometheus.Metric) {
+	s, err := ms.currentSnapshot()
+	if err != nil {
+		ms.logger.Error("failed to collect metrics", "error", err)
+		return
+	}
+
+	gauge := func(desc *prometheus.Desc, value float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, labels...)
+	}
+	counter := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value)
+	}
+	gaugeByLabel := func(desc *prometheus.Desc, values map[string]int) {
+		for label, value := range values {
+			gauge(desc, float64(value), label)
+		}
+	}
+
+	gauge(chainHeightDesc, float64(s.height))
+
+	gaugeByLabel(validatorsDesc, s.validatorsByStatus)
+	counter(antBurnedDesc, s.totalANTBurned)
+	gauge(halvingsDesc, float64(s.halvingCount))
+	counter(rewardsEmittedDesc, s.rewardsEmitted)
+	gauge(rewardStakeDesc, float64(s.rewardStake))
+
+	gaugeByLabel(auctionsDesc, s.auctionsByStatus)
+	gauge(auctionBidsDesc, float64(s.auctionBids))
+	gauge(auctionParticipantsDesc, float64(s.auctionParticipants))
+	gaugeByLabel(openOrdersDesc, s.openOrdersBySide)
+	counter(tradesDesc, float64(s.tradeCount))
+	counter(tradeVolumeDesc, s.tradeVolume)
+
+	gaugeByLabel(verifiedAccountsDesc, s.accountsByRole)
+	counter(roleMigrationsDesc, float64(s.roleMigrations))
+
+	gaugeByLabel(proposalsDesc, s.proposalsByStatus)
+}
+
+// currentSnapshot returns the cached metrics, collecting them again from the last committed
+// state once they are older than MetricsInterval
+func (ms *MonitoringService) currentSnapshot() (*metricsSnapshot, error) {
+	ms.mu.Lock()
+	cached := ms.snapshot
+	ms.mu.Unlock()
+
+	if cached != nil && time.Since(cached.collectedAt) < ms.config.MetricsInterval {
+		return cached, nil
+	}
+
+	// Height 0 selects the last committed height
+	ctx, err := ms.app.CreateQueryContext(0, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create query context: %w", err)
+	}
+
+	snapshot, err := ms.collectSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Concurrent scrapes may collect at the same time; keep the most recent snapshot
+	ms.mu.Lock()
+	if ms.snapshot == nil || !snapshot.collectedAt.Before(ms.snapshot.collectedAt) {
+		ms.snapshot = snapshot
+	}
+	ms.mu.Unlock()
+	return snapshot, nil
+}
+
+// collectSnapshot reads the metrics of every module from the given state
+func (ms *MonitoringService) collectSnapshot(ctx sdk.Context) (*metricsSnapshot, error) {
+	s := &metricsSnapshot{
+		height:             ctx.BlockHeight(),
+		collectedAt:        time.Now(),
+		validatorsByStatus: make(map[string]int),
+		auctionsByStatus:   make(map[string]int),
+		openOrdersBySide:   make(map[string]int),
+		accountsByRole:     make(map[string]int),
+		proposalsByStatus:  make(map[string]int),
+	}
+
+	if k := ms.app.consensusKeeper; k != nil {
+		for _, v := range k.GetAllValidators(ctx) {
+			s.validatorsByStatus[enumLabel(v.Status.String(), "VALIDATOR_STATUS_")]++
+		}
+		if state, err := k.GetConsensusState(ctx); err == nil {
+			s.totalANTBurned = parseAmount(state.TotalAntBurned)
+		}
+		weights, err := k.GetAllValidatorWeights(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get validator weights: %w", err)
+		}
+		for i := range weights {
+			s.totalWeight += parseAmount(weights[i].Weight)
+		}
+		s.halvingCount = k.GetHalvingCount(uint64(ctx.BlockHeight()))
+		if pool, found := k.GetRewardPool(ctx); found {
+			s.rewardsEmitted = parseAmount(pool.TotalEmitted)
+			s.rewardStake = pool.TotalStake
+		}
+	}
+
+	if k := ms.app.anteilKeeper; k != nil {
+		auctions, err := k.GetAllAuctions(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get auctions: %w", err)
+		}
+		bidders := make(map[string]bool)
+		for _, auction := range auctions {
+			s.auctionsByStatus[enumLabel(auction.Status.String(), "AUCTION_STATUS_")]++
+			if auction.Status != anteilv1.AuctionStatus_AUCTION_STATUS_OPEN {
+				continue
+			}
+			s.auctionBids += len(auction.Bids)
+			for _, bid := range auction.Bids {
+				bidders[bid.Bidder] = true
+			}
+		}
+		s.auctionParticipants = len(bidders)
+
+		// Orders and trades grow without bound: read the open order index and the trade totals
+		for _, side := range []anteilv1.OrderSide{anteilv1.OrderSide_ORDER_SIDE_BUY, anteilv1.OrderSide_ORDER_SIDE_SELL} {
+			s.openOrdersBySide[enumLabel(side.String(), "ORDER_SIDE_")] = int(k.CountOpenOrders(ctx, side))
+		}
+
+		var volume math.LegacyDec
+		s.tradeCount, volume = k.GetTradeTotals(ctx)
+		s.tradeVolume = parseAmount(volume.String())
+	}
+
+	if k := ms.app.identKeeper; k != nil {
+		accounts, err := k.GetAllVerifiedAccounts(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get verified accounts: %w", err)
+		}
+		for _, account := range accounts {
+			s.accountsByRole[enumLabel(account.Role.String(), "ROLE_")]++
+		}
+
+		migrations, err := k.GetAllRoleMigrations(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role migrations: %w", err)
+		}
+		s.roleMigrations = len(migrations)
+	}
+
+	if k := ms.app.governanceKeeper; k != nil {
+		proposals, err := k.GetAllProposals(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get proposals: %w", err)
+		}
+		for _, proposal := range proposals {
+			s.proposalsByStatus[enumLabel(proposal.Status.String(), "PROPOSAL_STATUS_")]++
+		}
+	}
+
+	return s, nil
+}
+
 // healthHandler provides health check endpoint
 func (ms *MonitoringService) healthHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
@@ -64,32 +334,23 @@ func (ms *MonitoringService) healthHandler(w http.ResponseWriter, r *http.Reques
 		"status":    "healthy",
 		"timestamp": time.Now().Unix(),
 		"version":   "0.1.0-alpha",
-		"chain_id":  "test-volnix",
+		"chain_id":  ms.app.ChainID(),
 	}
 
 	json.NewEncoder(w).Encode(health)
 }
 
-// metricsHandler provides Prometheus-style metrics
-func (ms *MonitoringService) metricsHandler(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "text/plain")
-
-	// Collect metrics (simplified for monitoring)
-	metrics := ms.collectAllMetrics()
-
-	// Format as Prometheus metrics
-	for key, value := range metrics {
-		fmt.Fprintf(w, "volnix_%s %v\n", key, value)
-	}
-}
-
 // statusHandler provides overall system status
 func (ms *MonitoringService) statusHandler(w http.ResponseWriter, r *http.Request) {
+	s, ok := ms.snapshotOrError(w)
+	if !ok {
+		return
+	}
 	w.Header().Set("Content-Type", "application/json")
 
 	status := map[string]interface{}{
-		"chain_id":      "test-volnix",
-		"latest_height": 0, // Would get from actual context
+		"chain_id":      ms.app.ChainID(),
+		"latest_height": s.height,
 		"timestamp":     time.Now().Unix(),
 		"modules": map[string]interface{}{
 			"ident":     "active",
@@ -98,8 +359,7 @@ func (ms *MonitoringService) statusHandler(w http.ResponseWriter, r *http.Reques
 			"consensus": "active",
 		},
 		"network": map[string]interface{}{
-			"peers":      0,
-			"validators": ms.getValidatorCount(),
+			"validators": sumCounts(s.validatorsByStatus),
 			"consensus":  "PoVB",
 		},
 	}
@@ -109,211 +369,95 @@ func (ms *MonitoringService) statusHandler(w http.ResponseWriter, r *http.Reques
 
 // consensusHandler provides consensus-specific metrics
 func (ms *MonitoringService) consensusHandler(w http.ResponseWriter, r *http.Request) {
+	s, ok := ms.snapshotOrError(w)
+	if !ok {
+		return
+	}
 	w.Header().Set("Content-Type", "application/json")
 
-	// Get consensus metrics
-	consensusMetrics := ms.getConsensusMetrics()
-
-	json.NewEncoder(w).Encode(consensusMetrics)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"height":              s.height,
+		"total_validators":    sumCounts(s.validatorsByStatus),
+		"active_validators":   s.validatorsByStatus[enumLabel(consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE.String(), "VALIDATOR_STATUS_")],
+		"validators":          s.validatorsByStatus,
+		"total_burned_tokens": s.totalANTBurned,
+		"total_weight":        s.totalWeight,
+		"halving_count":       s.halvingCount,
+		"rewards_emitted":     s.rewardsEmitted,
+		"reward_stake":        s.rewardStake,
+	})
 }
 
 // economicHandler provides economic metrics
 func (ms *MonitoringService) economicHandler(w http.ResponseWriter, r *http.Request) {
+	s, ok := ms.snapshotOrError(w)
+	if !ok {
+		return
+	}
 	w.Header().Set("Content-Type", "application/json")
 
-	// Get economic metrics
-	economicMetrics := ms.getEconomicMetrics()
-
-	json.NewEncoder(w).Encode(economicMetrics)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"height":               s.height,
+		"active_orders":        sumCounts(s.openOrdersBySide),
+		"open_orders":          s.openOrdersBySide,
+		"completed_trades":     s.tradeCount,
+		"total_volume":         s.tradeVolume,
+		"active_auctions":      s.auctionsByStatus[enumLabel(anteilv1.AuctionStatus_AUCTION_STATUS_OPEN.String(), "AUCTION_STATUS_")],
+		"auctions":             s.auctionsByStatus,
+		"auction_bids":         s.auctionBids,
+		"auction_participants": s.auctionParticipants,
+	})
 }
 
 // identityHandler provides identity system metrics
 func (ms *MonitoringService) identityHandler(w http.ResponseWriter, r *http.Request) {
+	s, ok := ms.snapshotOrError(w)
+	if !ok {
+		return
+	}
 	w.Header().Set("Content-Type", "application/json")
 
-	// Get identity metrics
-	identityMetrics := ms.getIdentityMetrics()
-
-	json.NewEncoder(w).Encode(identityMetrics)
+	role := func(r identv1.Role) int { return s.accountsByRole[enumLabel(r.String(), "ROLE_")] }
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"height":            s.height,
+		"verified_accounts": sumCounts(s.accountsByRole),
+		"citizens":          role(identv1.Role_ROLE_CITIZEN),
+		"validators":        role(identv1.Role_ROLE_VALIDATOR),
+		"guests":            role(identv1.Role_ROLE_GUEST),
+		"role_migrations":   s.roleMigrations,
+		"proposals":         s.proposalsByStatus,
+		"open_proposals":    s.proposalsByStatus[enumLabel(governancev1.ProposalStatus_PROPOSAL_STATUS_VOTING.String(), "PROPOSAL_STATUS_")],
+	})
 }
 
-// collectAllMetrics collects metrics from all modules
-func (ms *MonitoringService) collectAllMetrics() map[string]interface{} {
-	metrics := make(map[string]interface{})
-
-	// System metrics
-	metrics["uptime_seconds"] = time.Now().Unix() // Simplified
-	metrics["chain_height"] = 0                   // Would get from actual context
-
-	// Consensus metrics
-	consensusMetrics := ms.getConsensusMetrics()
-	metrics["consensus_validators_total"] = consensusMetrics["total_validators"]
-	metrics["consensus_validators_active"] = consensusMetrics["active_validators"]
-	metrics["consensus_burned_tokens"] = consensusMetrics["total_burned_tokens"]
-
-	// Economic metrics
-	economicMetrics := ms.getEconomicMetrics()
-	metrics["economic_orders_total"] = economicMetrics["total_orders"]
-	metrics["economic_orders_active"] = economicMetrics["active_orders"]
-	metrics["economic_volume_24h"] = economicMetrics["volume_24h"]
-
-	// Identity metrics
-	identityMetrics := ms.getIdentityMetrics()
-	metrics["identity_verified_accounts"] = identityMetrics["verified_accounts"]
-	metrics["identity_pending_verifications"] = identityMetrics["pending_verifications"]
-
-	return metrics
-}
-
-// getConsensusMetrics gets consensus system metrics
-func (ms *MonitoringService) getConsensusMetrics() map[string]interface{} {
-	metrics := map[string]interface{}{
-		"total_validators":     0,
-		"active_validators":    0,
-		"total_burned_tokens":  0,
-		"total_weight":         0,
-		"halving_count":        0,
-		"next_halving_height":  0,
+// snapshotOrError returns the current metrics or writes a 503 when no state has been committed yet
+func (ms *MonitoringService) snapshotOrError(w http.ResponseWriter) (*metricsSnapshot, bool) {
+	s, err := ms.currentSnapshot()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return nil, false
 	}
-
-	// TODO: Get actual metrics from consensus keeper when sdk.Context is available for monitoring.
-	// See docs/CODE_STUBS.md — proper context management (e.g. last committed state) is required.
-	metrics["total_validators"] = 0
-	metrics["active_validators"] = 0
-	metrics["total_burned_tokens"] = 0
-	metrics["total_weight"] = 0
-
-	return metrics
+	return s, true
 }
 
-// getEconomicMetrics gets economic system metrics
-func (ms *MonitoringService) getEconomicMetrics() map[string]interface{} {
-	metrics := map[string]interface{}{
-		"total_orders":     0,
-		"active_orders":    0,
-		"completed_orders": 0,
-		"volume_24h":       0,
-		"total_volume":     0,
-		"active_auctions":  0,
-		"avg_price":        0,
-	}
-
-	// Get actual metrics from anteil keeper
-	if ms.app.anteilKeeper != nil {
-		// Note: Monitoring needs sdk.Context; see docs/CODE_STUBS.md.
-		return metrics
-
-		/* Commented out until proper context is available
-		ctx := sdk.UnwrapSDKContext(context.Background())
-		// Get all orders
-		orders, err := ms.app.AnteilKeeper.GetAllOrders(ctx)
-		if err == nil {
-			metrics["total_orders"] = len(orders)
-			
-			// Count active orders
-			activeCount := 0
-			for _, order := range orders {
-				if order.Status == 1 { // OPEN status
-					activeCount++
-				}
-			}
-			metrics["active_orders"] = activeCount
-		}
-		
-		// Get all auctions
-		auctions, err := ms.app.AnteilKeeper.GetAllAuctions(ctx)
-		if err == nil {
-			activeAuctions := 0
-			for _, auction := range auctions {
-				if auction.Status == 1 { // OPEN status
-					activeAuctions++
-				}
-			}
-			metrics["active_auctions"] = activeAuctions
-		}
-		
-		// Get all trades
-		trades, err := ms.app.AnteilKeeper.GetAllTrades(ctx)
-		if err == nil {
-			metrics["completed_orders"] = len(trades)
-		}
-		*/
-	}
-
-	return metrics
+// enumLabel turns a protobuf enum name such as ORDER_STATUS_OPEN into a metric label such as "open"
+func enumLabel(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
 }
 
-// getIdentityMetrics gets identity system metrics
-func (ms *MonitoringService) getIdentityMetrics() map[string]interface{} {
-	metrics := map[string]interface{}{
-		"verified_accounts":         0,
-		"pending_verifications":     0,
-		"total_accounts":            0,
-		"role_migrations":           0,
-		"verification_success_rate": 0.0,
-		"citizens":                  0,
-		"validators":                0,
-		"guests":                    0,
-	}
-
-	// Get actual metrics from ident keeper
-	if ms.app.identKeeper != nil {
-	// Note: Monitoring needs sdk.Context; see docs/CODE_STUBS.md.
-	return metrics
-
-		/* Commented out until proper context is available
-		ctx := sdk.UnwrapSDKContext(context.Background())
-		// Get all verified accounts
-		accounts, err := ms.app.IdentKeeper.GetAllVerifiedAccounts(ctx)
-		if err == nil {
-			metrics["verified_accounts"] = len(accounts)
-			metrics["total_accounts"] = len(accounts)
-			
-			// Count by role
-			citizens := 0
-			validators := 0
-			guests := 0
-			
-			for _, account := range accounts {
-				switch account.Role {
-				case 2: // ROLE_CITIZEN
-					citizens++
-				case 3: // ROLE_VALIDATOR
-					validators++
-				case 1: // ROLE_GUEST
-					guests++
-				}
-			}
-			
-			metrics["citizens"] = citizens
-			metrics["validators"] = validators
-			metrics["guests"] = guests
-		}
-		
-		// Get role migrations
-		migrations, err := ms.app.IdentKeeper.GetAllRoleMigrations(ctx)
-		if err == nil {
-			metrics["role_migrations"] = len(migrations)
-		}
-		*/
+// parseAmount parses a decimal amount for export, treating malformed values as zero
+func parseAmount(s string) float64 {
+	amount, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
 	}
-
-	return metrics
+	return amount
 }
 
-// getValidatorCount gets the current validator count
-func (ms *MonitoringService) getValidatorCount() int {
-	if ms.app.consensusKeeper != nil {
-		// Note: sdk.Context needed for keeper queries; see docs/CODE_STUBS.md
-		return 0
-
-		/* Commented out until proper context is available
-		ctx := sdk.UnwrapSDKContext(context.Background())
-		validators, err := ms.app.ConsensusKeeper.GetAllValidators(ctx)
-		if err == nil {
-			return len(validators)
-		}
-		*/
+func sumCounts(counts map[string]int) int {
+	total := 0
+	for _, count := range counts {
+		total += count
 	}
-	return 0
-}
\ No newline at end of file
+	return total
+}
@@ -0,0 +1,142 @@
+package app
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	sdklog "cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cosmosdb "github.com/cosmos/cosmos-db"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/require"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	identtypes "github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+func newMonitoringTestApp(t *testing.T) *VolnixApp {
+	app := NewVolnixApp(sdklog.NewNopLogger(), cosmosdb.NewMemDB(), nil, MakeEncodingConfig(), cosmosdb.NewMemDB())
+	require.NoError(t, app.LoadLatestVersion())
+	return app
+}
+
+func TestMonitoringService_CollectSnapshot(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 42})
+
+	app.consensusKeeper.SetValidator(ctx, &consensusv1.Validator{Validator: "validator1", Status: consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE})
+	app.consensusKeeper.SetValidator(ctx, &consensusv1.Validator{Validator: "validator2", Status: consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE})
+	app.consensusKeeper.SetValidator(ctx, &consensusv1.Validator{Validator: "validator3", Status: consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED})
+
+	order := func(id string, side anteilv1.OrderSide, status anteilv1.OrderStatus) *anteilv1.Order {
+		return &anteilv1.Order{OrderId: id, Owner: "owner", OrderSide: side, AntAmount: "10", Price: "1.5", IdentityHash: "hash", Status: status}
+	}
+	require.NoError(t, app.anteilKeeper.SetOrder(ctx, order("order1", anteilv1.OrderSide_ORDER_SIDE_BUY, anteilv1.OrderStatus_ORDER_STATUS_OPEN)))
+	require.NoError(t, app.anteilKeeper.SetOrder(ctx, order("order2", anteilv1.OrderSide_ORDER_SIDE_BUY, anteilv1.OrderStatus_ORDER_STATUS_PARTIALLY_FILLED)))
+	require.NoError(t, app.anteilKeeper.SetOrder(ctx, order("order3", anteilv1.OrderSide_ORDER_SIDE_SELL, anteilv1.OrderStatus_ORDER_STATUS_FILLED)))
+	require.NoError(t, app.anteilKeeper.SetTrade(ctx, &anteilv1.Trade{TradeId: "trade1", BuyOrderId: "order1", SellOrderId: "order3", Buyer: "buyer", Seller: "seller", AntAmount: "7.5", Price: "1.5"}))
+	require.NoError(t, app.anteilKeeper.SetAuction(ctx, &anteilv1.Auction{
+		AuctionId:    "auction1",
+		Status:       anteilv1.AuctionStatus_AUCTION_STATUS_OPEN,
+		ReservePrice: "1",
+		AntAmount:    "100",
+		Bids: []*anteilv1.Bid{
+			{BidId: "bid1", Bidder: "bidder1", Amount: "5"},
+			{BidId: "bid2", Bidder: "bidder2", Amount: "6"},
+			{BidId: "bid3", Bidder: "bidder1", Amount: "7"},
+		},
+	}))
+
+	app.identKeeper.SetParams(ctx, identtypes.DefaultParams())
+	require.NoError(t, app.identKeeper.SetVerifiedAccount(ctx, &identv1.VerifiedAccount{Address: "citizen1", IdentityHash: "hash1", Role: identv1.Role_ROLE_CITIZEN}))
+	require.NoError(t, app.identKeeper.SetVerifiedAccount(ctx, &identv1.VerifiedAccount{Address: "validator1", IdentityHash: "hash2", Role: identv1.Role_ROLE_VALIDATOR}))
+
+	ms := NewMonitoringService(app, sdklog.NewNopLogger(), DefaultConfig().Monitoring)
+	s, err := ms.collectSnapshot(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(42), s.height)
+	require.Equal(t, map[string]int{"active": 2, "jailed": 1}, s.validatorsByStatus)
+	require.Equal(t, map[string]int{"buy": 2, "sell": 0}, s.openOrdersBySide)
+	require.Equal(t, uint64(1), s.tradeCount)
+	require.Equal(t, 7.5, s.tradeVolume)
+	require.Equal(t, map[string]int{"open": 1}, s.auctionsByStatus)
+	require.Equal(t, 3, s.auctionBids)
+	require.Equal(t, 2, s.auctionParticipants)
+	require.Equal(t, map[string]int{"citizen": 1, "validator": 1}, s.accountsByRole)
+}
+
+func TestMonitoringService_PrometheusMetrics(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 7})
+	app.consensusKeeper.SetValidator(ctx, &consensusv1.Validator{Validator: "validator1", Status: consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE})
+
+	ms := NewMonitoringService(app, sdklog.NewNopLogger(), DefaultConfig().Monitoring)
+	s, err := ms.collectSnapshot(ctx)
+	require.NoError(t, err)
+	ms.snapshot = s
+
+	rec := httptest.NewRecorder()
+	promhttp.HandlerFor(ms.registry, promhttp.HandlerOpts{}).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+
+	require.Contains(t, string(body), "# TYPE volnix_chain_height gauge")
+	require.Contains(t, string(body), "volnix_chain_height 7")
+	require.Contains(t, string(body), `volnix_consensus_validators{status="active"} 1`)
+	require.Contains(t, string(body), "# TYPE volnix_anteil_trades_total counter")
+	require.Contains(t, string(body), "volnix_consensus_rewards_emitted_total 0")
+}
+
+func TestMonitoringService_SnapshotCache(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	config := DefaultConfig().Monitoring
+	ms := NewMonitoringService(app, sdklog.NewNopLogger(), config)
+
+	// Nothing has been committed yet
+	_, err := ms.currentSnapshot()
+	require.Error(t, err)
+
+	// A fresh snapshot is served from the cache
+	cached := &metricsSnapshot{height: 3, collectedAt: time.Now()}
+	ms.snapshot = cached
+	s, err := ms.currentSnapshot()
+	require.NoError(t, err)
+	require.Same(t, cached, s)
+
+	// An expired snapshot is collected again
+	cached.collectedAt = time.Now().Add(-2 * config.MetricsInterval)
+	_, err = ms.currentSnapshot()
+	require.Error(t, err)
+}
+
+func TestMonitoringService_StartStop(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	ms := NewMonitoringService(app, sdklog.NewNopLogger(), DefaultConfig().Monitoring)
+
+	// Reserve a free port for the service
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+	require.NoError(t, listener.Close())
+
+	require.NoError(t, ms.Start(port))
+	resp, err := http.Get("http://127.0.0.1:" + port + "/health")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// A second service cannot bind the same port
+	require.Error(t, NewMonitoringService(app, sdklog.NewNopLogger(), DefaultConfig().Monitoring).Start(port))
+
+	require.NoError(t, ms.Stop())
+	_, err = http.Get("http://127.0.0.1:" + port + "/health")
+	require.Error(t, err)
+}
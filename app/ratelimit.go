@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"golang.org/x/time/rate"
 )
 
@@ -22,9 +23,16 @@ type RateLimiter struct {
 	perAddrRate rate.Limit // Transactions per second per address
 	burstSize   int        // Burst size for rate limiting
 	
+	// addressRate optionally lowers the per-address rate, e.g. for guests
+	addressRate AddressRateFunc
+	
 	mu sync.RWMutex
 }
 
+// AddressRateFunc returns the per-address rate (tx/sec) that applies to addr
+// It returns false to use the configured PerAddrRate
+type AddressRateFunc func(ctx sdk.Context, addr sdk.AccAddress) (float64, bool)
+
 // RateLimitConfig holds configuration for rate limiting
 type RateLimitConfig struct {
 	// GlobalRate is the global transaction rate limit (tx/sec)
@@ -73,49 +81,84 @@ func (rl *RateLimiter) Allow(ctx sdk.Context, tx sdk.Tx) error {
 		return nil // Rate limiting disabled
 	}
 	
-	rl.mu.RLock()
-	defer rl.mu.RUnlock()
-	
 	// Check global rate limit
 	if !rl.globalLimiter.Allow() {
 		return fmt.Errorf("global rate limit exceeded: %v tx/sec", rl.globalRate)
 	}
 	
-	// Check per-address rate limit
-	// Get signers from transaction messages
-	msgs := tx.GetMsgs()
-	for _, msg := range msgs {
-		// Try to get signers from message
-		if msgWithSigners, ok := msg.(interface{ GetSigners() []sdk.AccAddress }); ok {
-			signers := msgWithSigners.GetSigners()
-			for _, signer := range signers {
-				addr := signer.String()
-				
-				// Get or create per-address limiter
-				addrLimiter, exists := rl.addressLimiters[addr]
-				if !exists {
-					rl.mu.RUnlock()
-					rl.mu.Lock()
-					// Double-check after acquiring write lock
-					addrLimiter, exists = rl.addressLimiters[addr]
-					if !exists {
-						addrLimiter = rate.NewLimiter(rl.perAddrRate, rl.burstSize)
-						rl.addressLimiters[addr] = addrLimiter
-					}
-					rl.mu.Unlock()
-					rl.mu.RLock()
-				}
-				
-				if !addrLimiter.Allow() {
-					return fmt.Errorf("rate limit exceeded for address %s: %v tx/sec", addr, rl.perAddrRate)
-				}
-			}
+	// Check per-address rate limit of every signer
+	for _, signer := range txSigners(tx) {
+		addr := signer.String()
+		limit := rl.addressLimit(ctx, signer)
+		
+		rl.mu.Lock()
+		addrLimiter, exists := rl.addressLimiters[addr]
+		if !exists {
+			addrLimiter = rate.NewLimiter(limit, rl.burstSize)
+			rl.addressLimiters[addr] = addrLimiter
+		} else if addrLimiter.Limit() != limit {
+			// The role of the address or the ident params changed
+			addrLimiter.SetLimit(limit)
+		}
+		allowed := addrLimiter.Allow()
+		rl.mu.Unlock()
+		
+		if !allowed {
+			return fmt.Errorf("rate limit exceeded for address %s: %v tx/sec", addr, limit)
 		}
 	}
 	
 	return nil
 }
 
+// SetAddressRateFunc sets the function returning a stricter per-address rate for an address
+func (rl *RateLimiter) SetAddressRateFunc(fn AddressRateFunc) {
+	if rl == nil {
+		return
+	}
+	
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.addressRate = fn
+}
+
+// addressLimit returns the rate limit of an address, never above the configured per-address rate
+func (rl *RateLimiter) addressLimit(ctx sdk.Context, addr sdk.AccAddress) rate.Limit {
+	rl.mu.RLock()
+	fn := rl.addressRate
+	rl.mu.RUnlock()
+	
+	if fn != nil {
+		if r, ok := fn(ctx, addr); ok && rate.Limit(r) < rl.perAddrRate {
+			return rate.Limit(r)
+		}
+	}
+	return rl.perAddrRate
+}
+
+// txSigners returns the signers of a transaction
+func txSigners(tx sdk.Tx) []sdk.AccAddress {
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		signers, err := sigTx.GetSigners()
+		if err == nil {
+			addrs := make([]sdk.AccAddress, len(signers))
+			for i, signer := range signers {
+				addrs[i] = signer
+			}
+			return addrs
+		}
+	}
+	
+	// Fall back to messages that still name their signers
+	var addrs []sdk.AccAddress
+	for _, msg := range tx.GetMsgs() {
+		if msgWithSigners, ok := msg.(interface{ GetSigners() []sdk.AccAddress }); ok {
+			addrs = append(addrs, msgWithSigners.GetSigners()...)
+		}
+	}
+	return addrs
+}
+
 // Cleanup removes old address limiters to prevent memory leaks
 func (rl *RateLimiter) Cleanup(maxAge time.Duration) {
 	if rl == nil {
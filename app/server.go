@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	cosmosdb "github.com/cosmos/cosmos-db"
 
 	cmtcfg "github.com/cometbft/cometbft/config"
 	cmtlog "github.com/cometbft/cometbft/libs/log"
@@ -20,23 +21,86 @@ import (
 
 // VolnixServer wraps Volnix app with CometBFT node functionality
 type VolnixServer struct {
-	app       *MinimalVolnixApp
+	app       *VolnixApp
+	db        cosmosdb.DB
+	paramsDB  cosmosdb.DB
 	node      *node.Node
 	config    *cmtcfg.Config
 	homeDir   string
 	logger    log.Logger
 	cmtLogger cmtlog.Logger
+
+	monitoring *MonitoringService
+}
+
+// OpenDB opens the named database of a node home.
+// It uses PebbleDB: GoLevelDB reads empty values as missing, so the empty IAVL roots of
+// stores without records are lost and the app cannot load its state after a restart.
+func OpenDB(homeDir, name string) (cosmosdb.DB, error) {
+	return cosmosdb.NewPebbleDB(name, filepath.Join(homeDir, "data"), nil)
 }
 
-// REMOVED: Old NewCometBFTServer function that was causing protobuf registration issues
-// Use NewMinimalCometBFTServer from minimal_server.go instead
+// NewVolnixServer creates a server running the full Volnix app with every module
+// Upgrade plans at skipUpgradeHeights are skipped; upgrade-info.json is written under homeDir
+func NewVolnixServer(homeDir string, logger log.Logger, skipUpgradeHeights []int64) (*VolnixServer, error) {
+	dataDir := filepath.Join(homeDir, "data")
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	db, err := OpenDB(homeDir, "volnix")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database: %w", err)
+	}
+	// Consensus params outlive restarts in their own database
+	paramsDB, err := OpenDB(homeDir, "paramstore")
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create param store database: %w", err)
+	}
+
+	app := NewVolnixApp(logger, db, nil, MakeEncodingConfig(), paramsDB)
+	app.SetUpgradeOptions(UpgradeOptions{HomeDir: homeDir, SkipHeights: skipUpgradeHeights})
+	if err := app.SetSnapshotOptions(DefaultSnapshotOptions(homeDir)); err != nil {
+		return nil, fmt.Errorf("failed to configure snapshots: %w", err)
+	}
+	if err := app.LoadLatestVersion(); err != nil {
+		return nil, fmt.Errorf("failed to load state: %w", err)
+	}
+
+	config := cmtcfg.DefaultConfig()
+	config.SetRoot(homeDir)
+	config.Moniker = "volnix-node"
+
+	// Configure consensus; timeout_commit is replaced by the PoVB block time after every block
+	config.Consensus.TimeoutPropose = 3 * time.Second
+	config.Consensus.TimeoutPrevote = 1 * time.Second
+	config.Consensus.TimeoutPrecommit = 1 * time.Second
+	config.Consensus.TimeoutCommit = 5 * time.Second
+	config.Consensus.CreateEmptyBlocks = true
+	config.Consensus.CreateEmptyBlocksInterval = 0 * time.Second
+
+	config.P2P.ListenAddress = "tcp://0.0.0.0:26656"
+	config.RPC.ListenAddress = "tcp://0.0.0.0:26657"
+	config.RPC.CORSAllowedOrigins = []string{"*"}
+
+	return &VolnixServer{
+		app:       app,
+		db:        db,
+		paramsDB:  paramsDB,
+		config:    config,
+		homeDir:   homeDir,
+		logger:    logger,
+		cmtLogger: cmtlog.NewTMLogger(cmtlog.NewSyncWriter(os.Stdout)),
+	}, nil
+}
 
 // Start starts the Volnix server with CometBFT node
 func (s *VolnixServer) Start(ctx context.Context) error {
 	s.logger.Info("🚀 Starting Volnix Protocol with CometBFT...")
 
 	// Initialize files and configuration
-	if err := s.initializeFiles(); err != nil {
+	if err := s.InitializeFiles(); err != nil {
 		return fmt.Errorf("failed to initialize files: %w", err)
 	}
 
@@ -47,9 +111,9 @@ func (s *VolnixServer) Start(ctx context.Context) error {
 
 	s.logger.Info("✅ CometBFT node created successfully")
 	s.logger.Info("🌐 Network configuration:")
-	s.logger.Info("   🔗 Chain ID: test-volnix")
+	s.logger.Info("   🔗 Chain ID: " + s.app.ChainID())
 	s.logger.Info("   📁 Home: " + s.homeDir)
-	s.logger.Info("   💾 Database: GoLevelDB")
+	s.logger.Info("   💾 Database: PebbleDB")
 	s.logger.Info("   🏗️  Framework: Cosmos SDK + CometBFT")
 
 	s.logger.Info("📦 Active modules:")
@@ -68,6 +132,13 @@ func (s *VolnixServer) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to start CometBFT node: %w", err)
 	}
 
+	if err := s.startMonitoring(); err != nil {
+		if stopErr := s.node.Stop(); stopErr != nil {
+			s.logger.Error("Failed to stop CometBFT node", "error", stopErr)
+		}
+		return err
+	}
+
 	s.logger.Info("🎯 Volnix Protocol node is running!")
 	s.logger.Info("✨ Ready for transactions, queries, and consensus!")
 
@@ -81,6 +152,13 @@ func (s *VolnixServer) Start(ctx context.Context) error {
 func (s *VolnixServer) Stop() error {
 	s.logger.Info("🛑 Stopping Volnix Protocol node...")
 
+	if s.monitoring != nil {
+		if err := s.monitoring.Stop(); err != nil {
+			s.logger.Error("Failed to stop monitoring service", "error", err)
+		}
+		s.monitoring = nil
+	}
+
 	if s.node != nil && s.node.IsRunning() {
 		if err := s.node.Stop(); err != nil {
 			s.logger.Error("Failed to stop CometBFT node", "error", err)
@@ -88,13 +166,47 @@ func (s *VolnixServer) Stop() error {
 		}
 		s.logger.Info("✅ CometBFT node stopped")
 	}
+	if err := s.Close(); err != nil {
+		return err
+	}
 
 	s.logger.Info("✅ Volnix Protocol node stopped successfully")
 	return nil
 }
 
+// Close closes the databases of the app; the node must not be running
+func (s *VolnixServer) Close() error {
+	if err := s.db.Close(); err != nil {
+		return fmt.Errorf("failed to close database: %w", err)
+	}
+	if err := s.paramsDB.Close(); err != nil {
+		return fmt.Errorf("failed to close param store database: %w", err)
+	}
+	return nil
+}
+
+// startMonitoring starts the monitoring service configured in the app config of the node
+// home, which is created with the defaults on first start
+func (s *VolnixServer) startMonitoring() error {
+	config, err := LoadConfig(AppConfigPath(s.homeDir))
+	if err != nil {
+		return fmt.Errorf("failed to load app config: %w", err)
+	}
+	if !config.Monitoring.Enabled {
+		return nil
+	}
+
+	monitoring := NewMonitoringService(s.app, s.logger, config.Monitoring)
+	if err := monitoring.Start(config.Monitoring.Port); err != nil {
+		return fmt.Errorf("failed to start monitoring service: %w", err)
+	}
+	s.monitoring = monitoring
+	s.logger.Info("   📊 Monitoring: :" + config.Monitoring.Port)
+	return nil
+}
+
 // GetApp returns the Volnix app
-func (s *VolnixServer) GetApp() *MinimalVolnixApp {
+func (s *VolnixServer) GetApp() *VolnixApp {
 	return s.app
 }
 
@@ -129,6 +241,9 @@ func (s *VolnixServer) createCometBFTNode() error {
 	// Create metrics provider (disabled for now)
 	metricsProvider := node.DefaultMetricsProvider(s.config.Instrumentation)
 
+	// Apply the block time computed by the consensus module to this node's timeout_commit
+	s.app.SetBlockDelayApplier(TimeoutCommitApplier(s.config.Consensus))
+
 	// Create ABCI wrapper and client creator
 	abciWrapper := NewABCIWrapper(s.app)
 	clientCreator := proxy.NewLocalClientCreator(abciWrapper)
@@ -151,8 +266,8 @@ func (s *VolnixServer) createCometBFTNode() error {
 	return nil
 }
 
-// initializeFiles creates necessary configuration files
-func (s *VolnixServer) initializeFiles() error {
+// InitializeFiles creates the node configuration, keys and genesis file if they do not exist
+func (s *VolnixServer) InitializeFiles() error {
 	// Create config files
 	configDir := filepath.Join(s.homeDir, "config")
 	dataDir := filepath.Join(s.homeDir, "data")
@@ -189,52 +304,29 @@ func (s *VolnixServer) createGenesisFile(genesisFile string) error {
 	// Create a proper genesis document
 	genDoc := &types.GenesisDoc{
 		GenesisTime:     time.Now(),
-		ChainID:         "test-volnix",
+		ChainID:         s.app.ChainID(),
 		InitialHeight:   1,
 		ConsensusParams: types.DefaultConsensusParams(),
 		AppHash:         []byte{},
-		AppState: []byte(`{
-  "ident": {
-    "params": {},
-    "verified_accounts": []
-  },
-  "lizenz": {
-    "params": {},
-    "lizenzs": []
-  },
-  "anteil": {
-    "params": {},
-    "orders": [],
-    "auctions": [],
-    "user_positions": []
-  },
-  "consensus": {
-    "params": {},
-    "consensus_state": {},
-    "validator_weights": []
-  }
-}`),
-	}
-
-	// Add default validator if none exists
+		// Without app_state InitChainer starts every module from its default genesis
+	}
+
+	// The node's own key is the genesis validator; it is saved so the node signs with it on start
 	privValKeyFile := filepath.Join(s.config.RootDir, "config", "priv_validator_key.json")
-	if _, err := os.Stat(privValKeyFile); os.IsNotExist(err) {
-		// Generate private validator
-		privVal := privval.GenFilePV(privValKeyFile, filepath.Join(s.config.RootDir, "data", "priv_validator_state.json"))
-		pubKey, err := privVal.GetPubKey()
-		if err != nil {
-			return fmt.Errorf("failed to get validator public key: %w", err)
-		}
+	privVal := privval.LoadOrGenFilePV(privValKeyFile, filepath.Join(s.config.RootDir, "data", "priv_validator_state.json"))
+	pubKey, err := privVal.GetPubKey()
+	if err != nil {
+		return fmt.Errorf("failed to get validator public key: %w", err)
+	}
 
-		// Add validator to genesis
-		validator := types.GenesisValidator{
-			Address: pubKey.Address(),
-			PubKey:  pubKey,
-			Power:   10,
-			Name:    "volnix-validator",
-		}
-		genDoc.Validators = []types.GenesisValidator{validator}
+	// Add validator to genesis
+	validator := types.GenesisValidator{
+		Address: pubKey.Address(),
+		PubKey:  pubKey,
+		Power:   10,
+		Name:    "volnix-validator",
 	}
+	genDoc.Validators = []types.GenesisValidator{validator}
 
 	// Save genesis file
 	return genDoc.SaveAs(genesisFile)
@@ -0,0 +1,345 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	sdklog "cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cosmosdb "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banksims "github.com/cosmos/cosmos-sdk/x/bank/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	"github.com/stretchr/testify/require"
+
+	lizenztypes "github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// SimAppChainID is the chain ID the simulations run on
+const SimAppChainID = "volnix-1"
+
+// simLznPerAccount is the LZN every simulation account starts with, enough for the largest activation
+const simLznPerAccount = 1000000000
+
+// The simulations are configured with the -Enabled, -Seed, -NumBlocks, -BlockSize and -Period flags, e.g.
+// go test ./app -run TestFullAppSimulation -Enabled -NumBlocks=100 -BlockSize=50 -Seed=7
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+func TestFullAppSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "volnix-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	app := newSimApp(t, logger, db)
+	_, simParams, err := runSimulation(t, app, config)
+	require.NoError(t, err)
+	require.NoError(t, exportSimulation(app, config, simParams))
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
+
+func TestAppImportExport(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "volnix-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application import/export simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	app := newSimApp(t, logger, db)
+	_, simParams, err := runSimulation(t, app, config)
+	require.NoError(t, err)
+	require.NoError(t, exportSimulation(app, config, simParams))
+
+	newDB, newDir, _, _, err := simtestutil.SetupSimulation(config, "volnix-app-sim-2", "Simulation-2", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	require.NoError(t, err, "simulation setup failed")
+	defer func() {
+		require.NoError(t, newDB.Close())
+		require.NoError(t, os.RemoveAll(newDir))
+	}()
+
+	requireImportExportRoundTrip(t, app, newSimApp(t, logger, newDB))
+}
+
+// TestAppExportImportRoundTrip runs a short simulation and requires the exported state to import
+// into a new app that exports the same bytes; unlike TestAppImportExport it always runs
+func TestAppExportImportRoundTrip(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+	config.Seed = 11
+	config.NumBlocks = 30
+	config.BlockSize = 30
+	config.Commit = true
+
+	logger := sdklog.NewNopLogger()
+	app := newSimApp(t, logger, cosmosdb.NewMemDB())
+	_, _, err := runSimulation(t, app, config)
+	require.NoError(t, err)
+
+	requireImportExportRoundTrip(t, app, newSimApp(t, logger, cosmosdb.NewMemDB()))
+}
+
+// requireImportExportRoundTrip exports the state of app, imports it into newApp and requires
+// equal module stores and a byte-identical export from newApp
+func requireImportExportRoundTrip(t *testing.T, app, newApp *VolnixApp) {
+	t.Helper()
+
+	t.Log("exporting genesis...")
+	exported, err := app.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	appState, err := json.Marshal(exported)
+	require.NoError(t, err)
+
+	t.Log("importing genesis...")
+	header := cmtproto.Header{Height: app.LastBlockHeight(), ChainID: SimAppChainID}
+	ctxA := app.NewContextLegacy(true, header)
+	ctxB := newApp.NewContextLegacy(true, header)
+	_, err = newApp.InitChainer(ctxB, &abci.RequestInitChain{AppStateBytes: appState, ChainId: SimAppChainID})
+	require.NoError(t, err)
+
+	t.Log("comparing stores...")
+	storeKeysPrefixes := []struct {
+		name     string
+		keyA     storetypes.StoreKey
+		keyB     storetypes.StoreKey
+		prefixes [][]byte
+	}{
+		{"params", app.keyParams, newApp.keyParams, nil},
+		{"auth", app.keyAuth, newApp.keyAuth, nil},
+		{"bank", app.keyBank, newApp.keyBank, [][]byte{banktypes.BalancesPrefix}},
+		{"ident", app.keyIdent, newApp.keyIdent, nil},
+		{"lizenz", app.keyLizenz, newApp.keyLizenz, nil},
+		{"anteil", app.keyAnteil, newApp.keyAnteil, nil},
+		{"consensus", app.keyConsensus, newApp.keyConsensus, nil},
+		{"governance", app.keyGovernance, newApp.keyGovernance, nil},
+		{"integration", app.keyIntegration, newApp.keyIntegration, nil},
+	}
+	for _, skp := range storeKeysPrefixes {
+		storeA := ctxA.KVStore(skp.keyA)
+		storeB := ctxB.KVStore(skp.keyB)
+
+		failedKVAs, failedKVBs := simtestutil.DiffKVStores(storeA, storeB, skp.prefixes)
+		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare in %s", skp.name)
+
+		t.Logf("compared %d different key/value pairs between %s and %s", len(failedKVAs), skp.keyA, skp.keyB)
+		require.Empty(t, failedKVAs, simtestutil.GetSimulationLog(skp.keyA.Name(), app.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
+	}
+
+	t.Log("re-exporting genesis...")
+	reexported, err := newApp.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+	for name, state := range exported {
+		require.Equal(t, string(state), string(reexported[name]), "exported %s state changed after import", name)
+	}
+	reexportedState, err := json.Marshal(reexported)
+	require.NoError(t, err)
+	require.Equal(t, appState, reexportedState)
+}
+
+// TestAppStateDeterminism runs every seed several times and requires the same app hash each time
+func TestAppStateDeterminism(t *testing.T) {
+	if !simcli.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+	config.Commit = true
+
+	const (
+		numSeeds             = 3
+		numTimesToRunPerSeed = 3
+	)
+	seeds := []int64{config.Seed}
+	if config.Seed == simcli.DefaultSeedValue {
+		seeds = make([]int64, numSeeds)
+		for i := range seeds {
+			seeds[i] = rand.Int63()
+		}
+	}
+
+	for _, seed := range seeds {
+		config.Seed = seed
+		appHashes := make([]string, numTimesToRunPerSeed)
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			app := newSimApp(t, sdklog.NewNopLogger(), cosmosdb.NewMemDB())
+
+			fmt.Printf("running non-determinism simulation; seed %d: attempt: %d/%d\n", seed, j+1, numTimesToRunPerSeed)
+			_, _, err := runSimulation(t, app, config)
+			require.NoError(t, err)
+
+			appHashes[j] = fmt.Sprintf("%X", app.LastCommitID().Hash)
+			require.Equal(t, appHashes[0], appHashes[j], "non-determinism in seed %d: attempt %d/%d", seed, j+1, numTimesToRunPerSeed)
+		}
+	}
+}
+
+// newSimApp returns a loaded app that checks the invariants every -Period blocks
+func newSimApp(t *testing.T, logger sdklog.Logger, db cosmosdb.DB) *VolnixApp {
+	t.Helper()
+	app := NewVolnixApp(logger, db, nil, MakeEncodingConfig(), cosmosdb.NewMemDB())
+	require.NoError(t, app.LoadLatestVersion())
+	app.SetInvCheckPeriod(simcli.FlagPeriodValue)
+	return app
+}
+
+// runSimulation simulates config.NumBlocks blocks of random module operations
+func runSimulation(t *testing.T, app *VolnixApp, config simtypes.Config) (bool, simulation.Params, error) {
+	t.Helper()
+	appParams, err := simAppParams(config)
+	require.NoError(t, err)
+
+	simState := module.SimulationState{
+		AppParams: appParams,
+		Cdc:       app.appCodec,
+		TxConfig:  MakeEncodingConfig().TxConfig.SigningConfig,
+	}
+
+	return simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		simAppStateFn(app.appCodec, app.SimulationManager(), appParams),
+		simtypes.RandomAccounts,
+		app.SimulationManager().WeightedOperations(simState),
+		app.ModuleAccountAddrs(),
+		config,
+		app.appCodec,
+	)
+}
+
+// simAppParams reads the simulation params file, if any
+// Bank sends are disabled: their random fees do not meet the ident minimum gas prices
+func simAppParams(config simtypes.Config) (simtypes.AppParams, error) {
+	appParams := make(simtypes.AppParams)
+	if config.ParamsFile != "" {
+		bz, err := os.ReadFile(config.ParamsFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(bz, &appParams); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, key := range []string{banksims.OpWeightMsgSend, banksims.OpWeightMsgMultiSend} {
+		if _, ok := appParams[key]; !ok {
+			appParams[key] = json.RawMessage("0")
+		}
+	}
+	return appParams, nil
+}
+
+// simAppStateFn returns the randomized genesis of every module
+// Balances are in uwrt, the ident fee denom, plus LZN for activations, and the bank supply is their sum
+func simAppStateFn(cdc codec.JSONCodec, sm *module.SimulationManager, appParams simtypes.AppParams) simtypes.AppStateFn {
+	return func(r *rand.Rand, accs []simtypes.Account, config simtypes.Config) (json.RawMessage, []simtypes.Account, string, time.Time) {
+		if config.GenesisFile != "" {
+			panic("simulation genesis files are not supported")
+		}
+		genesisTimestamp := time.Unix(config.GenesisTime, 0)
+
+		var (
+			initialStake sdkmath.Int
+			numBonded    int64
+		)
+		appParams.GetOrGenerate(simtestutil.StakePerAccount, &initialStake, r,
+			func(r *rand.Rand) { initialStake = sdk.DefaultPowerReduction.AddRaw(r.Int63n(1e12)) })
+		appParams.GetOrGenerate(simtestutil.InitiallyBondedValidators, &numBonded, r,
+			func(r *rand.Rand) { numBonded = int64(r.Intn(10) + 1) })
+		if numBonded > int64(len(accs)) {
+			numBonded = int64(len(accs))
+		}
+
+		genesisState := make(map[string]json.RawMessage)
+		simState := &module.SimulationState{
+			AppParams:    appParams,
+			Cdc:          cdc,
+			Rand:         r,
+			GenState:     genesisState,
+			Accounts:     accs,
+			InitialStake: initialStake,
+			NumBonded:    numBonded,
+			BondDenom:    "uwrt",
+			GenTimestamp: genesisTimestamp,
+		}
+		sm.GenerateGenesisStates(simState)
+
+		var bankState banktypes.GenesisState
+		cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankState)
+		supply := sdk.NewCoins()
+		for i, balance := range bankState.Balances {
+			bankState.Balances[i].Coins = balance.Coins.Add(sdk.NewInt64Coin(lizenztypes.DefaultParams().LznDenom, simLznPerAccount))
+			supply = supply.Add(bankState.Balances[i].Coins...)
+		}
+		bankState.Supply = supply
+		genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankState)
+
+		appState, err := json.Marshal(genesisState)
+		if err != nil {
+			panic(err)
+		}
+		return appState, accs, config.ChainID, genesisTimestamp
+	}
+}
+
+// exportSimulation writes the app state and simulation params to the -ExportStatePath and -ExportParamsPath files
+func exportSimulation(app *VolnixApp, config simtypes.Config, params simtypes.Params) error {
+	if config.ExportStatePath != "" {
+		exported, err := app.ExportAppStateAndValidators(false, nil)
+		if err != nil {
+			return err
+		}
+		bz, err := json.MarshalIndent(exported, "", " ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(config.ExportStatePath, bz, 0o600); err != nil {
+			return err
+		}
+	}
+
+	if config.ExportParamsPath != "" {
+		bz, err := json.MarshalIndent(params, "", " ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(config.ExportParamsPath, bz, 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
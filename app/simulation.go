@@ -0,0 +1,21 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SimulationManager returns the app's simulation manager
+func (app *VolnixApp) SimulationManager() *module.SimulationManager {
+	return app.sm
+}
+
+// RandomGenesisAccounts returns a base account for every simulation account
+// Vesting accounts are left out: the ident fee policy expects spendable balances
+func RandomGenesisAccounts(simState *module.SimulationState) authtypes.GenesisAccounts {
+	genesisAccs := make(authtypes.GenesisAccounts, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		genesisAccs[i] = authtypes.NewBaseAccountWithAddress(acc.Address)
+	}
+	return genesisAccs
+}
@@ -1,259 +1,773 @@
 package app
 
 import (
+	"bytes"
+	"compress/zlib"
 	"crypto/sha256"
-	"encoding/binary"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"sync"
 
+	sdklog "cosmossdk.io/log"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	protoio "github.com/cosmos/gogoproto/io"
 )
 
 // SnapshotManager manages state snapshots for State Sync
+//
+// A snapshot is the zlib-compressed stream of SnapshotItem messages produced by the
+// committed multistore (every IAVL store of every module), followed by an extension
+// carrying the consensus params, which live outside the multistore. The stream is
+// split into chunks of chunkSize bytes; the SHA-256 of every chunk is sent to peers
+// in the snapshot metadata so that each chunk can be verified as it arrives.
 type SnapshotManager struct {
-	app        *VolnixApp
-	snapshots  map[uint64]*SnapshotInfo
-	chunks     map[string][]byte // chunk hash -> chunk data
+	bapp   *baseapp.BaseApp
+	logger sdklog.Logger
+
 	mu         sync.RWMutex
+	dir        string // Snapshot directory, in-memory only when empty
+	interval   uint64
+	keepRecent uint32
 	chunkSize  uint32 // Size of each chunk in bytes
+	snapshots  map[uint64]*SnapshotInfo
+	chunks     map[uint64][][]byte // Chunks of in-memory snapshots
+	creating   bool
+	restore    *snapshotRestore
 }
 
 // SnapshotInfo contains information about a snapshot
 type SnapshotInfo struct {
-	Height      uint64   // Block height of the snapshot
-	Format      uint32   // Snapshot format version
-	ChunkCount  uint32   // Number of chunks
-	Hash        []byte   // Hash of the snapshot
-	ChunkHashes []string // Chunk hashes
+	Height      uint64   `json:"height"`       // Block height of the snapshot
+	Format      uint32   `json:"format"`       // Snapshot format version
+	ChunkCount  uint32   `json:"chunk_count"`  // Number of chunks
+	Hash        []byte   `json:"hash"`         // SHA-256 of the whole snapshot stream
+	ChunkHashes [][]byte `json:"chunk_hashes"` // SHA-256 of every chunk
+}
+
+// SnapshotOptions configures where and how often snapshots are taken
+type SnapshotOptions struct {
+	Dir        string // Directory the snapshots are persisted to
+	Interval   uint64 // Take a snapshot every Interval blocks, 0 disables snapshots
+	KeepRecent uint32 // Number of recent snapshots to keep, 0 keeps all
+	ChunkSize  uint32 // Size of each chunk in bytes
+}
+
+// snapshotRestore tracks a snapshot offered by a peer while its chunks are applied
+//
+// Verified chunks are written to dir until the snapshot is complete, or kept in memory
+// when the manager has no snapshot directory
+type snapshotRestore struct {
+	snapshot *SnapshotInfo
+	appHash  []byte
+	dir      string
+	chunks   [][]byte
+	applied  []bool
+	received uint32
 }
 
 const (
 	// SnapshotFormatVersion is the current snapshot format version
-	SnapshotFormatVersion = 1
-	
+	SnapshotFormatVersion = 2
+
 	// DefaultChunkSize is the default size of each chunk (1 MB)
 	DefaultChunkSize = 1024 * 1024
+
+	// DefaultSnapshotInterval is the default number of blocks between snapshots
+	DefaultSnapshotInterval = 1000
+
+	// DefaultSnapshotKeepRecent is the default number of snapshots kept on disk
+	DefaultSnapshotKeepRecent = 2
+
+	// snapshotMaxItemSize bounds a single SnapshotItem read while restoring
+	snapshotMaxItemSize = 64 * 1024 * 1024
+
+	// snapshotMetadataFile is the file holding the SnapshotInfo of a persisted snapshot
+	snapshotMetadataFile = "metadata.json"
+
+	// snapshotRestoreDir is the directory, below the snapshot directory, holding the chunks
+	// of the snapshot being restored
+	snapshotRestoreDir = "restore"
+
+	// consensusParamsExtension names the extension carrying the consensus params
+	consensusParamsExtension = "volnix_consensus_params"
 )
 
-// NewSnapshotManager creates a new snapshot manager
-func NewSnapshotManager(app *VolnixApp) *SnapshotManager {
+// DefaultSnapshotOptions returns the snapshot options for a node home directory
+func DefaultSnapshotOptions(homeDir string) SnapshotOptions {
+	return SnapshotOptions{
+		Dir:        filepath.Join(homeDir, "data", "snapshots"),
+		Interval:   DefaultSnapshotInterval,
+		KeepRecent: DefaultSnapshotKeepRecent,
+		ChunkSize:  DefaultChunkSize,
+	}
+}
+
+// NewSnapshotManager creates an in-memory snapshot manager that takes no periodic snapshots
+// until SetOptions is called
+func NewSnapshotManager(bapp *baseapp.BaseApp, logger sdklog.Logger) *SnapshotManager {
 	return &SnapshotManager{
-		app:       app,
-		snapshots: make(map[uint64]*SnapshotInfo),
-		chunks:    make(map[string][]byte),
+		bapp:      bapp,
+		logger:    logger,
 		chunkSize: DefaultChunkSize,
+		snapshots: make(map[uint64]*SnapshotInfo),
+		chunks:    make(map[uint64][][]byte),
 	}
 }
 
-// CreateSnapshot creates a snapshot of the current application state
-func (sm *SnapshotManager) CreateSnapshot(height uint64) (*SnapshotInfo, error) {
+// SetOptions configures the snapshot manager and loads the snapshots persisted in opts.Dir
+func (sm *SnapshotManager) SetOptions(opts SnapshotOptions) error {
 	sm.mu.Lock()
 	defer sm.mu.Unlock()
-	
-	// Check if snapshot already exists
-	if snapshot, exists := sm.snapshots[height]; exists {
+
+	sm.interval = opts.Interval
+	// Keeps the multistore from pruning a snapshot height before its snapshot is taken
+	sm.bapp.CommitMultiStore().SetSnapshotInterval(opts.Interval)
+	sm.keepRecent = opts.KeepRecent
+	if opts.ChunkSize > 0 {
+		sm.chunkSize = opts.ChunkSize
+	}
+	sm.dir = opts.Dir
+	if sm.dir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(sm.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	return sm.loadSnapshots()
+}
+
+// SnapshotIfDue starts taking a snapshot in the background when the committed height of
+// ctx is a multiple of the snapshot interval. It is called after every commit.
+//
+// The multistore keeps the snapshot height from being pruned until PruneSnapshotHeight
+// is called for it, so every due height is handed back once its snapshot is done or skipped.
+func (sm *SnapshotManager) SnapshotIfDue(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	sm.mu.Lock()
+	if sm.interval == 0 || height <= 0 || uint64(height)%sm.interval != 0 {
+		sm.mu.Unlock()
+		return
+	}
+	if sm.creating {
+		sm.mu.Unlock()
+		sm.logger.Info("skipping state sync snapshot, another snapshot is in progress", "height", height)
+		sm.bapp.CommitMultiStore().PruneSnapshotHeight(height)
+		return
+	}
+	sm.creating = true
+	sm.mu.Unlock()
+
+	// Consensus params live outside the versioned multistore, so they are read here, on the
+	// commit path, while they still hold the values of height
+	cp := sm.bapp.GetConsensusParams(ctx)
+
+	go func() {
+		defer func() {
+			sm.mu.Lock()
+			sm.creating = false
+			sm.mu.Unlock()
+		}()
+		defer sm.bapp.CommitMultiStore().PruneSnapshotHeight(height)
+
+		if _, err := sm.CreateSnapshot(uint64(height), cp); err != nil {
+			sm.logger.Error("failed to create state sync snapshot", "height", height, "error", err)
+		}
+	}()
+}
+
+// CreateSnapshot creates a snapshot of the state committed at height, together with the
+// consensus params in effect at that height
+func (sm *SnapshotManager) CreateSnapshot(height uint64, cp cmtproto.ConsensusParams) (*SnapshotInfo, error) {
+	if snapshot, exists := sm.GetSnapshot(height); exists {
 		return snapshot, nil
 	}
-	
-	// Export application state
-	ctx := sm.app.NewContext(false)
-	state, err := sm.exportState(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to export state: %w", err)
+
+	sm.mu.RLock()
+	writer := &snapshotWriter{hash: sha256.New(), chunkSize: int(sm.chunkSize)}
+	if sm.dir != "" {
+		writer.dir = sm.snapshotDir(height)
 	}
-	
-	// Split state into chunks
-	chunks, chunkHashes, err := sm.splitIntoChunks(state)
-	if err != nil {
-		return nil, fmt.Errorf("failed to split into chunks: %w", err)
+	sm.mu.RUnlock()
+
+	if writer.dir != "" {
+		if err := os.MkdirAll(writer.dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create snapshot directory: %w", err)
+		}
+	}
+
+	// Export application state, chunk by chunk
+	if err := sm.exportState(height, cp, writer); err != nil {
+		writer.discard()
+		return nil, fmt.Errorf("failed to export state: %w", err)
 	}
-	
-	// Store chunks
-	for i, hash := range chunkHashes {
-		sm.chunks[hash] = chunks[i]
+	if err := writer.flush(); err != nil {
+		writer.discard()
+		return nil, err
 	}
-	
-	// Create snapshot info
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
 	snapshot := &SnapshotInfo{
 		Height:      height,
 		Format:      SnapshotFormatVersion,
-		ChunkCount:  uint32(len(chunks)),
-		ChunkHashes: chunkHashes,
-	}
-	
-	// Calculate snapshot hash
-	snapshot.Hash = sm.calculateSnapshotHash(snapshot)
-	
-	// Store snapshot
+		ChunkCount:  uint32(len(writer.chunkHashes)),
+		Hash:        writer.hash.Sum(nil),
+		ChunkHashes: writer.chunkHashes,
+	}
+
+	if err := sm.saveSnapshot(snapshot, writer.chunks); err != nil {
+		writer.discard()
+		return nil, err
+	}
 	sm.snapshots[height] = snapshot
-	
+	sm.pruneSnapshots()
+
+	sm.logger.Info("created state sync snapshot", "height", height, "chunks", snapshot.ChunkCount, "size", writer.size)
 	return snapshot, nil
 }
 
-// exportState exports the current application state
-func (sm *SnapshotManager) exportState(ctx sdk.Context) ([]byte, error) {
-	// Export state from all modules
-	// This is a simplified version - in production, you'd use module managers
-	
-	// For now, we'll export a basic state representation
-	// In a full implementation, this would iterate through all stores and export their data
-	
-	// Get current block height
-	height := ctx.BlockHeight()
-	
-	// Serialize state (simplified - in production use proper encoding)
-	// For now, return a placeholder with height
-	stateBytes := make([]byte, 8)
-	binary.BigEndian.PutUint64(stateBytes, uint64(height))
-	
-	return stateBytes, nil
-}
-
-// splitIntoChunks splits state data into chunks
-func (sm *SnapshotManager) splitIntoChunks(data []byte) ([][]byte, []string, error) {
-	var chunks [][]byte
-	var chunkHashes []string
-	
-	for i := 0; i < len(data); i += int(sm.chunkSize) {
-		end := i + int(sm.chunkSize)
-		if end > len(data) {
-			end = len(data)
+// exportState streams every store committed at height, followed by the consensus params
+func (sm *SnapshotManager) exportState(height uint64, cp cmtproto.ConsensusParams, w io.Writer) error {
+	zw, err := zlib.NewWriterLevel(w, zlib.BestSpeed)
+	if err != nil {
+		return err
+	}
+	writer := protoio.NewDelimitedWriter(zw)
+
+	if err := sm.bapp.CommitMultiStore().Snapshot(height, writer); err != nil {
+		return err
+	}
+
+	// Consensus params are kept in the param store, outside the multistore
+	if cp.Block != nil {
+		payload, err := cp.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal consensus params: %w", err)
 		}
-		
-		chunk := data[i:end]
-		chunks = append(chunks, chunk)
-		
-		// Calculate chunk hash
-		hash := sha256.Sum256(chunk)
-		chunkHash := fmt.Sprintf("%x", hash)
-		chunkHashes = append(chunkHashes, chunkHash)
-	}
-	
-	return chunks, chunkHashes, nil
-}
-
-// calculateSnapshotHash calculates the hash of a snapshot
-func (sm *SnapshotManager) calculateSnapshotHash(snapshot *SnapshotInfo) []byte {
-	// Combine snapshot metadata
-	data := make([]byte, 8+4+4+len(snapshot.ChunkHashes)*32)
-	binary.BigEndian.PutUint64(data[0:8], snapshot.Height)
-	binary.BigEndian.PutUint32(data[8:12], snapshot.Format)
-	binary.BigEndian.PutUint32(data[12:16], snapshot.ChunkCount)
-	
-	offset := 16
-	for _, chunkHash := range snapshot.ChunkHashes {
-		copy(data[offset:offset+32], []byte(chunkHash))
-		offset += 32
-	}
-	
-	hash := sha256.Sum256(data)
-	return hash[:]
+		if err := writer.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Extension{
+				Extension: &snapshottypes.SnapshotExtensionMeta{Name: consensusParamsExtension, Format: 1},
+			},
+		}); err != nil {
+			return err
+		}
+		if err := writer.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_ExtensionPayload{
+				ExtensionPayload: &snapshottypes.SnapshotExtensionPayload{Payload: payload},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
+
+// importState restores the stores and consensus params from a snapshot stream
+func (sm *SnapshotManager) importState(height uint64, state io.Reader) error {
+	zr, err := zlib.NewReader(state)
+	if err != nil {
+		return fmt.Errorf("invalid snapshot stream: %w", err)
+	}
+	reader := protoio.NewDelimitedReader(zr, snapshotMaxItemSize)
+	defer reader.Close()
+
+	item, err := sm.bapp.CommitMultiStore().Restore(height, snapshottypes.CurrentFormat, reader)
+	if err != nil {
+		return fmt.Errorf("failed to restore stores: %w", err)
+	}
+	if item.Item == nil {
+		return nil
+	}
+
+	extension := item.GetExtension()
+	if extension == nil || extension.Name != consensusParamsExtension {
+		return fmt.Errorf("unknown snapshot item %T", item.Item)
+	}
+	item = snapshottypes.SnapshotItem{}
+	if err := reader.ReadMsg(&item); err != nil {
+		return fmt.Errorf("failed to read consensus params: %w", err)
+	}
+	payload := item.GetExtensionPayload()
+	if payload == nil {
+		return errors.New("missing consensus params payload")
+	}
+
+	var cp cmtproto.ConsensusParams
+	if err := cp.Unmarshal(payload.Payload); err != nil {
+		return fmt.Errorf("failed to unmarshal consensus params: %w", err)
+	}
+	return sm.bapp.StoreConsensusParams(sm.bapp.NewUncachedContext(false, cmtproto.Header{}), cp)
+}
+
+// snapshotWriter splits the snapshot stream into chunks as it is written, so that no more
+// than one chunk is held in memory. Chunks are written to dir, or kept in memory when it is empty.
+type snapshotWriter struct {
+	dir         string
+	chunkSize   int
+	buf         []byte
+	chunks      [][]byte // Chunks of in-memory snapshots
+	chunkHashes [][]byte
+	hash        hash.Hash // SHA-256 of the whole stream
+	size        int
+}
+
+// Write implements io.Writer
+func (w *snapshotWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	w.hash.Write(p)
+	w.size += n
+
+	for len(p) > 0 {
+		space := w.chunkSize - len(w.buf)
+		if len(p) < space {
+			w.buf = append(w.buf, p...)
+			break
+		}
+		w.buf = append(w.buf, p[:space]...)
+		p = p[space:]
+		if err := w.flush(); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// flush writes out the buffered data as the next chunk
+func (w *snapshotWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	chunkHash := sha256.Sum256(w.buf)
+	index := len(w.chunkHashes)
+	w.chunkHashes = append(w.chunkHashes, chunkHash[:])
+
+	if w.dir == "" {
+		w.chunks = append(w.chunks, w.buf)
+		w.buf = nil
+		return nil
+	}
+	if err := os.WriteFile(filepath.Join(w.dir, strconv.Itoa(index)), w.buf, 0o644); err != nil {
+		return fmt.Errorf("failed to write snapshot chunk %d: %w", index, err)
+	}
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// discard removes the chunks written for a snapshot that could not be completed
+func (w *snapshotWriter) discard() {
+	if w.dir != "" {
+		_ = os.RemoveAll(w.dir)
+	}
+}
+
+// chunkReader reads the chunks of a snapshot one after another, opening each chunk only
+// once the previous one has been read
+type chunkReader struct {
+	open    func(index uint32) (io.ReadCloser, error)
+	count   uint32
+	next    uint32
+	current io.ReadCloser
+}
+
+// Read implements io.Reader
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if r.next >= r.count {
+				return 0, io.EOF
+			}
+			chunk, err := r.open(r.next)
+			if err != nil {
+				return 0, err
+			}
+			r.current = chunk
+			r.next++
+		}
+
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			r.current.Close()
+			r.current = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+// Close closes the chunk being read
+func (r *chunkReader) Close() error {
+	if r.current == nil {
+		return nil
+	}
+	err := r.current.Close()
+	r.current = nil
+	return err
+}
+
+// snapshotDir returns the directory of the snapshot at height
+func (sm *SnapshotManager) snapshotDir(height uint64) string {
+	return filepath.Join(sm.dir, strconv.FormatUint(height, 10))
+}
+
+// saveSnapshot records a snapshot whose chunks have been written, writing the metadata
+// last so that partially written snapshots are never loaded
+func (sm *SnapshotManager) saveSnapshot(snapshot *SnapshotInfo, chunks [][]byte) error {
+	if sm.dir == "" {
+		sm.chunks[snapshot.Height] = chunks
+		return nil
+	}
+
+	metadata, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot metadata: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(sm.snapshotDir(snapshot.Height), snapshotMetadataFile), metadata, 0o644); err != nil {
+		return fmt.Errorf("failed to write snapshot metadata: %w", err)
+	}
+	return nil
+}
+
+// loadSnapshots reads the metadata of every snapshot persisted in the snapshot directory
+func (sm *SnapshotManager) loadSnapshots() error {
+	entries, err := os.ReadDir(sm.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		height, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if !entry.IsDir() || err != nil {
+			continue
+		}
+
+		metadata, err := os.ReadFile(filepath.Join(sm.snapshotDir(height), snapshotMetadataFile))
+		if err != nil {
+			// Snapshot creation was interrupted before the metadata was written
+			sm.logger.Info("skipping incomplete snapshot", "height", height)
+			continue
+		}
+		var snapshot SnapshotInfo
+		if err := json.Unmarshal(metadata, &snapshot); err != nil {
+			return fmt.Errorf("invalid metadata of snapshot %d: %w", height, err)
+		}
+		sm.snapshots[height] = &snapshot
+	}
+	return nil
+}
+
+// pruneSnapshots deletes all but the keepRecent most recent snapshots
+func (sm *SnapshotManager) pruneSnapshots() {
+	if sm.keepRecent == 0 || len(sm.snapshots) <= int(sm.keepRecent) {
+		return
+	}
+
+	heights := make([]uint64, 0, len(sm.snapshots))
+	for height := range sm.snapshots {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+
+	for _, height := range heights[sm.keepRecent:] {
+		delete(sm.snapshots, height)
+		delete(sm.chunks, height)
+		if sm.dir != "" {
+			if err := os.RemoveAll(sm.snapshotDir(height)); err != nil {
+				sm.logger.Error("failed to prune snapshot", "height", height, "error", err)
+			}
+		}
+	}
 }
 
 // GetSnapshot returns a snapshot by height
 func (sm *SnapshotManager) GetSnapshot(height uint64) (*SnapshotInfo, bool) {
 	sm.mu.RLock()
 	defer sm.mu.RUnlock()
-	
+
 	snapshot, exists := sm.snapshots[height]
 	return snapshot, exists
 }
 
-// GetChunk returns a chunk by hash
-func (sm *SnapshotManager) GetChunk(hash string) ([]byte, bool) {
+// GetChunk returns a chunk of the snapshot at height
+func (sm *SnapshotManager) GetChunk(height uint64, index uint32) ([]byte, error) {
 	sm.mu.RLock()
 	defer sm.mu.RUnlock()
-	
-	chunk, exists := sm.chunks[hash]
-	return chunk, exists
+
+	snapshot, exists := sm.snapshots[height]
+	if !exists {
+		return nil, fmt.Errorf("snapshot not found at height %d", height)
+	}
+	if index >= snapshot.ChunkCount {
+		return nil, fmt.Errorf("chunk index %d out of range (max %d)", index, snapshot.ChunkCount-1)
+	}
+
+	if sm.dir == "" {
+		return sm.chunks[height][index], nil
+	}
+	chunk, err := os.ReadFile(filepath.Join(sm.snapshotDir(height), strconv.FormatUint(uint64(index), 10)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot chunk %d: %w", index, err)
+	}
+	return chunk, nil
 }
 
-// ListSnapshots returns all available snapshots
+// ListSnapshots returns all available snapshots, most recent first
 func (sm *SnapshotManager) ListSnapshots() []*SnapshotInfo {
 	sm.mu.RLock()
 	defer sm.mu.RUnlock()
-	
+
 	snapshots := make([]*SnapshotInfo, 0, len(sm.snapshots))
 	for _, snapshot := range sm.snapshots {
 		snapshots = append(snapshots, snapshot)
 	}
-	
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Height > snapshots[j].Height })
+
 	return snapshots
 }
 
-// ApplyChunk applies a chunk to the application state
-func (sm *SnapshotManager) ApplyChunk(chunkIndex uint32, chunk []byte, chunkHash string) error {
+// GetLatestSnapshot returns the latest snapshot
+func (sm *SnapshotManager) GetLatestSnapshot() *SnapshotInfo {
+	snapshots := sm.ListSnapshots()
+	if len(snapshots) == 0 {
+		return nil
+	}
+	return snapshots[0]
+}
+
+// ToABCI converts the snapshot into its ABCI representation, with the chunk hashes as metadata
+func (s *SnapshotInfo) ToABCI() (*abci.Snapshot, error) {
+	metadata, err := (&snapshottypes.Metadata{ChunkHashes: s.ChunkHashes}).Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal snapshot metadata: %w", err)
+	}
+
+	return &abci.Snapshot{
+		Height:   s.Height,
+		Format:   s.Format,
+		Chunks:   s.ChunkCount,
+		Hash:     s.Hash,
+		Metadata: metadata,
+	}, nil
+}
+
+// OfferSnapshot starts restoring a snapshot offered by a peer
+func (sm *SnapshotManager) OfferSnapshot(snapshot *abci.Snapshot, appHash []byte) abci.ResponseOfferSnapshot_Result {
+	if snapshot == nil || snapshot.Height == 0 || snapshot.Chunks == 0 {
+		return abci.ResponseOfferSnapshot_REJECT
+	}
+	if snapshot.Format != SnapshotFormatVersion {
+		return abci.ResponseOfferSnapshot_REJECT_FORMAT
+	}
+
+	var metadata snapshottypes.Metadata
+	if err := metadata.Unmarshal(snapshot.Metadata); err != nil || len(metadata.ChunkHashes) != int(snapshot.Chunks) {
+		return abci.ResponseOfferSnapshot_REJECT
+	}
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	restore := &snapshotRestore{
+		snapshot: &SnapshotInfo{
+			Height:      snapshot.Height,
+			Format:      snapshot.Format,
+			ChunkCount:  snapshot.Chunks,
+			Hash:        snapshot.Hash,
+			ChunkHashes: metadata.ChunkHashes,
+		},
+		appHash: appHash,
+		applied: make([]bool, snapshot.Chunks),
+	}
+	if sm.dir == "" {
+		restore.chunks = make([][]byte, snapshot.Chunks)
+	} else {
+		// Chunks left over from an earlier restore are never reused
+		restore.dir = filepath.Join(sm.dir, snapshotRestoreDir)
+		if err := os.RemoveAll(restore.dir); err != nil {
+			sm.logger.Error("failed to clear snapshot restore directory", "error", err)
+			return abci.ResponseOfferSnapshot_ABORT
+		}
+		if err := os.MkdirAll(restore.dir, 0o755); err != nil {
+			sm.logger.Error("failed to create snapshot restore directory", "error", err)
+			return abci.ResponseOfferSnapshot_ABORT
+		}
+	}
+	sm.restore = restore
+	return abci.ResponseOfferSnapshot_ACCEPT
+}
+
+// ApplyChunk verifies a chunk of the snapshot being restored against its hash and,
+// once every chunk has arrived, restores the application state from the snapshot
+func (sm *SnapshotManager) ApplyChunk(index uint32, chunk []byte, sender string) *abci.ResponseApplySnapshotChunk {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	restore := sm.restore
+	if restore == nil || index >= restore.snapshot.ChunkCount {
+		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_REJECT_SNAPSHOT}
+	}
+
 	// Verify chunk hash
 	hash := sha256.Sum256(chunk)
-	expectedHash := fmt.Sprintf("%x", hash)
-	if expectedHash != chunkHash {
-		return fmt.Errorf("chunk hash mismatch: expected %s, got %s", expectedHash, chunkHash)
+	if !bytes.Equal(hash[:], restore.snapshot.ChunkHashes[index]) {
+		sm.logger.Info("snapshot chunk hash mismatch", "height", restore.snapshot.Height, "chunk", index, "sender", sender)
+		resp := &abci.ResponseApplySnapshotChunk{
+			Result:        abci.ResponseApplySnapshotChunk_RETRY,
+			RefetchChunks: []uint32{index},
+		}
+		if sender != "" {
+			resp.RejectSenders = []string{sender}
+		}
+		return resp
 	}
-	
-	// Store chunk for later application
-	sm.mu.Lock()
-	sm.chunks[chunkHash] = chunk
-	sm.mu.Unlock()
-	
-	return nil
+
+	if err := restore.saveChunk(index, chunk); err != nil {
+		sm.logger.Error("failed to save snapshot chunk", "height", restore.snapshot.Height, "chunk", index, "error", err)
+		sm.abortRestore()
+		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
+	}
+	if restore.received < restore.snapshot.ChunkCount {
+		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}
+	}
+
+	// All chunks received, restore the state
+	defer sm.abortRestore()
+
+	hasher := sha256.New()
+	reader := restore.reader()
+	_, err := io.Copy(hasher, reader)
+	reader.Close()
+	if err != nil {
+		sm.logger.Error("failed to read snapshot chunks", "height", restore.snapshot.Height, "error", err)
+		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
+	}
+	if !bytes.Equal(hasher.Sum(nil), restore.snapshot.Hash) {
+		sm.logger.Error("snapshot hash mismatch", "height", restore.snapshot.Height)
+		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_REJECT_SNAPSHOT}
+	}
+
+	reader = restore.reader()
+	err = sm.importState(restore.snapshot.Height, reader)
+	reader.Close()
+	if err != nil {
+		sm.logger.Error("failed to restore snapshot", "height", restore.snapshot.Height, "error", err)
+		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
+	}
+
+	commitID := sm.bapp.CommitMultiStore().LastCommitID()
+	if len(restore.appHash) > 0 && !bytes.Equal(commitID.Hash, restore.appHash) {
+		sm.logger.Error("restored app hash mismatch", "height", restore.snapshot.Height,
+			"expected", fmt.Sprintf("%X", restore.appHash), "got", fmt.Sprintf("%X", commitID.Hash))
+		return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
+	}
+
+	sm.logger.Info("restored state from snapshot", "height", restore.snapshot.Height, "app_hash", fmt.Sprintf("%X", commitID.Hash))
+	return &abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}
 }
 
-// CompleteSnapshot completes the snapshot application process
-func (sm *SnapshotManager) CompleteSnapshot(snapshot *SnapshotInfo) error {
-	// Verify all chunks are present
-	sm.mu.RLock()
-	defer sm.mu.RUnlock()
-	
-	for _, chunkHash := range snapshot.ChunkHashes {
-		if _, exists := sm.chunks[chunkHash]; !exists {
-			return fmt.Errorf("missing chunk: %s", chunkHash)
+// abortRestore drops the snapshot being restored and the chunks received for it
+func (sm *SnapshotManager) abortRestore() {
+	if sm.restore == nil {
+		return
+	}
+	if sm.restore.dir != "" {
+		if err := os.RemoveAll(sm.restore.dir); err != nil {
+			sm.logger.Error("failed to remove snapshot restore directory", "error", err)
 		}
 	}
-	
-	// Reconstruct state from chunks
-	chunks := make([][]byte, len(snapshot.ChunkHashes))
-	for i, chunkHash := range snapshot.ChunkHashes {
-		chunks[i] = sm.chunks[chunkHash]
-	}
-	
-	// Apply state to application
-	// This is a simplified version - in production, you'd properly deserialize and apply state
-	ctx := sm.app.NewContext(false)
-	return sm.importState(ctx, chunks)
-}
-
-// importState imports state from chunks
-func (sm *SnapshotManager) importState(ctx sdk.Context, chunks [][]byte) error {
-	// Reconstruct state data from chunks
-	var stateData []byte
-	for _, chunk := range chunks {
-		stateData = append(stateData, chunk...)
-	}
-	
-	// Deserialize and apply state
-	// This is a simplified version - in production, you'd properly deserialize and apply to stores
-	
-	// For now, just log the import
-	ctx.Logger().Info("State imported from snapshot", "size", len(stateData))
-	
+	sm.restore = nil
+}
+
+// saveChunk stores a verified chunk until the snapshot is complete
+func (r *snapshotRestore) saveChunk(index uint32, chunk []byte) error {
+	if r.dir == "" {
+		r.chunks[index] = chunk
+	} else if err := os.WriteFile(filepath.Join(r.dir, strconv.FormatUint(uint64(index), 10)), chunk, 0o644); err != nil {
+		return err
+	}
+	if !r.applied[index] {
+		r.applied[index] = true
+		r.received++
+	}
 	return nil
 }
 
-// GetLatestSnapshot returns the latest snapshot
-func (sm *SnapshotManager) GetLatestSnapshot() *SnapshotInfo {
-	sm.mu.RLock()
-	defer sm.mu.RUnlock()
-	
-	var latest *SnapshotInfo
-	var maxHeight uint64
-	
-	for _, snapshot := range sm.snapshots {
-		if snapshot.Height > maxHeight {
-			maxHeight = snapshot.Height
-			latest = snapshot
+// reader returns the snapshot stream, read from the stored chunks
+func (r *snapshotRestore) reader() *chunkReader {
+	return &chunkReader{
+		count: r.snapshot.ChunkCount,
+		open: func(index uint32) (io.ReadCloser, error) {
+			if r.dir == "" {
+				return io.NopCloser(bytes.NewReader(r.chunks[index])), nil
+			}
+			return os.Open(filepath.Join(r.dir, strconv.FormatUint(uint64(index), 10)))
+		},
+	}
+}
+
+// The ABCI state sync methods of every app delegate to the snapshot manager
+
+// ListSnapshotsABCI answers the ListSnapshots ABCI request
+func (sm *SnapshotManager) ListSnapshotsABCI(_ *abci.RequestListSnapshots) (*abci.ResponseListSnapshots, error) {
+	snapshots := sm.ListSnapshots()
+	abciSnapshots := make([]*abci.Snapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		abciSnapshot, err := snapshot.ToABCI()
+		if err != nil {
+			return nil, err
 		}
+		abciSnapshots = append(abciSnapshots, abciSnapshot)
 	}
-	
-	return latest
+
+	return &abci.ResponseListSnapshots{Snapshots: abciSnapshots}, nil
+}
+
+// LoadSnapshotChunkABCI answers the LoadSnapshotChunk ABCI request
+func (sm *SnapshotManager) LoadSnapshotChunkABCI(req *abci.RequestLoadSnapshotChunk) (*abci.ResponseLoadSnapshotChunk, error) {
+	if req.Format != SnapshotFormatVersion {
+		return nil, fmt.Errorf("unsupported snapshot format %d", req.Format)
+	}
+
+	chunk, err := sm.GetChunk(req.Height, req.Chunk)
+	if err != nil {
+		return nil, err
+	}
+	return &abci.ResponseLoadSnapshotChunk{Chunk: chunk}, nil
 }
 
+// OfferSnapshotABCI answers the OfferSnapshot ABCI request
+func (sm *SnapshotManager) OfferSnapshotABCI(req *abci.RequestOfferSnapshot) (*abci.ResponseOfferSnapshot, error) {
+	return &abci.ResponseOfferSnapshot{Result: sm.OfferSnapshot(req.Snapshot, req.AppHash)}, nil
+}
+
+// ApplySnapshotChunkABCI answers the ApplySnapshotChunk ABCI request
+func (sm *SnapshotManager) ApplySnapshotChunkABCI(req *abci.RequestApplySnapshotChunk) (*abci.ResponseApplySnapshotChunk, error) {
+	return sm.ApplyChunk(req.Index, req.Chunk, req.Sender), nil
+}
+
+// SetSnapshotOptions configures the state sync snapshots of the app
+func (app *VolnixApp) SetSnapshotOptions(opts SnapshotOptions) error {
+	return app.snapshotManager.SetOptions(opts)
+}
+
+// SetSnapshotOptions configures the state sync snapshots of the app
+func (app *MinimalVolnixApp) SetSnapshotOptions(opts SnapshotOptions) error {
+	return app.snapshotManager.SetOptions(opts)
+}
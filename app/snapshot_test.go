@@ -0,0 +1,179 @@
+package app
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	sdklog "cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	identtypes "github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+// newSnapshotSourceApp commits some module state and consensus params at height 1
+func newSnapshotSourceApp(t *testing.T) *VolnixApp {
+	app := newMonitoringTestApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 1})
+
+	app.consensusKeeper.SetValidator(ctx, &consensusv1.Validator{Validator: "validator1", Status: consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE})
+	app.identKeeper.SetParams(ctx, identtypes.DefaultParams())
+	require.NoError(t, app.identKeeper.SetVerifiedAccount(ctx, &identv1.VerifiedAccount{Address: "citizen1", IdentityHash: "hash1", Role: identv1.Role_ROLE_CITIZEN}))
+	require.NoError(t, app.StoreConsensusParams(ctx, cmtproto.ConsensusParams{
+		Block:     &cmtproto.BlockParams{MaxBytes: 1048576, MaxGas: 10000000},
+		Evidence:  &cmtproto.EvidenceParams{MaxAgeNumBlocks: 100000, MaxBytes: 1048576},
+		Validator: &cmtproto.ValidatorParams{PubKeyTypes: []string{"ed25519"}},
+	}))
+
+	app.CommitMultiStore().Commit()
+	return app
+}
+
+// snapshotConsensusParams returns the consensus params committed by newSnapshotSourceApp
+func snapshotConsensusParams(app *VolnixApp) cmtproto.ConsensusParams {
+	return app.GetConsensusParams(app.NewUncachedContext(false, cmtproto.Header{Height: 1}))
+}
+
+func TestSnapshot_RoundTrip(t *testing.T) {
+	source := newSnapshotSourceApp(t)
+	opts := SnapshotOptions{Dir: t.TempDir(), ChunkSize: 512}
+	require.NoError(t, source.SetSnapshotOptions(opts))
+
+	info, err := source.snapshotManager.CreateSnapshot(1, snapshotConsensusParams(source))
+	require.NoError(t, err)
+	require.Greater(t, info.ChunkCount, uint32(1))
+
+	list, err := source.ListSnapshots(context.Background(), &abci.RequestListSnapshots{})
+	require.NoError(t, err)
+	require.Len(t, list.Snapshots, 1)
+	snapshot := list.Snapshots[0]
+
+	// Restore into a fresh node
+	target := newMonitoringTestApp(t)
+	offer, err := target.OfferSnapshot(context.Background(), &abci.RequestOfferSnapshot{
+		Snapshot: snapshot,
+		AppHash:  source.LastCommitID().Hash,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseOfferSnapshot_ACCEPT, offer.Result)
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := source.LoadSnapshotChunk(context.Background(), &abci.RequestLoadSnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  i,
+		})
+		require.NoError(t, err)
+
+		resp, err := target.ApplySnapshotChunk(context.Background(), &abci.RequestApplySnapshotChunk{Index: i, Chunk: chunk.Chunk, Sender: "peer"})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseApplySnapshotChunk_ACCEPT, resp.Result)
+	}
+
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	ctx := target.NewUncachedContext(false, cmtproto.Header{Height: 1})
+	validator, err := target.consensusKeeper.GetValidator(ctx, "validator1")
+	require.NoError(t, err)
+	require.Equal(t, consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE, validator.Status)
+	account, err := target.identKeeper.GetVerifiedAccount(ctx, "citizen1")
+	require.NoError(t, err)
+	require.Equal(t, "hash1", account.IdentityHash)
+	require.Equal(t, int64(10000000), target.GetConsensusParams(ctx).Block.MaxGas)
+}
+
+func TestSnapshot_Persisted(t *testing.T) {
+	source := newSnapshotSourceApp(t)
+	opts := SnapshotOptions{Dir: t.TempDir(), ChunkSize: 512}
+	require.NoError(t, source.SetSnapshotOptions(opts))
+	info, err := source.snapshotManager.CreateSnapshot(1, snapshotConsensusParams(source))
+	require.NoError(t, err)
+
+	// A restarted node serves the snapshots found on disk
+	restarted := NewSnapshotManager(source.BaseApp, sdklog.NewNopLogger())
+	require.NoError(t, restarted.SetOptions(opts))
+	loaded, found := restarted.GetSnapshot(1)
+	require.True(t, found)
+	require.Equal(t, info, loaded)
+
+	for i := uint32(0); i < info.ChunkCount; i++ {
+		want, err := source.snapshotManager.GetChunk(1, i)
+		require.NoError(t, err)
+		got, err := restarted.GetChunk(1, i)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+}
+
+func TestSnapshot_ApplyChunkVerifiesHash(t *testing.T) {
+	source := newSnapshotSourceApp(t)
+	info, err := source.snapshotManager.CreateSnapshot(1, snapshotConsensusParams(source))
+	require.NoError(t, err)
+	snapshot, err := info.ToABCI()
+	require.NoError(t, err)
+
+	target := newMonitoringTestApp(t)
+	require.Equal(t, abci.ResponseOfferSnapshot_ACCEPT, target.snapshotManager.OfferSnapshot(snapshot, source.LastCommitID().Hash))
+
+	chunk, err := source.snapshotManager.GetChunk(1, 0)
+	require.NoError(t, err)
+	corrupted := append([]byte{}, chunk...)
+	corrupted[0] ^= 0xff
+
+	resp := target.snapshotManager.ApplyChunk(0, corrupted, "bad-peer")
+	require.Equal(t, abci.ResponseApplySnapshotChunk_RETRY, resp.Result)
+	require.Equal(t, []uint32{0}, resp.RefetchChunks)
+	require.Equal(t, []string{"bad-peer"}, resp.RejectSenders)
+
+	resp = target.snapshotManager.ApplyChunk(0, chunk, "peer")
+	require.Equal(t, abci.ResponseApplySnapshotChunk_ACCEPT, resp.Result)
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+}
+
+func TestSnapshot_OfferRejectsInvalid(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	sm := app.snapshotManager
+
+	require.Equal(t, abci.ResponseOfferSnapshot_REJECT_FORMAT, sm.OfferSnapshot(&abci.Snapshot{Height: 1, Format: 1, Chunks: 1}, nil))
+	require.Equal(t, abci.ResponseOfferSnapshot_REJECT, sm.OfferSnapshot(&abci.Snapshot{Height: 1, Format: SnapshotFormatVersion, Chunks: 2}, nil))
+
+	// Chunks are rejected when no snapshot is being restored
+	resp := sm.ApplyChunk(0, []byte("chunk"), "peer")
+	require.Equal(t, abci.ResponseApplySnapshotChunk_REJECT_SNAPSHOT, resp.Result)
+}
+
+func TestSnapshot_RestoreFromDisk(t *testing.T) {
+	source := newSnapshotSourceApp(t)
+	require.NoError(t, source.SetSnapshotOptions(SnapshotOptions{Dir: t.TempDir(), ChunkSize: 512}))
+	info, err := source.snapshotManager.CreateSnapshot(1, snapshotConsensusParams(source))
+	require.NoError(t, err)
+	snapshot, err := info.ToABCI()
+	require.NoError(t, err)
+
+	// Chunks of the snapshot being restored wait on disk, not in memory
+	target := newMonitoringTestApp(t)
+	targetDir := t.TempDir()
+	require.NoError(t, target.SetSnapshotOptions(SnapshotOptions{Dir: targetDir}))
+	require.Equal(t, abci.ResponseOfferSnapshot_ACCEPT, target.snapshotManager.OfferSnapshot(snapshot, source.LastCommitID().Hash))
+
+	restoreDir := filepath.Join(targetDir, snapshotRestoreDir)
+	for i := uint32(0); i < info.ChunkCount; i++ {
+		chunk, err := source.snapshotManager.GetChunk(1, i)
+		require.NoError(t, err)
+		resp := target.snapshotManager.ApplyChunk(i, chunk, "peer")
+		require.Equal(t, abci.ResponseApplySnapshotChunk_ACCEPT, resp.Result)
+
+		if i+1 < info.ChunkCount {
+			require.FileExists(t, filepath.Join(restoreDir, strconv.FormatUint(uint64(i), 10)))
+			require.Nil(t, target.snapshotManager.restore.chunks)
+		}
+	}
+
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	require.NoDirExists(t, restoreDir)
+	require.Nil(t, target.snapshotManager.restore)
+}
@@ -1,11 +1,22 @@
 package app
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	sdklog "cosmossdk.io/log"
+
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+	consensustypes "github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+	governancetypes "github.com/volnix-protocol/volnix-protocol/x/governance/types"
+	identtypes "github.com/volnix-protocol/volnix-protocol/x/ident/types"
+	lizenztypes "github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
 )
 
 // UpgradePlan represents an upgrade plan
@@ -18,25 +29,55 @@ type UpgradePlan struct {
 // UpgradeHandler is a function that handles an upgrade
 type UpgradeHandler func(ctx sdk.Context, plan UpgradePlan, app *VolnixApp) error
 
+// UpgradePlanStore persists the upgrade plans scheduled through governance
+// The governance keeper implements it
+type UpgradePlanStore interface {
+	GetUpgradePlans(ctx sdk.Context) []*governancev1.UpgradePlan
+	DeleteUpgradePlan(ctx sdk.Context, height int64)
+	SetUpgradeDone(ctx sdk.Context, name string, height int64)
+}
+
+// UpgradeOptions configure how the node acts on upgrade plans
+type UpgradeOptions struct {
+	// HomeDir is the node home, upgrade-info.json is written to its data directory
+	HomeDir string
+	// SkipHeights are upgrade heights the node skips, set by --unsafe-skip-upgrades
+	SkipHeights []int64
+}
+
+// FlagUnsafeSkipUpgrades is the start flag listing upgrade heights to skip
+const FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
+
 // UpgradeManager manages upgrade handlers and migrations
+// Plans are kept in the governance store, so every node agrees on them and restarts keep them
 type UpgradeManager struct {
-	handlers     map[string]UpgradeHandler
-	pendingPlans map[int64]*UpgradePlan // height -> plan
-	logger       sdklog.Logger
-	mu           sync.RWMutex
+	handlers    map[string]UpgradeHandler
+	store       UpgradePlanStore
+	homeDir     string
+	skipHeights map[int64]bool
+	logger      sdklog.Logger
+	mu          sync.RWMutex
 }
 
-var (
-	upgradeManagerOnce sync.Once
-	upgradeManagerMu   sync.RWMutex
-)
-
-// NewUpgradeManager creates a new upgrade manager
-func NewUpgradeManager(logger sdklog.Logger) *UpgradeManager {
+// NewUpgradeManager creates a new upgrade manager reading plans from store
+func NewUpgradeManager(logger sdklog.Logger, store UpgradePlanStore) *UpgradeManager {
 	return &UpgradeManager{
-		handlers:     make(map[string]UpgradeHandler),
-		pendingPlans: make(map[int64]*UpgradePlan),
-		logger:       logger,
+		handlers:    make(map[string]UpgradeHandler),
+		store:       store,
+		skipHeights: make(map[int64]bool),
+		logger:      logger,
+	}
+}
+
+// SetOptions sets the node home and the upgrade heights to skip
+func (um *UpgradeManager) SetOptions(opts UpgradeOptions) {
+	um.mu.Lock()
+	defer um.mu.Unlock()
+
+	um.homeDir = opts.HomeDir
+	um.skipHeights = make(map[int64]bool, len(opts.SkipHeights))
+	for _, height := range opts.SkipHeights {
+		um.skipHeights[height] = true
 	}
 }
 
@@ -71,233 +112,148 @@ func (um *UpgradeManager) ExecuteUpgrade(ctx sdk.Context, plan UpgradePlan, app
 }
 
 // SetupUpgradeHandlers registers all upgrade handlers for the application
+// Store changes belong in module migrations, handlers run them with RunMigrations
 func SetupUpgradeHandlers(um *UpgradeManager, app *VolnixApp) {
-	// Register upgrade handlers for different versions
-	// Example: v0.2.0 upgrade
-	um.RegisterUpgradeHandler("v0.2.0", func(ctx sdk.Context, plan UpgradePlan, app *VolnixApp) error {
-		return MigrateToV0_2_0(ctx, app)
-	})
-	
-	// Example: v0.3.0 upgrade (commented out, ready to use)
+	// v0.3.0 brings every module store to the consensus version of this binary
 	um.RegisterUpgradeHandler("v0.3.0", func(ctx sdk.Context, plan UpgradePlan, app *VolnixApp) error {
-		return MigrateToV0_3_0(ctx, app)
+		return app.RunMigrations(ctx)
 	})
-	
-	// Add more upgrade handlers as needed
 }
 
-// MigrateToV0_3_0 performs state migration to version 0.3.0
-func MigrateToV0_3_0(ctx sdk.Context, app *VolnixApp) error {
-	// Example: Migrate all modules
-	if err := migrateIdentModuleV0_3_0(ctx, app); err != nil {
-		return fmt.Errorf("ident module migration failed: %w", err)
+// RunMigrations migrates every module store from the persisted version map to the current
+// consensus version of the module and persists the resulting map
+func (app *VolnixApp) RunMigrations(ctx sdk.Context) error {
+	fromVM := app.governanceKeeper.GetModuleVersionMap(ctx)
+	if len(fromVM) == 0 {
+		fromVM = app.legacyVersionMap()
 	}
-	
-	if err := migrateLizenzModuleV0_3_0(ctx, app); err != nil {
-		return fmt.Errorf("lizenz module migration failed: %w", err)
-	}
-	
-	if err := migrateAnteilModuleV0_3_0(ctx, app); err != nil {
-		return fmt.Errorf("anteil module migration failed: %w", err)
-	}
-	
-	if err := migrateConsensusModuleV0_3_0(ctx, app); err != nil {
-		return fmt.Errorf("consensus module migration failed: %w", err)
-	}
-	
-	return nil
-}
 
-// migrateIdentModuleV0_3_0 migrates ident module to v0.3.0
-func migrateIdentModuleV0_3_0(ctx sdk.Context, app *VolnixApp) error {
-	// Example: Add new fields or update structure
-	accounts, err := app.identKeeper.GetAllVerifiedAccounts(ctx)
+	toVM, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	if err != nil {
-		return fmt.Errorf("failed to get verified accounts: %w", err)
+		return fmt.Errorf("failed to run module migrations: %w", err)
 	}
-	
-	// Perform migration for each account
-	for _, account := range accounts {
-		// Example migration logic
-		_ = account // Use account in migration
-	}
-	
+	app.governanceKeeper.SetModuleVersionMap(ctx, toVM)
 	return nil
 }
 
-// migrateLizenzModuleV0_3_0 migrates lizenz module to v0.3.0
-func migrateLizenzModuleV0_3_0(ctx sdk.Context, app *VolnixApp) error {
-	// Example migration
-	lizenzs, err := app.lizenzKeeper.GetAllActivatedLizenz(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get activated lizenzs: %w", err)
+// legacyVersionMap is the version map of chains that started before versions were persisted
+// The Volnix modules were at version 1 and the SDK modules at the versions this app ships
+func (app *VolnixApp) legacyVersionMap() module.VersionMap {
+	vm := app.mm.GetVersionMap()
+	for _, name := range []string{
+		identtypes.ModuleName,
+		lizenztypes.ModuleName,
+		anteiltypes.ModuleName,
+		consensustypes.ModuleName,
+		governancetypes.ModuleName,
+	} {
+		vm[name] = 1
 	}
-	
-	for _, lizenz := range lizenzs {
-		_ = lizenz // Use lizenz in migration
-	}
-	
-	return nil
-}
-
-// migrateAnteilModuleV0_3_0 migrates anteil module to v0.3.0
-func migrateAnteilModuleV0_3_0(ctx sdk.Context, app *VolnixApp) error {
-	// Example migration for anteil module
-	// This is a placeholder - implement actual migration logic
-	return nil
+	return vm
 }
 
-// migrateConsensusModuleV0_3_0 migrates consensus module to v0.3.0
-func migrateConsensusModuleV0_3_0(ctx sdk.Context, app *VolnixApp) error {
-	// Example migration for consensus module
-	// This is a placeholder - implement actual migration logic
-	return nil
+// GetScheduledUpgrade returns the upgrade scheduled through governance at a specific height
+func (um *UpgradeManager) GetScheduledUpgrade(ctx sdk.Context, height int64) (*UpgradePlan, bool) {
+	for _, plan := range um.GetPendingUpgrades(ctx) {
+		if plan.Height == height {
+			return plan, true
+		}
+	}
+	return nil, false
 }
 
-// MigrateToV0_2_0 performs state migration to version 0.2.0
-// This is an example migration - customize based on actual needs
-func MigrateToV0_2_0(ctx sdk.Context, app *VolnixApp) error {
-	// Example: Migrate ident module
-	if err := migrateIdentModuleV0_2_0(ctx, app); err != nil {
-		return fmt.Errorf("ident module migration failed: %w", err)
+// GetPendingUpgrades returns all pending upgrade plans ordered by height
+func (um *UpgradeManager) GetPendingUpgrades(ctx sdk.Context) []*UpgradePlan {
+	if um.store == nil {
+		return nil
 	}
-	
-	// Example: Migrate lizenz module
-	if err := migrateLizenzModuleV0_2_0(ctx, app); err != nil {
-		return fmt.Errorf("lizenz module migration failed: %w", err)
+
+	stored := um.store.GetUpgradePlans(ctx)
+	plans := make([]*UpgradePlan, 0, len(stored))
+	for _, plan := range stored {
+		plans = append(plans, &UpgradePlan{Name: plan.Name, Height: plan.Height, Info: plan.Info})
 	}
-	
-	// Add more module migrations as needed
-	
-	return nil
+	return plans
 }
 
-// migrateIdentModuleV0_2_0 migrates the ident module to v0.2.0
-func migrateIdentModuleV0_2_0(ctx sdk.Context, app *VolnixApp) error {
-	// Example migration: Update account structure or parameters
-	// This is a placeholder - implement actual migration logic
-	
-	// Get all verified accounts
-	accounts, err := app.identKeeper.GetAllVerifiedAccounts(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get verified accounts: %w", err)
+// CheckUpgradeNeeded applies the upgrade that is due at the current block height
+//
+// The node panics deliberately when the running binary has no handler for the plan, after
+// writing upgrade-info.json for cosmovisor-style tooling, so that the new binary can take over
+// at the same height. Plans at heights passed with --unsafe-skip-upgrades are dropped.
+func (um *UpgradeManager) CheckUpgradeNeeded(ctx sdk.Context, app *VolnixApp) error {
+	currentHeight := ctx.BlockHeight()
+
+	// Plans are ordered by height, a plan below the current height was missed and is due now
+	var plan *UpgradePlan
+	if pending := um.GetPendingUpgrades(ctx); len(pending) > 0 && pending[0].Height <= currentHeight {
+		plan = pending[0]
 	}
-	
-	// Example: Update each account if needed
-	for _, account := range accounts {
-		// Perform migration logic here
-		// For example: add new fields, update structure, etc.
-		_ = account // Use account in migration
+	if plan == nil {
+		return nil
 	}
-	
-	return nil
-}
 
-// migrateLizenzModuleV0_2_0 migrates the lizenz module to v0.2.0
-func migrateLizenzModuleV0_2_0(ctx sdk.Context, app *VolnixApp) error {
-	// Example migration: Update lizenz structure or parameters
-	// This is a placeholder - implement actual migration logic
-	
-	// Get all activated lizenzs
-	lizenzs, err := app.lizenzKeeper.GetAllActivatedLizenz(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get activated lizenzs: %w", err)
-	}
-	
-	// Example: Update each lizenz if needed
-	for _, lizenz := range lizenzs {
-		// Perform migration logic here
-		// For example: add new fields, update structure, etc.
-		_ = lizenz // Use lizenz in migration
+	um.mu.RLock()
+	skip := um.skipHeights[plan.Height]
+	um.mu.RUnlock()
+	if skip {
+		um.logger.Info("Skipping upgrade", "name", plan.Name, "height", plan.Height)
+		um.store.DeleteUpgradePlan(ctx, plan.Height)
+		return nil
 	}
-	
-	return nil
-}
 
-// ScheduleUpgrade schedules an upgrade at a specific height
-func (um *UpgradeManager) ScheduleUpgrade(plan UpgradePlan) error {
-	um.mu.Lock()
-	defer um.mu.Unlock()
-	
-	// Check if upgrade handler exists
-	if _, exists := um.handlers[plan.Name]; !exists {
-		return fmt.Errorf("upgrade handler not found for version: %s", plan.Name)
+	if _, exists := um.GetUpgradeHandler(plan.Name); !exists {
+		if err := um.writeUpgradeInfo(*plan); err != nil {
+			um.logger.Error("Failed to write upgrade info", "error", err)
+		}
+		msg := fmt.Sprintf("UPGRADE %q NEEDED at height: %d: %s", plan.Name, plan.Height, plan.Info)
+		um.logger.Error(msg)
+		panic(msg)
 	}
-	
-	// Check if there's already an upgrade scheduled at this height
-	if existing, exists := um.pendingPlans[plan.Height]; exists {
-		return fmt.Errorf("upgrade already scheduled at height %d: %s", plan.Height, existing.Name)
+
+	um.logger.Info("Upgrade triggered", "name", plan.Name, "height", currentHeight)
+	if err := um.ExecuteUpgrade(ctx, *plan, app); err != nil {
+		return err
 	}
-	
-	um.pendingPlans[plan.Height] = &plan
-	um.logger.Info("Upgrade scheduled", "name", plan.Name, "height", plan.Height)
-	
+
+	um.store.DeleteUpgradePlan(ctx, plan.Height)
+	um.store.SetUpgradeDone(ctx, plan.Name, currentHeight)
+	um.logger.Info("Upgrade completed and removed from schedule", "name", plan.Name)
+
 	return nil
 }
 
-// GetScheduledUpgrade returns a scheduled upgrade at a specific height
-func (um *UpgradeManager) GetScheduledUpgrade(height int64) (*UpgradePlan, bool) {
-	um.mu.RLock()
-	defer um.mu.RUnlock()
-	
-	plan, exists := um.pendingPlans[height]
-	return plan, exists
-}
+// UpgradeInfoFileName is the file under the data directory that tells cosmovisor which upgrade to run
+const UpgradeInfoFileName = "upgrade-info.json"
 
-// CancelUpgrade cancels a scheduled upgrade
-func (um *UpgradeManager) CancelUpgrade(height int64) error {
-	um.mu.Lock()
-	defer um.mu.Unlock()
-	
-	if _, exists := um.pendingPlans[height]; !exists {
-		return fmt.Errorf("no upgrade scheduled at height %d", height)
-	}
-	
-	delete(um.pendingPlans, height)
-	um.logger.Info("Upgrade cancelled", "height", height)
-	
-	return nil
+// upgradeInfo is the content of upgrade-info.json, in the format cosmovisor reads
+type upgradeInfo struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	Info   string `json:"info,omitempty"`
 }
 
-// CheckUpgradeNeeded checks if an upgrade is needed at the current block height
-func (um *UpgradeManager) CheckUpgradeNeeded(ctx sdk.Context, app *VolnixApp) error {
-	currentHeight := ctx.BlockHeight()
-	
+// writeUpgradeInfo writes upgrade-info.json under the node's data directory
+func (um *UpgradeManager) writeUpgradeInfo(plan UpgradePlan) error {
 	um.mu.RLock()
-	plan, exists := um.pendingPlans[currentHeight]
+	homeDir := um.homeDir
 	um.mu.RUnlock()
-	
-	if exists && plan != nil {
-		um.logger.Info("Upgrade triggered", "name", plan.Name, "height", currentHeight)
-		
-		if err := um.ExecuteUpgrade(ctx, *plan, app); err != nil {
-			um.logger.Error("Upgrade execution failed", "error", err)
-			return err
-		}
-		
-		// Remove executed plan
-		um.mu.Lock()
-		delete(um.pendingPlans, currentHeight)
-		um.mu.Unlock()
-		
-		um.logger.Info("Upgrade completed and removed from schedule", "name", plan.Name)
+	if homeDir == "" {
+		return fmt.Errorf("home directory not set")
 	}
-	
-	return nil
-}
 
-// GetPendingUpgrades returns all pending upgrade plans
-func (um *UpgradeManager) GetPendingUpgrades() []*UpgradePlan {
-	um.mu.RLock()
-	defer um.mu.RUnlock()
-	
-	plans := make([]*UpgradePlan, 0, len(um.pendingPlans))
-	for _, plan := range um.pendingPlans {
-		plans = append(plans, plan)
+	dataDir := filepath.Join(homeDir, "data")
+	if err := os.MkdirAll(dataDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
 	}
-	
-	return plans
+	bz, err := json.Marshal(upgradeInfo{Name: plan.Name, Height: plan.Height, Info: plan.Info})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dataDir, UpgradeInfoFileName), bz, 0o600)
 }
 
-
-
+// SetUpgradeOptions configures how the app acts on upgrade plans
+func (app *VolnixApp) SetUpgradeOptions(opts UpgradeOptions) {
+	app.upgradeManager.SetOptions(opts)
+}
@@ -0,0 +1,138 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdklog "cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	consensustypes "github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+	governancetypes "github.com/volnix-protocol/volnix-protocol/x/governance/types"
+	identtypes "github.com/volnix-protocol/volnix-protocol/x/ident/types"
+	lizenztypes "github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// scheduleUpgrade schedules a plan the way a passed proposal does, through the Msg router
+func scheduleUpgrade(t *testing.T, app *VolnixApp, ctx sdk.Context, plan *governancev1.UpgradePlan) {
+	msg := &governancev1.MsgScheduleUpgrade{Authority: app.governanceKeeper.GetAuthority(), Plan: plan}
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+	_, err := handler(ctx, msg)
+	require.NoError(t, err)
+}
+
+func TestUpgradeManager_PlansPersisted(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 5})
+	scheduleUpgrade(t, app, ctx, &governancev1.UpgradePlan{Name: "v0.4.0", Height: 20, Info: "binaries"})
+
+	// A restarted node reads the plan from the store
+	restarted := NewUpgradeManager(sdklog.NewNopLogger(), app.governanceKeeper)
+	plan, found := restarted.GetScheduledUpgrade(ctx, 20)
+	require.True(t, found)
+	require.Equal(t, &UpgradePlan{Name: "v0.4.0", Height: 20, Info: "binaries"}, plan)
+
+	// Plans cannot be scheduled by other accounts
+	msg := &governancev1.MsgScheduleUpgrade{Authority: sdk.AccAddress("someone_else________").String(), Plan: &governancev1.UpgradePlan{Name: "v0.5.0", Height: 30}}
+	_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+	require.Error(t, err)
+	require.Len(t, restarted.GetPendingUpgrades(ctx), 1)
+}
+
+func TestUpgradeManager_MissingHandlerHalts(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	home := t.TempDir()
+	app.SetUpgradeOptions(UpgradeOptions{HomeDir: home})
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 5})
+	scheduleUpgrade(t, app, ctx, &governancev1.UpgradePlan{Name: "v9.0.0", Height: 20, Info: "binaries"})
+
+	// Nothing happens before the upgrade height
+	require.NoError(t, app.upgradeManager.CheckUpgradeNeeded(ctx.WithBlockHeight(19), app))
+
+	require.PanicsWithValue(t, `UPGRADE "v9.0.0" NEEDED at height: 20: binaries`, func() {
+		_ = app.upgradeManager.CheckUpgradeNeeded(ctx.WithBlockHeight(20), app)
+	})
+
+	bz, err := os.ReadFile(filepath.Join(home, "data", UpgradeInfoFileName))
+	require.NoError(t, err)
+	var info upgradeInfo
+	require.NoError(t, json.Unmarshal(bz, &info))
+	require.Equal(t, upgradeInfo{Name: "v9.0.0", Height: 20, Info: "binaries"}, info)
+}
+
+func TestUpgradeManager_AppliesPlan(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 5})
+	scheduleUpgrade(t, app, ctx, &governancev1.UpgradePlan{Name: "v0.4.0", Height: 20})
+
+	var applied []int64
+	app.upgradeManager.RegisterUpgradeHandler("v0.4.0", func(ctx sdk.Context, plan UpgradePlan, app *VolnixApp) error {
+		applied = append(applied, ctx.BlockHeight())
+		return nil
+	})
+
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, app.upgradeManager.CheckUpgradeNeeded(ctx, app))
+	require.NoError(t, app.upgradeManager.CheckUpgradeNeeded(ctx.WithBlockHeight(21), app))
+	require.Equal(t, []int64{20}, applied)
+
+	require.Empty(t, app.upgradeManager.GetPendingUpgrades(ctx))
+	height, done := app.governanceKeeper.GetDoneUpgradeHeight(ctx, "v0.4.0")
+	require.True(t, done)
+	require.Equal(t, int64(20), height)
+}
+
+func TestUpgradeManager_SkipHeights(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	app.SetUpgradeOptions(UpgradeOptions{HomeDir: t.TempDir(), SkipHeights: []int64{20}})
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 5})
+	scheduleUpgrade(t, app, ctx, &governancev1.UpgradePlan{Name: "v9.0.0", Height: 20})
+
+	// The plan is dropped instead of halting the node
+	require.NotPanics(t, func() {
+		require.NoError(t, app.upgradeManager.CheckUpgradeNeeded(ctx.WithBlockHeight(20), app))
+	})
+	require.Empty(t, app.upgradeManager.GetPendingUpgrades(ctx))
+	_, done := app.governanceKeeper.GetDoneUpgradeHeight(ctx, "v9.0.0")
+	require.False(t, done)
+}
+
+func TestUpgradeManager_RunsModuleMigrations(t *testing.T) {
+	app := newMonitoringTestApp(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 5})
+	app.identKeeper.SetParams(ctx, identtypes.DefaultParams())
+	app.lizenzKeeper.SetParams(ctx, lizenztypes.DefaultParams())
+	app.consensusKeeper.SetParams(ctx, *consensustypes.DefaultParams())
+
+	// A chain from before version maps were stored, without the params ident version 2 added
+	paramStore := ctx.KVStore(app.keyParams)
+	paramStore.Delete(append([]byte(identtypes.ModuleName+"/"), identtypes.KeyGuestTxRate...))
+	require.Panics(t, func() { app.identKeeper.GetParams(ctx) })
+	require.Empty(t, app.governanceKeeper.GetModuleVersionMap(ctx))
+
+	scheduleUpgrade(t, app, ctx, &governancev1.UpgradePlan{Name: "v0.3.0", Height: 20})
+	require.NoError(t, app.upgradeManager.CheckUpgradeNeeded(ctx.WithBlockHeight(20), app))
+
+	require.Equal(t, identtypes.DefaultParams().GuestTxRate, app.identKeeper.GetParams(ctx).GuestTxRate)
+	vm := app.governanceKeeper.GetModuleVersionMap(ctx)
+	require.Equal(t, app.mm.GetVersionMap(), vm)
+	require.Equal(t, uint64(2), vm[identtypes.ModuleName])
+	require.Equal(t, uint64(1), vm[governancetypes.ModuleName])
+}
+
+func TestNewVolnixServer_UpgradeOptions(t *testing.T) {
+	home := t.TempDir()
+	server, err := NewVolnixServer(home, sdklog.NewNopLogger(), []int64{20, 30})
+	require.NoError(t, err)
+	defer server.Close()
+
+	um := server.GetApp().upgradeManager
+	require.Equal(t, home, um.homeDir)
+	require.Equal(t, map[int64]bool{20: true, 30: true}, um.skipHeights)
+}
@@ -3,6 +3,7 @@ module github.com/volnix-protocol/volnix-protocol/backend/api
 go 1.24.0
 
 require (
+	github.com/cosmos/cosmos-sdk v0.53.4
 	github.com/volnix-protocol/volnix-protocol v0.0.0
 	google.golang.org/grpc v1.73.0
 )
@@ -34,7 +35,6 @@ require (
 	github.com/cosmos/btcutil v1.0.5 // indirect
 	github.com/cosmos/cosmos-db v1.1.1 // indirect
 	github.com/cosmos/cosmos-proto v1.0.0-beta.5 // indirect
-	github.com/cosmos/cosmos-sdk v0.53.4 // indirect
 	github.com/cosmos/gogoproto v1.7.0 // indirect
 	github.com/cosmos/ics23/go v0.11.0 // indirect
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
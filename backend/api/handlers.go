@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
 	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
@@ -83,8 +84,31 @@ func (s *Server) identVerifiedAccountsHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 	
+	pageReq, err := pageRequestFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	role, err := enumFromQuery(r, "role", identv1.Role_value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := &identv1.QueryVerifiedAccountsRequest{
+		Pagination: pageReq,
+		Role:       identv1.Role(role),
+	}
+	if activeStr := r.URL.Query().Get("active"); activeStr != "" {
+		active, err := strconv.ParseBool(activeStr)
+		if err != nil {
+			http.Error(w, "Invalid active", http.StatusBadRequest)
+			return
+		}
+		req.IsActive = &active
+	}
+	
 	ctx := r.Context()
-	resp, err := s.identClient.VerifiedAccounts(ctx, &identv1.QueryVerifiedAccountsRequest{})
+	resp, err := s.identClient.VerifiedAccounts(ctx, req)
 	if err != nil {
 		s.handleError(w, err, "Failed to get verified accounts")
 		return
@@ -194,8 +218,28 @@ func (s *Server) anteilOrdersHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Anteil service not available", http.StatusServiceUnavailable)
 		return
 	}
+	pageReq, err := pageRequestFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	side, err := enumFromQuery(r, "side", anteilv1.OrderSide_value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	orderStatus, err := enumFromQuery(r, "status", anteilv1.OrderStatus_value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	ctx := r.Context()
-	resp, err := s.anteilClient.Orders(ctx, &anteilv1.QueryOrdersRequest{})
+	resp, err := s.anteilClient.Orders(ctx, &anteilv1.QueryOrdersRequest{
+		Owner:      r.URL.Query().Get("owner"),
+		Side:       anteilv1.OrderSide(side),
+		Status:     anteilv1.OrderStatus(orderStatus),
+		Pagination: pageReq,
+	})
 	if err != nil {
 		s.handleError(w, err, "Failed to get orders")
 		return
@@ -215,8 +259,21 @@ func (s *Server) anteilAuctionsHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Anteil service not available", http.StatusServiceUnavailable)
 		return
 	}
+	pageReq, err := pageRequestFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	auctionStatus, err := enumFromQuery(r, "status", anteilv1.AuctionStatus_value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	ctx := r.Context()
-	resp, err := s.anteilClient.Auctions(ctx, &anteilv1.QueryAuctionsRequest{})
+	resp, err := s.anteilClient.Auctions(ctx, &anteilv1.QueryAuctionsRequest{
+		Status:     anteilv1.AuctionStatus(auctionStatus),
+		Pagination: pageReq,
+	})
 	if err != nil {
 		s.handleError(w, err, "Failed to get auctions")
 		return
@@ -224,3 +281,140 @@ func (s *Server) anteilAuctionsHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(resp)
 }
+
+func (s *Server) anteilTradesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		s.setCORSHeaders(w)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	s.setCORSHeaders(w)
+	if s.anteilClient == nil {
+		http.Error(w, "Anteil service not available", http.StatusServiceUnavailable)
+		return
+	}
+	pageReq, err := pageRequestFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	resp, err := s.anteilClient.Trades(ctx, &anteilv1.QueryTradesRequest{Pagination: pageReq})
+	if err != nil {
+		s.handleError(w, err, "Failed to get trades")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (s *Server) anteilOrderHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		s.setCORSHeaders(w)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	s.setCORSHeaders(w)
+	if s.anteilClient == nil {
+		http.Error(w, "Anteil service not available", http.StatusServiceUnavailable)
+		return
+	}
+	// Extract order ID from URL path
+	orderID := r.URL.Path[len("/volnix/anteil/v1/order/"):]
+	if orderID == "" {
+		http.Error(w, "Order ID is required", http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	resp, err := s.anteilClient.Order(ctx, &anteilv1.QueryOrderRequest{OrderId: orderID})
+	if err != nil {
+		s.handleError(w, err, "Failed to get order")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (s *Server) anteilOrderBookHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		s.setCORSHeaders(w)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	s.setCORSHeaders(w)
+	if s.anteilClient == nil {
+		http.Error(w, "Anteil service not available", http.StatusServiceUnavailable)
+		return
+	}
+	var depth uint64
+	if depthStr := r.URL.Query().Get("depth"); depthStr != "" {
+		var err error
+		depth, err = strconv.ParseUint(depthStr, 10, 32)
+		if err != nil {
+			http.Error(w, "Invalid depth", http.StatusBadRequest)
+			return
+		}
+	}
+	ctx := r.Context()
+	resp, err := s.anteilClient.OrderBook(ctx, &anteilv1.QueryOrderBookRequest{Depth: uint32(depth)})
+	if err != nil {
+		s.handleError(w, err, "Failed to get order book")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (s *Server) anteilPositionHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		s.setCORSHeaders(w)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	s.setCORSHeaders(w)
+	if s.anteilClient == nil {
+		http.Error(w, "Anteil service not available", http.StatusServiceUnavailable)
+		return
+	}
+	// Extract owner from URL path
+	owner := r.URL.Path[len("/volnix/anteil/v1/position/"):]
+	if owner == "" {
+		http.Error(w, "Owner address is required", http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	resp, err := s.anteilClient.UserPosition(ctx, &anteilv1.QueryUserPositionRequest{Owner: owner})
+	if err != nil {
+		s.handleError(w, err, "Failed to get user position")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (s *Server) anteilAuctionHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		s.setCORSHeaders(w)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	s.setCORSHeaders(w)
+	if s.anteilClient == nil {
+		http.Error(w, "Anteil service not available", http.StatusServiceUnavailable)
+		return
+	}
+	// Extract auction ID from URL path
+	auctionID := r.URL.Path[len("/volnix/anteil/v1/auction/"):]
+	if auctionID == "" {
+		http.Error(w, "Auction ID is required", http.StatusBadRequest)
+		return
+	}
+	ctx := r.Context()
+	resp, err := s.anteilClient.Auction(ctx, &anteilv1.QueryAuctionRequest{AuctionId: auctionID})
+	if err != nil {
+		s.handleError(w, err, "Failed to get auction")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
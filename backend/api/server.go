@@ -2,12 +2,15 @@ package main
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"strconv"
 
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
 	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
@@ -46,6 +49,7 @@ func (s *Server) SetupRoutes(mux *http.ServeMux) {
 	// Consensus module endpoints
 	mux.HandleFunc("/volnix/consensus/v1/params", s.consensusParamsHandler)
 	mux.HandleFunc("/volnix/consensus/v1/validators", s.consensusValidatorsHandler)
+	mux.HandleFunc("/volnix/consensus/v1/halving_schedule", s.consensusHalvingScheduleHandler)
 
 	// Identity module endpoints
 	mux.HandleFunc("/volnix/ident/v1/params", s.identParamsHandler)
@@ -58,7 +62,12 @@ func (s *Server) SetupRoutes(mux *http.ServeMux) {
 
 	// Anteil module endpoints
 	mux.HandleFunc("/volnix/anteil/v1/params", s.anteilParamsHandler)
+	mux.HandleFunc("/volnix/anteil/v1/order/", s.anteilOrderHandler)
 	mux.HandleFunc("/volnix/anteil/v1/orders", s.anteilOrdersHandler)
+	mux.HandleFunc("/volnix/anteil/v1/order_book", s.anteilOrderBookHandler)
+	mux.HandleFunc("/volnix/anteil/v1/position/", s.anteilPositionHandler)
+	mux.HandleFunc("/volnix/anteil/v1/trades", s.anteilTradesHandler)
+	mux.HandleFunc("/volnix/anteil/v1/auction/", s.anteilAuctionHandler)
 	mux.HandleFunc("/volnix/anteil/v1/auctions", s.anteilAuctionsHandler)
 }
 
@@ -112,6 +121,7 @@ func (s *Server) rootHandler(w http.ResponseWriter, r *http.Request) {
 			"health":               "/health",
 			"consensus_params":     "/volnix/consensus/v1/params",
 			"consensus_validators": "/volnix/consensus/v1/validators",
+			"halving_schedule":     "/volnix/consensus/v1/halving_schedule",
 		},
 	})
 }
@@ -254,10 +264,16 @@ func (s *Server) consensusValidatorsHandler(w http.ResponseWriter, r *http.Reque
 
 	s.setCORSHeaders(w)
 
+	pageReq, err := pageRequestFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	// Try gRPC first
 	if s.consensusClient != nil {
 		ctx := r.Context()
-		resp, err := s.consensusClient.Validators(ctx, &consensusv1.QueryValidatorsRequest{})
+		resp, err := s.consensusClient.Validators(ctx, &consensusv1.QueryValidatorsRequest{Pagination: pageReq})
 		if err == nil {
 			w.Header().Set("Content-Type", "application/json")
 			json.NewEncoder(w).Encode(resp)
@@ -279,6 +295,93 @@ func (s *Server) consensusValidatorsHandler(w http.ResponseWriter, r *http.Reque
 	})
 }
 
+// consensusHalvingScheduleHandler handles halving schedule requests
+// The optional count query parameter sets the number of projected halvings
+func (s *Server) consensusHalvingScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		s.setCORSHeaders(w)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.setCORSHeaders(w)
+	if s.consensusClient == nil {
+		http.Error(w, "Consensus service not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	req := &consensusv1.QueryHalvingScheduleRequest{}
+	if countStr := r.URL.Query().Get("count"); countStr != "" {
+		count, err := strconv.ParseUint(countStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid count", http.StatusBadRequest)
+			return
+		}
+		req.Count = count
+	}
+
+	resp, err := s.consensusClient.HalvingSchedule(r.Context(), req)
+	if err != nil {
+		s.handleError(w, err, "Failed to get halving schedule")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// pageRequestFromQuery reads the limit, offset and key query parameters into a page request
+// key is the base64 next_key of a previous page; it cannot be combined with offset
+func pageRequestFromQuery(r *http.Request) (*sdkquery.PageRequest, error) {
+	query := r.URL.Query()
+	pageReq := &sdkquery.PageRequest{}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err := strconv.ParseUint(limitStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit: %s", limitStr)
+		}
+		pageReq.Limit = limit
+	}
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		offset, err := strconv.ParseUint(offsetStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid offset: %s", offsetStr)
+		}
+		pageReq.Offset = offset
+	}
+	if keyStr := query.Get("key"); keyStr != "" {
+		key, err := base64.StdEncoding.DecodeString(keyStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key: %s", keyStr)
+		}
+		pageReq.Key = key
+	}
+	if pageReq.Key != nil && pageReq.Offset > 0 {
+		return nil, fmt.Errorf("key and offset cannot both be set")
+	}
+
+	return pageReq, nil
+}
+
+// enumFromQuery reads an enum query parameter by its proto name; an absent parameter is the zero value
+func enumFromQuery(r *http.Request, name string, values map[string]int32) (int32, error) {
+	valueStr := r.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+	value, ok := values[valueStr]
+	if !ok {
+		return 0, fmt.Errorf("invalid %s: %s", name, valueStr)
+	}
+	return value, nil
+}
+
 // handleError handles gRPC errors and converts them to HTTP errors
 func (s *Server) handleError(w http.ResponseWriter, err error, message string) {
 	st, ok := status.FromError(err)
@@ -42,6 +42,8 @@ import (
 	protov2 "google.golang.org/protobuf/proto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+
+	volnixapp "github.com/volnix-protocol/volnix-protocol/app"
 )
 
 const DefaultNodeHome = ".volnix"
@@ -281,6 +283,9 @@ type StandaloneApp struct {
 	// Key: address, Value: map[denom]amount (as string for math.Int compatibility)
 	accountBalances map[string]map[string]string
 	balancesMutex   sync.RWMutex
+	// Snapshot manager for State Sync
+	// Snapshots cover the committed stores; the in-memory maps above are not part of them
+	snapshotManager *volnixapp.SnapshotManager
 }
 
 // FinalizeBlock overrides BaseApp FinalizeBlock to ensure transactions are properly processed
@@ -851,7 +856,11 @@ func NewStandaloneApp(logger log.Logger, db cosmosdb.DB, chainID string) *Standa
 		txDecoder:        txDecoder, // Store txDecoder for CheckTx override
 		accountSequences: make(map[string]uint64),
 		accountBalances:  make(map[string]map[string]string), // Initialize balances map
+		snapshotManager:  volnixapp.NewSnapshotManager(bapp, logger),
 	}
+	bapp.SetPrepareCheckStater(func(ctx sdk.Context) {
+		app.snapshotManager.SnapshotIfDue(ctx)
+	})
 
 	// CRITICAL: Initialize genesis account with initial balances
 	// This ensures balances are tracked in accountBalances from the start
@@ -957,38 +966,22 @@ func NewStandaloneApp(logger log.Logger, db cosmosdb.DB, chainID string) *Standa
 
 // ApplySnapshotChunk implements the ABCI interface with context
 func (app *StandaloneApp) ApplySnapshotChunk(ctx context.Context, req *abci.RequestApplySnapshotChunk) (*abci.ResponseApplySnapshotChunk, error) {
-	return &abci.ResponseApplySnapshotChunk{
-		Result: abci.ResponseApplySnapshotChunk_ACCEPT,
-	}, nil
+	return app.snapshotManager.ApplySnapshotChunkABCI(req)
 }
 
 // LoadSnapshotChunk implements the ABCI interface with context
-// IMPROVED: Support State Sync by loading snapshot chunks
 func (app *StandaloneApp) LoadSnapshotChunk(ctx context.Context, req *abci.RequestLoadSnapshotChunk) (*abci.ResponseLoadSnapshotChunk, error) {
-	// For State Sync to work, we need to provide snapshot chunks
-	// In a full implementation, this would return actual snapshot chunks
-	// For now, return empty chunk (State Sync will use block sync as fallback)
-	return &abci.ResponseLoadSnapshotChunk{
-		Chunk: []byte{},
-	}, nil
+	return app.snapshotManager.LoadSnapshotChunkABCI(req)
 }
 
 // ListSnapshots implements the ABCI interface with context
-// IMPROVED: Support State Sync by providing snapshot information
 func (app *StandaloneApp) ListSnapshots(ctx context.Context, req *abci.RequestListSnapshots) (*abci.ResponseListSnapshots, error) {
-	// For State Sync to work, we need to provide snapshots
-	// In a full implementation, this would return actual snapshots
-	// For now, return empty list (State Sync will use block sync as fallback)
-	return &abci.ResponseListSnapshots{
-		Snapshots: []*abci.Snapshot{},
-	}, nil
+	return app.snapshotManager.ListSnapshotsABCI(req)
 }
 
 // OfferSnapshot implements the ABCI interface with context
 func (app *StandaloneApp) OfferSnapshot(ctx context.Context, req *abci.RequestOfferSnapshot) (*abci.ResponseOfferSnapshot, error) {
-	return &abci.ResponseOfferSnapshot{
-		Result: abci.ResponseOfferSnapshot_REJECT,
-	}, nil
+	return app.snapshotManager.OfferSnapshotABCI(req)
 }
 
 // StandaloneABCIWrapper wraps StandaloneApp to provide context-aware ABCI methods
@@ -1400,6 +1393,9 @@ func (s *StandaloneServer) Start(ctx context.Context) error {
 	// Create standalone app with fresh database
 	// CRITICAL: Pass chainID from genesis.json to set it in BaseApp before handshake
 	s.app = NewStandaloneApp(s.logger, db, chainID)
+	if err := s.app.snapshotManager.SetOptions(volnixapp.DefaultSnapshotOptions(s.homeDir)); err != nil {
+		return fmt.Errorf("failed to configure snapshots: %w", err)
+	}
 
 	// NOTE: We cannot call InitChain manually because BaseApp validates chain-id
 	// and will fail if database already has a chain-id (even empty).
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/spf13/cobra"
+
+	"github.com/volnix-protocol/volnix-protocol/app"
+)
+
+func createDebugCommands() *cobra.Command {
+	debugCmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Tools for inspecting node state",
+	}
+
+	checkInvariantsCmd := &cobra.Command{
+		Use:   "check-invariants",
+		Short: "Run all module invariants against the latest committed state",
+		Long:  "Run all module invariants against the latest committed state. Stop the node first, the command opens its database.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			homeDir, _ := cmd.Flags().GetString("home")
+			if homeDir == "" {
+				homeDir = os.Getenv("HOME")
+				if homeDir == "" {
+					homeDir = os.Getenv("USERPROFILE") // Windows
+				}
+				homeDir = filepath.Join(homeDir, ".volnix")
+			} else {
+				homeDir, _ = filepath.Abs(homeDir)
+			}
+
+			db, err := app.OpenDB(homeDir, "volnix")
+			if err != nil {
+				return fmt.Errorf("failed to open database: %w", err)
+			}
+			defer db.Close()
+
+			volnixApp := app.NewVolnixApp(log.NewNopLogger(), db, nil, app.MakeEncodingConfig(), nil)
+			if err := volnixApp.LoadLatestVersion(); err != nil {
+				return fmt.Errorf("failed to load state: %w", err)
+			}
+			height := volnixApp.LastBlockHeight()
+			ctx := volnixApp.NewContextLegacy(true, cmtproto.Header{Height: height})
+
+			broken := 0
+			for _, result := range volnixApp.CheckInvariants(ctx) {
+				status := "ok"
+				if result.Broken {
+					status = "BROKEN"
+					broken++
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%-40s %s\n", result.Route, status)
+				if result.Broken {
+					fmt.Fprint(cmd.OutOrStdout(), result.Msg)
+				}
+			}
+
+			if broken > 0 {
+				return fmt.Errorf("%d invariants broken at height %d", broken, height)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "All invariants hold at height %d\n", height)
+			return nil
+		},
+	}
+	checkInvariantsCmd.Flags().String("home", "", "Directory for config and data (default: $HOME/.volnix)")
+
+	debugCmd.AddCommand(checkInvariantsCmd)
+	return debugCmd
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volnix-protocol/volnix-protocol/app"
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+)
+
+// commitHome commits one block of state written by write to the database of a node home
+func commitHome(t *testing.T, homeDir string, write func(volnixApp *app.VolnixApp, height int64)) {
+	db, err := app.OpenDB(homeDir, "volnix")
+	require.NoError(t, err)
+	defer db.Close()
+
+	volnixApp := app.NewVolnixApp(log.NewNopLogger(), db, nil, app.MakeEncodingConfig(), nil)
+	require.NoError(t, volnixApp.LoadLatestVersion())
+	height := volnixApp.LastBlockHeight() + 1
+	write(volnixApp, height)
+	volnixApp.CommitMultiStore().Commit()
+}
+
+// runCheckInvariants runs the debug check-invariants command against a node home
+func runCheckInvariants(homeDir string) (string, error) {
+	cmd := createDebugCommands()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{"check-invariants", "--home", homeDir})
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestCheckInvariants(t *testing.T) {
+	homeDir := t.TempDir()
+	commitHome(t, homeDir, func(volnixApp *app.VolnixApp, height int64) {})
+
+	out, err := runCheckInvariants(homeDir)
+	require.NoError(t, err)
+	require.Contains(t, out, "lizenz/validator-cap")
+	require.Contains(t, out, "All invariants hold at height 1")
+
+	// A passed proposal whose tally has no votes behind it
+	commitHome(t, homeDir, func(volnixApp *app.VolnixApp, height int64) {
+		ctx := volnixApp.NewUncachedContext(false, cmtproto.Header{Height: height})
+		require.NoError(t, volnixApp.GetGovernanceKeeper().SetProposal(ctx, &governancev1.Proposal{
+			ProposalId:   1,
+			Status:       governancev1.ProposalStatus_PROPOSAL_STATUS_PASSED,
+			YesVotes:     "10",
+			NoVotes:      "0",
+			AbstainVotes: "0",
+			TotalVotes:   "10",
+		}))
+	})
+
+	out, err = runCheckInvariants(homeDir)
+	require.ErrorContains(t, err, "1 invariants broken at height 2")
+	require.Contains(t, out, "governance/tallies")
+	require.Contains(t, out, "BROKEN")
+}
@@ -40,12 +40,13 @@ func main() {
 			fmt.Printf("📁 Home directory: %s\n", homeDir)
 
 			logger := log.NewLogger(os.Stdout)
-			server, err := NewFullVolnixServer(homeDir, logger)
+			server, err := app.NewVolnixServer(homeDir, logger, nil)
 			if err != nil {
 				return fmt.Errorf("failed to create server: %w", err)
 			}
+			defer server.Close()
 
-			if err := server.initializeFiles(); err != nil {
+			if err := server.InitializeFiles(); err != nil {
 				return fmt.Errorf("failed to initialize files: %w", err)
 			}
 
@@ -84,11 +85,20 @@ func main() {
 				return fmt.Errorf("❌ Node not initialized. Run 'volnixd init <moniker>' first")
 			}
 
+			skipHeights, err := cmd.Flags().GetInt64Slice(app.FlagUnsafeSkipUpgrades)
+			if err != nil {
+				return err
+			}
 			logger := log.NewLogger(os.Stdout)
-			server, err := NewFullVolnixServer(homeDir, logger)
+			server, err := app.NewVolnixServer(homeDir, logger, skipHeights)
 			if err != nil {
 				return fmt.Errorf("failed to create server: %w", err)
 			}
+			invCheckPeriod, err := cmd.Flags().GetUint(app.FlagInvCheckPeriod)
+			if err != nil {
+				return err
+			}
+			server.GetApp().SetInvCheckPeriod(invCheckPeriod)
 
 			fmt.Println("⚡ Starting CometBFT consensus...")
 			fmt.Println("✨ Full Volnix Protocol node running! Press Ctrl+C to stop...")
@@ -98,6 +108,8 @@ func main() {
 		},
 	}
 	startCmd.Flags().String("home", "", "Directory for config and data (default: $HOME/.volnix)")
+	startCmd.Flags().Int64Slice(app.FlagUnsafeSkipUpgrades, nil, "Skip the upgrade plans at these heights, e.g. 100,200 (unsafe, the node diverges unless the network skips them too)")
+	startCmd.Flags().Uint(app.FlagInvCheckPeriod, 0, "Check the module invariants every this many blocks and halt when one is broken (0 disables the checks)")
 
 	rootCmd.AddCommand(
 		&cobra.Command{
@@ -118,6 +130,7 @@ func main() {
 			},
 		},
 		createNetworkCommands(),
+		createDebugCommands(),
 		&cobra.Command{
 			Use:   "test-integration",
 			Short: "Test module integration",
@@ -9,6 +9,7 @@ package anteilv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -33,9 +34,13 @@ type GenesisState struct {
 	Auctions      []*Auction      `protobuf:"bytes,5,rep,name=auctions,proto3" json:"auctions,omitempty"`
 	OrderBook     *OrderBook      `protobuf:"bytes,6,opt,name=order_book,json=orderBook,proto3" json:"order_book,omitempty"`
 	// New economic state
-	MarketMakers   []*MarketMaker   `protobuf:"bytes,7,rep,name=market_makers,json=marketMakers,proto3" json:"market_makers,omitempty"`
-	LiquidityPools []*LiquidityPool `protobuf:"bytes,8,rep,name=liquidity_pools,json=liquidityPools,proto3" json:"liquidity_pools,omitempty"`
-	StakingRewards []*StakingReward `protobuf:"bytes,9,rep,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards,omitempty"`
+	MarketMakers         []*MarketMaker         `protobuf:"bytes,7,rep,name=market_makers,json=marketMakers,proto3" json:"market_makers,omitempty"`
+	LiquidityPools       []*LiquidityPool       `protobuf:"bytes,8,rep,name=liquidity_pools,json=liquidityPools,proto3" json:"liquidity_pools,omitempty"`
+	StakingRewards       []*StakingReward       `protobuf:"bytes,9,rep,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards,omitempty"`
+	Bids                 []*AuctionBid          `protobuf:"bytes,10,rep,name=bids,proto3" json:"bids,omitempty"`
+	LastDistributionTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_distribution_time,json=lastDistributionTime,proto3" json:"last_distribution_time,omitempty"` // Last ANT distribution to citizens
+	AntSupply            uint64                 `protobuf:"varint,12,opt,name=ant_supply,json=antSupply,proto3" json:"ant_supply,omitempty"`                                   // ANT minted into user positions, net of burns
+	AntBonds             []*AntBond             `protobuf:"bytes,13,rep,name=ant_bonds,json=antBonds,proto3" json:"ant_bonds,omitempty"`                                       // ANT held in escrow as blind-auction commit bonds
 }
 
 func (x *GenesisState) Reset() {
@@ -133,56 +138,220 @@ func (x *GenesisState) GetStakingRewards() []*StakingReward {
 	return nil
 }
 
+func (x *GenesisState) GetBids() []*AuctionBid {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLastDistributionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDistributionTime
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAntSupply() uint64 {
+	if x != nil {
+		return x.AntSupply
+	}
+	return 0
+}
+
+func (x *GenesisState) GetAntBonds() []*AntBond {
+	if x != nil {
+		return x.AntBonds
+	}
+	return nil
+}
+
+// AntBond holds the ANT an owner has locked in escrow as commit bonds
+type AntBond struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AntBond) Reset() {
+	*x = AntBond{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_anteil_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AntBond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AntBond) ProtoMessage() {}
+
+func (x *AntBond) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_anteil_v1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AntBond.ProtoReflect.Descriptor instead.
+func (*AntBond) Descriptor() ([]byte, []int) {
+	return file_volnix_anteil_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AntBond) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *AntBond) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// AuctionBid holds a bid of an auction
+type AuctionBid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionId string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bid       *Bid   `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid,omitempty"`
+}
+
+func (x *AuctionBid) Reset() {
+	*x = AuctionBid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_anteil_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionBid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionBid) ProtoMessage() {}
+
+func (x *AuctionBid) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_anteil_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionBid.ProtoReflect.Descriptor instead.
+func (*AuctionBid) Descriptor() ([]byte, []int) {
+	return file_volnix_anteil_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuctionBid) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *AuctionBid) GetBid() *Bid {
+	if x != nil {
+		return x.Bid
+	}
+	return nil
+}
+
 var File_volnix_anteil_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_volnix_anteil_v1_genesis_proto_rawDesc = []byte{
 	0x0a, 0x1e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2f,
 	0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x10, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e,
-	0x76, 0x31, 0x1a, 0x1c, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x61, 0x6e, 0x74, 0x65, 0x69,
-	0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xb4, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x61, 0x6e, 0x74, 0x65, 0x69,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x61, 0x6e, 0x74,
-	0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03,
+	0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x61, 0x6e, 0x74, 0x65,
+	0x69, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x8f, 0x06, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x61, 0x6e, 0x74, 0x65,
+	0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x61, 0x6e,
-	0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x61,
+	0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x75, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a,
+	0x08, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x6f,
+	0x6f, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
+	0x78, 0x2e, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b,
+	0x12, 0x42, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x6b, 0x65, 0x72,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
+	0x2e, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61,
+	0x6b, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
 	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x75,
-	0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x08,
-	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0e,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x48,
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
+	0x2e, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e,
+	0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x69, 0x64, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x61, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x61,
+	0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x61, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f,
-	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
-	0x2e, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12,
-	0x42, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e,
-	0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x4d, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x6b,
-	0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76,
-	0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0e, 0x6c,
-	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x48, 0x0a,
-	0x0f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e,
-	0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x53, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-	0x67, 0x6f, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c,
-	0x2f, 0x76, 0x31, 0x3b, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x41, 0x6e, 0x74, 0x42, 0x6f, 0x6e, 0x64, 0x52, 0x08, 0x61, 0x6e, 0x74, 0x42, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x37, 0x0a, 0x07, 0x41, 0x6e, 0x74, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x0a,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x03, 0x62, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e,
+	0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x52, 0x03, 0x62,
+	0x69, 0x64, 0x42, 0x53, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x6f,
+	0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x61, 0x6e, 0x74, 0x65, 0x69, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x61,
+	0x6e, 0x74, 0x65, 0x69, 0x6c, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -197,34 +366,42 @@ func file_volnix_anteil_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_volnix_anteil_v1_genesis_proto_rawDescData
 }
 
-var file_volnix_anteil_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_volnix_anteil_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_volnix_anteil_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),  // 0: volnix.anteil.v1.GenesisState
-	(*Params)(nil),        // 1: volnix.anteil.v1.Params
-	(*Order)(nil),         // 2: volnix.anteil.v1.Order
-	(*Trade)(nil),         // 3: volnix.anteil.v1.Trade
-	(*UserPosition)(nil),  // 4: volnix.anteil.v1.UserPosition
-	(*Auction)(nil),       // 5: volnix.anteil.v1.Auction
-	(*OrderBook)(nil),     // 6: volnix.anteil.v1.OrderBook
-	(*MarketMaker)(nil),   // 7: volnix.anteil.v1.MarketMaker
-	(*LiquidityPool)(nil), // 8: volnix.anteil.v1.LiquidityPool
-	(*StakingReward)(nil), // 9: volnix.anteil.v1.StakingReward
+	(*GenesisState)(nil),          // 0: volnix.anteil.v1.GenesisState
+	(*AntBond)(nil),               // 1: volnix.anteil.v1.AntBond
+	(*AuctionBid)(nil),            // 2: volnix.anteil.v1.AuctionBid
+	(*Params)(nil),                // 3: volnix.anteil.v1.Params
+	(*Order)(nil),                 // 4: volnix.anteil.v1.Order
+	(*Trade)(nil),                 // 5: volnix.anteil.v1.Trade
+	(*UserPosition)(nil),          // 6: volnix.anteil.v1.UserPosition
+	(*Auction)(nil),               // 7: volnix.anteil.v1.Auction
+	(*OrderBook)(nil),             // 8: volnix.anteil.v1.OrderBook
+	(*MarketMaker)(nil),           // 9: volnix.anteil.v1.MarketMaker
+	(*LiquidityPool)(nil),         // 10: volnix.anteil.v1.LiquidityPool
+	(*StakingReward)(nil),         // 11: volnix.anteil.v1.StakingReward
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(*Bid)(nil),                   // 13: volnix.anteil.v1.Bid
 }
 var file_volnix_anteil_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: volnix.anteil.v1.GenesisState.params:type_name -> volnix.anteil.v1.Params
-	2, // 1: volnix.anteil.v1.GenesisState.orders:type_name -> volnix.anteil.v1.Order
-	3, // 2: volnix.anteil.v1.GenesisState.trades:type_name -> volnix.anteil.v1.Trade
-	4, // 3: volnix.anteil.v1.GenesisState.user_positions:type_name -> volnix.anteil.v1.UserPosition
-	5, // 4: volnix.anteil.v1.GenesisState.auctions:type_name -> volnix.anteil.v1.Auction
-	6, // 5: volnix.anteil.v1.GenesisState.order_book:type_name -> volnix.anteil.v1.OrderBook
-	7, // 6: volnix.anteil.v1.GenesisState.market_makers:type_name -> volnix.anteil.v1.MarketMaker
-	8, // 7: volnix.anteil.v1.GenesisState.liquidity_pools:type_name -> volnix.anteil.v1.LiquidityPool
-	9, // 8: volnix.anteil.v1.GenesisState.staking_rewards:type_name -> volnix.anteil.v1.StakingReward
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	3,  // 0: volnix.anteil.v1.GenesisState.params:type_name -> volnix.anteil.v1.Params
+	4,  // 1: volnix.anteil.v1.GenesisState.orders:type_name -> volnix.anteil.v1.Order
+	5,  // 2: volnix.anteil.v1.GenesisState.trades:type_name -> volnix.anteil.v1.Trade
+	6,  // 3: volnix.anteil.v1.GenesisState.user_positions:type_name -> volnix.anteil.v1.UserPosition
+	7,  // 4: volnix.anteil.v1.GenesisState.auctions:type_name -> volnix.anteil.v1.Auction
+	8,  // 5: volnix.anteil.v1.GenesisState.order_book:type_name -> volnix.anteil.v1.OrderBook
+	9,  // 6: volnix.anteil.v1.GenesisState.market_makers:type_name -> volnix.anteil.v1.MarketMaker
+	10, // 7: volnix.anteil.v1.GenesisState.liquidity_pools:type_name -> volnix.anteil.v1.LiquidityPool
+	11, // 8: volnix.anteil.v1.GenesisState.staking_rewards:type_name -> volnix.anteil.v1.StakingReward
+	2,  // 9: volnix.anteil.v1.GenesisState.bids:type_name -> volnix.anteil.v1.AuctionBid
+	12, // 10: volnix.anteil.v1.GenesisState.last_distribution_time:type_name -> google.protobuf.Timestamp
+	1,  // 11: volnix.anteil.v1.GenesisState.ant_bonds:type_name -> volnix.anteil.v1.AntBond
+	13, // 12: volnix.anteil.v1.AuctionBid.bid:type_name -> volnix.anteil.v1.Bid
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_volnix_anteil_v1_genesis_proto_init() }
@@ -246,6 +423,30 @@ func file_volnix_anteil_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_volnix_anteil_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AntBond); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_anteil_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionBid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -253,7 +454,7 @@ func file_volnix_anteil_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_volnix_anteil_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -9,6 +9,7 @@ package consensusv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,26 +21,105 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// InitialValidator holds CometBFT validator pubkey and power for genesis.
+type InitialValidator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PubKey    []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"` // marshaled CometBFT PublicKey
+	Power     int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"` // PoVB validator address owning the consensus key (used for slashing)
+}
+
+func (x *InitialValidator) Reset() {
+	*x = InitialValidator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitialValidator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitialValidator) ProtoMessage() {}
+
+func (x *InitialValidator) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitialValidator.ProtoReflect.Descriptor instead.
+func (*InitialValidator) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InitialValidator) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *InitialValidator) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *InitialValidator) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
 // GenesisState defines the consensus module's genesis state
 type GenesisState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params           *Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Validators       []*Validator       `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
-	BlockCreators    []*BlockCreator    `protobuf:"bytes,3,rep,name=block_creators,json=blockCreators,proto3" json:"block_creators,omitempty"`
-	BurnProofs       []*BurnProof       `protobuf:"bytes,4,rep,name=burn_proofs,json=burnProofs,proto3" json:"burn_proofs,omitempty"`
-	ActivityScores   []*ActivityScore   `protobuf:"bytes,5,rep,name=activity_scores,json=activityScores,proto3" json:"activity_scores,omitempty"`
-	HalvingInfo      *HalvingInfo       `protobuf:"bytes,6,opt,name=halving_info,json=halvingInfo,proto3" json:"halving_info,omitempty"`
-	ConsensusState   *ConsensusState    `protobuf:"bytes,7,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
-	ValidatorWeights []*ValidatorWeight `protobuf:"bytes,8,rep,name=validator_weights,json=validatorWeights,proto3" json:"validator_weights,omitempty"`
+	Params                 *Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Validators             []*Validator            `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	BlockCreators          []*BlockCreator         `protobuf:"bytes,3,rep,name=block_creators,json=blockCreators,proto3" json:"block_creators,omitempty"`
+	BurnProofs             []*BurnProof            `protobuf:"bytes,4,rep,name=burn_proofs,json=burnProofs,proto3" json:"burn_proofs,omitempty"`
+	ActivityScores         []*ActivityScore        `protobuf:"bytes,5,rep,name=activity_scores,json=activityScores,proto3" json:"activity_scores,omitempty"`
+	HalvingInfo            *HalvingInfo            `protobuf:"bytes,6,opt,name=halving_info,json=halvingInfo,proto3" json:"halving_info,omitempty"`
+	ConsensusState         *ConsensusState         `protobuf:"bytes,7,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+	ValidatorWeights       []*ValidatorWeight      `protobuf:"bytes,8,rep,name=validator_weights,json=validatorWeights,proto3" json:"validator_weights,omitempty"`
+	InitialValidators      []*InitialValidator     `protobuf:"bytes,9,rep,name=initial_validators,json=initialValidators,proto3" json:"initial_validators,omitempty"` // for ModuleManager HasABCIGenesis
+	BlindAuctions          []*BlindAuction         `protobuf:"bytes,10,rep,name=blind_auctions,json=blindAuctions,proto3" json:"blind_auctions,omitempty"`
+	BlockTimes             []*BlockTimeRecord      `protobuf:"bytes,11,rep,name=block_times,json=blockTimes,proto3" json:"block_times,omitempty"` // Block times kept in the rolling window
+	BlockTimeWindow        *BlockTimeWindow        `protobuf:"bytes,12,opt,name=block_time_window,json=blockTimeWindow,proto3" json:"block_time_window,omitempty"`
+	BlockBurnedAnt         uint64                  `protobuf:"varint,13,opt,name=block_burned_ant,json=blockBurnedAnt,proto3" json:"block_burned_ant,omitempty"` // ANT burned in the block being built
+	NextBlockDelay         int64                   `protobuf:"varint,14,opt,name=next_block_delay,json=nextBlockDelay,proto3" json:"next_block_delay,omitempty"` // Delay before the next block in nanoseconds
+	BidHistories           []*BidHistory           `protobuf:"bytes,15,rep,name=bid_histories,json=bidHistories,proto3" json:"bid_histories,omitempty"`
+	SigningInfos           []*ValidatorSigningInfo `protobuf:"bytes,16,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos,omitempty"`
+	MissedBlocks           []*MissedBlock          `protobuf:"bytes,17,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	ValidatorConsAddresses []*ValidatorConsAddress `protobuf:"bytes,18,rep,name=validator_cons_addresses,json=validatorConsAddresses,proto3" json:"validator_cons_addresses,omitempty"`
+	AuctionFaults          []*AuctionFault         `protobuf:"bytes,19,rep,name=auction_faults,json=auctionFaults,proto3" json:"auction_faults,omitempty"`
+	ValidatorConsPubKeys   []*ValidatorConsPubKey  `protobuf:"bytes,20,rep,name=validator_cons_pub_keys,json=validatorConsPubKeys,proto3" json:"validator_cons_pub_keys,omitempty"`
+	LastValidatorPowers    []*LastValidatorPower   `protobuf:"bytes,21,rep,name=last_validator_powers,json=lastValidatorPowers,proto3" json:"last_validator_powers,omitempty"`
+	RewardPool             *RewardPool             `protobuf:"bytes,22,opt,name=reward_pool,json=rewardPool,proto3" json:"reward_pool,omitempty"`
+	ValidatorRewards       []*ValidatorRewards     `protobuf:"bytes,23,rep,name=validator_rewards,json=validatorRewards,proto3" json:"validator_rewards,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
 	*x = GenesisState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[0]
+		mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -52,7 +132,7 @@ func (x *GenesisState) String() string {
 func (*GenesisState) ProtoMessage() {}
 
 func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[0]
+	mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -65,7 +145,7 @@ func (x *GenesisState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_volnix_consensus_v1_genesis_proto_rawDescGZIP(), []int{0}
+	return file_volnix_consensus_v1_genesis_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *GenesisState) GetParams() *Params {
@@ -124,57 +204,680 @@ func (x *GenesisState) GetValidatorWeights() []*ValidatorWeight {
 	return nil
 }
 
+func (x *GenesisState) GetInitialValidators() []*InitialValidator {
+	if x != nil {
+		return x.InitialValidators
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBlindAuctions() []*BlindAuction {
+	if x != nil {
+		return x.BlindAuctions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBlockTimes() []*BlockTimeRecord {
+	if x != nil {
+		return x.BlockTimes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBlockTimeWindow() *BlockTimeWindow {
+	if x != nil {
+		return x.BlockTimeWindow
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBlockBurnedAnt() uint64 {
+	if x != nil {
+		return x.BlockBurnedAnt
+	}
+	return 0
+}
+
+func (x *GenesisState) GetNextBlockDelay() int64 {
+	if x != nil {
+		return x.NextBlockDelay
+	}
+	return 0
+}
+
+func (x *GenesisState) GetBidHistories() []*BidHistory {
+	if x != nil {
+		return x.BidHistories
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSigningInfos() []*ValidatorSigningInfo {
+	if x != nil {
+		return x.SigningInfos
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMissedBlocks() []*MissedBlock {
+	if x != nil {
+		return x.MissedBlocks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetValidatorConsAddresses() []*ValidatorConsAddress {
+	if x != nil {
+		return x.ValidatorConsAddresses
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuctionFaults() []*AuctionFault {
+	if x != nil {
+		return x.AuctionFaults
+	}
+	return nil
+}
+
+func (x *GenesisState) GetValidatorConsPubKeys() []*ValidatorConsPubKey {
+	if x != nil {
+		return x.ValidatorConsPubKeys
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLastValidatorPowers() []*LastValidatorPower {
+	if x != nil {
+		return x.LastValidatorPowers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRewardPool() *RewardPool {
+	if x != nil {
+		return x.RewardPool
+	}
+	return nil
+}
+
+func (x *GenesisState) GetValidatorRewards() []*ValidatorRewards {
+	if x != nil {
+		return x.ValidatorRewards
+	}
+	return nil
+}
+
+// BlockTimeRecord holds the recorded time of a block
+type BlockTimeRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *BlockTimeRecord) Reset() {
+	*x = BlockTimeRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockTimeRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTimeRecord) ProtoMessage() {}
+
+func (x *BlockTimeRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTimeRecord.ProtoReflect.Descriptor instead.
+func (*BlockTimeRecord) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BlockTimeRecord) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BlockTimeRecord) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// BidHistoryEntry holds one bid of a validator's bid history
+type BidHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BidAmount   string `protobuf:"bytes,1,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	Timestamp   int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Block time of the bid in Unix seconds
+	BlockHeight int64  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *BidHistoryEntry) Reset() {
+	*x = BidHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BidHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BidHistoryEntry) ProtoMessage() {}
+
+func (x *BidHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BidHistoryEntry.ProtoReflect.Descriptor instead.
+func (*BidHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_genesis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BidHistoryEntry) GetBidAmount() string {
+	if x != nil {
+		return x.BidAmount
+	}
+	return ""
+}
+
+func (x *BidHistoryEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BidHistoryEntry) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// BidHistory holds the recent bids of a validator
+type BidHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator string             `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Entries   []*BidHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *BidHistory) Reset() {
+	*x = BidHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BidHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BidHistory) ProtoMessage() {}
+
+func (x *BidHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BidHistory.ProtoReflect.Descriptor instead.
+func (*BidHistory) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_genesis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BidHistory) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *BidHistory) GetEntries() []*BidHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// MissedBlock marks a block missed by a validator at an index of the signed blocks window
+type MissedBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Index     uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *MissedBlock) Reset() {
+	*x = MissedBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissedBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedBlock) ProtoMessage() {}
+
+func (x *MissedBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedBlock.ProtoReflect.Descriptor instead.
+func (*MissedBlock) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_genesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MissedBlock) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *MissedBlock) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// ValidatorConsAddress links a CometBFT consensus address to its validator
+type ValidatorConsAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsAddress []byte `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	Validator   string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (x *ValidatorConsAddress) Reset() {
+	*x = ValidatorConsAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorConsAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorConsAddress) ProtoMessage() {}
+
+func (x *ValidatorConsAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorConsAddress.ProtoReflect.Descriptor instead.
+func (*ValidatorConsAddress) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_genesis_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ValidatorConsAddress) GetConsAddress() []byte {
+	if x != nil {
+		return x.ConsAddress
+	}
+	return nil
+}
+
+func (x *ValidatorConsAddress) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+// ValidatorConsPubKey holds the consensus public key (marshaled CometBFT PublicKey) of a validator
+type ValidatorConsPubKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	PubKey    []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (x *ValidatorConsPubKey) Reset() {
+	*x = ValidatorConsPubKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorConsPubKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorConsPubKey) ProtoMessage() {}
+
+func (x *ValidatorConsPubKey) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorConsPubKey.ProtoReflect.Descriptor instead.
+func (*ValidatorConsPubKey) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_genesis_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidatorConsPubKey) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorConsPubKey) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+// LastValidatorPower holds the voting power last sent to CometBFT for a validator
+type LastValidatorPower struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Power     int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (x *LastValidatorPower) Reset() {
+	*x = LastValidatorPower{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastValidatorPower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastValidatorPower) ProtoMessage() {}
+
+func (x *LastValidatorPower) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_genesis_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastValidatorPower.ProtoReflect.Descriptor instead.
+func (*LastValidatorPower) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_genesis_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LastValidatorPower) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *LastValidatorPower) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
 var File_volnix_consensus_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_volnix_consensus_v1_genesis_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
 	0x75, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x13, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
-	0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x04, 0x0a, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x6f, 0x6c,
-	0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x3e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e,
-	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
-	0x48, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
-	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x62, 0x75, 0x72,
-	0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0a,
-	0x62, 0x75, 0x72, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x4b, 0x0a, 0x0f, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e,
-	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x68, 0x61, 0x6c, 0x76, 0x69,
-	0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0b, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4c, 0x0a, 0x0f,
-	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x11, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
+	0x78, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5f, 0x0a, 0x10, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xae, 0x0d, 0x0a, 0x0c,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76,
+	0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63,
 	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x42, 0x59, 0x5a,
-	0x57, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x6c, 0x6e,
-	0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x76, 0x6f, 0x6c, 0x6e,
-	0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f,
-	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e,
-	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x48, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x6f, 0x6c, 0x6e,
+	0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x62,
+	0x75, 0x72, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x0a, 0x62, 0x75, 0x72, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x4b, 0x0a, 0x0f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x68, 0x61, 0x6c,
+	0x76, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0b, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4c,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x11,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12,
+	0x54, 0x0a, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x6f,
+	0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0e, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x5f, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x45, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41,
+	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x65,
+	0x78, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x44, 0x0a, 0x0d,
+	0x62, 0x69, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x62, 0x69, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x76, 0x6f, 0x6c, 0x6e,
+	0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x6f, 0x6c, 0x6e,
+	0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x63, 0x0a, 0x18, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x76, 0x6f,
+	0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x48,
+	0x0a, 0x0e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x5f, 0x0a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x6f, 0x6c, 0x6e,
+	0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x52, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6e, 0x73, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x5b, 0x0a, 0x15, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
+	0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x61, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x6f,
+	0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0a, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x52, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x17, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x0f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x71, 0x0a, 0x0f, 0x42, 0x69, 0x64, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69,
+	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x6a, 0x0a, 0x0a, 0x42, 0x69,
+	0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69,
+	0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x0b, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x57, 0x0a, 0x14, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x22, 0x48, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x42, 0x59, 0x5a, 0x57, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -189,32 +892,62 @@ func file_volnix_consensus_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_volnix_consensus_v1_genesis_proto_rawDescData
 }
 
-var file_volnix_consensus_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_volnix_consensus_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_volnix_consensus_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),    // 0: volnix.consensus.v1.GenesisState
-	(*Params)(nil),          // 1: volnix.consensus.v1.Params
-	(*Validator)(nil),       // 2: volnix.consensus.v1.Validator
-	(*BlockCreator)(nil),    // 3: volnix.consensus.v1.BlockCreator
-	(*BurnProof)(nil),       // 4: volnix.consensus.v1.BurnProof
-	(*ActivityScore)(nil),   // 5: volnix.consensus.v1.ActivityScore
-	(*HalvingInfo)(nil),     // 6: volnix.consensus.v1.HalvingInfo
-	(*ConsensusState)(nil),  // 7: volnix.consensus.v1.ConsensusState
-	(*ValidatorWeight)(nil), // 8: volnix.consensus.v1.ValidatorWeight
+	(*InitialValidator)(nil),      // 0: volnix.consensus.v1.InitialValidator
+	(*GenesisState)(nil),          // 1: volnix.consensus.v1.GenesisState
+	(*BlockTimeRecord)(nil),       // 2: volnix.consensus.v1.BlockTimeRecord
+	(*BidHistoryEntry)(nil),       // 3: volnix.consensus.v1.BidHistoryEntry
+	(*BidHistory)(nil),            // 4: volnix.consensus.v1.BidHistory
+	(*MissedBlock)(nil),           // 5: volnix.consensus.v1.MissedBlock
+	(*ValidatorConsAddress)(nil),  // 6: volnix.consensus.v1.ValidatorConsAddress
+	(*ValidatorConsPubKey)(nil),   // 7: volnix.consensus.v1.ValidatorConsPubKey
+	(*LastValidatorPower)(nil),    // 8: volnix.consensus.v1.LastValidatorPower
+	(*Params)(nil),                // 9: volnix.consensus.v1.Params
+	(*Validator)(nil),             // 10: volnix.consensus.v1.Validator
+	(*BlockCreator)(nil),          // 11: volnix.consensus.v1.BlockCreator
+	(*BurnProof)(nil),             // 12: volnix.consensus.v1.BurnProof
+	(*ActivityScore)(nil),         // 13: volnix.consensus.v1.ActivityScore
+	(*HalvingInfo)(nil),           // 14: volnix.consensus.v1.HalvingInfo
+	(*ConsensusState)(nil),        // 15: volnix.consensus.v1.ConsensusState
+	(*ValidatorWeight)(nil),       // 16: volnix.consensus.v1.ValidatorWeight
+	(*BlindAuction)(nil),          // 17: volnix.consensus.v1.BlindAuction
+	(*BlockTimeWindow)(nil),       // 18: volnix.consensus.v1.BlockTimeWindow
+	(*ValidatorSigningInfo)(nil),  // 19: volnix.consensus.v1.ValidatorSigningInfo
+	(*AuctionFault)(nil),          // 20: volnix.consensus.v1.AuctionFault
+	(*RewardPool)(nil),            // 21: volnix.consensus.v1.RewardPool
+	(*ValidatorRewards)(nil),      // 22: volnix.consensus.v1.ValidatorRewards
+	(*timestamppb.Timestamp)(nil), // 23: google.protobuf.Timestamp
 }
 var file_volnix_consensus_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: volnix.consensus.v1.GenesisState.params:type_name -> volnix.consensus.v1.Params
-	2, // 1: volnix.consensus.v1.GenesisState.validators:type_name -> volnix.consensus.v1.Validator
-	3, // 2: volnix.consensus.v1.GenesisState.block_creators:type_name -> volnix.consensus.v1.BlockCreator
-	4, // 3: volnix.consensus.v1.GenesisState.burn_proofs:type_name -> volnix.consensus.v1.BurnProof
-	5, // 4: volnix.consensus.v1.GenesisState.activity_scores:type_name -> volnix.consensus.v1.ActivityScore
-	6, // 5: volnix.consensus.v1.GenesisState.halving_info:type_name -> volnix.consensus.v1.HalvingInfo
-	7, // 6: volnix.consensus.v1.GenesisState.consensus_state:type_name -> volnix.consensus.v1.ConsensusState
-	8, // 7: volnix.consensus.v1.GenesisState.validator_weights:type_name -> volnix.consensus.v1.ValidatorWeight
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	9,  // 0: volnix.consensus.v1.GenesisState.params:type_name -> volnix.consensus.v1.Params
+	10, // 1: volnix.consensus.v1.GenesisState.validators:type_name -> volnix.consensus.v1.Validator
+	11, // 2: volnix.consensus.v1.GenesisState.block_creators:type_name -> volnix.consensus.v1.BlockCreator
+	12, // 3: volnix.consensus.v1.GenesisState.burn_proofs:type_name -> volnix.consensus.v1.BurnProof
+	13, // 4: volnix.consensus.v1.GenesisState.activity_scores:type_name -> volnix.consensus.v1.ActivityScore
+	14, // 5: volnix.consensus.v1.GenesisState.halving_info:type_name -> volnix.consensus.v1.HalvingInfo
+	15, // 6: volnix.consensus.v1.GenesisState.consensus_state:type_name -> volnix.consensus.v1.ConsensusState
+	16, // 7: volnix.consensus.v1.GenesisState.validator_weights:type_name -> volnix.consensus.v1.ValidatorWeight
+	0,  // 8: volnix.consensus.v1.GenesisState.initial_validators:type_name -> volnix.consensus.v1.InitialValidator
+	17, // 9: volnix.consensus.v1.GenesisState.blind_auctions:type_name -> volnix.consensus.v1.BlindAuction
+	2,  // 10: volnix.consensus.v1.GenesisState.block_times:type_name -> volnix.consensus.v1.BlockTimeRecord
+	18, // 11: volnix.consensus.v1.GenesisState.block_time_window:type_name -> volnix.consensus.v1.BlockTimeWindow
+	4,  // 12: volnix.consensus.v1.GenesisState.bid_histories:type_name -> volnix.consensus.v1.BidHistory
+	19, // 13: volnix.consensus.v1.GenesisState.signing_infos:type_name -> volnix.consensus.v1.ValidatorSigningInfo
+	5,  // 14: volnix.consensus.v1.GenesisState.missed_blocks:type_name -> volnix.consensus.v1.MissedBlock
+	6,  // 15: volnix.consensus.v1.GenesisState.validator_cons_addresses:type_name -> volnix.consensus.v1.ValidatorConsAddress
+	20, // 16: volnix.consensus.v1.GenesisState.auction_faults:type_name -> volnix.consensus.v1.AuctionFault
+	7,  // 17: volnix.consensus.v1.GenesisState.validator_cons_pub_keys:type_name -> volnix.consensus.v1.ValidatorConsPubKey
+	8,  // 18: volnix.consensus.v1.GenesisState.last_validator_powers:type_name -> volnix.consensus.v1.LastValidatorPower
+	21, // 19: volnix.consensus.v1.GenesisState.reward_pool:type_name -> volnix.consensus.v1.RewardPool
+	22, // 20: volnix.consensus.v1.GenesisState.validator_rewards:type_name -> volnix.consensus.v1.ValidatorRewards
+	23, // 21: volnix.consensus.v1.BlockTimeRecord.time:type_name -> google.protobuf.Timestamp
+	3,  // 22: volnix.consensus.v1.BidHistory.entries:type_name -> volnix.consensus.v1.BidHistoryEntry
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_volnix_consensus_v1_genesis_proto_init() }
@@ -225,6 +958,18 @@ func file_volnix_consensus_v1_genesis_proto_init() {
 	file_volnix_consensus_v1_types_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_volnix_consensus_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitialValidator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
 			case 0:
 				return &v.state
@@ -236,6 +981,90 @@ func file_volnix_consensus_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_volnix_consensus_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockTimeRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_genesis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_genesis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorConsAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_genesis_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorConsPubKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_genesis_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastValidatorPower); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -243,7 +1072,7 @@ func file_volnix_consensus_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_volnix_consensus_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName     = "/volnix.consensus.v1.Query/Params"
-	Query_Validators_FullMethodName = "/volnix.consensus.v1.Query/Validators"
+	Query_Params_FullMethodName          = "/volnix.consensus.v1.Query/Params"
+	Query_Validators_FullMethodName      = "/volnix.consensus.v1.Query/Validators"
+	Query_AuctionFaults_FullMethodName   = "/volnix.consensus.v1.Query/AuctionFaults"
+	Query_HalvingSchedule_FullMethodName = "/volnix.consensus.v1.Query/HalvingSchedule"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +33,10 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Validators queries all validators.
 	Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error)
+	// AuctionFaults queries blind auction commits that were never revealed.
+	AuctionFaults(ctx context.Context, in *QueryAuctionFaultsRequest, opts ...grpc.CallOption) (*QueryAuctionFaultsResponse, error)
+	// HalvingSchedule queries the projected upcoming halvings with their block rewards.
+	HalvingSchedule(ctx context.Context, in *QueryHalvingScheduleRequest, opts ...grpc.CallOption) (*QueryHalvingScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +65,24 @@ func (c *queryClient) Validators(ctx context.Context, in *QueryValidatorsRequest
 	return out, nil
 }
 
+func (c *queryClient) AuctionFaults(ctx context.Context, in *QueryAuctionFaultsRequest, opts ...grpc.CallOption) (*QueryAuctionFaultsResponse, error) {
+	out := new(QueryAuctionFaultsResponse)
+	err := c.cc.Invoke(ctx, Query_AuctionFaults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HalvingSchedule(ctx context.Context, in *QueryHalvingScheduleRequest, opts ...grpc.CallOption) (*QueryHalvingScheduleResponse, error) {
+	out := new(QueryHalvingScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_HalvingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +91,10 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Validators queries all validators.
 	Validators(context.Context, *QueryValidatorsRequest) (*QueryValidatorsResponse, error)
+	// AuctionFaults queries blind auction commits that were never revealed.
+	AuctionFaults(context.Context, *QueryAuctionFaultsRequest) (*QueryAuctionFaultsResponse, error)
+	// HalvingSchedule queries the projected upcoming halvings with their block rewards.
+	HalvingSchedule(context.Context, *QueryHalvingScheduleRequest) (*QueryHalvingScheduleResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +108,12 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) Validators(context.Context, *QueryValidatorsRequest) (*QueryValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
 }
+func (UnimplementedQueryServer) AuctionFaults(context.Context, *QueryAuctionFaultsRequest) (*QueryAuctionFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionFaults not implemented")
+}
+func (UnimplementedQueryServer) HalvingSchedule(context.Context, *QueryHalvingScheduleRequest) (*QueryHalvingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HalvingSchedule not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +163,42 @@ func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AuctionFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuctionFaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionFaults(ctx, req.(*QueryAuctionFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HalvingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHalvingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HalvingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HalvingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HalvingSchedule(ctx, req.(*QueryHalvingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +214,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Validators",
 			Handler:    _Query_Validators_Handler,
 		},
+		{
+			MethodName: "AuctionFaults",
+			Handler:    _Query_AuctionFaults_Handler,
+		},
+		{
+			MethodName: "HalvingSchedule",
+			Handler:    _Query_HalvingSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "volnix/consensus/v1/query.proto",
@@ -27,28 +27,50 @@ const (
 	Msg_CalculateBlockTime_FullMethodName   = "/volnix.consensus.v1.Msg/CalculateBlockTime"
 	Msg_CommitBid_FullMethodName            = "/volnix.consensus.v1.Msg/CommitBid"
 	Msg_RevealBid_FullMethodName            = "/volnix.consensus.v1.Msg/RevealBid"
+	Msg_Unjail_FullMethodName               = "/volnix.consensus.v1.Msg/Unjail"
+	Msg_WithdrawRewards_FullMethodName      = "/volnix.consensus.v1.Msg/WithdrawRewards"
+	Msg_SetConsensusKey_FullMethodName      = "/volnix.consensus.v1.Msg/SetConsensusKey"
+	Msg_UpdateParams_FullMethodName         = "/volnix.consensus.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MsgClient interface {
-	// SelectBlockCreator selects the next block creator
+	// Deprecated: Do not use.
+	// SelectBlockCreator is deprecated: block creators are selected in EndBlocker.
+	// The message stays decodable but is always rejected.
 	SelectBlockCreator(ctx context.Context, in *MsgSelectBlockCreator, opts ...grpc.CallOption) (*MsgSelectBlockCreatorResponse, error)
-	// UpdateConsensusState updates the consensus state
+	// Deprecated: Do not use.
+	// UpdateConsensusState is deprecated: the consensus state is updated in EndBlocker.
+	// The message stays decodable but is always rejected.
 	UpdateConsensusState(ctx context.Context, in *MsgUpdateConsensusState, opts ...grpc.CallOption) (*MsgUpdateConsensusStateResponse, error)
-	// SetValidatorWeight sets validator weight
+	// SetValidatorWeight sets validator weight; the authority must be the governance module address
 	SetValidatorWeight(ctx context.Context, in *MsgSetValidatorWeight, opts ...grpc.CallOption) (*MsgSetValidatorWeightResponse, error)
-	// ProcessHalving processes halving event
+	// Deprecated: Do not use.
+	// ProcessHalving is deprecated: halvings are processed in EndBlocker.
+	// The message stays decodable but is always rejected.
 	ProcessHalving(ctx context.Context, in *MsgProcessHalving, opts ...grpc.CallOption) (*MsgProcessHalvingResponse, error)
-	// SelectBlockProducer selects block producer
+	// Deprecated: Do not use.
+	// SelectBlockProducer is deprecated: block producers are selected in EndBlocker.
+	// The message stays decodable but is always rejected.
 	SelectBlockProducer(ctx context.Context, in *MsgSelectBlockProducer, opts ...grpc.CallOption) (*MsgSelectBlockProducerResponse, error)
-	// CalculateBlockTime calculates block time
+	// Deprecated: Do not use.
+	// CalculateBlockTime is deprecated: the block time is derived from burned ANT in EndBlocker.
+	// The message stays decodable but is always rejected.
 	CalculateBlockTime(ctx context.Context, in *MsgCalculateBlockTime, opts ...grpc.CallOption) (*MsgCalculateBlockTimeResponse, error)
 	// CommitBid commits an encrypted bid for blind auction
 	CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error)
 	// RevealBid reveals a bid in blind auction
 	RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error)
+	// Unjail returns a jailed validator to the active set after its jail period
+	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
+	// WithdrawRewards sends a validator's accrued block rewards to its account
+	WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error)
+	// SetConsensusKey registers the CometBFT consensus key of a validator's node
+	SetConsensusKey(ctx context.Context, in *MsgSetConsensusKey, opts ...grpc.CallOption) (*MsgSetConsensusKeyResponse, error)
+	// UpdateParams updates the module parameters; the authority must be the governance module address
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -59,6 +81,7 @@ func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
 	return &msgClient{cc}
 }
 
+// Deprecated: Do not use.
 func (c *msgClient) SelectBlockCreator(ctx context.Context, in *MsgSelectBlockCreator, opts ...grpc.CallOption) (*MsgSelectBlockCreatorResponse, error) {
 	out := new(MsgSelectBlockCreatorResponse)
 	err := c.cc.Invoke(ctx, Msg_SelectBlockCreator_FullMethodName, in, out, opts...)
@@ -68,6 +91,7 @@ func (c *msgClient) SelectBlockCreator(ctx context.Context, in *MsgSelectBlockCr
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *msgClient) UpdateConsensusState(ctx context.Context, in *MsgUpdateConsensusState, opts ...grpc.CallOption) (*MsgUpdateConsensusStateResponse, error) {
 	out := new(MsgUpdateConsensusStateResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateConsensusState_FullMethodName, in, out, opts...)
@@ -86,6 +110,7 @@ func (c *msgClient) SetValidatorWeight(ctx context.Context, in *MsgSetValidatorW
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *msgClient) ProcessHalving(ctx context.Context, in *MsgProcessHalving, opts ...grpc.CallOption) (*MsgProcessHalvingResponse, error) {
 	out := new(MsgProcessHalvingResponse)
 	err := c.cc.Invoke(ctx, Msg_ProcessHalving_FullMethodName, in, out, opts...)
@@ -95,6 +120,7 @@ func (c *msgClient) ProcessHalving(ctx context.Context, in *MsgProcessHalving, o
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *msgClient) SelectBlockProducer(ctx context.Context, in *MsgSelectBlockProducer, opts ...grpc.CallOption) (*MsgSelectBlockProducerResponse, error) {
 	out := new(MsgSelectBlockProducerResponse)
 	err := c.cc.Invoke(ctx, Msg_SelectBlockProducer_FullMethodName, in, out, opts...)
@@ -104,6 +130,7 @@ func (c *msgClient) SelectBlockProducer(ctx context.Context, in *MsgSelectBlockP
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *msgClient) CalculateBlockTime(ctx context.Context, in *MsgCalculateBlockTime, opts ...grpc.CallOption) (*MsgCalculateBlockTimeResponse, error) {
 	out := new(MsgCalculateBlockTimeResponse)
 	err := c.cc.Invoke(ctx, Msg_CalculateBlockTime_FullMethodName, in, out, opts...)
@@ -131,26 +158,80 @@ func (c *msgClient) RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error) {
+	out := new(MsgUnjailResponse)
+	err := c.cc.Invoke(ctx, Msg_Unjail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error) {
+	out := new(MsgWithdrawRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetConsensusKey(ctx context.Context, in *MsgSetConsensusKey, opts ...grpc.CallOption) (*MsgSetConsensusKeyResponse, error) {
+	out := new(MsgSetConsensusKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetConsensusKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
-	// SelectBlockCreator selects the next block creator
+	// Deprecated: Do not use.
+	// SelectBlockCreator is deprecated: block creators are selected in EndBlocker.
+	// The message stays decodable but is always rejected.
 	SelectBlockCreator(context.Context, *MsgSelectBlockCreator) (*MsgSelectBlockCreatorResponse, error)
-	// UpdateConsensusState updates the consensus state
+	// Deprecated: Do not use.
+	// UpdateConsensusState is deprecated: the consensus state is updated in EndBlocker.
+	// The message stays decodable but is always rejected.
 	UpdateConsensusState(context.Context, *MsgUpdateConsensusState) (*MsgUpdateConsensusStateResponse, error)
-	// SetValidatorWeight sets validator weight
+	// SetValidatorWeight sets validator weight; the authority must be the governance module address
 	SetValidatorWeight(context.Context, *MsgSetValidatorWeight) (*MsgSetValidatorWeightResponse, error)
-	// ProcessHalving processes halving event
+	// Deprecated: Do not use.
+	// ProcessHalving is deprecated: halvings are processed in EndBlocker.
+	// The message stays decodable but is always rejected.
 	ProcessHalving(context.Context, *MsgProcessHalving) (*MsgProcessHalvingResponse, error)
-	// SelectBlockProducer selects block producer
+	// Deprecated: Do not use.
+	// SelectBlockProducer is deprecated: block producers are selected in EndBlocker.
+	// The message stays decodable but is always rejected.
 	SelectBlockProducer(context.Context, *MsgSelectBlockProducer) (*MsgSelectBlockProducerResponse, error)
-	// CalculateBlockTime calculates block time
+	// Deprecated: Do not use.
+	// CalculateBlockTime is deprecated: the block time is derived from burned ANT in EndBlocker.
+	// The message stays decodable but is always rejected.
 	CalculateBlockTime(context.Context, *MsgCalculateBlockTime) (*MsgCalculateBlockTimeResponse, error)
 	// CommitBid commits an encrypted bid for blind auction
 	CommitBid(context.Context, *MsgCommitBid) (*MsgCommitBidResponse, error)
 	// RevealBid reveals a bid in blind auction
 	RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error)
+	// Unjail returns a jailed validator to the active set after its jail period
+	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+	// WithdrawRewards sends a validator's accrued block rewards to its account
+	WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error)
+	// SetConsensusKey registers the CometBFT consensus key of a validator's node
+	SetConsensusKey(context.Context, *MsgSetConsensusKey) (*MsgSetConsensusKeyResponse, error)
+	// UpdateParams updates the module parameters; the authority must be the governance module address
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -182,6 +263,18 @@ func (UnimplementedMsgServer) CommitBid(context.Context, *MsgCommitBid) (*MsgCom
 func (UnimplementedMsgServer) RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
 }
+func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
+}
+func (UnimplementedMsgServer) WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewards not implemented")
+}
+func (UnimplementedMsgServer) SetConsensusKey(context.Context, *MsgSetConsensusKey) (*MsgSetConsensusKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConsensusKey not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -339,6 +432,78 @@ func _Msg_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unjail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unjail(ctx, req.(*MsgUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRewards(ctx, req.(*MsgWithdrawRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetConsensusKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetConsensusKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetConsensusKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetConsensusKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConsensusKey(ctx, req.(*MsgSetConsensusKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -378,6 +543,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevealBid",
 			Handler:    _Msg_RevealBid_Handler,
 		},
+		{
+			MethodName: "Unjail",
+			Handler:    _Msg_Unjail_Handler,
+		},
+		{
+			MethodName: "WithdrawRewards",
+			Handler:    _Msg_WithdrawRewards_Handler,
+		},
+		{
+			MethodName: "SetConsensusKey",
+			Handler:    _Msg_SetConsensusKey_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "volnix/consensus/v1/tx.proto",
@@ -29,6 +29,7 @@ const (
 	ValidatorStatus_VALIDATOR_STATUS_ACTIVE      ValidatorStatus = 1
 	ValidatorStatus_VALIDATOR_STATUS_INACTIVE    ValidatorStatus = 2
 	ValidatorStatus_VALIDATOR_STATUS_SLASHED     ValidatorStatus = 3
+	ValidatorStatus_VALIDATOR_STATUS_JAILED      ValidatorStatus = 4
 )
 
 // Enum value maps for ValidatorStatus.
@@ -38,12 +39,14 @@ var (
 		1: "VALIDATOR_STATUS_ACTIVE",
 		2: "VALIDATOR_STATUS_INACTIVE",
 		3: "VALIDATOR_STATUS_SLASHED",
+		4: "VALIDATOR_STATUS_JAILED",
 	}
 	ValidatorStatus_value = map[string]int32{
 		"VALIDATOR_STATUS_UNSPECIFIED": 0,
 		"VALIDATOR_STATUS_ACTIVE":      1,
 		"VALIDATOR_STATUS_INACTIVE":    2,
 		"VALIDATOR_STATUS_SLASHED":     3,
+		"VALIDATOR_STATUS_JAILED":      4,
 	}
 )
 
@@ -533,6 +536,21 @@ type Params struct {
 	BidHistoryLimit            uint64 `protobuf:"varint,18,opt,name=bid_history_limit,json=bidHistoryLimit,proto3" json:"bid_history_limit,omitempty"`                                      // Maximum number of bid history entries per validator (default: 100)
 	AuctionHistoryBlocks       uint64 `protobuf:"varint,19,opt,name=auction_history_blocks,json=auctionHistoryBlocks,proto3" json:"auction_history_blocks,omitempty"`                       // Number of blocks to keep auction history (default: 100)
 	RapidBidLimit              uint64 `protobuf:"varint,20,opt,name=rapid_bid_limit,json=rapidBidLimit,proto3" json:"rapid_bid_limit,omitempty"`                                            // Maximum rapid bids allowed (default: 5)
+	// Slashing: downtime, double-sign and unrevealed auction commit faults
+	SignedBlocksWindow            uint64 `protobuf:"varint,21,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`                                   // Number of blocks tracked for downtime (default: 100)
+	MinSignedPerWindow            string `protobuf:"bytes,22,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3" json:"min_signed_per_window,omitempty"`                                  // Minimum share of signed blocks in the window (default: 0.5)
+	DowntimeJailDuration          string `protobuf:"bytes,23,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3" json:"downtime_jail_duration,omitempty"`                              // Jail duration for downtime (default: 10m)
+	DoubleSignJailDuration        string `protobuf:"bytes,24,opt,name=double_sign_jail_duration,json=doubleSignJailDuration,proto3" json:"double_sign_jail_duration,omitempty"`                      // Jail duration for double-signing (default: 720h)
+	SlashFractionDowntime         string `protobuf:"bytes,25,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3" json:"slash_fraction_downtime,omitempty"`                           // Share of activated LZN burned for downtime (default: 0.01)
+	SlashFractionDoubleSign       string `protobuf:"bytes,26,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3" json:"slash_fraction_double_sign,omitempty"`                   // Share of activated LZN burned for double-signing (default: 0.05)
+	SlashFractionUnrevealedCommit string `protobuf:"bytes,27,opt,name=slash_fraction_unrevealed_commit,json=slashFractionUnrevealedCommit,proto3" json:"slash_fraction_unrevealed_commit,omitempty"` // Share of activated LZN burned for an unrevealed auction commit (default: 0.001)
+	// Blind auction commit bond
+	AuctionCommitBond uint64 `protobuf:"varint,28,opt,name=auction_commit_bond,json=auctionCommitBond,proto3" json:"auction_commit_bond,omitempty"` // ANT locked per commit, returned on reveal, forfeited otherwise (default: 100000, 0 disables bonds)
+	// Blind auction windows: an auction opens commit + reveal blocks ahead of its target height
+	AuctionCommitWindow uint64 `protobuf:"varint,29,opt,name=auction_commit_window,json=auctionCommitWindow,proto3" json:"auction_commit_window,omitempty"` // Number of blocks commits are accepted (default: 5)
+	AuctionRevealWindow uint64 `protobuf:"varint,30,opt,name=auction_reveal_window,json=auctionRevealWindow,proto3" json:"auction_reveal_window,omitempty"` // Number of blocks reveals are accepted after the commit window (default: 5)
+	// Rewards: validator rewards are settled and recorded once per epoch
+	RewardEpochLength uint64 `protobuf:"varint,31,opt,name=reward_epoch_length,json=rewardEpochLength,proto3" json:"reward_epoch_length,omitempty"` // Number of blocks per reward epoch (default: 100)
 }
 
 func (x *Params) Reset() {
@@ -707,6 +725,453 @@ func (x *Params) GetRapidBidLimit() uint64 {
 	return 0
 }
 
+func (x *Params) GetSignedBlocksWindow() uint64 {
+	if x != nil {
+		return x.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMinSignedPerWindow() string {
+	if x != nil {
+		return x.MinSignedPerWindow
+	}
+	return ""
+}
+
+func (x *Params) GetDowntimeJailDuration() string {
+	if x != nil {
+		return x.DowntimeJailDuration
+	}
+	return ""
+}
+
+func (x *Params) GetDoubleSignJailDuration() string {
+	if x != nil {
+		return x.DoubleSignJailDuration
+	}
+	return ""
+}
+
+func (x *Params) GetSlashFractionDowntime() string {
+	if x != nil {
+		return x.SlashFractionDowntime
+	}
+	return ""
+}
+
+func (x *Params) GetSlashFractionDoubleSign() string {
+	if x != nil {
+		return x.SlashFractionDoubleSign
+	}
+	return ""
+}
+
+func (x *Params) GetSlashFractionUnrevealedCommit() string {
+	if x != nil {
+		return x.SlashFractionUnrevealedCommit
+	}
+	return ""
+}
+
+func (x *Params) GetAuctionCommitBond() uint64 {
+	if x != nil {
+		return x.AuctionCommitBond
+	}
+	return 0
+}
+
+func (x *Params) GetAuctionCommitWindow() uint64 {
+	if x != nil {
+		return x.AuctionCommitWindow
+	}
+	return 0
+}
+
+func (x *Params) GetAuctionRevealWindow() uint64 {
+	if x != nil {
+		return x.AuctionRevealWindow
+	}
+	return 0
+}
+
+func (x *Params) GetRewardEpochLength() uint64 {
+	if x != nil {
+		return x.RewardEpochLength
+	}
+	return 0
+}
+
+// ValidatorSigningInfo tracks the liveness and slashing state of a validator
+type ValidatorSigningInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator           string                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	ConsAddress         []byte                 `protobuf:"bytes,2,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`                            // CometBFT consensus address
+	StartHeight         uint64                 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`                           // Height at which tracking started
+	IndexOffset         uint64                 `protobuf:"varint,4,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`                           // Index in the missed blocks window
+	MissedBlocksCounter uint64                 `protobuf:"varint,5,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"` // Missed blocks in the current window
+	JailedUntil         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`                            // Time until which the validator is jailed
+	UnrevealedCommits   uint64                 `protobuf:"varint,7,opt,name=unrevealed_commits,json=unrevealedCommits,proto3" json:"unrevealed_commits,omitempty"`         // Total auction commits left unrevealed
+	DoubleSignFaults    uint64                 `protobuf:"varint,8,opt,name=double_sign_faults,json=doubleSignFaults,proto3" json:"double_sign_faults,omitempty"`          // Total double-sign faults
+}
+
+func (x *ValidatorSigningInfo) Reset() {
+	*x = ValidatorSigningInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorSigningInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSigningInfo) ProtoMessage() {}
+
+func (x *ValidatorSigningInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorSigningInfo.ProtoReflect.Descriptor instead.
+func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ValidatorSigningInfo) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorSigningInfo) GetConsAddress() []byte {
+	if x != nil {
+		return x.ConsAddress
+	}
+	return nil
+}
+
+func (x *ValidatorSigningInfo) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *ValidatorSigningInfo) GetIndexOffset() uint64 {
+	if x != nil {
+		return x.IndexOffset
+	}
+	return 0
+}
+
+func (x *ValidatorSigningInfo) GetMissedBlocksCounter() uint64 {
+	if x != nil {
+		return x.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (x *ValidatorSigningInfo) GetJailedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JailedUntil
+	}
+	return nil
+}
+
+func (x *ValidatorSigningInfo) GetUnrevealedCommits() uint64 {
+	if x != nil {
+		return x.UnrevealedCommits
+	}
+	return 0
+}
+
+func (x *ValidatorSigningInfo) GetDoubleSignFaults() uint64 {
+	if x != nil {
+		return x.DoubleSignFaults
+	}
+	return 0
+}
+
+// BlockTimeWindow tracks the recorded block times behind the rolling average block time
+type BlockTimeWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldestHeight  uint64 `protobuf:"varint,1,opt,name=oldest_height,json=oldestHeight,proto3" json:"oldest_height,omitempty"`    // Height of the oldest block time kept in the window
+	NewestHeight  uint64 `protobuf:"varint,2,opt,name=newest_height,json=newestHeight,proto3" json:"newest_height,omitempty"`    // Height of the newest recorded block time
+	IntervalCount uint64 `protobuf:"varint,3,opt,name=interval_count,json=intervalCount,proto3" json:"interval_count,omitempty"` // Number of intervals between consecutive recorded block times
+	IntervalSum   int64  `protobuf:"varint,4,opt,name=interval_sum,json=intervalSum,proto3" json:"interval_sum,omitempty"`       // Sum of those intervals in nanoseconds
+}
+
+func (x *BlockTimeWindow) Reset() {
+	*x = BlockTimeWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockTimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTimeWindow) ProtoMessage() {}
+
+func (x *BlockTimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTimeWindow.ProtoReflect.Descriptor instead.
+func (*BlockTimeWindow) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BlockTimeWindow) GetOldestHeight() uint64 {
+	if x != nil {
+		return x.OldestHeight
+	}
+	return 0
+}
+
+func (x *BlockTimeWindow) GetNewestHeight() uint64 {
+	if x != nil {
+		return x.NewestHeight
+	}
+	return 0
+}
+
+func (x *BlockTimeWindow) GetIntervalCount() uint64 {
+	if x != nil {
+		return x.IntervalCount
+	}
+	return 0
+}
+
+func (x *BlockTimeWindow) GetIntervalSum() int64 {
+	if x != nil {
+		return x.IntervalSum
+	}
+	return 0
+}
+
+// RewardPool is the global reward accumulator
+// Each block adds block_reward / total_stake to reward_index; a validator is owed
+// stake × (reward_index − its index snapshot) × its MOA penalty multiplier
+type RewardPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RewardIndex         string `protobuf:"bytes,1,opt,name=reward_index,json=rewardIndex,proto3" json:"reward_index,omitempty"`                           // Cumulative micro WRT per unit of activated LZN (decimal)
+	TotalStake          uint64 `protobuf:"varint,2,opt,name=total_stake,json=totalStake,proto3" json:"total_stake,omitempty"`                             // Activated LZN of all non-jailed validators
+	TotalEffectiveStake string `protobuf:"bytes,3,opt,name=total_effective_stake,json=totalEffectiveStake,proto3" json:"total_effective_stake,omitempty"` // Sum of stake × penalty multiplier; the WRT owed per index unit (decimal)
+	Epoch               uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`                                                         // Current reward epoch
+	EpochStartHeight    uint64 `protobuf:"varint,5,opt,name=epoch_start_height,json=epochStartHeight,proto3" json:"epoch_start_height,omitempty"`         // First height of the current reward epoch
+	TotalEmitted        string `protobuf:"bytes,6,opt,name=total_emitted,json=totalEmitted,proto3" json:"total_emitted,omitempty"`                        // Micro WRT minted for block rewards since genesis
+}
+
+func (x *RewardPool) Reset() {
+	*x = RewardPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardPool) ProtoMessage() {}
+
+func (x *RewardPool) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardPool.ProtoReflect.Descriptor instead.
+func (*RewardPool) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RewardPool) GetRewardIndex() string {
+	if x != nil {
+		return x.RewardIndex
+	}
+	return ""
+}
+
+func (x *RewardPool) GetTotalStake() uint64 {
+	if x != nil {
+		return x.TotalStake
+	}
+	return 0
+}
+
+func (x *RewardPool) GetTotalEffectiveStake() string {
+	if x != nil {
+		return x.TotalEffectiveStake
+	}
+	return ""
+}
+
+func (x *RewardPool) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *RewardPool) GetEpochStartHeight() uint64 {
+	if x != nil {
+		return x.EpochStartHeight
+	}
+	return 0
+}
+
+func (x *RewardPool) GetTotalEmitted() string {
+	if x != nil {
+		return x.TotalEmitted
+	}
+	return ""
+}
+
+// ValidatorRewards is a validator's position in the reward pool
+type ValidatorRewards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator         string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Stake             uint64 `protobuf:"varint,2,opt,name=stake,proto3" json:"stake,omitempty"`                                                 // Activated LZN earning rewards, zero while jailed
+	PenaltyMultiplier string `protobuf:"bytes,3,opt,name=penalty_multiplier,json=penaltyMultiplier,proto3" json:"penalty_multiplier,omitempty"` // MOA penalty multiplier applied to settled rewards (decimal)
+	MoaCompliance     string `protobuf:"bytes,4,opt,name=moa_compliance,json=moaCompliance,proto3" json:"moa_compliance,omitempty"`             // MOA compliance the multiplier was derived from (decimal)
+	IndexSnapshot     string `protobuf:"bytes,5,opt,name=index_snapshot,json=indexSnapshot,proto3" json:"index_snapshot,omitempty"`             // Reward index at the last settlement (decimal)
+	Accrued           uint64 `protobuf:"varint,6,opt,name=accrued,proto3" json:"accrued,omitempty"`                                             // Settled micro WRT not yet withdrawn
+	EpochReward       uint64 `protobuf:"varint,7,opt,name=epoch_reward,json=epochReward,proto3" json:"epoch_reward,omitempty"`                  // Micro WRT settled during the current epoch
+	EpochBaseReward   uint64 `protobuf:"varint,8,opt,name=epoch_base_reward,json=epochBaseReward,proto3" json:"epoch_base_reward,omitempty"`    // Micro WRT the epoch reward would have been without the MOA penalty
+}
+
+func (x *ValidatorRewards) Reset() {
+	*x = ValidatorRewards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorRewards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorRewards) ProtoMessage() {}
+
+func (x *ValidatorRewards) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorRewards.ProtoReflect.Descriptor instead.
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidatorRewards) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorRewards) GetStake() uint64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *ValidatorRewards) GetPenaltyMultiplier() string {
+	if x != nil {
+		return x.PenaltyMultiplier
+	}
+	return ""
+}
+
+func (x *ValidatorRewards) GetMoaCompliance() string {
+	if x != nil {
+		return x.MoaCompliance
+	}
+	return ""
+}
+
+func (x *ValidatorRewards) GetIndexSnapshot() string {
+	if x != nil {
+		return x.IndexSnapshot
+	}
+	return ""
+}
+
+func (x *ValidatorRewards) GetAccrued() uint64 {
+	if x != nil {
+		return x.Accrued
+	}
+	return 0
+}
+
+func (x *ValidatorRewards) GetEpochReward() uint64 {
+	if x != nil {
+		return x.EpochReward
+	}
+	return 0
+}
+
+func (x *ValidatorRewards) GetEpochBaseReward() uint64 {
+	if x != nil {
+		return x.EpochBaseReward
+	}
+	return 0
+}
+
 // HalvingInfo represents halving information
 // According to whitepaper: "Халвинг происходит строго каждые N блоков, но реальная дата адаптируется к динамическому времени блока"
 type HalvingInfo struct {
@@ -724,7 +1189,7 @@ type HalvingInfo struct {
 func (x *HalvingInfo) Reset() {
 	*x = HalvingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_consensus_v1_types_proto_msgTypes[5]
+		mi := &file_volnix_consensus_v1_types_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -737,7 +1202,7 @@ func (x *HalvingInfo) String() string {
 func (*HalvingInfo) ProtoMessage() {}
 
 func (x *HalvingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_consensus_v1_types_proto_msgTypes[5]
+	mi := &file_volnix_consensus_v1_types_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,7 +1215,7 @@ func (x *HalvingInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HalvingInfo.ProtoReflect.Descriptor instead.
 func (*HalvingInfo) Descriptor() ([]byte, []int) {
-	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{5}
+	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *HalvingInfo) GetLastHalvingHeight() uint64 {
@@ -803,7 +1268,7 @@ type ConsensusState struct {
 func (x *ConsensusState) Reset() {
 	*x = ConsensusState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_consensus_v1_types_proto_msgTypes[6]
+		mi := &file_volnix_consensus_v1_types_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -816,7 +1281,7 @@ func (x *ConsensusState) String() string {
 func (*ConsensusState) ProtoMessage() {}
 
 func (x *ConsensusState) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_consensus_v1_types_proto_msgTypes[6]
+	mi := &file_volnix_consensus_v1_types_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +1294,7 @@ func (x *ConsensusState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsensusState.ProtoReflect.Descriptor instead.
 func (*ConsensusState) Descriptor() ([]byte, []int) {
-	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{6}
+	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ConsensusState) GetCurrentHeight() uint64 {
@@ -873,7 +1338,7 @@ type ValidatorWeight struct {
 func (x *ValidatorWeight) Reset() {
 	*x = ValidatorWeight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_consensus_v1_types_proto_msgTypes[7]
+		mi := &file_volnix_consensus_v1_types_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -886,7 +1351,7 @@ func (x *ValidatorWeight) String() string {
 func (*ValidatorWeight) ProtoMessage() {}
 
 func (x *ValidatorWeight) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_consensus_v1_types_proto_msgTypes[7]
+	mi := &file_volnix_consensus_v1_types_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1364,7 @@ func (x *ValidatorWeight) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidatorWeight.ProtoReflect.Descriptor instead.
 func (*ValidatorWeight) Descriptor() ([]byte, []int) {
-	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{7}
+	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ValidatorWeight) GetValidator() string {
@@ -927,12 +1392,13 @@ type EncryptedBid struct {
 	CommitHash  string                 `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"` // Hash of (nonce + bid_amount)
 	BlockHeight uint64                 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	CommitTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=commit_time,json=commitTime,proto3" json:"commit_time,omitempty"`
+	BondAmount  string                 `protobuf:"bytes,5,opt,name=bond_amount,json=bondAmount,proto3" json:"bond_amount,omitempty"` // ANT locked as commit bond, returned on a valid reveal
 }
 
 func (x *EncryptedBid) Reset() {
 	*x = EncryptedBid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_consensus_v1_types_proto_msgTypes[8]
+		mi := &file_volnix_consensus_v1_types_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -945,7 +1411,7 @@ func (x *EncryptedBid) String() string {
 func (*EncryptedBid) ProtoMessage() {}
 
 func (x *EncryptedBid) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_consensus_v1_types_proto_msgTypes[8]
+	mi := &file_volnix_consensus_v1_types_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1424,7 @@ func (x *EncryptedBid) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EncryptedBid.ProtoReflect.Descriptor instead.
 func (*EncryptedBid) Descriptor() ([]byte, []int) {
-	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{8}
+	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EncryptedBid) GetValidator() string {
@@ -989,6 +1455,13 @@ func (x *EncryptedBid) GetCommitTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *EncryptedBid) GetBondAmount() string {
+	if x != nil {
+		return x.BondAmount
+	}
+	return ""
+}
+
 // BidReveal represents a revealed bid in the blind auction
 // This is the reveal phase: validator reveals nonce and bid amount
 type BidReveal struct {
@@ -1006,7 +1479,7 @@ type BidReveal struct {
 func (x *BidReveal) Reset() {
 	*x = BidReveal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_consensus_v1_types_proto_msgTypes[9]
+		mi := &file_volnix_consensus_v1_types_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1019,7 +1492,7 @@ func (x *BidReveal) String() string {
 func (*BidReveal) ProtoMessage() {}
 
 func (x *BidReveal) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_consensus_v1_types_proto_msgTypes[9]
+	mi := &file_volnix_consensus_v1_types_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1505,7 @@ func (x *BidReveal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BidReveal.ProtoReflect.Descriptor instead.
 func (*BidReveal) Descriptor() ([]byte, []int) {
-	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{9}
+	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BidReveal) GetValidator() string {
@@ -1070,26 +1543,100 @@ func (x *BidReveal) GetRevealTime() *timestamppb.Timestamp {
 	return nil
 }
 
+// AuctionFault records a blind auction commit that was never revealed
+type AuctionFault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator     string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	AuctionHeight uint64 `protobuf:"varint,2,opt,name=auction_height,json=auctionHeight,proto3" json:"auction_height,omitempty"` // Height of the auction the commit belonged to
+	BondForfeited string `protobuf:"bytes,3,opt,name=bond_forfeited,json=bondForfeited,proto3" json:"bond_forfeited,omitempty"`  // ANT bond forfeited by the validator
+	FaultHeight   uint64 `protobuf:"varint,4,opt,name=fault_height,json=faultHeight,proto3" json:"fault_height,omitempty"`       // Height at which the fault was recorded
+}
+
+func (x *AuctionFault) Reset() {
+	*x = AuctionFault{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_consensus_v1_types_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionFault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionFault) ProtoMessage() {}
+
+func (x *AuctionFault) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_consensus_v1_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionFault.ProtoReflect.Descriptor instead.
+func (*AuctionFault) Descriptor() ([]byte, []int) {
+	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuctionFault) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *AuctionFault) GetAuctionHeight() uint64 {
+	if x != nil {
+		return x.AuctionHeight
+	}
+	return 0
+}
+
+func (x *AuctionFault) GetBondForfeited() string {
+	if x != nil {
+		return x.BondForfeited
+	}
+	return ""
+}
+
+func (x *AuctionFault) GetFaultHeight() uint64 {
+	if x != nil {
+		return x.FaultHeight
+	}
+	return 0
+}
+
 // BlindAuction represents a blind auction for block creation rights
 type BlindAuction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockHeight uint64                 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Phase       AuctionPhase           `protobuf:"varint,2,opt,name=phase,proto3,enum=volnix.consensus.v1.AuctionPhase" json:"phase,omitempty"`
-	Commits     []*EncryptedBid        `protobuf:"bytes,3,rep,name=commits,proto3" json:"commits,omitempty"`                         // Committed bids
-	Reveals     []*BidReveal           `protobuf:"bytes,4,rep,name=reveals,proto3" json:"reveals,omitempty"`                         // Revealed bids
-	Winner      string                 `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`                           // Winner validator address
-	WinningBid  string                 `protobuf:"bytes,6,opt,name=winning_bid,json=winningBid,proto3" json:"winning_bid,omitempty"` // Winning bid amount
-	StartTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	BlockHeight     uint64                 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Phase           AuctionPhase           `protobuf:"varint,2,opt,name=phase,proto3,enum=volnix.consensus.v1.AuctionPhase" json:"phase,omitempty"`
+	Commits         []*EncryptedBid        `protobuf:"bytes,3,rep,name=commits,proto3" json:"commits,omitempty"`                         // Committed bids
+	Reveals         []*BidReveal           `protobuf:"bytes,4,rep,name=reveals,proto3" json:"reveals,omitempty"`                         // Revealed bids
+	Winner          string                 `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`                           // Winner validator address
+	WinningBid      string                 `protobuf:"bytes,6,opt,name=winning_bid,json=winningBid,proto3" json:"winning_bid,omitempty"` // Winning bid amount
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	CommitEndHeight uint64                 `protobuf:"varint,9,opt,name=commit_end_height,json=commitEndHeight,proto3" json:"commit_end_height,omitempty"`  // Last block height at which commits are accepted
+	RevealEndHeight uint64                 `protobuf:"varint,10,opt,name=reveal_end_height,json=revealEndHeight,proto3" json:"reveal_end_height,omitempty"` // Last block height at which reveals are accepted; the winner is selected at its end
 }
 
 func (x *BlindAuction) Reset() {
 	*x = BlindAuction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_consensus_v1_types_proto_msgTypes[10]
+		mi := &file_volnix_consensus_v1_types_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1102,7 +1649,7 @@ func (x *BlindAuction) String() string {
 func (*BlindAuction) ProtoMessage() {}
 
 func (x *BlindAuction) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_consensus_v1_types_proto_msgTypes[10]
+	mi := &file_volnix_consensus_v1_types_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,7 +1662,7 @@ func (x *BlindAuction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlindAuction.ProtoReflect.Descriptor instead.
 func (*BlindAuction) Descriptor() ([]byte, []int) {
-	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{10}
+	return file_volnix_consensus_v1_types_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BlindAuction) GetBlockHeight() uint64 {
@@ -1174,6 +1721,20 @@ func (x *BlindAuction) GetEndTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *BlindAuction) GetCommitEndHeight() uint64 {
+	if x != nil {
+		return x.CommitEndHeight
+	}
+	return 0
+}
+
+func (x *BlindAuction) GetRevealEndHeight() uint64 {
+	if x != nil {
+		return x.RevealEndHeight
+	}
+	return 0
+}
+
 var File_volnix_consensus_v1_types_proto protoreflect.FileDescriptor
 
 var file_volnix_consensus_v1_types_proto_rawDesc = []byte{
@@ -1254,7 +1815,7 @@ var file_volnix_consensus_v1_types_proto_rawDesc = []byte{
 	0x3b, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xa1, 0x08, 0x0a,
+	0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xfd, 0x0c, 0x0a,
 	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x5f,
 	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12,
@@ -1321,115 +1882,240 @@ var file_volnix_consensus_v1_types_proto_rawDesc = []byte{
 	0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x61, 0x70, 0x69,
 	0x64, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x0d, 0x72, 0x61, 0x70, 0x69, 0x64, 0x42, 0x69, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0xbb, 0x02, 0x0a, 0x0b, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6c,
-	0x61, 0x73, 0x74, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x29, 0x0a, 0x10, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x68, 0x61, 0x6c, 0x76,
-	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x6e,
-	0x65, 0x78, 0x74, 0x5f, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x61,
-	0x6c, 0x76, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x59, 0x0a, 0x1b, 0x65,
-	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x61,
-	0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x18, 0x65, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x61, 0x6c, 0x76, 0x69,
-	0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68,
-	0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c,
-	0x61, 0x73, 0x74, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x22, 0xd2,
-	0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x61, 0x6e, 0x74, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6e, 0x74, 0x42, 0x75, 0x72, 0x6e,
-	0x65, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x73, 0x22, 0x47, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xad, 0x01, 0x0a,
-	0x0c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x42, 0x69, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a,
+	0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x4a, 0x61, 0x69, 0x6c, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f,
+	0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3b,
+	0x0a, 0x1a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x47, 0x0a, 0x20, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x6e,
+	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x42, 0x6f, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x13, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2e, 0x0a, 0x13,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xed, 0x02, 0x0a,
+	0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x15,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x12, 0x3d, 0x0a, 0x0c, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x75, 0x6e, 0x72,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xa5, 0x01, 0x0a,
+	0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x65,
+	0x77, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x75,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x53, 0x75, 0x6d, 0x22, 0xed, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x22, 0xac, 0x02, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2d, 0x0a,
+	0x12, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e,
+	0x6d, 0x6f, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x61, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x72, 0x75, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x72, 0x75, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x22, 0xbb, 0x02, 0x0a, 0x0b, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x6c, 0x76,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x68,
+	0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2e,
+	0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6e, 0x65, 0x78,
+	0x74, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x59,
+	0x0a, 0x1b, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x18, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x61,
+	0x6c, 0x76, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xbe, 0x01, 0x0a,
-	0x09, 0x42, 0x69, 0x64, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8c, 0x03,
-	0x0a, 0x0c, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21,
-	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x37, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x21, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
-	0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68,
-	0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x6f,
-	0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x42, 0x69, 0x64, 0x52, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x61,
-	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
-	0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x69, 0x64, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74,
+	0x65, 0x22, 0xd2, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6e, 0x74, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6e, 0x74, 0x42,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x47, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0xce, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x42, 0x69, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0x8d, 0x01, 0x0a,
-	0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x20, 0x0a, 0x1c, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12,
-	0x1d, 0x0a, 0x19, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x1c,
-	0x0a, 0x18, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x53, 0x4c, 0x41, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x7d, 0x0a, 0x0c,
-	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x19,
-	0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x41,
-	0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4d,
-	0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x10, 0x02, 0x12,
-	0x1a, 0x0a, 0x16, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45,
-	0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x42, 0x59, 0x5a, 0x57, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
-	0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
-	0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x63, 0x6f,
-	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e, 0x73, 0x65,
-	0x6e, 0x73, 0x75, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xbe, 0x01, 0x0a, 0x09, 0x42, 0x69, 0x64, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x9d, 0x01, 0x0a, 0x0c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6f, 0x6e, 0x64, 0x5f,
+	0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x62, 0x6f, 0x6e, 0x64, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xe4, 0x03, 0x0a, 0x0c, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x42,
+	0x69, 0x64, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x72,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76,
+	0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x52, 0x07, 0x72, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a,
+	0x0b, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x45,
+	0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2a, 0xaa, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b,
+	0x0a, 0x17, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53,
+	0x4c, 0x41, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4a, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x7d, 0x0a, 0x0c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x18,
+	0x0a, 0x14, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f,
+	0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x45, 0x10, 0x03, 0x42, 0x59, 0x5a, 0x57, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f,
+	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1445,7 +2131,7 @@ func file_volnix_consensus_v1_types_proto_rawDescGZIP() []byte {
 }
 
 var file_volnix_consensus_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_volnix_consensus_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_volnix_consensus_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_volnix_consensus_v1_types_proto_goTypes = []interface{}{
 	(ValidatorStatus)(0),          // 0: volnix.consensus.v1.ValidatorStatus
 	(AuctionPhase)(0),             // 1: volnix.consensus.v1.AuctionPhase
@@ -1454,35 +2140,41 @@ var file_volnix_consensus_v1_types_proto_goTypes = []interface{}{
 	(*BurnProof)(nil),             // 4: volnix.consensus.v1.BurnProof
 	(*ActivityScore)(nil),         // 5: volnix.consensus.v1.ActivityScore
 	(*Params)(nil),                // 6: volnix.consensus.v1.Params
-	(*HalvingInfo)(nil),           // 7: volnix.consensus.v1.HalvingInfo
-	(*ConsensusState)(nil),        // 8: volnix.consensus.v1.ConsensusState
-	(*ValidatorWeight)(nil),       // 9: volnix.consensus.v1.ValidatorWeight
-	(*EncryptedBid)(nil),          // 10: volnix.consensus.v1.EncryptedBid
-	(*BidReveal)(nil),             // 11: volnix.consensus.v1.BidReveal
-	(*BlindAuction)(nil),          // 12: volnix.consensus.v1.BlindAuction
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(*ValidatorSigningInfo)(nil),  // 7: volnix.consensus.v1.ValidatorSigningInfo
+	(*BlockTimeWindow)(nil),       // 8: volnix.consensus.v1.BlockTimeWindow
+	(*RewardPool)(nil),            // 9: volnix.consensus.v1.RewardPool
+	(*ValidatorRewards)(nil),      // 10: volnix.consensus.v1.ValidatorRewards
+	(*HalvingInfo)(nil),           // 11: volnix.consensus.v1.HalvingInfo
+	(*ConsensusState)(nil),        // 12: volnix.consensus.v1.ConsensusState
+	(*ValidatorWeight)(nil),       // 13: volnix.consensus.v1.ValidatorWeight
+	(*EncryptedBid)(nil),          // 14: volnix.consensus.v1.EncryptedBid
+	(*BidReveal)(nil),             // 15: volnix.consensus.v1.BidReveal
+	(*AuctionFault)(nil),          // 16: volnix.consensus.v1.AuctionFault
+	(*BlindAuction)(nil),          // 17: volnix.consensus.v1.BlindAuction
+	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
 }
 var file_volnix_consensus_v1_types_proto_depIdxs = []int32{
 	0,  // 0: volnix.consensus.v1.Validator.status:type_name -> volnix.consensus.v1.ValidatorStatus
-	13, // 1: volnix.consensus.v1.Validator.last_active:type_name -> google.protobuf.Timestamp
-	13, // 2: volnix.consensus.v1.BlockCreator.selection_time:type_name -> google.protobuf.Timestamp
-	13, // 3: volnix.consensus.v1.BurnProof.burn_time:type_name -> google.protobuf.Timestamp
-	13, // 4: volnix.consensus.v1.ActivityScore.last_update:type_name -> google.protobuf.Timestamp
-	13, // 5: volnix.consensus.v1.HalvingInfo.estimated_next_halving_date:type_name -> google.protobuf.Timestamp
-	13, // 6: volnix.consensus.v1.HalvingInfo.last_halving_date:type_name -> google.protobuf.Timestamp
-	13, // 7: volnix.consensus.v1.ConsensusState.last_block_time:type_name -> google.protobuf.Timestamp
-	13, // 8: volnix.consensus.v1.EncryptedBid.commit_time:type_name -> google.protobuf.Timestamp
-	13, // 9: volnix.consensus.v1.BidReveal.reveal_time:type_name -> google.protobuf.Timestamp
-	1,  // 10: volnix.consensus.v1.BlindAuction.phase:type_name -> volnix.consensus.v1.AuctionPhase
-	10, // 11: volnix.consensus.v1.BlindAuction.commits:type_name -> volnix.consensus.v1.EncryptedBid
-	11, // 12: volnix.consensus.v1.BlindAuction.reveals:type_name -> volnix.consensus.v1.BidReveal
-	13, // 13: volnix.consensus.v1.BlindAuction.start_time:type_name -> google.protobuf.Timestamp
-	13, // 14: volnix.consensus.v1.BlindAuction.end_time:type_name -> google.protobuf.Timestamp
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	18, // 1: volnix.consensus.v1.Validator.last_active:type_name -> google.protobuf.Timestamp
+	18, // 2: volnix.consensus.v1.BlockCreator.selection_time:type_name -> google.protobuf.Timestamp
+	18, // 3: volnix.consensus.v1.BurnProof.burn_time:type_name -> google.protobuf.Timestamp
+	18, // 4: volnix.consensus.v1.ActivityScore.last_update:type_name -> google.protobuf.Timestamp
+	18, // 5: volnix.consensus.v1.ValidatorSigningInfo.jailed_until:type_name -> google.protobuf.Timestamp
+	18, // 6: volnix.consensus.v1.HalvingInfo.estimated_next_halving_date:type_name -> google.protobuf.Timestamp
+	18, // 7: volnix.consensus.v1.HalvingInfo.last_halving_date:type_name -> google.protobuf.Timestamp
+	18, // 8: volnix.consensus.v1.ConsensusState.last_block_time:type_name -> google.protobuf.Timestamp
+	18, // 9: volnix.consensus.v1.EncryptedBid.commit_time:type_name -> google.protobuf.Timestamp
+	18, // 10: volnix.consensus.v1.BidReveal.reveal_time:type_name -> google.protobuf.Timestamp
+	1,  // 11: volnix.consensus.v1.BlindAuction.phase:type_name -> volnix.consensus.v1.AuctionPhase
+	14, // 12: volnix.consensus.v1.BlindAuction.commits:type_name -> volnix.consensus.v1.EncryptedBid
+	15, // 13: volnix.consensus.v1.BlindAuction.reveals:type_name -> volnix.consensus.v1.BidReveal
+	18, // 14: volnix.consensus.v1.BlindAuction.start_time:type_name -> google.protobuf.Timestamp
+	18, // 15: volnix.consensus.v1.BlindAuction.end_time:type_name -> google.protobuf.Timestamp
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_volnix_consensus_v1_types_proto_init() }
@@ -1552,7 +2244,7 @@ func file_volnix_consensus_v1_types_proto_init() {
 			}
 		}
 		file_volnix_consensus_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HalvingInfo); i {
+			switch v := v.(*ValidatorSigningInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1564,7 +2256,7 @@ func file_volnix_consensus_v1_types_proto_init() {
 			}
 		}
 		file_volnix_consensus_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsensusState); i {
+			switch v := v.(*BlockTimeWindow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1576,7 +2268,7 @@ func file_volnix_consensus_v1_types_proto_init() {
 			}
 		}
 		file_volnix_consensus_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorWeight); i {
+			switch v := v.(*RewardPool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1588,7 +2280,7 @@ func file_volnix_consensus_v1_types_proto_init() {
 			}
 		}
 		file_volnix_consensus_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptedBid); i {
+			switch v := v.(*ValidatorRewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1600,7 +2292,7 @@ func file_volnix_consensus_v1_types_proto_init() {
 			}
 		}
 		file_volnix_consensus_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BidReveal); i {
+			switch v := v.(*HalvingInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1612,6 +2304,66 @@ func file_volnix_consensus_v1_types_proto_init() {
 			}
 		}
 		file_volnix_consensus_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsensusState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorWeight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptedBid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidReveal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionFault); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_consensus_v1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlindAuction); i {
 			case 0:
 				return &v.state
@@ -1630,7 +2382,7 @@ func file_volnix_consensus_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_volnix_consensus_v1_types_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   11,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -24,6 +24,8 @@ const (
 	Query_VerifiedAccounts_FullMethodName      = "/volnix.ident.v1.Query/VerifiedAccounts"
 	Query_IdentityVerification_FullMethodName  = "/volnix.ident.v1.Query/IdentityVerification"
 	Query_VerificationProviders_FullMethodName = "/volnix.ident.v1.Query/VerificationProviders"
+	Query_RoleMigrations_FullMethodName        = "/volnix.ident.v1.Query/RoleMigrations"
+	Query_Nullifier_FullMethodName             = "/volnix.ident.v1.Query/Nullifier"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +42,10 @@ type QueryClient interface {
 	IdentityVerification(ctx context.Context, in *QueryIdentityVerificationRequest, opts ...grpc.CallOption) (*QueryIdentityVerificationResponse, error)
 	// VerificationProviders queries all verification providers
 	VerificationProviders(ctx context.Context, in *QueryVerificationProvidersRequest, opts ...grpc.CallOption) (*QueryVerificationProvidersResponse, error)
+	// RoleMigrations queries role migrations, optionally those of one address
+	RoleMigrations(ctx context.Context, in *QueryRoleMigrationsRequest, opts ...grpc.CallOption) (*QueryRoleMigrationsResponse, error)
+	// Nullifier queries the recorded use of a ZKP nullifier
+	Nullifier(ctx context.Context, in *QueryNullifierRequest, opts ...grpc.CallOption) (*QueryNullifierResponse, error)
 }
 
 type queryClient struct {
@@ -95,6 +101,24 @@ func (c *queryClient) VerificationProviders(ctx context.Context, in *QueryVerifi
 	return out, nil
 }
 
+func (c *queryClient) RoleMigrations(ctx context.Context, in *QueryRoleMigrationsRequest, opts ...grpc.CallOption) (*QueryRoleMigrationsResponse, error) {
+	out := new(QueryRoleMigrationsResponse)
+	err := c.cc.Invoke(ctx, Query_RoleMigrations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Nullifier(ctx context.Context, in *QueryNullifierRequest, opts ...grpc.CallOption) (*QueryNullifierResponse, error) {
+	out := new(QueryNullifierResponse)
+	err := c.cc.Invoke(ctx, Query_Nullifier_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +133,10 @@ type QueryServer interface {
 	IdentityVerification(context.Context, *QueryIdentityVerificationRequest) (*QueryIdentityVerificationResponse, error)
 	// VerificationProviders queries all verification providers
 	VerificationProviders(context.Context, *QueryVerificationProvidersRequest) (*QueryVerificationProvidersResponse, error)
+	// RoleMigrations queries role migrations, optionally those of one address
+	RoleMigrations(context.Context, *QueryRoleMigrationsRequest) (*QueryRoleMigrationsResponse, error)
+	// Nullifier queries the recorded use of a ZKP nullifier
+	Nullifier(context.Context, *QueryNullifierRequest) (*QueryNullifierResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +159,12 @@ func (UnimplementedQueryServer) IdentityVerification(context.Context, *QueryIden
 func (UnimplementedQueryServer) VerificationProviders(context.Context, *QueryVerificationProvidersRequest) (*QueryVerificationProvidersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerificationProviders not implemented")
 }
+func (UnimplementedQueryServer) RoleMigrations(context.Context, *QueryRoleMigrationsRequest) (*QueryRoleMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleMigrations not implemented")
+}
+func (UnimplementedQueryServer) Nullifier(context.Context, *QueryNullifierRequest) (*QueryNullifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nullifier not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +268,42 @@ func _Query_VerificationProviders_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RoleMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoleMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RoleMigrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoleMigrations(ctx, req.(*QueryRoleMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Nullifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNullifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Nullifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Nullifier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Nullifier(ctx, req.(*QueryNullifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +331,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerificationProviders",
 			Handler:    _Query_VerificationProviders_Handler,
 		},
+		{
+			MethodName: "RoleMigrations",
+			Handler:    _Query_RoleMigrations_Handler,
+		},
+		{
+			MethodName: "Nullifier",
+			Handler:    _Query_Nullifier_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "volnix/ident/v1/query.proto",
@@ -23,6 +23,7 @@ const (
 	Msg_MigrateRole_FullMethodName                  = "/volnix.ident.v1.Msg/MigrateRole"
 	Msg_ChangeRole_FullMethodName                   = "/volnix.ident.v1.Msg/ChangeRole"
 	Msg_RegisterVerificationProvider_FullMethodName = "/volnix.ident.v1.Msg/RegisterVerificationProvider"
+	Msg_UpdateParams_FullMethodName                 = "/volnix.ident.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +34,8 @@ type MsgClient interface {
 	MigrateRole(ctx context.Context, in *MsgMigrateRole, opts ...grpc.CallOption) (*MsgMigrateRoleResponse, error)
 	ChangeRole(ctx context.Context, in *MsgChangeRole, opts ...grpc.CallOption) (*MsgChangeRoleResponse, error)
 	RegisterVerificationProvider(ctx context.Context, in *MsgRegisterVerificationProvider, opts ...grpc.CallOption) (*MsgRegisterVerificationProviderResponse, error)
+	// UpdateParams updates the module parameters; the authority must be the governance module address
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -79,6 +82,15 @@ func (c *msgClient) RegisterVerificationProvider(ctx context.Context, in *MsgReg
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -87,6 +99,8 @@ type MsgServer interface {
 	MigrateRole(context.Context, *MsgMigrateRole) (*MsgMigrateRoleResponse, error)
 	ChangeRole(context.Context, *MsgChangeRole) (*MsgChangeRoleResponse, error)
 	RegisterVerificationProvider(context.Context, *MsgRegisterVerificationProvider) (*MsgRegisterVerificationProviderResponse, error)
+	// UpdateParams updates the module parameters; the authority must be the governance module address
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -106,6 +120,9 @@ func (UnimplementedMsgServer) ChangeRole(context.Context, *MsgChangeRole) (*MsgC
 func (UnimplementedMsgServer) RegisterVerificationProvider(context.Context, *MsgRegisterVerificationProvider) (*MsgRegisterVerificationProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterVerificationProvider not implemented")
 }
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +208,24 @@ func _Msg_RegisterVerificationProvider_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +249,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterVerificationProvider",
 			Handler:    _Msg_RegisterVerificationProvider_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "volnix/ident/v1/tx.proto",
@@ -9,6 +9,7 @@ package lizenzv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -26,13 +27,17 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params                *Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	ActivatedLizenz       []*ActivatedLizenz      `protobuf:"bytes,2,rep,name=activated_lizenz,json=activatedLizenz,proto3" json:"activated_lizenz,omitempty"`
-	DeactivatingLizenz    []*DeactivatingLizenz   `protobuf:"bytes,3,rep,name=deactivating_lizenz,json=deactivatingLizenz,proto3" json:"deactivating_lizenz,omitempty"`
-	MoaStatuses           []*MOAStatus            `protobuf:"bytes,4,rep,name=moa_statuses,json=moaStatuses,proto3" json:"moa_statuses,omitempty"`
-	ValidatorIntegrations []*ValidatorIntegration `protobuf:"bytes,5,rep,name=validator_integrations,json=validatorIntegrations,proto3" json:"validator_integrations,omitempty"`
-	CrossModuleEvents     []*CrossModuleEvent     `protobuf:"bytes,6,rep,name=cross_module_events,json=crossModuleEvents,proto3" json:"cross_module_events,omitempty"`
-	ModuleDependencies    []*ModuleDependency     `protobuf:"bytes,7,rep,name=module_dependencies,json=moduleDependencies,proto3" json:"module_dependencies,omitempty"`
+	Params                *Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	ActivatedLizenz       []*ActivatedLizenz       `protobuf:"bytes,2,rep,name=activated_lizenz,json=activatedLizenz,proto3" json:"activated_lizenz,omitempty"`
+	DeactivatingLizenz    []*DeactivatingLizenz    `protobuf:"bytes,3,rep,name=deactivating_lizenz,json=deactivatingLizenz,proto3" json:"deactivating_lizenz,omitempty"`
+	MoaStatuses           []*MOAStatus             `protobuf:"bytes,4,rep,name=moa_statuses,json=moaStatuses,proto3" json:"moa_statuses,omitempty"`
+	ValidatorIntegrations []*ValidatorIntegration  `protobuf:"bytes,5,rep,name=validator_integrations,json=validatorIntegrations,proto3" json:"validator_integrations,omitempty"`
+	CrossModuleEvents     []*CrossModuleEvent      `protobuf:"bytes,6,rep,name=cross_module_events,json=crossModuleEvents,proto3" json:"cross_module_events,omitempty"`
+	ModuleDependencies    []*ModuleDependency      `protobuf:"bytes,7,rep,name=module_dependencies,json=moduleDependencies,proto3" json:"module_dependencies,omitempty"`
+	RewardRecords         []*ValidatorRewardRecord `protobuf:"bytes,8,rep,name=reward_records,json=rewardRecords,proto3" json:"reward_records,omitempty"`                   // Per-epoch reward records
+	MoaEpochStart         *timestamppb.Timestamp   `protobuf:"bytes,9,opt,name=moa_epoch_start,json=moaEpochStart,proto3" json:"moa_epoch_start,omitempty"`                 // Start of the current MOA epoch
+	MoaEpochActivities    []*MOAEpochActivity      `protobuf:"bytes,10,rep,name=moa_epoch_activities,json=moaEpochActivities,proto3" json:"moa_epoch_activities,omitempty"` // Consensus activity counted in the current MOA epoch
+	TotalActivated        uint64                   `protobuf:"varint,11,opt,name=total_activated,json=totalActivated,proto3" json:"total_activated,omitempty"`              // LZN locked by activations, net of unlocks and burns
 }
 
 func (x *GenesisState) Reset() {
@@ -116,49 +121,231 @@ func (x *GenesisState) GetModuleDependencies() []*ModuleDependency {
 	return nil
 }
 
+func (x *GenesisState) GetRewardRecords() []*ValidatorRewardRecord {
+	if x != nil {
+		return x.RewardRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMoaEpochStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MoaEpochStart
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMoaEpochActivities() []*MOAEpochActivity {
+	if x != nil {
+		return x.MoaEpochActivities
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTotalActivated() uint64 {
+	if x != nil {
+		return x.TotalActivated
+	}
+	return 0
+}
+
+// ValidatorRewardRecord holds a validator's reward record of one epoch
+type ValidatorRewardRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator string        `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Record    *RewardRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *ValidatorRewardRecord) Reset() {
+	*x = ValidatorRewardRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_lizenz_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorRewardRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorRewardRecord) ProtoMessage() {}
+
+func (x *ValidatorRewardRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_lizenz_v1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorRewardRecord.ProtoReflect.Descriptor instead.
+func (*ValidatorRewardRecord) Descriptor() ([]byte, []int) {
+	return file_volnix_lizenz_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorRewardRecord) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorRewardRecord) GetRecord() *RewardRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// IntegrationGenesisState defines the integration module's genesis state
+// The integration records are defined in this package
+type IntegrationGenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorIntegrations []*ValidatorIntegration `protobuf:"bytes,1,rep,name=validator_integrations,json=validatorIntegrations,proto3" json:"validator_integrations,omitempty"`
+	CrossModuleEvents     []*CrossModuleEvent     `protobuf:"bytes,2,rep,name=cross_module_events,json=crossModuleEvents,proto3" json:"cross_module_events,omitempty"` // Events still in the retention window, oldest first
+}
+
+func (x *IntegrationGenesisState) Reset() {
+	*x = IntegrationGenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_lizenz_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntegrationGenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationGenesisState) ProtoMessage() {}
+
+func (x *IntegrationGenesisState) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_lizenz_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationGenesisState.ProtoReflect.Descriptor instead.
+func (*IntegrationGenesisState) Descriptor() ([]byte, []int) {
+	return file_volnix_lizenz_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IntegrationGenesisState) GetValidatorIntegrations() []*ValidatorIntegration {
+	if x != nil {
+		return x.ValidatorIntegrations
+	}
+	return nil
+}
+
+func (x *IntegrationGenesisState) GetCrossModuleEvents() []*CrossModuleEvent {
+	if x != nil {
+		return x.CrossModuleEvents
+	}
+	return nil
+}
+
 var File_volnix_lizenz_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_volnix_lizenz_v1_genesis_proto_rawDesc = []byte{
 	0x0a, 0x1e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f,
 	0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x10, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e,
-	0x76, 0x31, 0x1a, 0x1c, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e,
-	0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xad, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e,
-	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x4c, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65,
+	0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xc0, 0x06, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x30, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x4c, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76,
+	0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52,
+	0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a,
+	0x12, 0x55, 0x0a, 0x13, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a,
+	0x65, 0x6e, 0x7a, 0x52, 0x12, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x12, 0x3e, 0x0a, 0x0c, 0x6d, 0x6f, 0x61, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a,
-	0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e,
-	0x7a, 0x12, 0x55, 0x0a, 0x13, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69,
-	0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x12, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x12, 0x3e, 0x0a, 0x0c, 0x6d, 0x6f, 0x61, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x4f, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6d, 0x6f, 0x61,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
-	0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x52, 0x0a, 0x13, 0x63, 0x72, 0x6f, 0x73, 0x73,
-	0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69,
-	0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x13, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
-	0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x12, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x2e, 0x4d, 0x4f, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6d, 0x6f, 0x61, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
+	0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x52, 0x0a, 0x13, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a,
+	0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x13, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
+	0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x12, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x4e, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
+	0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x42, 0x0a, 0x0f, 0x6d, 0x6f, 0x61, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6d, 0x6f, 0x61, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x54, 0x0a, 0x14, 0x6d, 0x6f, 0x61, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e,
+	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x4f, 0x41, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x12, 0x6d, 0x6f, 0x61, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x6d, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x6e,
+	0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x22, 0xcc, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x5d, 0x0a,
+	0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x52, 0x0a, 0x13,
+	0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x6f, 0x6c, 0x6e,
+	0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x63,
+	0x72, 0x6f, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
 	0x42, 0x53, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76,
 	0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x76,
 	0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x70,
@@ -179,30 +366,41 @@ func file_volnix_lizenz_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_volnix_lizenz_v1_genesis_proto_rawDescData
 }
 
-var file_volnix_lizenz_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_volnix_lizenz_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_volnix_lizenz_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),         // 0: volnix.lizenz.v1.GenesisState
-	(*Params)(nil),               // 1: volnix.lizenz.v1.Params
-	(*ActivatedLizenz)(nil),      // 2: volnix.lizenz.v1.ActivatedLizenz
-	(*DeactivatingLizenz)(nil),   // 3: volnix.lizenz.v1.DeactivatingLizenz
-	(*MOAStatus)(nil),            // 4: volnix.lizenz.v1.MOAStatus
-	(*ValidatorIntegration)(nil), // 5: volnix.lizenz.v1.ValidatorIntegration
-	(*CrossModuleEvent)(nil),     // 6: volnix.lizenz.v1.CrossModuleEvent
-	(*ModuleDependency)(nil),     // 7: volnix.lizenz.v1.ModuleDependency
+	(*GenesisState)(nil),            // 0: volnix.lizenz.v1.GenesisState
+	(*ValidatorRewardRecord)(nil),   // 1: volnix.lizenz.v1.ValidatorRewardRecord
+	(*IntegrationGenesisState)(nil), // 2: volnix.lizenz.v1.IntegrationGenesisState
+	(*Params)(nil),                  // 3: volnix.lizenz.v1.Params
+	(*ActivatedLizenz)(nil),         // 4: volnix.lizenz.v1.ActivatedLizenz
+	(*DeactivatingLizenz)(nil),      // 5: volnix.lizenz.v1.DeactivatingLizenz
+	(*MOAStatus)(nil),               // 6: volnix.lizenz.v1.MOAStatus
+	(*ValidatorIntegration)(nil),    // 7: volnix.lizenz.v1.ValidatorIntegration
+	(*CrossModuleEvent)(nil),        // 8: volnix.lizenz.v1.CrossModuleEvent
+	(*ModuleDependency)(nil),        // 9: volnix.lizenz.v1.ModuleDependency
+	(*timestamppb.Timestamp)(nil),   // 10: google.protobuf.Timestamp
+	(*MOAEpochActivity)(nil),        // 11: volnix.lizenz.v1.MOAEpochActivity
+	(*RewardRecord)(nil),            // 12: volnix.lizenz.v1.RewardRecord
 }
 var file_volnix_lizenz_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: volnix.lizenz.v1.GenesisState.params:type_name -> volnix.lizenz.v1.Params
-	2, // 1: volnix.lizenz.v1.GenesisState.activated_lizenz:type_name -> volnix.lizenz.v1.ActivatedLizenz
-	3, // 2: volnix.lizenz.v1.GenesisState.deactivating_lizenz:type_name -> volnix.lizenz.v1.DeactivatingLizenz
-	4, // 3: volnix.lizenz.v1.GenesisState.moa_statuses:type_name -> volnix.lizenz.v1.MOAStatus
-	5, // 4: volnix.lizenz.v1.GenesisState.validator_integrations:type_name -> volnix.lizenz.v1.ValidatorIntegration
-	6, // 5: volnix.lizenz.v1.GenesisState.cross_module_events:type_name -> volnix.lizenz.v1.CrossModuleEvent
-	7, // 6: volnix.lizenz.v1.GenesisState.module_dependencies:type_name -> volnix.lizenz.v1.ModuleDependency
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	3,  // 0: volnix.lizenz.v1.GenesisState.params:type_name -> volnix.lizenz.v1.Params
+	4,  // 1: volnix.lizenz.v1.GenesisState.activated_lizenz:type_name -> volnix.lizenz.v1.ActivatedLizenz
+	5,  // 2: volnix.lizenz.v1.GenesisState.deactivating_lizenz:type_name -> volnix.lizenz.v1.DeactivatingLizenz
+	6,  // 3: volnix.lizenz.v1.GenesisState.moa_statuses:type_name -> volnix.lizenz.v1.MOAStatus
+	7,  // 4: volnix.lizenz.v1.GenesisState.validator_integrations:type_name -> volnix.lizenz.v1.ValidatorIntegration
+	8,  // 5: volnix.lizenz.v1.GenesisState.cross_module_events:type_name -> volnix.lizenz.v1.CrossModuleEvent
+	9,  // 6: volnix.lizenz.v1.GenesisState.module_dependencies:type_name -> volnix.lizenz.v1.ModuleDependency
+	1,  // 7: volnix.lizenz.v1.GenesisState.reward_records:type_name -> volnix.lizenz.v1.ValidatorRewardRecord
+	10, // 8: volnix.lizenz.v1.GenesisState.moa_epoch_start:type_name -> google.protobuf.Timestamp
+	11, // 9: volnix.lizenz.v1.GenesisState.moa_epoch_activities:type_name -> volnix.lizenz.v1.MOAEpochActivity
+	12, // 10: volnix.lizenz.v1.ValidatorRewardRecord.record:type_name -> volnix.lizenz.v1.RewardRecord
+	7,  // 11: volnix.lizenz.v1.IntegrationGenesisState.validator_integrations:type_name -> volnix.lizenz.v1.ValidatorIntegration
+	8,  // 12: volnix.lizenz.v1.IntegrationGenesisState.cross_module_events:type_name -> volnix.lizenz.v1.CrossModuleEvent
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_volnix_lizenz_v1_genesis_proto_init() }
@@ -210,6 +408,7 @@ func file_volnix_lizenz_v1_genesis_proto_init() {
 	if File_volnix_lizenz_v1_genesis_proto != nil {
 		return
 	}
+	file_volnix_lizenz_v1_query_proto_init()
 	file_volnix_lizenz_v1_types_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_volnix_lizenz_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -224,6 +423,30 @@ func file_volnix_lizenz_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_volnix_lizenz_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorRewardRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_lizenz_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntegrationGenesisState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -231,7 +454,7 @@ func file_volnix_lizenz_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_volnix_lizenz_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
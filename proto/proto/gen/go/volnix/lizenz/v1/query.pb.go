@@ -310,6 +310,198 @@ func (x *QueryAllActivatedLizenzResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryTotalActivatedLizenzRequest is request type for the Query/TotalActivatedLizenz RPC method
+type QueryTotalActivatedLizenzRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"` // Page of the validator shares
+}
+
+func (x *QueryTotalActivatedLizenzRequest) Reset() {
+	*x = QueryTotalActivatedLizenzRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTotalActivatedLizenzRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTotalActivatedLizenzRequest) ProtoMessage() {}
+
+func (x *QueryTotalActivatedLizenzRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTotalActivatedLizenzRequest.ProtoReflect.Descriptor instead.
+func (*QueryTotalActivatedLizenzRequest) Descriptor() ([]byte, []int) {
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryTotalActivatedLizenzRequest) GetPagination() *query.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryTotalActivatedLizenzResponse is response type for the Query/TotalActivatedLizenz RPC method
+type QueryTotalActivatedLizenzResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalAmount       string                  `protobuf:"bytes,1,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`                     // Total activated LZN across all validators
+	MaxValidatorShare string                  `protobuf:"bytes,2,opt,name=max_validator_share,json=maxValidatorShare,proto3" json:"max_validator_share,omitempty"` // Share of the pool a single validator may hold
+	Shares            []*ValidatorLizenzShare `protobuf:"bytes,3,rep,name=shares,proto3" json:"shares,omitempty"`                                                  // Page of the validator shares, in validator order
+	Pagination        *query.PageResponse     `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryTotalActivatedLizenzResponse) Reset() {
+	*x = QueryTotalActivatedLizenzResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTotalActivatedLizenzResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTotalActivatedLizenzResponse) ProtoMessage() {}
+
+func (x *QueryTotalActivatedLizenzResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTotalActivatedLizenzResponse.ProtoReflect.Descriptor instead.
+func (*QueryTotalActivatedLizenzResponse) Descriptor() ([]byte, []int) {
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryTotalActivatedLizenzResponse) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *QueryTotalActivatedLizenzResponse) GetMaxValidatorShare() string {
+	if x != nil {
+		return x.MaxValidatorShare
+	}
+	return ""
+}
+
+func (x *QueryTotalActivatedLizenzResponse) GetShares() []*ValidatorLizenzShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *QueryTotalActivatedLizenzResponse) GetPagination() *query.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// ValidatorLizenzShare is the activated LZN of a validator relative to the whole pool
+type ValidatorLizenzShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator   string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Amount      string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                              // Activated LZN of the validator
+	Share       string `protobuf:"bytes,3,opt,name=share,proto3" json:"share,omitempty"`                                // amount / total_amount, as a decimal string
+	CapHeadroom string `protobuf:"bytes,4,opt,name=cap_headroom,json=capHeadroom,proto3" json:"cap_headroom,omitempty"` // max_validator_share - share; negative when the validator is above the cap
+}
+
+func (x *ValidatorLizenzShare) Reset() {
+	*x = ValidatorLizenzShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorLizenzShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorLizenzShare) ProtoMessage() {}
+
+func (x *ValidatorLizenzShare) ProtoReflect() protoreflect.Message {
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorLizenzShare.ProtoReflect.Descriptor instead.
+func (*ValidatorLizenzShare) Descriptor() ([]byte, []int) {
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidatorLizenzShare) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorLizenzShare) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ValidatorLizenzShare) GetShare() string {
+	if x != nil {
+		return x.Share
+	}
+	return ""
+}
+
+func (x *ValidatorLizenzShare) GetCapHeadroom() string {
+	if x != nil {
+		return x.CapHeadroom
+	}
+	return ""
+}
+
 // QueryDeactivatingLizenzRequest is request type for the Query/DeactivatingLizenz RPC method
 type QueryDeactivatingLizenzRequest struct {
 	state         protoimpl.MessageState
@@ -322,7 +514,7 @@ type QueryDeactivatingLizenzRequest struct {
 func (x *QueryDeactivatingLizenzRequest) Reset() {
 	*x = QueryDeactivatingLizenzRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[6]
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -335,7 +527,7 @@ func (x *QueryDeactivatingLizenzRequest) String() string {
 func (*QueryDeactivatingLizenzRequest) ProtoMessage() {}
 
 func (x *QueryDeactivatingLizenzRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[6]
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +540,7 @@ func (x *QueryDeactivatingLizenzRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryDeactivatingLizenzRequest.ProtoReflect.Descriptor instead.
 func (*QueryDeactivatingLizenzRequest) Descriptor() ([]byte, []int) {
-	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueryDeactivatingLizenzRequest) GetValidator() string {
@@ -370,7 +562,7 @@ type QueryDeactivatingLizenzResponse struct {
 func (x *QueryDeactivatingLizenzResponse) Reset() {
 	*x = QueryDeactivatingLizenzResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[7]
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -383,7 +575,7 @@ func (x *QueryDeactivatingLizenzResponse) String() string {
 func (*QueryDeactivatingLizenzResponse) ProtoMessage() {}
 
 func (x *QueryDeactivatingLizenzResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[7]
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +588,7 @@ func (x *QueryDeactivatingLizenzResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryDeactivatingLizenzResponse.ProtoReflect.Descriptor instead.
 func (*QueryDeactivatingLizenzResponse) Descriptor() ([]byte, []int) {
-	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *QueryDeactivatingLizenzResponse) GetDeactivatingLizenz() *DeactivatingLizenz {
@@ -418,7 +610,7 @@ type QueryMOAStatusRequest struct {
 func (x *QueryMOAStatusRequest) Reset() {
 	*x = QueryMOAStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[8]
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -431,7 +623,7 @@ func (x *QueryMOAStatusRequest) String() string {
 func (*QueryMOAStatusRequest) ProtoMessage() {}
 
 func (x *QueryMOAStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[8]
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +636,7 @@ func (x *QueryMOAStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryMOAStatusRequest.ProtoReflect.Descriptor instead.
 func (*QueryMOAStatusRequest) Descriptor() ([]byte, []int) {
-	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryMOAStatusRequest) GetValidator() string {
@@ -466,7 +658,7 @@ type QueryMOAStatusResponse struct {
 func (x *QueryMOAStatusResponse) Reset() {
 	*x = QueryMOAStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[9]
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -479,7 +671,7 @@ func (x *QueryMOAStatusResponse) String() string {
 func (*QueryMOAStatusResponse) ProtoMessage() {}
 
 func (x *QueryMOAStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[9]
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +684,7 @@ func (x *QueryMOAStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryMOAStatusResponse.ProtoReflect.Descriptor instead.
 func (*QueryMOAStatusResponse) Descriptor() ([]byte, []int) {
-	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QueryMOAStatusResponse) GetMoaStatus() *MOAStatus {
@@ -514,7 +706,7 @@ type QueryValidatorIntegrationRequest struct {
 func (x *QueryValidatorIntegrationRequest) Reset() {
 	*x = QueryValidatorIntegrationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[10]
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -527,7 +719,7 @@ func (x *QueryValidatorIntegrationRequest) String() string {
 func (*QueryValidatorIntegrationRequest) ProtoMessage() {}
 
 func (x *QueryValidatorIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[10]
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +732,7 @@ func (x *QueryValidatorIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryValidatorIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*QueryValidatorIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryValidatorIntegrationRequest) GetValidator() string {
@@ -562,7 +754,7 @@ type QueryValidatorIntegrationResponse struct {
 func (x *QueryValidatorIntegrationResponse) Reset() {
 	*x = QueryValidatorIntegrationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[11]
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -575,7 +767,7 @@ func (x *QueryValidatorIntegrationResponse) String() string {
 func (*QueryValidatorIntegrationResponse) ProtoMessage() {}
 
 func (x *QueryValidatorIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[11]
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -588,7 +780,7 @@ func (x *QueryValidatorIntegrationResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use QueryValidatorIntegrationResponse.ProtoReflect.Descriptor instead.
 func (*QueryValidatorIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryValidatorIntegrationResponse) GetValidatorIntegration() *ValidatorIntegration {
@@ -604,13 +796,14 @@ type QueryRewardHistoryRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"` // Validator address
+	Validator  string             `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"` // Validator address
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (x *QueryRewardHistoryRequest) Reset() {
 	*x = QueryRewardHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[12]
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -623,7 +816,7 @@ func (x *QueryRewardHistoryRequest) String() string {
 func (*QueryRewardHistoryRequest) ProtoMessage() {}
 
 func (x *QueryRewardHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[12]
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +829,7 @@ func (x *QueryRewardHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRewardHistoryRequest.ProtoReflect.Descriptor instead.
 func (*QueryRewardHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryRewardHistoryRequest) GetValidator() string {
@@ -646,21 +839,29 @@ func (x *QueryRewardHistoryRequest) GetValidator() string {
 	return ""
 }
 
+func (x *QueryRewardHistoryRequest) GetPagination() *query.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 // QueryRewardHistoryResponse is response type for Query/GetRewardHistory
 type QueryRewardHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Validator     string          `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	RewardHistory []*RewardRecord `protobuf:"bytes,2,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history,omitempty"`
-	TotalRecords  uint64          `protobuf:"varint,3,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	Validator     string              `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	RewardHistory []*RewardRecord     `protobuf:"bytes,2,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history,omitempty"`
+	TotalRecords  uint64              `protobuf:"varint,3,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	Pagination    *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (x *QueryRewardHistoryResponse) Reset() {
 	*x = QueryRewardHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[13]
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -673,7 +874,7 @@ func (x *QueryRewardHistoryResponse) String() string {
 func (*QueryRewardHistoryResponse) ProtoMessage() {}
 
 func (x *QueryRewardHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[13]
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,7 +887,7 @@ func (x *QueryRewardHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRewardHistoryResponse.ProtoReflect.Descriptor instead.
 func (*QueryRewardHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryRewardHistoryResponse) GetValidator() string {
@@ -710,19 +911,27 @@ func (x *QueryRewardHistoryResponse) GetTotalRecords() uint64 {
 	return 0
 }
 
+func (x *QueryRewardHistoryResponse) GetPagination() *query.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 // QueryRewardStatsRequest is request type for Query/GetRewardStats
 type QueryRewardStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"` // Validator address
+	Validator  string             `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`   // Validator address
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"` // Page of the reward history
 }
 
 func (x *QueryRewardStatsRequest) Reset() {
 	*x = QueryRewardStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[14]
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -735,7 +944,7 @@ func (x *QueryRewardStatsRequest) String() string {
 func (*QueryRewardStatsRequest) ProtoMessage() {}
 
 func (x *QueryRewardStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[14]
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +957,7 @@ func (x *QueryRewardStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRewardStatsRequest.ProtoReflect.Descriptor instead.
 func (*QueryRewardStatsRequest) Descriptor() ([]byte, []int) {
-	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryRewardStatsRequest) GetValidator() string {
@@ -758,6 +967,13 @@ func (x *QueryRewardStatsRequest) GetValidator() string {
 	return ""
 }
 
+func (x *QueryRewardStatsRequest) GetPagination() *query.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 // QueryRewardStatsResponse is response type for Query/GetRewardStats
 type QueryRewardStatsResponse struct {
 	state         protoimpl.MessageState
@@ -767,14 +983,15 @@ type QueryRewardStatsResponse struct {
 	TotalRewardsEarned string                 `protobuf:"bytes,1,opt,name=total_rewards_earned,json=totalRewardsEarned,proto3" json:"total_rewards_earned,omitempty"` // Total WRT rewards earned (cumulative)
 	LastRewardBlock    string                 `protobuf:"bytes,2,opt,name=last_reward_block,json=lastRewardBlock,proto3" json:"last_reward_block,omitempty"`          // Block height of last reward
 	LastRewardTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_reward_time,json=lastRewardTime,proto3" json:"last_reward_time,omitempty"`             // Timestamp of last reward
-	RewardHistory      []*RewardRecord        `protobuf:"bytes,4,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history,omitempty"`                  // Recent reward history
-	TotalRewardsCount  uint64                 `protobuf:"varint,5,opt,name=total_rewards_count,json=totalRewardsCount,proto3" json:"total_rewards_count,omitempty"`   // Total number of reward distributions
+	RewardHistory      []*RewardRecord        `protobuf:"bytes,4,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history,omitempty"`                  // Page of the per-epoch reward history
+	TotalRewardsCount  uint64                 `protobuf:"varint,5,opt,name=total_rewards_count,json=totalRewardsCount,proto3" json:"total_rewards_count,omitempty"`   // Total number of rewarded epochs
+	Pagination         *query.PageResponse    `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (x *QueryRewardStatsResponse) Reset() {
 	*x = QueryRewardStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[15]
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -787,7 +1004,7 @@ func (x *QueryRewardStatsResponse) String() string {
 func (*QueryRewardStatsResponse) ProtoMessage() {}
 
 func (x *QueryRewardStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[15]
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +1017,7 @@ func (x *QueryRewardStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryRewardStatsResponse.ProtoReflect.Descriptor instead.
 func (*QueryRewardStatsResponse) Descriptor() ([]byte, []int) {
-	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QueryRewardStatsResponse) GetTotalRewardsEarned() string {
@@ -838,24 +1055,33 @@ func (x *QueryRewardStatsResponse) GetTotalRewardsCount() uint64 {
 	return 0
 }
 
-// RewardRecord represents a single reward distribution record
+func (x *QueryRewardStatsResponse) GetPagination() *query.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// RewardRecord aggregates the rewards of a validator over one reward epoch
 type RewardRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockHeight    uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHeight    uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`   // Last height of the epoch
 	RewardAmount   string `protobuf:"bytes,2,opt,name=reward_amount,json=rewardAmount,proto3" json:"reward_amount,omitempty"` // in micro WRT
 	Timestamp      int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	MoaCompliance  string `protobuf:"bytes,4,opt,name=moa_compliance,json=moaCompliance,proto3" json:"moa_compliance,omitempty"`    // MOA compliance ratio at time of reward
 	PenaltyApplied string `protobuf:"bytes,5,opt,name=penalty_applied,json=penaltyApplied,proto3" json:"penalty_applied,omitempty"` // Penalty multiplier applied
 	BaseReward     string `protobuf:"bytes,6,opt,name=base_reward,json=baseReward,proto3" json:"base_reward,omitempty"`             // Base reward before penalty
+	Epoch          uint64 `protobuf:"varint,7,opt,name=epoch,proto3" json:"epoch,omitempty"`                                        // Reward epoch
+	StartHeight    uint64 `protobuf:"varint,8,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`         // First height of the epoch
 }
 
 func (x *RewardRecord) Reset() {
 	*x = RewardRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[16]
+		mi := &file_volnix_lizenz_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -868,7 +1094,7 @@ func (x *RewardRecord) String() string {
 func (*RewardRecord) ProtoMessage() {}
 
 func (x *RewardRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[16]
+	mi := &file_volnix_lizenz_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,7 +1107,7 @@ func (x *RewardRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RewardRecord.ProtoReflect.Descriptor instead.
 func (*RewardRecord) Descriptor() ([]byte, []int) {
-	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_volnix_lizenz_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RewardRecord) GetBlockHeight() uint64 {
@@ -926,6 +1152,20 @@ func (x *RewardRecord) GetBaseReward() string {
 	return ""
 }
 
+func (x *RewardRecord) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *RewardRecord) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
 var File_volnix_lizenz_v1_query_proto protoreflect.FileDescriptor
 
 var file_volnix_lizenz_v1_query_proto_rawDesc = []byte{
@@ -975,58 +1215,103 @@ var file_volnix_lizenz_v1_query_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
 	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
 	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a,
-	0x65, 0x6e, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x78, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a,
-	0x65, 0x6e, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x13, 0x64,
-	0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x7a, 0x65,
-	0x6e, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
-	0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x12,
-	0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65,
-	0x6e, 0x7a, 0x22, 0x35, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x4f, 0x41, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x54, 0x0a, 0x16, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4d, 0x4f, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x6d, 0x6f, 0x61, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
-	0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x4f, 0x41, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x6d, 0x6f, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x40, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0x80, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e,
-	0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c,
+	0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xff, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65,
+	0x6e, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x13, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x3e, 0x0a,
+	0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x47, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x61, 0x70, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x61, 0x70, 0x48, 0x65, 0x61, 0x64, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x3e,
+	0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x78,
+	0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x13, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x12, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x22, 0x35, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4d, 0x4f, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22,
-	0xa6, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x45, 0x0a, 0x0e,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69,
-	0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x37, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0xb5, 0x02, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x54, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x4f, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x6d, 0x6f, 0x61,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x4f, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x6d, 0x6f, 0x61, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x40, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x80, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a,
+	0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76,
+	0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x19, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xef,
+	0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x45, 0x0a, 0x0e, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a,
+	0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x7f, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xfe, 0x02, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72,
 	0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
 	0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f,
 	0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x6f,
@@ -1045,108 +1330,127 @@ var file_volnix_lizenz_v1_query_proto_rawDesc = []byte{
 	0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74,
 	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe5, 0x01, 0x0a, 0x0c, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a,
-	0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x61, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x61, 0x6c,
-	0x74, 0x79, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x32, 0x81, 0x0a, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x77, 0x0a, 0x06, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c,
-	0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x6f,
-	0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x6f, 0x6c,
-	0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x12, 0x2d, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
-	0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
+	0x61, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x9e, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x61,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x61, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x32, 0xae, 0x0b, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x77, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
 	0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12,
-	0x27, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x7b, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x12, 0x41, 0x6c, 0x6c,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x12,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76,
+	0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xa1, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x12, 0x2d, 0x2e, 0x76, 0x6f, 0x6c,
+	0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65,
+	0x6e, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x76, 0x6f, 0x6c, 0x6e,
+	0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e,
+	0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x29, 0x12, 0x27, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e,
+	0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x7b,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x12, 0x41,
+	0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e,
+	0x7a, 0x12, 0x30, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e,
+	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a,
+	0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
+	0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0xaa, 0x01, 0x0a, 0x14,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69,
+	0x7a, 0x65, 0x6e, 0x7a, 0x12, 0x32, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69,
+	0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e,
+	0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
+	0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c,
+	0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c,
+	0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0xad, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x12,
 	0x30, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x31, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e,
-	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76,
+	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x76,
 	0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0xad, 0x01, 0x0a, 0x12, 0x44, 0x65,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a,
-	0x12, 0x30, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65,
-	0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f,
-	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31,
-	0x2f, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0x90, 0x01, 0x0a, 0x09, 0x4d, 0x4f,
-	0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78,
-	0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4d, 0x4f, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x4f, 0x41, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2a, 0x12, 0x28, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65,
-	0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x61, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0xb2, 0x01, 0x0a,
-	0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c,
-	0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76, 0x6f, 0x6c, 0x6e,
-	0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f,
-	0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2b, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e,
-	0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a,
-	0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x6f, 0x6c, 0x6e,
-	0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x7b, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0x9b, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x76, 0x6f, 0x6c,
-	0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c,
+	0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0x90, 0x01, 0x0a, 0x09, 0x4d, 0x4f, 0x41,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e,
+	0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d,
+	0x4f, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x4f, 0x41, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2a, 0x12, 0x28, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e,
+	0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x61, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2f,
+	0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0xb2, 0x01, 0x0a, 0x14,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69,
+	0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
+	0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c,
+	0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d,
+	0x12, 0xa3, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2b, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c,
 	0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x76, 0x6f, 0x6c, 0x6e,
-	0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x7d, 0x42, 0x53, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
-	0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76,
-	0x31, 0x3b, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65,
+	0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
+	0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0x9b, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x76, 0x6f, 0x6c, 0x6e,
+	0x69, 0x78, 0x2e, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2e, 0x6c, 0x69,
+	0x7a, 0x65, 0x6e, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69,
+	0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x7d, 0x42, 0x53, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f,
+	0x76, 0x6f, 0x6c, 0x6e, 0x69, 0x78, 0x2f, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x2f, 0x76, 0x31,
+	0x3b, 0x6c, 0x69, 0x7a, 0x65, 0x6e, 0x7a, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1161,7 +1465,7 @@ func file_volnix_lizenz_v1_query_proto_rawDescGZIP() []byte {
 	return file_volnix_lizenz_v1_query_proto_rawDescData
 }
 
-var file_volnix_lizenz_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_volnix_lizenz_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_volnix_lizenz_v1_query_proto_goTypes = []interface{}{
 	(*QueryParamsRequest)(nil),                // 0: volnix.lizenz.v1.QueryParamsRequest
 	(*QueryParamsResponse)(nil),               // 1: volnix.lizenz.v1.QueryParamsResponse
@@ -1169,59 +1473,71 @@ var file_volnix_lizenz_v1_query_proto_goTypes = []interface{}{
 	(*QueryActivatedLizenzResponse)(nil),      // 3: volnix.lizenz.v1.QueryActivatedLizenzResponse
 	(*QueryAllActivatedLizenzRequest)(nil),    // 4: volnix.lizenz.v1.QueryAllActivatedLizenzRequest
 	(*QueryAllActivatedLizenzResponse)(nil),   // 5: volnix.lizenz.v1.QueryAllActivatedLizenzResponse
-	(*QueryDeactivatingLizenzRequest)(nil),    // 6: volnix.lizenz.v1.QueryDeactivatingLizenzRequest
-	(*QueryDeactivatingLizenzResponse)(nil),   // 7: volnix.lizenz.v1.QueryDeactivatingLizenzResponse
-	(*QueryMOAStatusRequest)(nil),             // 8: volnix.lizenz.v1.QueryMOAStatusRequest
-	(*QueryMOAStatusResponse)(nil),            // 9: volnix.lizenz.v1.QueryMOAStatusResponse
-	(*QueryValidatorIntegrationRequest)(nil),  // 10: volnix.lizenz.v1.QueryValidatorIntegrationRequest
-	(*QueryValidatorIntegrationResponse)(nil), // 11: volnix.lizenz.v1.QueryValidatorIntegrationResponse
-	(*QueryRewardHistoryRequest)(nil),         // 12: volnix.lizenz.v1.QueryRewardHistoryRequest
-	(*QueryRewardHistoryResponse)(nil),        // 13: volnix.lizenz.v1.QueryRewardHistoryResponse
-	(*QueryRewardStatsRequest)(nil),           // 14: volnix.lizenz.v1.QueryRewardStatsRequest
-	(*QueryRewardStatsResponse)(nil),          // 15: volnix.lizenz.v1.QueryRewardStatsResponse
-	(*RewardRecord)(nil),                      // 16: volnix.lizenz.v1.RewardRecord
-	(*Params)(nil),                            // 17: volnix.lizenz.v1.Params
-	(*ActivatedLizenz)(nil),                   // 18: volnix.lizenz.v1.ActivatedLizenz
-	(*query.PageRequest)(nil),                 // 19: cosmos.base.query.v1beta1.PageRequest
-	(*query.PageResponse)(nil),                // 20: cosmos.base.query.v1beta1.PageResponse
-	(*DeactivatingLizenz)(nil),                // 21: volnix.lizenz.v1.DeactivatingLizenz
-	(*MOAStatus)(nil),                         // 22: volnix.lizenz.v1.MOAStatus
-	(*ValidatorIntegration)(nil),              // 23: volnix.lizenz.v1.ValidatorIntegration
-	(*timestamppb.Timestamp)(nil),             // 24: google.protobuf.Timestamp
+	(*QueryTotalActivatedLizenzRequest)(nil),  // 6: volnix.lizenz.v1.QueryTotalActivatedLizenzRequest
+	(*QueryTotalActivatedLizenzResponse)(nil), // 7: volnix.lizenz.v1.QueryTotalActivatedLizenzResponse
+	(*ValidatorLizenzShare)(nil),              // 8: volnix.lizenz.v1.ValidatorLizenzShare
+	(*QueryDeactivatingLizenzRequest)(nil),    // 9: volnix.lizenz.v1.QueryDeactivatingLizenzRequest
+	(*QueryDeactivatingLizenzResponse)(nil),   // 10: volnix.lizenz.v1.QueryDeactivatingLizenzResponse
+	(*QueryMOAStatusRequest)(nil),             // 11: volnix.lizenz.v1.QueryMOAStatusRequest
+	(*QueryMOAStatusResponse)(nil),            // 12: volnix.lizenz.v1.QueryMOAStatusResponse
+	(*QueryValidatorIntegrationRequest)(nil),  // 13: volnix.lizenz.v1.QueryValidatorIntegrationRequest
+	(*QueryValidatorIntegrationResponse)(nil), // 14: volnix.lizenz.v1.QueryValidatorIntegrationResponse
+	(*QueryRewardHistoryRequest)(nil),         // 15: volnix.lizenz.v1.QueryRewardHistoryRequest
+	(*QueryRewardHistoryResponse)(nil),        // 16: volnix.lizenz.v1.QueryRewardHistoryResponse
+	(*QueryRewardStatsRequest)(nil),           // 17: volnix.lizenz.v1.QueryRewardStatsRequest
+	(*QueryRewardStatsResponse)(nil),          // 18: volnix.lizenz.v1.QueryRewardStatsResponse
+	(*RewardRecord)(nil),                      // 19: volnix.lizenz.v1.RewardRecord
+	(*Params)(nil),                            // 20: volnix.lizenz.v1.Params
+	(*ActivatedLizenz)(nil),                   // 21: volnix.lizenz.v1.ActivatedLizenz
+	(*query.PageRequest)(nil),                 // 22: cosmos.base.query.v1beta1.PageRequest
+	(*query.PageResponse)(nil),                // 23: cosmos.base.query.v1beta1.PageResponse
+	(*DeactivatingLizenz)(nil),                // 24: volnix.lizenz.v1.DeactivatingLizenz
+	(*MOAStatus)(nil),                         // 25: volnix.lizenz.v1.MOAStatus
+	(*ValidatorIntegration)(nil),              // 26: volnix.lizenz.v1.ValidatorIntegration
+	(*timestamppb.Timestamp)(nil),             // 27: google.protobuf.Timestamp
 }
 var file_volnix_lizenz_v1_query_proto_depIdxs = []int32{
-	17, // 0: volnix.lizenz.v1.QueryParamsResponse.params:type_name -> volnix.lizenz.v1.Params
-	18, // 1: volnix.lizenz.v1.QueryActivatedLizenzResponse.activated_lizenz:type_name -> volnix.lizenz.v1.ActivatedLizenz
-	19, // 2: volnix.lizenz.v1.QueryAllActivatedLizenzRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	18, // 3: volnix.lizenz.v1.QueryAllActivatedLizenzResponse.activated_lizenz:type_name -> volnix.lizenz.v1.ActivatedLizenz
-	20, // 4: volnix.lizenz.v1.QueryAllActivatedLizenzResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	21, // 5: volnix.lizenz.v1.QueryDeactivatingLizenzResponse.deactivating_lizenz:type_name -> volnix.lizenz.v1.DeactivatingLizenz
-	22, // 6: volnix.lizenz.v1.QueryMOAStatusResponse.moa_status:type_name -> volnix.lizenz.v1.MOAStatus
-	23, // 7: volnix.lizenz.v1.QueryValidatorIntegrationResponse.validator_integration:type_name -> volnix.lizenz.v1.ValidatorIntegration
-	16, // 8: volnix.lizenz.v1.QueryRewardHistoryResponse.reward_history:type_name -> volnix.lizenz.v1.RewardRecord
-	24, // 9: volnix.lizenz.v1.QueryRewardStatsResponse.last_reward_time:type_name -> google.protobuf.Timestamp
-	16, // 10: volnix.lizenz.v1.QueryRewardStatsResponse.reward_history:type_name -> volnix.lizenz.v1.RewardRecord
-	0,  // 11: volnix.lizenz.v1.Query.Params:input_type -> volnix.lizenz.v1.QueryParamsRequest
-	2,  // 12: volnix.lizenz.v1.Query.ActivatedLizenz:input_type -> volnix.lizenz.v1.QueryActivatedLizenzRequest
-	4,  // 13: volnix.lizenz.v1.Query.AllActivatedLizenz:input_type -> volnix.lizenz.v1.QueryAllActivatedLizenzRequest
-	6,  // 14: volnix.lizenz.v1.Query.DeactivatingLizenz:input_type -> volnix.lizenz.v1.QueryDeactivatingLizenzRequest
-	8,  // 15: volnix.lizenz.v1.Query.MOAStatus:input_type -> volnix.lizenz.v1.QueryMOAStatusRequest
-	10, // 16: volnix.lizenz.v1.Query.ValidatorIntegration:input_type -> volnix.lizenz.v1.QueryValidatorIntegrationRequest
-	12, // 17: volnix.lizenz.v1.Query.GetRewardHistory:input_type -> volnix.lizenz.v1.QueryRewardHistoryRequest
-	14, // 18: volnix.lizenz.v1.Query.GetRewardStats:input_type -> volnix.lizenz.v1.QueryRewardStatsRequest
-	1,  // 19: volnix.lizenz.v1.Query.Params:output_type -> volnix.lizenz.v1.QueryParamsResponse
-	3,  // 20: volnix.lizenz.v1.Query.ActivatedLizenz:output_type -> volnix.lizenz.v1.QueryActivatedLizenzResponse
-	5,  // 21: volnix.lizenz.v1.Query.AllActivatedLizenz:output_type -> volnix.lizenz.v1.QueryAllActivatedLizenzResponse
-	7,  // 22: volnix.lizenz.v1.Query.DeactivatingLizenz:output_type -> volnix.lizenz.v1.QueryDeactivatingLizenzResponse
-	9,  // 23: volnix.lizenz.v1.Query.MOAStatus:output_type -> volnix.lizenz.v1.QueryMOAStatusResponse
-	11, // 24: volnix.lizenz.v1.Query.ValidatorIntegration:output_type -> volnix.lizenz.v1.QueryValidatorIntegrationResponse
-	13, // 25: volnix.lizenz.v1.Query.GetRewardHistory:output_type -> volnix.lizenz.v1.QueryRewardHistoryResponse
-	15, // 26: volnix.lizenz.v1.Query.GetRewardStats:output_type -> volnix.lizenz.v1.QueryRewardStatsResponse
-	19, // [19:27] is the sub-list for method output_type
-	11, // [11:19] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	20, // 0: volnix.lizenz.v1.QueryParamsResponse.params:type_name -> volnix.lizenz.v1.Params
+	21, // 1: volnix.lizenz.v1.QueryActivatedLizenzResponse.activated_lizenz:type_name -> volnix.lizenz.v1.ActivatedLizenz
+	22, // 2: volnix.lizenz.v1.QueryAllActivatedLizenzRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	21, // 3: volnix.lizenz.v1.QueryAllActivatedLizenzResponse.activated_lizenz:type_name -> volnix.lizenz.v1.ActivatedLizenz
+	23, // 4: volnix.lizenz.v1.QueryAllActivatedLizenzResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	22, // 5: volnix.lizenz.v1.QueryTotalActivatedLizenzRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	8,  // 6: volnix.lizenz.v1.QueryTotalActivatedLizenzResponse.shares:type_name -> volnix.lizenz.v1.ValidatorLizenzShare
+	23, // 7: volnix.lizenz.v1.QueryTotalActivatedLizenzResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	24, // 8: volnix.lizenz.v1.QueryDeactivatingLizenzResponse.deactivating_lizenz:type_name -> volnix.lizenz.v1.DeactivatingLizenz
+	25, // 9: volnix.lizenz.v1.QueryMOAStatusResponse.moa_status:type_name -> volnix.lizenz.v1.MOAStatus
+	26, // 10: volnix.lizenz.v1.QueryValidatorIntegrationResponse.validator_integration:type_name -> volnix.lizenz.v1.ValidatorIntegration
+	22, // 11: volnix.lizenz.v1.QueryRewardHistoryRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	19, // 12: volnix.lizenz.v1.QueryRewardHistoryResponse.reward_history:type_name -> volnix.lizenz.v1.RewardRecord
+	23, // 13: volnix.lizenz.v1.QueryRewardHistoryResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	22, // 14: volnix.lizenz.v1.QueryRewardStatsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	27, // 15: volnix.lizenz.v1.QueryRewardStatsResponse.last_reward_time:type_name -> google.protobuf.Timestamp
+	19, // 16: volnix.lizenz.v1.QueryRewardStatsResponse.reward_history:type_name -> volnix.lizenz.v1.RewardRecord
+	23, // 17: volnix.lizenz.v1.QueryRewardStatsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 18: volnix.lizenz.v1.Query.Params:input_type -> volnix.lizenz.v1.QueryParamsRequest
+	2,  // 19: volnix.lizenz.v1.Query.ActivatedLizenz:input_type -> volnix.lizenz.v1.QueryActivatedLizenzRequest
+	4,  // 20: volnix.lizenz.v1.Query.AllActivatedLizenz:input_type -> volnix.lizenz.v1.QueryAllActivatedLizenzRequest
+	6,  // 21: volnix.lizenz.v1.Query.TotalActivatedLizenz:input_type -> volnix.lizenz.v1.QueryTotalActivatedLizenzRequest
+	9,  // 22: volnix.lizenz.v1.Query.DeactivatingLizenz:input_type -> volnix.lizenz.v1.QueryDeactivatingLizenzRequest
+	11, // 23: volnix.lizenz.v1.Query.MOAStatus:input_type -> volnix.lizenz.v1.QueryMOAStatusRequest
+	13, // 24: volnix.lizenz.v1.Query.ValidatorIntegration:input_type -> volnix.lizenz.v1.QueryValidatorIntegrationRequest
+	15, // 25: volnix.lizenz.v1.Query.GetRewardHistory:input_type -> volnix.lizenz.v1.QueryRewardHistoryRequest
+	17, // 26: volnix.lizenz.v1.Query.GetRewardStats:input_type -> volnix.lizenz.v1.QueryRewardStatsRequest
+	1,  // 27: volnix.lizenz.v1.Query.Params:output_type -> volnix.lizenz.v1.QueryParamsResponse
+	3,  // 28: volnix.lizenz.v1.Query.ActivatedLizenz:output_type -> volnix.lizenz.v1.QueryActivatedLizenzResponse
+	5,  // 29: volnix.lizenz.v1.Query.AllActivatedLizenz:output_type -> volnix.lizenz.v1.QueryAllActivatedLizenzResponse
+	7,  // 30: volnix.lizenz.v1.Query.TotalActivatedLizenz:output_type -> volnix.lizenz.v1.QueryTotalActivatedLizenzResponse
+	10, // 31: volnix.lizenz.v1.Query.DeactivatingLizenz:output_type -> volnix.lizenz.v1.QueryDeactivatingLizenzResponse
+	12, // 32: volnix.lizenz.v1.Query.MOAStatus:output_type -> volnix.lizenz.v1.QueryMOAStatusResponse
+	14, // 33: volnix.lizenz.v1.Query.ValidatorIntegration:output_type -> volnix.lizenz.v1.QueryValidatorIntegrationResponse
+	16, // 34: volnix.lizenz.v1.Query.GetRewardHistory:output_type -> volnix.lizenz.v1.QueryRewardHistoryResponse
+	18, // 35: volnix.lizenz.v1.Query.GetRewardStats:output_type -> volnix.lizenz.v1.QueryRewardStatsResponse
+	27, // [27:36] is the sub-list for method output_type
+	18, // [18:27] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_volnix_lizenz_v1_query_proto_init() }
@@ -1304,7 +1620,7 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			}
 		}
 		file_volnix_lizenz_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDeactivatingLizenzRequest); i {
+			switch v := v.(*QueryTotalActivatedLizenzRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1316,7 +1632,7 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			}
 		}
 		file_volnix_lizenz_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryDeactivatingLizenzResponse); i {
+			switch v := v.(*QueryTotalActivatedLizenzResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1328,7 +1644,7 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			}
 		}
 		file_volnix_lizenz_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryMOAStatusRequest); i {
+			switch v := v.(*ValidatorLizenzShare); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1340,7 +1656,7 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			}
 		}
 		file_volnix_lizenz_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryMOAStatusResponse); i {
+			switch v := v.(*QueryDeactivatingLizenzRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1352,7 +1668,7 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			}
 		}
 		file_volnix_lizenz_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorIntegrationRequest); i {
+			switch v := v.(*QueryDeactivatingLizenzResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1364,7 +1680,7 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			}
 		}
 		file_volnix_lizenz_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorIntegrationResponse); i {
+			switch v := v.(*QueryMOAStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1376,7 +1692,7 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			}
 		}
 		file_volnix_lizenz_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRewardHistoryRequest); i {
+			switch v := v.(*QueryMOAStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1388,7 +1704,7 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			}
 		}
 		file_volnix_lizenz_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRewardHistoryResponse); i {
+			switch v := v.(*QueryValidatorIntegrationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1400,7 +1716,7 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			}
 		}
 		file_volnix_lizenz_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRewardStatsRequest); i {
+			switch v := v.(*QueryValidatorIntegrationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1412,7 +1728,7 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			}
 		}
 		file_volnix_lizenz_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRewardStatsResponse); i {
+			switch v := v.(*QueryRewardHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1424,6 +1740,42 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			}
 		}
 		file_volnix_lizenz_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRewardHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_lizenz_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRewardStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_lizenz_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRewardStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_volnix_lizenz_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RewardRecord); i {
 			case 0:
 				return &v.state
@@ -1442,7 +1794,7 @@ func file_volnix_lizenz_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_volnix_lizenz_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
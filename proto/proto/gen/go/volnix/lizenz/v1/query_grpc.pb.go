@@ -22,6 +22,7 @@ const (
 	Query_Params_FullMethodName               = "/volnix.lizenz.v1.Query/Params"
 	Query_ActivatedLizenz_FullMethodName      = "/volnix.lizenz.v1.Query/ActivatedLizenz"
 	Query_AllActivatedLizenz_FullMethodName   = "/volnix.lizenz.v1.Query/AllActivatedLizenz"
+	Query_TotalActivatedLizenz_FullMethodName = "/volnix.lizenz.v1.Query/TotalActivatedLizenz"
 	Query_DeactivatingLizenz_FullMethodName   = "/volnix.lizenz.v1.Query/DeactivatingLizenz"
 	Query_MOAStatus_FullMethodName            = "/volnix.lizenz.v1.Query/MOAStatus"
 	Query_ValidatorIntegration_FullMethodName = "/volnix.lizenz.v1.Query/ValidatorIntegration"
@@ -39,6 +40,8 @@ type QueryClient interface {
 	ActivatedLizenz(ctx context.Context, in *QueryActivatedLizenzRequest, opts ...grpc.CallOption) (*QueryActivatedLizenzResponse, error)
 	// AllActivatedLizenz queries all activated LZN licenses
 	AllActivatedLizenz(ctx context.Context, in *QueryAllActivatedLizenzRequest, opts ...grpc.CallOption) (*QueryAllActivatedLizenzResponse, error)
+	// TotalActivatedLizenz queries the activated LZN pool and the share of each validator in it
+	TotalActivatedLizenz(ctx context.Context, in *QueryTotalActivatedLizenzRequest, opts ...grpc.CallOption) (*QueryTotalActivatedLizenzResponse, error)
 	// DeactivatingLizenz queries deactivating LZN for a validator
 	DeactivatingLizenz(ctx context.Context, in *QueryDeactivatingLizenzRequest, opts ...grpc.CallOption) (*QueryDeactivatingLizenzResponse, error)
 	// MOAStatus queries MOA status for a validator
@@ -86,6 +89,15 @@ func (c *queryClient) AllActivatedLizenz(ctx context.Context, in *QueryAllActiva
 	return out, nil
 }
 
+func (c *queryClient) TotalActivatedLizenz(ctx context.Context, in *QueryTotalActivatedLizenzRequest, opts ...grpc.CallOption) (*QueryTotalActivatedLizenzResponse, error) {
+	out := new(QueryTotalActivatedLizenzResponse)
+	err := c.cc.Invoke(ctx, Query_TotalActivatedLizenz_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DeactivatingLizenz(ctx context.Context, in *QueryDeactivatingLizenzRequest, opts ...grpc.CallOption) (*QueryDeactivatingLizenzResponse, error) {
 	out := new(QueryDeactivatingLizenzResponse)
 	err := c.cc.Invoke(ctx, Query_DeactivatingLizenz_FullMethodName, in, out, opts...)
@@ -141,6 +153,8 @@ type QueryServer interface {
 	ActivatedLizenz(context.Context, *QueryActivatedLizenzRequest) (*QueryActivatedLizenzResponse, error)
 	// AllActivatedLizenz queries all activated LZN licenses
 	AllActivatedLizenz(context.Context, *QueryAllActivatedLizenzRequest) (*QueryAllActivatedLizenzResponse, error)
+	// TotalActivatedLizenz queries the activated LZN pool and the share of each validator in it
+	TotalActivatedLizenz(context.Context, *QueryTotalActivatedLizenzRequest) (*QueryTotalActivatedLizenzResponse, error)
 	// DeactivatingLizenz queries deactivating LZN for a validator
 	DeactivatingLizenz(context.Context, *QueryDeactivatingLizenzRequest) (*QueryDeactivatingLizenzResponse, error)
 	// MOAStatus queries MOA status for a validator
@@ -167,6 +181,9 @@ func (UnimplementedQueryServer) ActivatedLizenz(context.Context, *QueryActivated
 func (UnimplementedQueryServer) AllActivatedLizenz(context.Context, *QueryAllActivatedLizenzRequest) (*QueryAllActivatedLizenzResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllActivatedLizenz not implemented")
 }
+func (UnimplementedQueryServer) TotalActivatedLizenz(context.Context, *QueryTotalActivatedLizenzRequest) (*QueryTotalActivatedLizenzResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalActivatedLizenz not implemented")
+}
 func (UnimplementedQueryServer) DeactivatingLizenz(context.Context, *QueryDeactivatingLizenzRequest) (*QueryDeactivatingLizenzResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivatingLizenz not implemented")
 }
@@ -249,6 +266,24 @@ func _Query_AllActivatedLizenz_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TotalActivatedLizenz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalActivatedLizenzRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalActivatedLizenz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalActivatedLizenz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalActivatedLizenz(ctx, req.(*QueryTotalActivatedLizenzRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DeactivatingLizenz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDeactivatingLizenzRequest)
 	if err := dec(in); err != nil {
@@ -358,6 +393,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllActivatedLizenz",
 			Handler:    _Query_AllActivatedLizenz_Handler,
 		},
+		{
+			MethodName: "TotalActivatedLizenz",
+			Handler:    _Query_TotalActivatedLizenz_Handler,
+		},
 		{
 			MethodName: "DeactivatingLizenz",
 			Handler:    _Query_DeactivatingLizenz_Handler,
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Msg_ActivateLZN_FullMethodName   = "/volnix.lizenz.v1.Msg/ActivateLZN"
 	Msg_DeactivateLZN_FullMethodName = "/volnix.lizenz.v1.Msg/DeactivateLZN"
+	Msg_UpdateParams_FullMethodName  = "/volnix.lizenz.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +30,8 @@ const (
 type MsgClient interface {
 	ActivateLZN(ctx context.Context, in *MsgActivateLZN, opts ...grpc.CallOption) (*MsgActivateLZNResponse, error)
 	DeactivateLZN(ctx context.Context, in *MsgDeactivateLZN, opts ...grpc.CallOption) (*MsgDeactivateLZNResponse, error)
+	// UpdateParams updates the module parameters; the authority must be the governance module address
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -57,12 +60,23 @@ func (c *msgClient) DeactivateLZN(ctx context.Context, in *MsgDeactivateLZN, opt
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	ActivateLZN(context.Context, *MsgActivateLZN) (*MsgActivateLZNResponse, error)
 	DeactivateLZN(context.Context, *MsgDeactivateLZN) (*MsgDeactivateLZNResponse, error)
+	// UpdateParams updates the module parameters; the authority must be the governance module address
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -76,6 +90,9 @@ func (UnimplementedMsgServer) ActivateLZN(context.Context, *MsgActivateLZN) (*Ms
 func (UnimplementedMsgServer) DeactivateLZN(context.Context, *MsgDeactivateLZN) (*MsgDeactivateLZNResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateLZN not implemented")
 }
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -125,6 +142,24 @@ func _Msg_DeactivateLZN_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -140,6 +175,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateLZN",
 			Handler:    _Msg_DeactivateLZN_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "volnix/lizenz/v1/tx.proto",
@@ -45,7 +45,7 @@ func (suite *GRPCGatewayTestSuite) SetupTest() {
 	suite.mux = http.NewServeMux()
 
 	// Register REST endpoints that simulate gRPC Gateway behavior
-	// In production, BaseApp handles this automatically from proto annotations
+	// In production, the modules register them in RegisterGRPCGatewayRoutes
 	suite.registerGatewayRoutes()
 
 	// Create HTTP test server
@@ -59,7 +59,7 @@ func (suite *GRPCGatewayTestSuite) TearDownTest() {
 }
 
 // registerGatewayRoutes manually registers REST endpoints for testing
-// In production, BaseApp automatically registers routes from proto annotations
+// In production, the modules register the generated gateway handlers in RegisterGRPCGatewayRoutes
 func (suite *GRPCGatewayTestSuite) registerGatewayRoutes() {
 	// Consensus module routes
 	suite.mux.HandleFunc("/volnix/consensus/v1/params", suite.handleConsensusParams)
@@ -0,0 +1,70 @@
+// Package simtx delivers the transactions of module simulation operations under the ident fee policy
+package simtx
+
+import (
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	identtypes "github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+// IdentKeeper is the part of the ident keeper operations read identities, roles and fee prices from
+type IdentKeeper interface {
+	GetVerifiedAccount(ctx sdk.Context, address string) (*identv1.VerifiedAccount, error)
+	GetParams(ctx sdk.Context) identtypes.Params
+	GetFeeRole(ctx sdk.Context, address string) identv1.Role
+	GetFreeTxUsage(ctx sdk.Context, address string) (*identv1.FreeTxUsage, error)
+}
+
+// RandomAccountWithRole returns a random simulation account whose fee role is role
+// Guests are accounts without an active verified identity
+func RandomAccountWithRole(r *rand.Rand, ctx sdk.Context, ik IdentKeeper, accs []simtypes.Account, role identv1.Role) (simtypes.Account, bool) {
+	var candidates []simtypes.Account
+	for _, acc := range accs {
+		if ik.GetFeeRole(ctx, acc.Address.String()) == role {
+			candidates = append(candidates, acc)
+		}
+	}
+	if len(candidates) == 0 {
+		return simtypes.Account{}, false
+	}
+	return candidates[r.Intn(len(candidates))], true
+}
+
+// Fee returns the fee the ante handler requires from payer for a transaction with the simulation gas limit
+// Citizens with free transactions left pay nothing every other time, if the simulation gas limit is within MaxFreeTxGas
+func Fee(r *rand.Rand, ctx sdk.Context, ik IdentKeeper, payer sdk.AccAddress) sdk.Coins {
+	params := ik.GetParams(ctx)
+	role := ik.GetFeeRole(ctx, payer.String())
+	if role == identv1.Role_ROLE_CITIZEN && params.CitizenDailyFreeTxs > 0 &&
+		simtestutil.DefaultGenTxGas <= params.MaxFreeTxGas && r.Intn(2) == 0 {
+		usage, err := ik.GetFreeTxUsage(ctx, payer.String())
+		if err == nil && usage.Count < params.CitizenDailyFreeTxs {
+			return sdk.Coins{}
+		}
+	}
+
+	gas := sdkmath.LegacyNewDec(int64(simtestutil.DefaultGenTxGas))
+	fee := sdk.Coins{}
+	for _, gp := range params.MinGasPrices(role) {
+		fee = fee.Add(sdk.NewCoin(gp.Denom, gp.Amount.Mul(gas).Ceil().RoundInt()))
+	}
+	return fee
+}
+
+// GenAndDeliverTx signs the operation's message with the minimum fee of the signer's role and delivers it
+// It returns a no-op when the signer cannot pay the fee and the coins the message spends
+func GenAndDeliverTx(txCtx simulation.OperationInput, ik IdentKeeper) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	fee := Fee(txCtx.R, txCtx.Context, ik, txCtx.SimAccount.Address)
+	spendable := txCtx.Bankkeeper.SpendableCoins(txCtx.Context, txCtx.SimAccount.Address)
+	if _, hasNeg := spendable.SafeSub(txCtx.CoinsSpentInMsg.Add(fee...)...); hasNeg {
+		return simtypes.NoOpMsg(txCtx.ModuleName, sdk.MsgTypeURL(txCtx.Msg), "insufficient funds for fee"), nil, nil
+	}
+	return simulation.GenAndDeliverTx(txCtx, fee)
+}
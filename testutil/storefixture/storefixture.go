@@ -0,0 +1,91 @@
+// Package storefixture loads and compares module store snapshots for store migration tests
+package storefixture
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"os"
+	"testing"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the expected store fixtures")
+
+// Fixture is a snapshot of a module's state: the raw param values of its subspace,
+// keyed by param key, and the entries of its KV store, hex encoded
+type Fixture struct {
+	Params map[string]json.RawMessage `json:"params"`
+	Store  map[string]string          `json:"store"`
+}
+
+// Load writes the fixture at path into the module store and the module's params subspace
+func Load(t *testing.T, ctx sdk.Context, storeKey, paramsKey storetypes.StoreKey, subspace, path string) {
+	t.Helper()
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var fixture Fixture
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+
+	paramStore := prefix.NewStore(ctx.KVStore(paramsKey), []byte(subspace+"/"))
+	for key, value := range fixture.Params {
+		// Amino stores compact JSON, the indentation of the fixture file is not part of the value
+		var compact bytes.Buffer
+		require.NoError(t, json.Compact(&compact, value))
+		paramStore.Set([]byte(key), compact.Bytes())
+	}
+
+	store := ctx.KVStore(storeKey)
+	for key, value := range fixture.Store {
+		k, err := hex.DecodeString(key)
+		require.NoError(t, err)
+		v, err := hex.DecodeString(value)
+		require.NoError(t, err)
+		store.Set(k, v)
+	}
+}
+
+// Dump returns the module store and the module's params subspace as a fixture
+func Dump(ctx sdk.Context, storeKey, paramsKey storetypes.StoreKey, subspace string) Fixture {
+	fixture := Fixture{Params: map[string]json.RawMessage{}, Store: map[string]string{}}
+
+	paramStore := prefix.NewStore(ctx.KVStore(paramsKey), []byte(subspace+"/"))
+	paramIterator := paramStore.Iterator(nil, nil)
+	for ; paramIterator.Valid(); paramIterator.Next() {
+		fixture.Params[string(paramIterator.Key())] = json.RawMessage(paramIterator.Value())
+	}
+	paramIterator.Close()
+
+	storeIterator := ctx.KVStore(storeKey).Iterator(nil, nil)
+	for ; storeIterator.Valid(); storeIterator.Next() {
+		fixture.Store[hex.EncodeToString(storeIterator.Key())] = hex.EncodeToString(storeIterator.Value())
+	}
+	storeIterator.Close()
+
+	return fixture
+}
+
+// RequireEqual checks the module state against the fixture at path
+// Running the tests with -update rewrites the fixture from the current state instead
+func RequireEqual(t *testing.T, ctx sdk.Context, storeKey, paramsKey storetypes.StoreKey, subspace, path string) {
+	t.Helper()
+
+	got, err := json.MarshalIndent(Dump(ctx, storeKey, paramsKey, subspace), "", "  ")
+	require.NoError(t, err)
+	got = append(got, '\n')
+
+	if *update {
+		require.NoError(t, os.WriteFile(path, got, 0o644))
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.JSONEq(t, string(want), string(got))
+}
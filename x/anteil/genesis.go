@@ -1,6 +1,8 @@
 package anteil
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/anteil/keeper"
@@ -30,28 +32,98 @@ func Validate(gen *anteilv1.GenesisState) error {
 	if err != nil {
 		return err
 	}
-	return p.Validate()
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	// These records are not stored by the anteil module; the order book is derived from the orders
+	if len(gen.MarketMakers) > 0 || len(gen.LiquidityPools) > 0 || len(gen.StakingRewards) > 0 {
+		return fmt.Errorf("market makers, liquidity pools and staking rewards are not stored by the anteil module")
+	}
+	if gen.OrderBook != nil && (len(gen.OrderBook.BuyOrders) > 0 || len(gen.OrderBook.SellOrders) > 0) {
+		return fmt.Errorf("order book is derived from the orders and cannot be imported")
+	}
+
+	orders := make(map[string]bool, len(gen.Orders))
+	for _, order := range gen.Orders {
+		if order == nil || order.OrderId == "" {
+			return fmt.Errorf("order without ID")
+		}
+		if orders[order.OrderId] {
+			return fmt.Errorf("duplicate order %s", order.OrderId)
+		}
+		orders[order.OrderId] = true
+	}
+
+	trades := make(map[string]bool, len(gen.Trades))
+	for _, trade := range gen.Trades {
+		if trade == nil || trade.TradeId == "" {
+			return fmt.Errorf("trade without ID")
+		}
+		if trades[trade.TradeId] {
+			return fmt.Errorf("duplicate trade %s", trade.TradeId)
+		}
+		trades[trade.TradeId] = true
+	}
+
+	positions := make(map[string]bool, len(gen.UserPositions))
+	for _, position := range gen.UserPositions {
+		if position == nil || position.Owner == "" {
+			return fmt.Errorf("user position without owner")
+		}
+		if positions[position.Owner] {
+			return fmt.Errorf("duplicate user position for %s", position.Owner)
+		}
+		positions[position.Owner] = true
+	}
+
+	auctions := make(map[string]bool, len(gen.Auctions))
+	for _, auction := range gen.Auctions {
+		if auction == nil || auction.AuctionId == "" {
+			return fmt.Errorf("auction without ID")
+		}
+		if auctions[auction.AuctionId] {
+			return fmt.Errorf("duplicate auction %s", auction.AuctionId)
+		}
+		auctions[auction.AuctionId] = true
+	}
+
+	bids := make(map[string]bool, len(gen.Bids))
+	for _, bid := range gen.Bids {
+		if bid == nil || bid.Bid == nil || bid.Bid.BidId == "" {
+			return fmt.Errorf("bid without ID")
+		}
+		if !auctions[bid.AuctionId] {
+			return fmt.Errorf("bid %s references unknown auction %s", bid.Bid.BidId, bid.AuctionId)
+		}
+		key := string(atypes.GetBidKey(bid.AuctionId, bid.Bid.BidId))
+		if bids[key] {
+			return fmt.Errorf("duplicate bid %s in auction %s", bid.Bid.BidId, bid.AuctionId)
+		}
+		bids[key] = true
+	}
+
+	bonds := make(map[string]bool, len(gen.AntBonds))
+	for _, bond := range gen.AntBonds {
+		if bond == nil || bond.Owner == "" {
+			return fmt.Errorf("ANT bond without owner")
+		}
+		if bonds[bond.Owner] {
+			return fmt.Errorf("duplicate ANT bond for %s", bond.Owner)
+		}
+		bonds[bond.Owner] = true
+	}
+
+	return nil
 }
 
 func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState *anteilv1.GenesisState) {
 	if genState == nil {
 		genState = DefaultGenesis()
 	}
-	p, _ := atypes.ParamsFromProto(genState.Params)
-	k.SetParams(ctx, p)
+	k.InitGenesis(ctx, genState)
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *anteilv1.GenesisState {
-	params := k.GetParams(ctx)
-	return &anteilv1.GenesisState{
-		Params:           params.ToProto(),
-		Orders:           []*anteilv1.Order{},
-		Trades:           []*anteilv1.Trade{},
-		UserPositions:    []*anteilv1.UserPosition{},
-		Auctions:         []*anteilv1.Auction{},
-		OrderBook:        &anteilv1.OrderBook{},
-		MarketMakers:     []*anteilv1.MarketMaker{},
-		LiquidityPools:   []*anteilv1.LiquidityPool{},
-		StakingRewards:   []*anteilv1.StakingReward{},
-	}
+	return k.ExportGenesis(ctx)
 }
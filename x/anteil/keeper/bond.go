@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// ============================================================================
+// Commit Bond Escrow
+// ============================================================================
+//
+// Blind-auction commit bonds leave the owner's balance and are held in an escrow
+// record until the consensus module releases them on reveal or burns them when
+// the commit is never revealed.
+
+// GetAntBond returns the ANT an owner holds in escrow as commit bonds
+func (k Keeper) GetAntBond(ctx sdk.Context, owner string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(anteiltypes.GetAntBondKey(owner))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setAntBond sets the ANT an owner holds in escrow, an empty escrow is removed
+func (k Keeper) setAntBond(ctx sdk.Context, owner string, amount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if amount == 0 {
+		store.Delete(anteiltypes.GetAntBondKey(owner))
+		return
+	}
+	store.Set(anteiltypes.GetAntBondKey(owner), sdk.Uint64ToBigEndian(amount))
+}
+
+// GetAllAntBonds returns the escrowed ANT of every owner, in owner order
+func (k Keeper) GetAllAntBonds(ctx sdk.Context) []*anteilv1.AntBond {
+	bondStore := prefix.NewStore(ctx.KVStore(k.storeKey), anteiltypes.AntBondKeyPrefix)
+	iterator := bondStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var bonds []*anteilv1.AntBond
+	for ; iterator.Valid(); iterator.Next() {
+		bonds = append(bonds, &anteilv1.AntBond{
+			Owner:  string(iterator.Key()),
+			Amount: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return bonds
+}
+
+// LockAntBond moves ANT from the owner's balance into escrow
+func (k Keeper) LockAntBond(ctx sdk.Context, owner string, amount uint64) error {
+	position, err := k.GetUserPosition(ctx, owner)
+	if err != nil {
+		return fmt.Errorf("%w: %s has no ANT position", anteiltypes.ErrInsufficientBalance, owner)
+	}
+
+	balance := anteiltypes.ParseUint64(position.AntBalance)
+	if balance < amount {
+		return fmt.Errorf("%w: have %d, need %d", anteiltypes.ErrInsufficientBalance, balance, amount)
+	}
+
+	if err := k.setPositionBalance(ctx, position, balance-amount); err != nil {
+		return err
+	}
+	k.setAntBond(ctx, owner, k.GetAntBond(ctx, owner)+amount)
+	return nil
+}
+
+// ReleaseAntBond returns ANT held in escrow to the owner's balance
+func (k Keeper) ReleaseAntBond(ctx sdk.Context, owner string, amount uint64) error {
+	bond := k.GetAntBond(ctx, owner)
+	if bond < amount {
+		return fmt.Errorf("%w: %s holds %d ANT in escrow, cannot release %d", anteiltypes.ErrInsufficientBalance, owner, bond, amount)
+	}
+
+	position, err := k.GetUserPosition(ctx, owner)
+	if err != nil {
+		position = anteiltypes.NewUserPositionAt(ctx.BlockTime(), owner, "0")
+	}
+
+	k.setAntBond(ctx, owner, bond-amount)
+	return k.setPositionBalance(ctx, position, anteiltypes.ParseUint64(position.AntBalance)+amount)
+}
+
+// BurnAntBond burns ANT held in escrow
+func (k Keeper) BurnAntBond(ctx sdk.Context, owner string, amount uint64) error {
+	bond := k.GetAntBond(ctx, owner)
+	if bond < amount {
+		return fmt.Errorf("%w: %s holds %d ANT in escrow, cannot burn %d", anteiltypes.ErrInsufficientBalance, owner, bond, amount)
+	}
+
+	if err := k.burnAntSupply(ctx, amount); err != nil {
+		return err
+	}
+	k.setAntBond(ctx, owner, bond-amount)
+
+	ctx.Logger().Info("ANT bond burned", "owner", owner, "amount", amount)
+	return nil
+}
+
+// setPositionBalance writes a new ANT balance to a position, keeping its other fields
+func (k Keeper) setPositionBalance(ctx sdk.Context, position *anteilv1.UserPosition, balance uint64) error {
+	position.AntBalance = strconv.FormatUint(balance, 10)
+	position.AvailableAnt = position.AntBalance
+	position.LastActivity = timestamppb.New(ctx.BlockTime())
+	return k.SetUserPosition(ctx, position)
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// Test bonds move between the balance and escrow and burned bonds leave escrow
+func (suite *KeeperTestSuite) TestAntBond_LockReleaseBurn() {
+	owner := "volnix1validator"
+	require.NoError(suite.T(), suite.keeper.SetUserPosition(suite.ctx, types.NewUserPosition(owner, "1000")))
+	suite.keeper.SetAntSupply(suite.ctx, 1000)
+
+	require.NoError(suite.T(), suite.keeper.LockAntBond(suite.ctx, owner, 300))
+	require.NoError(suite.T(), suite.keeper.LockAntBond(suite.ctx, owner, 200))
+	require.Equal(suite.T(), uint64(500), suite.keeper.GetAntBond(suite.ctx, owner))
+	position, err := suite.keeper.GetUserPosition(suite.ctx, owner)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "500", position.AntBalance)
+
+	err = suite.keeper.LockAntBond(suite.ctx, owner, 501)
+	require.ErrorIs(suite.T(), err, types.ErrInsufficientBalance)
+
+	require.NoError(suite.T(), suite.keeper.ReleaseAntBond(suite.ctx, owner, 300))
+	require.NoError(suite.T(), suite.keeper.BurnAntBond(suite.ctx, owner, 200))
+	require.Equal(suite.T(), uint64(0), suite.keeper.GetAntBond(suite.ctx, owner))
+	require.Empty(suite.T(), suite.keeper.GetAllAntBonds(suite.ctx))
+	position, err = suite.keeper.GetUserPosition(suite.ctx, owner)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "800", position.AntBalance)
+	require.Equal(suite.T(), uint64(800), suite.keeper.GetAntSupply(suite.ctx))
+
+	err = suite.keeper.ReleaseAntBond(suite.ctx, owner, 1)
+	require.ErrorIs(suite.T(), err, types.ErrInsufficientBalance)
+	err = suite.keeper.BurnAntBond(suite.ctx, owner, 1)
+	require.ErrorIs(suite.T(), err, types.ErrInsufficientBalance)
+}
+
+// Test escrowed bonds round-trip through genesis
+func (suite *KeeperTestSuite) TestAntBond_Genesis() {
+	require.NoError(suite.T(), suite.keeper.SetUserPosition(suite.ctx, types.NewUserPosition("volnix1a", "100")))
+	require.NoError(suite.T(), suite.keeper.SetUserPosition(suite.ctx, types.NewUserPosition("volnix1b", "100")))
+	require.NoError(suite.T(), suite.keeper.LockAntBond(suite.ctx, "volnix1b", 40))
+	require.NoError(suite.T(), suite.keeper.LockAntBond(suite.ctx, "volnix1a", 10))
+
+	exported := suite.keeper.ExportGenesis(suite.ctx)
+	require.Equal(suite.T(), []*anteilv1.AntBond{
+		{Owner: "volnix1a", Amount: 10},
+		{Owner: "volnix1b", Amount: 40},
+	}, exported.AntBonds)
+
+	suite.SetupTest()
+	suite.keeper.InitGenesis(suite.ctx, exported)
+	require.Equal(suite.T(), uint64(10), suite.keeper.GetAntBond(suite.ctx, "volnix1a"))
+	require.Equal(suite.T(), uint64(40), suite.keeper.GetAntBond(suite.ctx, "volnix1b"))
+}
@@ -1,12 +1,16 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
 )
 
 // EconomicEngine handles advanced economic operations
@@ -86,8 +90,12 @@ func (ee *EconomicEngine) executeMatching(ctx sdk.Context, engine *MatchingEngin
 
 		// Check if orders can match
 		if buyPrice >= sellPrice {
-			// Execute trade
+			// Execute trade; a seller that cannot deliver the ANT is skipped
 			if err := ee.executeTrade(ctx, buyOrder, sellOrder); err != nil {
+				if errors.Is(err, anteiltypes.ErrInsufficientBalance) {
+					engine.sellOrders = engine.sellOrders[1:]
+					continue
+				}
 				return fmt.Errorf("failed to execute trade: %w", err)
 			}
 
@@ -130,6 +138,12 @@ func (ee *EconomicEngine) executeTrade(ctx sdk.Context, buyOrder, sellOrder *ant
 		AntAmount:   fmt.Sprintf("%.6f", tradeQty),
 		Price:       fmt.Sprintf("%.6f", tradePrice),
 		TotalValue:  fmt.Sprintf("%.6f", tradeQty*tradePrice),
+		ExecutedAt:  timestamppb.New(ctx.BlockTime()),
+	}
+
+	// Move the ANT first, so a seller without the ANT leaves the orders untouched
+	if err := ee.updateUserPositions(ctx, trade, buyOrder.Owner, sellOrder.Owner); err != nil {
+		return fmt.Errorf("failed to update user positions: %w", err)
 	}
 
 	// Update order quantities
@@ -155,11 +169,6 @@ func (ee *EconomicEngine) executeTrade(ctx sdk.Context, buyOrder, sellOrder *ant
 		return fmt.Errorf("failed to update sell order: %w", err)
 	}
 
-	// Update user positions
-	if err := ee.updateUserPositions(ctx, trade, buyOrder.Owner, sellOrder.Owner); err != nil {
-		return fmt.Errorf("failed to update user positions: %w", err)
-	}
-
 	// Store trade record
 	if err := ee.keeper.SetTrade(ctx, trade); err != nil {
 		return fmt.Errorf("failed to store trade: %w", err)
@@ -182,21 +191,11 @@ func (ee *EconomicEngine) executeTrade(ctx sdk.Context, buyOrder, sellOrder *ant
 	return nil
 }
 
-// updateUserPositions updates user ANT positions after trade
+// updateUserPositions moves the traded ANT from the seller to the buyer
 func (ee *EconomicEngine) updateUserPositions(ctx sdk.Context, trade *anteilv1.Trade, buyer, seller string) error {
 	tradeQty, _ := strconv.ParseFloat(trade.AntAmount, 64)
 
-	// Update buyer position (increase ANT)
-	if err := ee.keeper.UpdateUserPosition(ctx, buyer, fmt.Sprintf("%.6f", tradeQty), 1); err != nil {
-		return fmt.Errorf("failed to update buyer position: %w", err)
-	}
-
-	// Update seller position (decrease ANT) - use 0 for decrease operation
-	if err := ee.keeper.UpdateUserPosition(ctx, seller, fmt.Sprintf("%.6f", -tradeQty), 0); err != nil {
-		return fmt.Errorf("failed to update seller position: %w", err)
-	}
-
-	return nil
+	return ee.keeper.transferAnt(ctx, seller, buyer, uint64(tradeQty))
 }
 
 // ProcessAuctions processes auction settlements
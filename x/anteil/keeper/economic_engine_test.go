@@ -8,6 +8,7 @@ import (
 
 	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/anteil/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/anteil/types"
 )
 
 func (suite *KeeperTestSuite) TestNewEconomicEngine() {
@@ -55,6 +56,29 @@ func (suite *KeeperTestSuite) TestProcessOrderMatching() {
 	engine := keeper.NewEconomicEngine(suite.keeper)
 	err = engine.ProcessOrderMatching(suite.ctx)
 	require.NoError(suite.T(), err)
+
+	// The seller has no ANT to deliver, the orders stay open
+	order, err := suite.keeper.GetOrder(suite.ctx, "sell1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), anteilv1.OrderStatus_ORDER_STATUS_OPEN, order.Status)
+
+	// The traded ANT moves from the seller to the buyer, the supply is unchanged
+	require.NoError(suite.T(), suite.keeper.SetUserPosition(suite.ctx, types.NewUserPosition("cosmos1seller", "1500000")))
+	suite.keeper.SetAntSupply(suite.ctx, 1500000)
+	require.NoError(suite.T(), engine.ProcessOrderMatching(suite.ctx))
+
+	order, err = suite.keeper.GetOrder(suite.ctx, "sell1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), anteilv1.OrderStatus_ORDER_STATUS_FILLED, order.Status)
+	seller, err := suite.keeper.GetUserPosition(suite.ctx, "cosmos1seller")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "500000", seller.AntBalance)
+	buyer, err := suite.keeper.GetUserPosition(suite.ctx, "cosmos1buyer")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "1000000", buyer.AntBalance)
+
+	_, broken := keeper.AntSupplyInvariant(*suite.keeper)(suite.ctx)
+	require.False(suite.T(), broken)
 }
 
 func (suite *KeeperTestSuite) TestProcessAuctions_EconomicEngine() {
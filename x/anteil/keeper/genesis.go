@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// InitGenesis initializes the anteil state from a genesis state
+// The order book is derived from the stored orders and is not imported.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *anteilv1.GenesisState) {
+	params, err := anteiltypes.ParamsFromProto(genState.Params)
+	if err != nil {
+		panic(fmt.Errorf("invalid anteil params: %w", err))
+	}
+	k.SetParams(ctx, params)
+
+	store := ctx.KVStore(k.storeKey)
+
+	for _, order := range genState.Orders {
+		k.mustSetRecord(store, anteiltypes.GetOrderKey(order.OrderId), order)
+		store.Set(anteiltypes.GetOrderOwnerIndexKey(order.Owner, order.OrderId), []byte{})
+		setOpenOrderIndex(store, order)
+	}
+
+	for _, trade := range genState.Trades {
+		k.mustSetRecord(store, anteiltypes.GetTradeKey(trade.TradeId), trade)
+		setTradeTimeIndex(store, trade)
+		k.addTradeTotals(ctx, trade)
+	}
+
+	for _, position := range genState.UserPositions {
+		k.mustSetRecord(store, anteiltypes.GetUserPositionKey(position.Owner), position)
+	}
+
+	for _, auction := range genState.Auctions {
+		k.mustSetRecord(store, anteiltypes.GetAuctionKey(auction.AuctionId), auction)
+	}
+
+	for _, bid := range genState.Bids {
+		k.mustSetRecord(store, anteiltypes.GetBidKey(bid.AuctionId, bid.Bid.BidId), bid.Bid)
+	}
+
+	if genState.LastDistributionTime != nil {
+		if err := k.SetLastDistributionTime(ctx, genState.LastDistributionTime.AsTime()); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, bond := range genState.AntBonds {
+		k.setAntBond(ctx, bond.Owner, bond.Amount)
+	}
+
+	k.SetAntSupply(ctx, genState.AntSupply)
+}
+
+// ExportGenesis returns the anteil state as a genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *anteilv1.GenesisState {
+	params := k.GetParams(ctx)
+	genState := &anteilv1.GenesisState{
+		Params:         params.ToProto(),
+		Orders:         []*anteilv1.Order{},
+		Trades:         []*anteilv1.Trade{},
+		UserPositions:  []*anteilv1.UserPosition{},
+		Auctions:       []*anteilv1.Auction{},
+		OrderBook:      &anteilv1.OrderBook{},
+		MarketMakers:   []*anteilv1.MarketMaker{},
+		LiquidityPools: []*anteilv1.LiquidityPool{},
+		StakingRewards: []*anteilv1.StakingReward{},
+		Bids:           []*anteilv1.AuctionBid{},
+		AntSupply:      k.GetAntSupply(ctx),
+		AntBonds:       k.GetAllAntBonds(ctx),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, anteiltypes.OrderKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var order anteilv1.Order
+		k.mustUnmarshalRecord(iterator.Value(), &order)
+		genState.Orders = append(genState.Orders, &order)
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, anteiltypes.TradeKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var trade anteilv1.Trade
+		k.mustUnmarshalRecord(iterator.Value(), &trade)
+		genState.Trades = append(genState.Trades, &trade)
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, anteiltypes.UserPositionKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var position anteilv1.UserPosition
+		k.mustUnmarshalRecord(iterator.Value(), &position)
+		genState.UserPositions = append(genState.UserPositions, &position)
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, anteiltypes.AuctionKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var auction anteilv1.Auction
+		k.mustUnmarshalRecord(iterator.Value(), &auction)
+		genState.Auctions = append(genState.Auctions, &auction)
+	}
+	iterator.Close()
+
+	// Bid keys are the auction ID and the bid ID joined by an underscore
+	iterator = storetypes.KVStorePrefixIterator(store, anteiltypes.BidKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(anteiltypes.BidKeyPrefix):]
+		var bid anteilv1.Bid
+		k.mustUnmarshalRecord(iterator.Value(), &bid)
+		auctionLen := len(key) - len(bid.BidId) - 1
+		if auctionLen < 0 || string(key[auctionLen:]) != "_"+bid.BidId {
+			panic(fmt.Errorf("bid %s stored under malformed key %X", bid.BidId, key))
+		}
+		genState.Bids = append(genState.Bids, &anteilv1.AuctionBid{
+			AuctionId: string(key[:auctionLen]),
+			Bid:       &bid,
+		})
+	}
+	iterator.Close()
+
+	if store.Has(anteiltypes.LastDistributionTimeKey) {
+		lastTime, err := k.GetLastDistributionTime(ctx)
+		if err != nil {
+			panic(err)
+		}
+		genState.LastDistributionTime = timestamppb.New(lastTime)
+	}
+
+	return genState
+}
+
+func (k Keeper) mustSetRecord(store storetypes.KVStore, key []byte, record gogoproto.Message) {
+	bz, err := k.cdc.Marshal(record)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal genesis record: %w", err))
+	}
+	store.Set(key, bz)
+}
+
+func (k Keeper) mustUnmarshalRecord(bz []byte, record gogoproto.Message) {
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		panic(fmt.Errorf("failed to unmarshal genesis record: %w", err))
+	}
+}
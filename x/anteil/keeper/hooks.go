@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+)
+
+// LizenzHooks creates the ANT position of validators activating LZN
+type LizenzHooks struct {
+	k *Keeper
+}
+
+// LizenzHooks returns the lizenz hooks of the anteil keeper
+func (k *Keeper) LizenzHooks() LizenzHooks {
+	return LizenzHooks{k}
+}
+
+// AfterLizenzActivated creates an empty ANT position for the validator if it has none,
+// so it can take part in blind auctions
+func (h LizenzHooks) AfterLizenzActivated(ctx sdk.Context, validator string, amount string) error {
+	if _, err := h.k.GetUserPosition(ctx, validator); err == nil {
+		return nil
+	}
+
+	return h.k.SetUserPosition(ctx, &anteilv1.UserPosition{
+		Owner:        validator,
+		AntBalance:   "0",
+		LockedAnt:    "0",
+		AvailableAnt: "0",
+	})
+}
+
+// AfterDeactivationStarted keeps the ANT position: ANT belongs to the validator account, not to its LZN
+func (h LizenzHooks) AfterDeactivationStarted(ctx sdk.Context, validator string, amount string) error {
+	return nil
+}
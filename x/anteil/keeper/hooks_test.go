@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+)
+
+// Test LZN activation creates an empty ANT position and keeps an existing one
+func (suite *KeeperTestSuite) TestLizenzHooks_AfterLizenzActivated() {
+	hooks := suite.keeper.LizenzHooks()
+
+	require.NoError(suite.T(), hooks.AfterLizenzActivated(suite.ctx, "cosmos1validator", "1000000"))
+	position, err := suite.keeper.GetUserPosition(suite.ctx, "cosmos1validator")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "0", position.AntBalance)
+
+	require.NoError(suite.T(), suite.keeper.SetUserPosition(suite.ctx, &anteilv1.UserPosition{
+		Owner:      "cosmos1funded",
+		AntBalance: "5000",
+	}))
+	require.NoError(suite.T(), hooks.AfterLizenzActivated(suite.ctx, "cosmos1funded", "1000000"))
+	position, err = suite.keeper.GetUserPosition(suite.ctx, "cosmos1funded")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "5000", position.AntBalance)
+}
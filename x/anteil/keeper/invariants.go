@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// RegisterInvariants registers the anteil module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(anteiltypes.ModuleName, "ant-supply", AntSupplyInvariant(k))
+}
+
+// AntSupplyInvariant checks that the ANT balances of all user positions and the ANT held
+// in escrow as commit bonds add up to the minted ANT, net of burns
+func AntSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		positionStore := prefix.NewStore(ctx.KVStore(k.storeKey), anteiltypes.UserPositionKeyPrefix)
+		iterator := positionStore.Iterator(nil, nil)
+		defer iterator.Close()
+
+		var balances uint64
+		var count int
+		for ; iterator.Valid(); iterator.Next() {
+			var position anteilv1.UserPosition
+			if err := k.cdc.Unmarshal(iterator.Value(), &position); err != nil {
+				return sdk.FormatInvariant(anteiltypes.ModuleName, "ant-supply",
+					fmt.Sprintf("\tposition %s cannot be decoded: %v\n", iterator.Key(), err)), true
+			}
+			balances += anteiltypes.ParseUint64(position.AntBalance)
+			count++
+		}
+
+		var bonds uint64
+		for _, bond := range k.GetAllAntBonds(ctx) {
+			bonds += bond.Amount
+		}
+
+		supply := k.GetAntSupply(ctx)
+		return sdk.FormatInvariant(anteiltypes.ModuleName, "ant-supply", fmt.Sprintf(
+			"\tsum of %d position balances: %d\n\tANT in bond escrow: %d\n\tminted ANT: %d\n",
+			count, balances, bonds, supply)), balances+bonds != supply
+	}
+}
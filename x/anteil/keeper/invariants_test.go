@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/anteil/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// TestAntSupplyInvariant tests the minted ANT follows mints and burns and catches balances written around them
+func (suite *KeeperTestSuite) TestAntSupplyInvariant() {
+	invariant := keeper.AntSupplyInvariant(*suite.keeper)
+
+	require.NoError(suite.T(), suite.keeper.SetUserPosition(suite.ctx, types.NewUserPosition("volnix1alice", "500")))
+	require.NoError(suite.T(), suite.keeper.SetUserPosition(suite.ctx, types.NewUserPosition("volnix1bob", "300")))
+	suite.keeper.SetAntSupply(suite.ctx, 800)
+
+	// Bonds leave the balances but stay in the supply until they are burned
+	require.NoError(suite.T(), suite.keeper.LockAntBond(suite.ctx, "volnix1bob", 100))
+	require.NoError(suite.T(), suite.keeper.LockAntBond(suite.ctx, "volnix1bob", 100))
+	require.NoError(suite.T(), suite.keeper.ReleaseAntBond(suite.ctx, "volnix1bob", 100))
+	require.Equal(suite.T(), uint64(800), suite.keeper.GetAntSupply(suite.ctx))
+	require.NoError(suite.T(), suite.keeper.BurnAntBond(suite.ctx, "volnix1bob", 100))
+	require.NoError(suite.T(), suite.keeper.BurnAnt(suite.ctx, "volnix1bob", 50))
+	require.NoError(suite.T(), suite.keeper.BurnAntFromUser(suite.ctx, "volnix1alice"))
+	require.Equal(suite.T(), uint64(150), suite.keeper.GetAntSupply(suite.ctx))
+
+	_, broken := invariant(suite.ctx)
+	require.False(suite.T(), broken)
+
+	// Writing a balance is not a mint
+	require.NoError(suite.T(), suite.keeper.UpdateUserPosition(suite.ctx, "volnix1bob", "200", 0))
+
+	msg, broken := invariant(suite.ctx)
+	require.True(suite.T(), broken)
+	require.Contains(suite.T(), msg, "sum of 2 position balances: 200")
+	require.Contains(suite.T(), msg, "minted ANT: 150")
+
+	// A position written directly to the store mints ANT the supply does not know about
+	require.NoError(suite.T(), suite.keeper.UpdateUserPosition(suite.ctx, "volnix1bob", "150", 0))
+	bz, err := suite.cdc.Marshal(&anteilv1.UserPosition{Owner: "volnix1carol", AntBalance: "50"})
+	require.NoError(suite.T(), err)
+	suite.ctx.KVStore(suite.storeKey).Set(types.GetUserPositionKey("volnix1carol"), bz)
+
+	msg, broken = invariant(suite.ctx)
+	require.True(suite.T(), broken)
+	require.Contains(suite.T(), msg, "sum of 3 position balances: 200")
+	require.Contains(suite.T(), msg, "ANT in bond escrow: 0")
+	require.Contains(suite.T(), msg, "minted ANT: 150")
+}
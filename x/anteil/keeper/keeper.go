@@ -5,15 +5,19 @@ import (
 	"strconv"
 	"time"
 
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
 	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
 	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+	governancetypes "github.com/volnix-protocol/volnix-protocol/x/governance/types"
 )
 
 // IdentKeeperInterface defines the interface for interacting with ident module
@@ -28,6 +32,9 @@ type (
 		storeKey   storetypes.StoreKey
 		paramstore paramtypes.Subspace
 		identKeeper IdentKeeperInterface // Optional: for getting verified citizens
+
+		// authority is the governance module address allowed to update params
+		authority string
 	}
 )
 
@@ -45,9 +52,15 @@ func NewKeeper(
 		cdc:        cdc,
 		storeKey:   storeKey,
 		paramstore: ps,
+		authority:  authtypes.NewModuleAddress(governancetypes.ModuleName).String(),
 	}
 }
 
+// GetAuthority returns the address allowed to execute MsgUpdateParams
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetParams returns the current parameters for the anteil module
 func (k Keeper) GetParams(ctx sdk.Context) anteiltypes.Params {
 	var params anteiltypes.Params
@@ -88,6 +101,8 @@ func (k Keeper) SetOrder(ctx sdk.Context, order *anteilv1.Order) error {
 	}
 
 	store.Set(orderKey, orderBz)
+	store.Set(anteiltypes.GetOrderOwnerIndexKey(order.Owner, order.OrderId), []byte{})
+	setOpenOrderIndex(store, order)
 	return nil
 }
 
@@ -124,8 +139,9 @@ func (k Keeper) UpdateOrder(ctx sdk.Context, order *anteilv1.Order) error {
 	orderKey := anteiltypes.GetOrderKey(order.GetOrderId())
 
 	// Check if order exists
-	if !store.Has(orderKey) {
-		return anteiltypes.ErrOrderNotFound
+	stored, err := k.GetOrder(ctx, order.GetOrderId())
+	if err != nil {
+		return err
 	}
 
 	// Store the updated order
@@ -135,6 +151,12 @@ func (k Keeper) UpdateOrder(ctx sdk.Context, order *anteilv1.Order) error {
 	}
 
 	store.Set(orderKey, orderBz)
+	if stored.Owner != order.Owner {
+		store.Delete(anteiltypes.GetOrderOwnerIndexKey(stored.Owner, stored.OrderId))
+		store.Set(anteiltypes.GetOrderOwnerIndexKey(order.Owner, order.OrderId), []byte{})
+	}
+	deleteOpenOrderIndex(store, stored)
+	setOpenOrderIndex(store, order)
 	return nil
 }
 
@@ -152,14 +174,15 @@ func (k Keeper) CancelOrder(ctx sdk.Context, orderID string) error {
 
 // DeleteOrder removes an order from the store
 func (k Keeper) DeleteOrder(ctx sdk.Context, orderID string) error {
-	store := ctx.KVStore(k.storeKey)
-	orderKey := anteiltypes.GetOrderKey(orderID)
-
-	if !store.Has(orderKey) {
-		return anteiltypes.ErrOrderNotFound
+	order, err := k.GetOrder(ctx, orderID)
+	if err != nil {
+		return err
 	}
 
-	store.Delete(orderKey)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(anteiltypes.GetOrderKey(orderID))
+	store.Delete(anteiltypes.GetOrderOwnerIndexKey(order.Owner, orderID))
+	deleteOpenOrderIndex(store, order)
 	return nil
 }
 
@@ -189,6 +212,73 @@ func (k Keeper) GetAllOrders(ctx sdk.Context) ([]*anteilv1.Order, error) {
 	return orders, nil
 }
 
+// OrderFilter selects orders in GetOrdersPage; zero fields match every order
+type OrderFilter struct {
+	Owner  string
+	Side   anteilv1.OrderSide
+	Status anteilv1.OrderStatus
+}
+
+// Matches reports whether the order passes the filter
+func (f OrderFilter) Matches(order *anteilv1.Order) bool {
+	if f.Owner != "" && order.Owner != f.Owner {
+		return false
+	}
+	if f.Side != anteilv1.OrderSide_ORDER_SIDE_UNSPECIFIED && order.OrderSide != f.Side {
+		return false
+	}
+	if f.Status != anteilv1.OrderStatus_ORDER_STATUS_UNSPECIFIED && order.Status != f.Status {
+		return false
+	}
+	return true
+}
+
+// GetOrdersPage returns a page of the orders matching the filter, in order ID order
+// With an owner filter only the owner index of that owner is iterated
+func (k Keeper) GetOrdersPage(ctx sdk.Context, filter OrderFilter, pageReq *sdkquery.PageRequest) ([]*anteilv1.Order, *sdkquery.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	var orders []*anteilv1.Order
+	accumulateOrder := func(order *anteilv1.Order, accumulate bool) bool {
+		if !filter.Matches(order) {
+			return false
+		}
+		if accumulate {
+			orders = append(orders, order)
+		}
+		return true
+	}
+
+	var (
+		pageRes *sdkquery.PageResponse
+		err     error
+	)
+	if filter.Owner != "" {
+		// Owner index keys end with the order ID, so pages keep the order ID order
+		ownerStore := prefix.NewStore(store, anteiltypes.GetOrderOwnerPrefix(filter.Owner))
+		pageRes, err = sdkquery.FilteredPaginate(ownerStore, pageReq, func(orderID []byte, _ []byte, accumulate bool) (bool, error) {
+			order, err := k.GetOrder(ctx, string(orderID))
+			if err != nil {
+				return false, err
+			}
+			return accumulateOrder(order, accumulate), nil
+		})
+	} else {
+		pageRes, err = sdkquery.FilteredPaginate(anteiltypes.NewOrderStore(store), pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+			var order anteilv1.Order
+			if err := k.cdc.Unmarshal(value, &order); err != nil {
+				return false, fmt.Errorf("failed to unmarshal order: %w", err)
+			}
+			return accumulateOrder(&order, accumulate), nil
+		})
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return orders, pageRes, nil
+}
+
 // Trade Management Methods
 
 // executeTrade executes a trade between two orders
@@ -220,6 +310,7 @@ func (k Keeper) executeTrade(ctx sdk.Context, buyOrderID, sellOrderID string) er
 		Seller:      sellOrder.Owner,
 		Price:       buyOrder.Price, // Use buy order price
 		AntAmount:   buyOrder.AntAmount,
+		ExecutedAt:  timestamppb.New(ctx.BlockTime()),
 	}
 
 	// Store the trade
@@ -262,7 +353,7 @@ func (k Keeper) updateUserPositionForTrade(ctx sdk.Context, trade *anteilv1.Trad
 			AntBalance:   "0",
 			TotalTrades:  "0",
 			TotalVolume:  "0",
-			LastActivity: timestamppb.Now(),
+			LastActivity: timestamppb.New(ctx.BlockTime()),
 		}
 	}
 
@@ -273,7 +364,7 @@ func (k Keeper) updateUserPositionForTrade(ctx sdk.Context, trade *anteilv1.Trad
 
 	buyerPosition.TotalTrades = fmt.Sprintf("%d", buyerTrades+1)
 	buyerPosition.TotalVolume = fmt.Sprintf("%d", buyerVolume+tradeAmount)
-	buyerPosition.LastActivity = timestamppb.Now()
+	buyerPosition.LastActivity = timestamppb.New(ctx.BlockTime())
 
 	if err := k.SetUserPosition(ctx, buyerPosition); err != nil {
 		return err
@@ -288,7 +379,7 @@ func (k Keeper) updateUserPositionForTrade(ctx sdk.Context, trade *anteilv1.Trad
 			AntBalance:   "0",
 			TotalTrades:  "0",
 			TotalVolume:  "0",
-			LastActivity: timestamppb.Now(),
+			LastActivity: timestamppb.New(ctx.BlockTime()),
 		}
 	}
 
@@ -298,7 +389,7 @@ func (k Keeper) updateUserPositionForTrade(ctx sdk.Context, trade *anteilv1.Trad
 
 	sellerPosition.TotalTrades = fmt.Sprintf("%d", sellerTrades+1)
 	sellerPosition.TotalVolume = fmt.Sprintf("%d", sellerVolume+tradeAmount)
-	sellerPosition.LastActivity = timestamppb.Now()
+	sellerPosition.LastActivity = timestamppb.New(ctx.BlockTime())
 
 	if err := k.SetUserPosition(ctx, sellerPosition); err != nil {
 		return err
@@ -328,6 +419,8 @@ func (k Keeper) SetTrade(ctx sdk.Context, trade *anteilv1.Trade) error {
 	}
 
 	store.Set(tradeKey, tradeBz)
+	setTradeTimeIndex(store, trade)
+	k.addTradeTotals(ctx, trade)
 	return nil
 }
 
@@ -375,6 +468,26 @@ func (k Keeper) GetAllTrades(ctx sdk.Context) ([]*anteilv1.Trade, error) {
 	return trades, nil
 }
 
+// GetTradesPage returns a page of the trades, in trade ID order
+func (k Keeper) GetTradesPage(ctx sdk.Context, pageReq *sdkquery.PageRequest) ([]*anteilv1.Trade, *sdkquery.PageResponse, error) {
+	tradeStore := anteiltypes.NewTradeStore(ctx.KVStore(k.storeKey))
+
+	var trades []*anteilv1.Trade
+	pageRes, err := sdkquery.Paginate(tradeStore, pageReq, func(_ []byte, value []byte) error {
+		var trade anteilv1.Trade
+		if err := k.cdc.Unmarshal(value, &trade); err != nil {
+			return fmt.Errorf("failed to unmarshal trade: %w", err)
+		}
+		trades = append(trades, &trade)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return trades, pageRes, nil
+}
+
 // Auction Management Methods
 
 // SetAuction stores an auction in the store
@@ -474,6 +587,31 @@ func (k Keeper) GetAllAuctions(ctx sdk.Context) ([]*anteilv1.Auction, error) {
 	return auctions, nil
 }
 
+// GetAuctionsPage returns a page of the auctions with the given status (any status if unspecified), in auction ID order
+func (k Keeper) GetAuctionsPage(ctx sdk.Context, status anteilv1.AuctionStatus, pageReq *sdkquery.PageRequest) ([]*anteilv1.Auction, *sdkquery.PageResponse, error) {
+	auctionStore := anteiltypes.NewAuctionStore(ctx.KVStore(k.storeKey))
+
+	var auctions []*anteilv1.Auction
+	pageRes, err := sdkquery.FilteredPaginate(auctionStore, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var auction anteilv1.Auction
+		if err := k.cdc.Unmarshal(value, &auction); err != nil {
+			return false, fmt.Errorf("failed to unmarshal auction: %w", err)
+		}
+		if status != anteilv1.AuctionStatus_AUCTION_STATUS_UNSPECIFIED && auction.Status != status {
+			return false, nil
+		}
+		if accumulate {
+			auctions = append(auctions, &auction)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return auctions, pageRes, nil
+}
+
 // ProcessAuctions processes active auctions
 func (k Keeper) ProcessAuctions(ctx sdk.Context) error {
 	auctions, err := k.GetAllAuctions(ctx)
@@ -590,7 +728,7 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID string, bidder string, amoun
 		BidId:       fmt.Sprintf("%s_%s_%d", auctionID, bidder, ctx.BlockHeight()),
 		Bidder:      bidder,
 		Amount:      amount,
-		SubmittedAt: timestamppb.Now(),
+		SubmittedAt: timestamppb.New(ctx.BlockTime()),
 	}
 
 	// Store bid
@@ -690,6 +828,7 @@ func (k Keeper) GetUserPosition(ctx sdk.Context, user string) (*anteilv1.UserPos
 }
 
 // SetUserPosition sets user's position
+// Writing a position does not change the ANT supply, which only follows mints and burns
 func (k Keeper) SetUserPosition(ctx sdk.Context, position *anteilv1.UserPosition) error {
 	store := ctx.KVStore(k.storeKey)
 	positionKey := anteiltypes.GetUserPositionKey(position.Owner)
@@ -726,11 +865,15 @@ func (k Keeper) BurnAntFromUser(ctx sdk.Context, user string) error {
 		return nil
 	}
 
+	if err := k.burnAntSupply(ctx, currentBalance); err != nil {
+		return fmt.Errorf("failed to burn ANT: %w", err)
+	}
+
 	// Set balance to zero (burn all ANT)
 	position.AntBalance = "0"
 	position.AvailableAnt = "0"
 	position.LockedAnt = "0"
-	position.LastActivity = timestamppb.Now()
+	position.LastActivity = timestamppb.New(ctx.BlockTime())
 
 	// Update position
 	if err := k.SetUserPosition(ctx, position); err != nil {
@@ -743,24 +886,24 @@ func (k Keeper) BurnAntFromUser(ctx sdk.Context, user string) error {
 }
 
 // UpdateUserPosition updates user's position
+// The balance is written as given: it neither mints nor burns ANT
 func (k Keeper) UpdateUserPosition(ctx sdk.Context, user string, antBalance string, orderCount uint32) error {
 	position := &anteilv1.UserPosition{
 		Owner:        user,
 		AntBalance:   antBalance,
 		TotalTrades:  fmt.Sprintf("%d", orderCount),
-		LastActivity: timestamppb.Now(),
+		LastActivity: timestamppb.New(ctx.BlockTime()),
 	}
 
 	return k.SetUserPosition(ctx, position)
 }
 
-// GetOrdersByOwner retrieves all orders for a specific owner
+// GetOrdersByOwner retrieves all orders for a specific owner from the owner index
 func (k Keeper) GetOrdersByOwner(ctx sdk.Context, owner string) ([]*anteilv1.Order, error) {
-	store := ctx.KVStore(k.storeKey)
-	prefix := anteiltypes.GetOrderPrefix()
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), anteiltypes.GetOrderOwnerPrefix(owner))
 
 	var orders []*anteilv1.Order
-	iterator := store.Iterator(prefix, append(prefix, 0xFF))
+	iterator := ownerStore.Iterator(nil, nil)
 	defer func() {
 		if err := iterator.Close(); err != nil {
 			// Log error instead of panicking - iterator close failures are non-critical
@@ -770,13 +913,11 @@ func (k Keeper) GetOrdersByOwner(ctx sdk.Context, owner string) ([]*anteilv1.Ord
 	}()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var order anteilv1.Order
-		if err := k.cdc.Unmarshal(iterator.Value(), &order); err != nil {
+		order, err := k.GetOrder(ctx, string(iterator.Key()))
+		if err != nil {
 			continue
 		}
-		if order.Owner == owner {
-			orders = append(orders, &order)
-		}
+		orders = append(orders, order)
 	}
 
 	return orders, nil
@@ -814,7 +955,7 @@ func (k Keeper) DistributeAntToCitizens(ctx sdk.Context) error {
 	distributedCount := 0
 	
 	// OPTIMIZATION: Cache timestamp to avoid repeated allocations
-	now := timestamppb.Now()
+	now := timestamppb.New(ctx.BlockTime())
 	
 	// OPTIMIZATION: Get store once for batch operations
 	store := ctx.KVStore(k.storeKey)
@@ -836,11 +977,11 @@ func (k Keeper) DistributeAntToCitizens(ctx sdk.Context) error {
 			position = &anteilv1.UserPosition{}
 			if err := k.cdc.Unmarshal(positionBz, position); err != nil {
 				// If unmarshal fails, create new position
-				position = anteiltypes.NewUserPosition(account.Address, "0")
+				position = anteiltypes.NewUserPositionAt(ctx.BlockTime(), account.Address, "0")
 			}
 		} else {
 			// Create new position if not found
-			position = anteiltypes.NewUserPosition(account.Address, "0")
+			position = anteiltypes.NewUserPositionAt(ctx.BlockTime(), account.Address, "0")
 		}
 
 		// Check accumulation limit
@@ -873,6 +1014,7 @@ func (k Keeper) DistributeAntToCitizens(ctx sdk.Context) error {
 			continue
 		}
 		store.Set(positionKey, positionBz)
+		k.mintAntSupply(ctx, newBalance-currentBalance)
 
 		distributedCount++
 
@@ -912,9 +1054,10 @@ func (k Keeper) GetLastDistributionTime(ctx sdk.Context) (time.Time, error) {
 }
 
 // SetLastDistributionTime sets the last time ANT was distributed to citizens
+// The time is stored in UTC, so the encoding does not depend on the node's time zone
 func (k Keeper) SetLastDistributionTime(ctx sdk.Context, t time.Time) error {
 	store := ctx.KVStore(k.storeKey)
-	bz, err := t.MarshalBinary()
+	bz, err := t.UTC().MarshalBinary()
 	if err != nil {
 		return fmt.Errorf("failed to marshal distribution time: %w", err)
 	}
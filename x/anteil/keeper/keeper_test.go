@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	storetypes "cosmossdk.io/store/types"
@@ -262,6 +263,41 @@ func (suite *KeeperTestSuite) TestGetOrdersByOwner() {
 	require.Len(suite.T(), orders, 2)
 }
 
+func (suite *KeeperTestSuite) TestOrderOwnerIndex() {
+	order := &anteilv1.Order{
+		OrderId:      "order_1",
+		Owner:        "cosmos1alice",
+		OrderType:    anteilv1.OrderType_ORDER_TYPE_LIMIT,
+		OrderSide:    anteilv1.OrderSide_ORDER_SIDE_BUY,
+		AntAmount:    "1000000",
+		Price:        "1.5",
+		Status:       anteilv1.OrderStatus_ORDER_STATUS_OPEN,
+		CreatedAt:    timestamppb.Now(),
+		IdentityHash: "hash_alice",
+	}
+	require.NoError(suite.T(), suite.keeper.SetOrder(suite.ctx, order))
+
+	// An owner whose address extends another's does not see its orders
+	orders, err := suite.keeper.GetOrdersByOwner(suite.ctx, "cosmos1alicex")
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), orders)
+
+	// Changing the owner moves the index entry
+	order.Owner = "cosmos1bob"
+	require.NoError(suite.T(), suite.keeper.UpdateOrder(suite.ctx, order))
+	orders, err = suite.keeper.GetOrdersByOwner(suite.ctx, "cosmos1alice")
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), orders)
+	orders, err = suite.keeper.GetOrdersByOwner(suite.ctx, "cosmos1bob")
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), orders, 1)
+
+	// Deleting the order removes the index entry
+	require.NoError(suite.T(), suite.keeper.DeleteOrder(suite.ctx, "order_1"))
+	store := suite.ctx.KVStore(suite.storeKey)
+	require.False(suite.T(), store.Has(types.GetOrderOwnerIndexKey("cosmos1bob", "order_1")))
+}
+
 // Test Trade Management
 func (suite *KeeperTestSuite) TestExecuteTrade() {
 	buyOrder := &anteilv1.Order{
@@ -1286,6 +1322,7 @@ func (suite *KeeperTestSuite) TestDistributeAntToCitizens() {
 	position2, err := suite.keeper.GetUserPosition(suite.ctx, "cosmos1citizen2")
 	require.NoError(suite.T(), err)
 	require.Equal(suite.T(), "5000000", position2.AntBalance)
+	require.Equal(suite.T(), uint64(10000000), suite.keeper.GetAntSupply(suite.ctx))
 
 	// Check events
 	events := suite.ctx.EventManager().Events()
@@ -1435,6 +1472,7 @@ func (suite *KeeperTestSuite) TestBurnAntFromUser() {
 	position := types.NewUserPosition("cosmos1citizen1", "50000000") // 50 ANT
 	err := suite.keeper.SetUserPosition(suite.ctx, position)
 	require.NoError(suite.T(), err)
+	suite.keeper.SetAntSupply(suite.ctx, 50000000)
 
 	// Verify position exists with balance
 	position, err = suite.keeper.GetUserPosition(suite.ctx, "cosmos1citizen1")
@@ -1451,6 +1489,7 @@ func (suite *KeeperTestSuite) TestBurnAntFromUser() {
 	require.Equal(suite.T(), "0", position.AntBalance)
 	require.Equal(suite.T(), "0", position.AvailableAnt)
 	require.Equal(suite.T(), "0", position.LockedAnt)
+	require.Equal(suite.T(), uint64(0), suite.keeper.GetAntSupply(suite.ctx))
 }
 
 func (suite *KeeperTestSuite) TestBurnAntFromUser_NoPosition() {
@@ -1871,3 +1910,58 @@ func (suite *KeeperTestSuite) TestSetTrade_Duplicate() {
 	require.Error(suite.T(), err)
 	require.Equal(suite.T(), types.ErrTradeAlreadyExists, err)
 }
+
+// TestInitGenesis_ExportGenesis_RoundTrip exports every stored record type and imports it into a new store
+func (suite *KeeperTestSuite) TestInitGenesis_ExportGenesis_RoundTrip() {
+	require.NoError(suite.T(), suite.keeper.SetOrder(suite.ctx, &anteilv1.Order{
+		OrderId:      "order1",
+		Owner:        "cosmos1owner",
+		OrderType:    anteilv1.OrderType_ORDER_TYPE_LIMIT,
+		OrderSide:    anteilv1.OrderSide_ORDER_SIDE_BUY,
+		AntAmount:    "1000000",
+		Price:        "1.5",
+		Status:       anteilv1.OrderStatus_ORDER_STATUS_OPEN,
+		CreatedAt:    timestamppb.New(time.Unix(1700000000, 0)),
+		IdentityHash: "hash123",
+	}))
+	require.NoError(suite.T(), suite.keeper.SetTrade(suite.ctx, &anteilv1.Trade{
+		TradeId:     "trade1",
+		BuyOrderId:  "order1",
+		SellOrderId: "order2",
+		Buyer:       "cosmos1owner",
+		Seller:      "cosmos1seller",
+		AntAmount:   "500000",
+		Price:       "1.5",
+	}))
+	require.NoError(suite.T(), suite.keeper.SetUserPosition(suite.ctx, &anteilv1.UserPosition{
+		Owner:      "cosmos1owner",
+		AntBalance: "2000000",
+	}))
+	suite.keeper.SetAntSupply(suite.ctx, 2000000)
+	require.NoError(suite.T(), suite.keeper.SetAuction(suite.ctx, &anteilv1.Auction{
+		AuctionId:    "auction_1",
+		BlockHeight:  1000,
+		ReservePrice: "1000000",
+		AntAmount:    "1000000",
+		EndTime:      timestamppb.New(time.Unix(1900000000, 0)),
+		Status:       anteilv1.AuctionStatus_AUCTION_STATUS_OPEN,
+	}))
+	require.NoError(suite.T(), suite.keeper.PlaceBid(suite.ctx, "auction_1", "cosmos1bidder", "1500000"))
+	require.NoError(suite.T(), suite.keeper.SetLastDistributionTime(suite.ctx, time.Unix(1700000000, 0)))
+
+	exported := suite.keeper.ExportGenesis(suite.ctx)
+	require.Len(suite.T(), exported.Orders, 1)
+	require.Len(suite.T(), exported.Trades, 1)
+	require.Len(suite.T(), exported.UserPositions, 1)
+	require.Len(suite.T(), exported.Auctions, 1)
+	require.Len(suite.T(), exported.Bids, 1)
+	require.Equal(suite.T(), "auction_1", exported.Bids[0].AuctionId)
+	require.Equal(suite.T(), uint64(2000000), exported.AntSupply)
+	require.NotNil(suite.T(), exported.LastDistributionTime)
+
+	suite.SetupTest()
+	suite.keeper.InitGenesis(suite.ctx, exported)
+
+	reexported := suite.keeper.ExportGenesis(suite.ctx)
+	require.True(suite.T(), proto.Equal(exported, reexported), "genesis changed after import")
+}
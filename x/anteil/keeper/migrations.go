@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// Migrator migrates the anteil store between consensus versions
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 migrates the anteil store from consensus version 1 to 2
+// Version 2 tracks the minted ANT, indexes the stored orders by owner and the open orders
+// by side, and indexes the trades by execution time and counts them into the trade totals.
+// Version 1 kept no record of mints and burns nor any bond escrow, so the minted ANT
+// starts at the sum of the position balances and afterwards only follows mints and burns.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.indexOrders(ctx); err != nil {
+		return err
+	}
+	if err := m.indexTrades(ctx); err != nil {
+		return err
+	}
+
+	positionStore := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), anteiltypes.UserPositionKeyPrefix)
+	iterator := positionStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var supply uint64
+	for ; iterator.Valid(); iterator.Next() {
+		var position anteilv1.UserPosition
+		if err := m.keeper.cdc.Unmarshal(iterator.Value(), &position); err != nil {
+			return fmt.Errorf("failed to unmarshal user position: %w", err)
+		}
+		supply += anteiltypes.ParseUint64(position.AntBalance)
+	}
+
+	m.keeper.SetAntSupply(ctx, supply)
+	return nil
+}
+
+// indexOrders writes the owner index entry of every stored order and the open order index
+// entry of every order that rests on the book
+func (m Migrator) indexOrders(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	iterator := anteiltypes.NewOrderStore(store).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var order anteilv1.Order
+		if err := m.keeper.cdc.Unmarshal(iterator.Value(), &order); err != nil {
+			return fmt.Errorf("failed to unmarshal order: %w", err)
+		}
+		store.Set(anteiltypes.GetOrderOwnerIndexKey(order.Owner, order.OrderId), []byte{})
+		setOpenOrderIndex(store, &order)
+	}
+	return nil
+}
+
+// indexTrades writes the trade time index entry of every stored trade and counts it
+// into the trade totals
+func (m Migrator) indexTrades(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	iterator := anteiltypes.NewTradeStore(store).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var trade anteilv1.Trade
+		if err := m.keeper.cdc.Unmarshal(iterator.Value(), &trade); err != nil {
+			return fmt.Errorf("failed to unmarshal trade: %w", err)
+		}
+		setTradeTimeIndex(store, &trade)
+		m.keeper.addTradeTotals(ctx, &trade)
+	}
+	return nil
+}
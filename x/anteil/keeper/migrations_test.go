@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volnix-protocol/volnix-protocol/testutil/storefixture"
+	"github.com/volnix-protocol/volnix-protocol/x/anteil/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// TestMigrate1to2 migrates a store written by consensus version 1 and compares it with testdata/v2.json
+// The minted ANT starts at the sum of the position balances, the stored orders are indexed by owner
+// and by side while open, and the stored trades are indexed by execution time and counted
+func TestMigrate1to2(t *testing.T) {
+	keyModule := storetypes.NewKVStoreKey(types.StoreKey)
+	keyParams := storetypes.NewKVStoreKey(paramtypes.StoreKey)
+	tkeyParams := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{types.StoreKey: keyModule, paramtypes.StoreKey: keyParams},
+		map[string]*storetypes.TransientStoreKey{paramtypes.TStoreKey: tkeyParams},
+		nil,
+	)
+
+	cdc := codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	paramsKeeper := paramskeeper.NewKeeper(cdc, codec.NewLegacyAmino(), keyParams, tkeyParams)
+	k := keeper.NewKeeper(cdc, keyModule, paramsKeeper.Subspace(types.ModuleName))
+
+	storefixture.Load(t, ctx, keyModule, keyParams, types.ModuleName, "testdata/v1.json")
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+	storefixture.RequireEqual(t, ctx, keyModule, keyParams, types.ModuleName, "testdata/v2.json")
+	require.Equal(t, uint64(42500000), k.GetAntSupply(ctx))
+
+	orders, err := k.GetOrdersByOwner(ctx, "volnix1citizen")
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	require.Equal(t, "order_1", orders[0].OrderId)
+
+	book, err := k.GetOrderBook(ctx.WithBlockTime(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)), 0)
+	require.NoError(t, err)
+	require.Len(t, book.BuyOrders, 1)
+	require.Equal(t, "1.5", book.LastPrice)
+	require.Equal(t, "5000000", book.Volume_24H)
+
+	count, volume := k.GetTradeTotals(ctx)
+	require.Equal(t, uint64(1), count)
+	require.Equal(t, "5000000.000000000000000000", volume.String())
+}
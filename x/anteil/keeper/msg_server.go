@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/anteil/types"
@@ -38,7 +39,8 @@ func (s MsgServer) PlaceOrder(ctx context.Context, req *anteilv1.MsgPlaceOrder)
 	}
 
 	// Create order
-	order := types.NewOrder(
+	order := types.NewOrderAt(
+		sdkCtx.BlockTime(),
 		req.Owner,
 		req.OrderType,
 		req.OrderSide,
@@ -224,3 +226,26 @@ func (s MsgServer) ClaimRewards(ctx context.Context, req *anteilv1.MsgClaimRewar
 		TotalRewardsEarned: "1000",
 	}, nil
 }
+
+// UpdateParams replaces the module parameters; only the governance authority may execute it
+func (s MsgServer) UpdateParams(ctx context.Context, req *anteilv1.MsgUpdateParams) (*anteilv1.MsgUpdateParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != s.k.GetAuthority() {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", s.k.GetAuthority(), req.Authority)
+	}
+	if req.Params == nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, "params cannot be nil")
+	}
+
+	params, err := types.ParamsFromProto(req.Params)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	if err := params.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	s.k.SetParams(sdkCtx, params)
+	return &anteilv1.MsgUpdateParamsResponse{}, nil
+}
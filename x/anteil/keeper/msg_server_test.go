@@ -212,3 +212,35 @@ func (suite *MsgServerTestSuite) TestSettleAuction() {
 func TestMsgServerTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgServerTestSuite))
 }
+
+func (suite *MsgServerTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.MaxOpenOrders = 42
+
+	// Only the governance authority may update params
+	_, err := suite.msgServer.UpdateParams(suite.ctx, &anteilv1.MsgUpdateParams{
+		Authority: "cosmos1notauthority",
+		Params:    params.ToProto(),
+	})
+	require.ErrorIs(suite.T(), err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &anteilv1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+	})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidParams)
+
+	invalid := params
+	invalid.MaxOpenOrders = 0
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &anteilv1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    invalid.ToProto(),
+	})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidParams)
+
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &anteilv1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    params.ToProto(),
+	})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint32(42), suite.keeper.GetParams(suite.ctx).MaxOpenOrders)
+}
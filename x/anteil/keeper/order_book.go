@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// orderBookVolumeWindow is the trailing window summed into OrderBook.Volume_24H
+const orderBookVolumeWindow = 24 * time.Hour
+
+// IsOrderOpen reports whether the order still rests on the book
+func IsOrderOpen(order *anteilv1.Order) bool {
+	return order.Status == anteilv1.OrderStatus_ORDER_STATUS_OPEN ||
+		order.Status == anteilv1.OrderStatus_ORDER_STATUS_PARTIALLY_FILLED
+}
+
+// OpenOrderAmount returns the ANT amount of the order that is not filled yet
+func OpenOrderAmount(order *anteilv1.Order) uint64 {
+	if order.RemainingAmount != "" {
+		return anteiltypes.ParseAntAmount(order.RemainingAmount)
+	}
+	amount := anteiltypes.ParseAntAmount(order.AntAmount)
+	filled := anteiltypes.ParseAntAmount(order.FilledAmount)
+	if filled >= amount {
+		return 0
+	}
+	return amount - filled
+}
+
+// priceLevel accumulates the open orders resting at one price
+type priceLevel struct {
+	price      math.LegacyDec
+	amount     uint64
+	orderCount uint64
+}
+
+// GetOrderBook aggregates the open orders into price levels, best price first on each side.
+// depth bounds the number of levels returned per side; zero returns every level.
+// Only the orders in the open order index are read, so filled and cancelled orders cost nothing.
+func (k Keeper) GetOrderBook(ctx sdk.Context, depth uint32) (*anteilv1.OrderBook, error) {
+	buyLevels, buyOrders, buyAmount, err := k.openPriceLevels(ctx, anteilv1.OrderSide_ORDER_SIDE_BUY)
+	if err != nil {
+		return nil, err
+	}
+	sellLevels, sellOrders, sellAmount, err := k.openPriceLevels(ctx, anteilv1.OrderSide_ORDER_SIDE_SELL)
+	if err != nil {
+		return nil, err
+	}
+
+	bids := sortPriceLevels(buyLevels, true)
+	asks := sortPriceLevels(sellLevels, false)
+
+	book := &anteilv1.OrderBook{
+		BuyOrders:   priceLevelEntries(bids, depth),
+		SellOrders:  priceLevelEntries(asks, depth),
+		TotalOrders: buyOrders + sellOrders,
+		MarketDepth: fmt.Sprintf("%d", buyAmount+sellAmount),
+	}
+	if len(bids) > 0 && len(asks) > 0 {
+		book.BidAskSpread = asks[0].price.Sub(bids[0].price).String()
+	}
+
+	lastPrice, volume, err := k.recentTradeStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	book.LastPrice = lastPrice
+	book.Volume_24H = fmt.Sprintf("%d", volume)
+
+	return book, nil
+}
+
+// openPriceLevels aggregates the open orders of one side into price levels keyed by price
+// and returns them with the number of orders and the ANT amount they hold
+func (k Keeper) openPriceLevels(ctx sdk.Context, side anteilv1.OrderSide) (map[string]*priceLevel, uint64, uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, anteiltypes.GetOpenOrderSidePrefix(side))
+	defer iterator.Close()
+
+	prefixLen := len(anteiltypes.GetOpenOrderSidePrefix(side))
+	levels := make(map[string]*priceLevel)
+	var orderCount, totalAmount uint64
+	for ; iterator.Valid(); iterator.Next() {
+		order, err := k.GetOrder(ctx, string(iterator.Key()[prefixLen:]))
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		amount := OpenOrderAmount(order)
+		if amount == 0 {
+			continue
+		}
+		price, err := math.LegacyNewDecFromStr(order.Price)
+		if err != nil || !price.IsPositive() {
+			continue
+		}
+
+		// Key by the canonical decimal so "1.5" and "1.50" share a level
+		key := price.String()
+		level, ok := levels[key]
+		if !ok {
+			level = &priceLevel{price: price}
+			levels[key] = level
+		}
+		level.amount += amount
+		level.orderCount++
+		orderCount++
+		totalAmount += amount
+	}
+
+	return levels, orderCount, totalAmount, nil
+}
+
+// CountOpenOrders returns the number of open orders on one side of the book
+// Only the open order index keys are read, the orders are not decoded
+func (k Keeper) CountOpenOrders(ctx sdk.Context, side anteilv1.OrderSide) uint64 {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), anteiltypes.GetOpenOrderSidePrefix(side))
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// recentTradeStats returns the price of the latest trade and the ANT volume traded in the last 24 hours
+// Both are read from the trade time index: the latest entry for the price and the entries
+// from the start of the window for the volume.
+func (k Keeper) recentTradeStats(ctx sdk.Context) (string, uint64, error) {
+	lastPrice, err := k.lastTradePrice(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	windowStart := ctx.BlockTime().Add(-orderBookVolumeWindow)
+	iterator := ctx.KVStore(k.storeKey).Iterator(
+		anteiltypes.GetTradeTimePrefix(windowStart),
+		storetypes.PrefixEndBytes(anteiltypes.TradeTimeIndexKeyPrefix),
+	)
+	defer iterator.Close()
+
+	var volume uint64
+	for ; iterator.Valid(); iterator.Next() {
+		trade, err := k.tradeFromTimeIndex(ctx, iterator.Key())
+		if err != nil {
+			return "", 0, err
+		}
+		volume += anteiltypes.ParseAntAmount(trade.AntAmount)
+	}
+
+	return lastPrice, volume, nil
+}
+
+// lastTradePrice returns the price of the latest trade, empty when nothing was traded
+func (k Keeper) lastTradePrice(ctx sdk.Context) (string, error) {
+	iterator := storetypes.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), anteiltypes.TradeTimeIndexKeyPrefix)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return "", nil
+	}
+	trade, err := k.tradeFromTimeIndex(ctx, iterator.Key())
+	if err != nil {
+		return "", err
+	}
+	return trade.Price, nil
+}
+
+// tradeFromTimeIndex loads the trade a trade time index key points to
+func (k Keeper) tradeFromTimeIndex(ctx sdk.Context, key []byte) (*anteilv1.Trade, error) {
+	tradeID := key[len(anteiltypes.GetTradeTimePrefix(time.Time{})):]
+	return k.GetTrade(ctx, string(tradeID))
+}
+
+// setOpenOrderIndex writes the open order index entry of order while it rests on the book
+func setOpenOrderIndex(store storetypes.KVStore, order *anteilv1.Order) {
+	if IsOrderOpen(order) {
+		store.Set(anteiltypes.GetOpenOrderIndexKey(order.OrderSide, order.OrderId), []byte{})
+	}
+}
+
+// deleteOpenOrderIndex removes the open order index entry of order
+func deleteOpenOrderIndex(store storetypes.KVStore, order *anteilv1.Order) {
+	store.Delete(anteiltypes.GetOpenOrderIndexKey(order.OrderSide, order.OrderId))
+}
+
+// setTradeTimeIndex writes the trade time index entry of a trade that has an execution time
+func setTradeTimeIndex(store storetypes.KVStore, trade *anteilv1.Trade) {
+	if trade.ExecutedAt != nil {
+		store.Set(anteiltypes.GetTradeTimeIndexKey(trade.ExecutedAt.AsTime(), trade.TradeId), []byte{})
+	}
+}
+
+// sortPriceLevels orders the levels by price, highest first when descending
+func sortPriceLevels(levels map[string]*priceLevel, descending bool) []*priceLevel {
+	sorted := make([]*priceLevel, 0, len(levels))
+	for _, level := range levels {
+		sorted = append(sorted, level)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if descending {
+			return sorted[i].price.GT(sorted[j].price)
+		}
+		return sorted[i].price.LT(sorted[j].price)
+	})
+	return sorted
+}
+
+// priceLevelEntries converts the first depth levels into order book entries
+func priceLevelEntries(levels []*priceLevel, depth uint32) []*anteilv1.OrderBookEntry {
+	if depth > 0 && len(levels) > int(depth) {
+		levels = levels[:depth]
+	}
+	entries := make([]*anteilv1.OrderBookEntry, 0, len(levels))
+	for _, level := range levels {
+		entries = append(entries, &anteilv1.OrderBookEntry{
+			Price:       level.price.String(),
+			TotalAmount: fmt.Sprintf("%d", level.amount),
+			OrderCount:  level.orderCount,
+		})
+	}
+	return entries
+}
+
+// GetUserPositionSummary returns the stored position of owner together with its open order IDs
+// and the split of its balance into ANT locked by open sell orders and ANT still available.
+func (k Keeper) GetUserPositionSummary(ctx sdk.Context, owner string) (*anteilv1.UserPosition, error) {
+	position, err := k.GetUserPosition(ctx, owner)
+	if err != nil && !anteiltypes.ErrPositionNotFound.Is(err) {
+		return nil, err
+	}
+
+	orders, err := k.GetOrdersByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	openOrderIDs := make([]string, 0, len(orders))
+	var locked uint64
+	for _, order := range orders {
+		if !IsOrderOpen(order) {
+			continue
+		}
+		openOrderIDs = append(openOrderIDs, order.OrderId)
+		if order.OrderSide == anteilv1.OrderSide_ORDER_SIDE_SELL {
+			locked += OpenOrderAmount(order)
+		}
+	}
+
+	if position == nil {
+		if len(openOrderIDs) == 0 {
+			return nil, anteiltypes.ErrPositionNotFound
+		}
+		position = &anteilv1.UserPosition{
+			Owner:       owner,
+			AntBalance:  "0",
+			TotalTrades: "0",
+			TotalVolume: "0",
+		}
+	}
+
+	balance := anteiltypes.ParseUint64(position.AntBalance)
+	var available uint64
+	if balance > locked {
+		available = balance - locked
+	}
+
+	position.OpenOrderIds = openOrderIDs
+	position.LockedAnt = fmt.Sprintf("%d", locked)
+	position.AvailableAnt = fmt.Sprintf("%d", available)
+
+	return position, nil
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+
+	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
 )
 
 type QueryServer struct {
@@ -28,31 +31,82 @@ func (s QueryServer) Params(ctx context.Context, _ *anteilv1.QueryParamsRequest)
 	return &anteilv1.QueryParamsResponse{Json: string(bz)}, nil
 }
 
+// Order returns a single order by ID
+func (s QueryServer) Order(ctx context.Context, req *anteilv1.QueryOrderRequest) (*anteilv1.QueryOrderResponse, error) {
+	if req.GetOrderId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "order ID cannot be empty")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	order, err := s.k.GetOrder(sdkCtx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return &anteilv1.QueryOrderResponse{Order: order}, nil
+}
+
+// Orders returns a page of orders, filtered by owner, side and status
 func (s QueryServer) Orders(ctx context.Context, req *anteilv1.QueryOrdersRequest) (*anteilv1.QueryOrdersResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	var orders []*anteilv1.Order
-	var err error
-	if req.Owner != "" {
-		orders, err = s.k.GetOrdersByOwner(sdkCtx, req.Owner)
-	} else {
-		orders, err = s.k.GetAllOrders(sdkCtx)
+	filter := OrderFilter{Owner: req.GetOwner(), Side: req.GetSide(), Status: req.GetStatus()}
+	orders, pageRes, err := s.k.GetOrdersPage(sdkCtx, filter, req.GetPagination())
+	if err != nil {
+		return nil, err
 	}
+	return &anteilv1.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+// OrderBook returns the open orders aggregated into price levels, limited to depth levels per side
+func (s QueryServer) OrderBook(ctx context.Context, req *anteilv1.QueryOrderBookRequest) (*anteilv1.QueryOrderBookResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	book, err := s.k.GetOrderBook(sdkCtx, req.GetDepth())
 	if err != nil {
 		return nil, err
 	}
-	return &anteilv1.QueryOrdersResponse{Orders: orders, Pagination: nil}, nil
+	return &anteilv1.QueryOrderBookResponse{OrderBook: book}, nil
 }
 
+// UserPosition returns the position of an owner with its open orders and locked versus available ANT
+func (s QueryServer) UserPosition(ctx context.Context, req *anteilv1.QueryUserPositionRequest) (*anteilv1.QueryUserPositionResponse, error) {
+	if req.GetOwner() == "" {
+		return nil, anteiltypes.ErrEmptyOwner
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	position, err := s.k.GetUserPositionSummary(sdkCtx, req.Owner)
+	if err != nil {
+		return nil, err
+	}
+	return &anteilv1.QueryUserPositionResponse{Position: position}, nil
+}
+
+// Trades returns a page of trades
 func (s QueryServer) Trades(ctx context.Context, req *anteilv1.QueryTradesRequest) (*anteilv1.QueryTradesResponse, error) {
-	_ = sdkquery.PageRequest{}
-	return &anteilv1.QueryTradesResponse{Trades: nil, Pagination: nil}, nil
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	trades, pageRes, err := s.k.GetTradesPage(sdkCtx, req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	return &anteilv1.QueryTradesResponse{Trades: trades, Pagination: pageRes}, nil
+}
+
+// Auction returns a single auction by ID
+func (s QueryServer) Auction(ctx context.Context, req *anteilv1.QueryAuctionRequest) (*anteilv1.QueryAuctionResponse, error) {
+	if req.GetAuctionId() == "" {
+		return nil, anteiltypes.ErrEmptyAuctionID
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	auction, err := s.k.GetAuction(sdkCtx, req.AuctionId)
+	if err != nil {
+		return nil, err
+	}
+	return &anteilv1.QueryAuctionResponse{Auction: auction}, nil
 }
 
+// Auctions returns a page of auctions, filtered by status
 func (s QueryServer) Auctions(ctx context.Context, req *anteilv1.QueryAuctionsRequest) (*anteilv1.QueryAuctionsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	auctions, err := s.k.GetAllAuctions(sdkCtx)
+	auctions, pageRes, err := s.k.GetAuctionsPage(sdkCtx, req.GetStatus(), req.GetPagination())
 	if err != nil {
 		return nil, err
 	}
-	return &anteilv1.QueryAuctionsResponse{Auctions: auctions, Pagination: nil}, nil
+	return &anteilv1.QueryAuctionsResponse{Auctions: auctions, Pagination: pageRes}, nil
 }
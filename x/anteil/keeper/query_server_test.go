@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -12,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -73,6 +76,257 @@ func (suite *QueryServerTestSuite) TestOrders() {
 	}
 }
 
+func (suite *QueryServerTestSuite) TestOrders_FiltersAndPagination() {
+	orders := []*anteilv1.Order{
+		{OrderId: "order_1", Owner: "cosmos1alice", OrderSide: anteilv1.OrderSide_ORDER_SIDE_BUY, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN},
+		{OrderId: "order_2", Owner: "cosmos1bob", OrderSide: anteilv1.OrderSide_ORDER_SIDE_BUY, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN},
+		{OrderId: "order_3", Owner: "cosmos1alice", OrderSide: anteilv1.OrderSide_ORDER_SIDE_SELL, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN},
+		{OrderId: "order_4", Owner: "cosmos1alice", OrderSide: anteilv1.OrderSide_ORDER_SIDE_BUY, Status: anteilv1.OrderStatus_ORDER_STATUS_FILLED},
+		{OrderId: "order_5", Owner: "cosmos1alice", OrderSide: anteilv1.OrderSide_ORDER_SIDE_BUY, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN},
+	}
+	for _, order := range orders {
+		order.AntAmount = "100"
+		order.Price = "1.5"
+		order.IdentityHash = "hash_" + order.Owner
+		require.NoError(suite.T(), suite.keeper.SetOrder(suite.ctx, order))
+	}
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// Open buy orders of alice, one per page in order ID order
+	req := &anteilv1.QueryOrdersRequest{
+		Owner:      "cosmos1alice",
+		Side:       anteilv1.OrderSide_ORDER_SIDE_BUY,
+		Status:     anteilv1.OrderStatus_ORDER_STATUS_OPEN,
+		Pagination: &sdkquery.PageRequest{Limit: 1, CountTotal: true},
+	}
+	resp, err := suite.queryServer.Orders(ctx, req)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.Orders, 1)
+	require.Equal(suite.T(), "order_1", resp.Orders[0].OrderId)
+	require.Equal(suite.T(), uint64(2), resp.Pagination.Total)
+
+	req.Pagination = &sdkquery.PageRequest{Key: resp.Pagination.NextKey, Limit: 1}
+	resp, err = suite.queryServer.Orders(ctx, req)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.Orders, 1)
+	require.Equal(suite.T(), "order_5", resp.Orders[0].OrderId)
+	require.Nil(suite.T(), resp.Pagination.NextKey)
+
+	// Offset pagination without filters
+	resp, err = suite.queryServer.Orders(ctx, &anteilv1.QueryOrdersRequest{
+		Pagination: &sdkquery.PageRequest{Offset: 3, Limit: 10},
+	})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.Orders, 2)
+	require.Equal(suite.T(), "order_4", resp.Orders[0].OrderId)
+}
+
+func (suite *QueryServerTestSuite) TestOrder() {
+	order := &anteilv1.Order{
+		OrderId:      "order_1",
+		Owner:        "cosmos1alice",
+		OrderSide:    anteilv1.OrderSide_ORDER_SIDE_BUY,
+		Status:       anteilv1.OrderStatus_ORDER_STATUS_OPEN,
+		AntAmount:    "100",
+		Price:        "1.5",
+		IdentityHash: "hash_alice",
+	}
+	require.NoError(suite.T(), suite.keeper.SetOrder(suite.ctx, order))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	resp, err := suite.queryServer.Order(ctx, &anteilv1.QueryOrderRequest{OrderId: "order_1"})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "cosmos1alice", resp.Order.Owner)
+
+	_, err = suite.queryServer.Order(ctx, &anteilv1.QueryOrderRequest{OrderId: "order_missing"})
+	require.ErrorIs(suite.T(), err, types.ErrOrderNotFound)
+
+	_, err = suite.queryServer.Order(ctx, &anteilv1.QueryOrderRequest{})
+	require.Error(suite.T(), err)
+}
+
+func (suite *QueryServerTestSuite) TestOrderBook() {
+	orders := []*anteilv1.Order{
+		{OrderId: "order_1", OrderSide: anteilv1.OrderSide_ORDER_SIDE_BUY, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN, AntAmount: "100", Price: "1.5"},
+		{OrderId: "order_2", OrderSide: anteilv1.OrderSide_ORDER_SIDE_BUY, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN, AntAmount: "50", Price: "1.50"},
+		{OrderId: "order_3", OrderSide: anteilv1.OrderSide_ORDER_SIDE_BUY, Status: anteilv1.OrderStatus_ORDER_STATUS_PARTIALLY_FILLED, AntAmount: "80", FilledAmount: "30", Price: "1.4"},
+		{OrderId: "order_4", OrderSide: anteilv1.OrderSide_ORDER_SIDE_SELL, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN, AntAmount: "70", Price: "1.7"},
+		{OrderId: "order_5", OrderSide: anteilv1.OrderSide_ORDER_SIDE_SELL, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN, AntAmount: "40", Price: "1.6"},
+		{OrderId: "order_6", OrderSide: anteilv1.OrderSide_ORDER_SIDE_SELL, Status: anteilv1.OrderStatus_ORDER_STATUS_FILLED, AntAmount: "90", Price: "1.55"},
+		{OrderId: "order_7", OrderSide: anteilv1.OrderSide_ORDER_SIDE_BUY, Status: anteilv1.OrderStatus_ORDER_STATUS_CANCELLED, AntAmount: "90", Price: "1.58"},
+	}
+	for _, order := range orders {
+		order.Owner = "cosmos1alice"
+		order.IdentityHash = "hash_alice"
+		require.NoError(suite.T(), suite.keeper.SetOrder(suite.ctx, order))
+	}
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	resp, err := suite.queryServer.OrderBook(ctx, &anteilv1.QueryOrderBookRequest{})
+	require.NoError(suite.T(), err)
+	book := resp.OrderBook
+
+	// Bids highest first, equal prices merged into one level
+	require.Len(suite.T(), book.BuyOrders, 2)
+	require.Equal(suite.T(), "1.500000000000000000", book.BuyOrders[0].Price)
+	require.Equal(suite.T(), "150", book.BuyOrders[0].TotalAmount)
+	require.Equal(suite.T(), uint64(2), book.BuyOrders[0].OrderCount)
+	require.Equal(suite.T(), "50", book.BuyOrders[1].TotalAmount)
+
+	// Asks lowest first
+	require.Len(suite.T(), book.SellOrders, 2)
+	require.Equal(suite.T(), "1.600000000000000000", book.SellOrders[0].Price)
+	require.Equal(suite.T(), "1.700000000000000000", book.SellOrders[1].Price)
+
+	require.Equal(suite.T(), uint64(5), book.TotalOrders)
+	require.Equal(suite.T(), "310", book.MarketDepth)
+	require.Equal(suite.T(), "0.100000000000000000", book.BidAskSpread)
+
+	resp, err = suite.queryServer.OrderBook(ctx, &anteilv1.QueryOrderBookRequest{Depth: 1})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.OrderBook.BuyOrders, 1)
+	require.Len(suite.T(), resp.OrderBook.SellOrders, 1)
+	require.Equal(suite.T(), "1.600000000000000000", resp.OrderBook.SellOrders[0].Price)
+}
+
+// TestOrderBook_FollowsOrderUpdates checks that filled, cancelled and deleted orders leave the book
+func (suite *QueryServerTestSuite) TestOrderBook_FollowsOrderUpdates() {
+	orders := []*anteilv1.Order{
+		{OrderId: "order_1", OrderSide: anteilv1.OrderSide_ORDER_SIDE_BUY, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN, AntAmount: "100", Price: "1.5"},
+		{OrderId: "order_2", OrderSide: anteilv1.OrderSide_ORDER_SIDE_SELL, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN, AntAmount: "70", Price: "1.7"},
+		{OrderId: "order_3", OrderSide: anteilv1.OrderSide_ORDER_SIDE_SELL, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN, AntAmount: "40", Price: "1.6"},
+	}
+	for _, order := range orders {
+		order.Owner = "cosmos1alice"
+		order.IdentityHash = "hash_alice"
+		require.NoError(suite.T(), suite.keeper.SetOrder(suite.ctx, order))
+	}
+
+	orders[0].Status = anteilv1.OrderStatus_ORDER_STATUS_FILLED
+	require.NoError(suite.T(), suite.keeper.UpdateOrder(suite.ctx, orders[0]))
+	require.NoError(suite.T(), suite.keeper.CancelOrder(suite.ctx, "order_2"))
+
+	book, err := suite.keeper.GetOrderBook(suite.ctx, 0)
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), book.BuyOrders)
+	require.Len(suite.T(), book.SellOrders, 1)
+	require.Equal(suite.T(), "1.600000000000000000", book.SellOrders[0].Price)
+	require.Equal(suite.T(), uint64(1), book.TotalOrders)
+
+	require.NoError(suite.T(), suite.keeper.DeleteOrder(suite.ctx, "order_3"))
+	book, err = suite.keeper.GetOrderBook(suite.ctx, 0)
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), book.SellOrders)
+	require.Equal(suite.T(), uint64(0), book.TotalOrders)
+}
+
+// TestOrderBook_TradeStats checks the last price and the volume of the trades inside the 24h window
+func (suite *QueryServerTestSuite) TestOrderBook_TradeStats() {
+	now := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockTime(now)
+
+	trades := []*anteilv1.Trade{
+		{TradeId: "trade_a", AntAmount: "500", Price: "1.1", ExecutedAt: timestamppb.New(now.Add(-25 * time.Hour))},
+		{TradeId: "trade_b", AntAmount: "30", Price: "1.3", ExecutedAt: timestamppb.New(now.Add(-time.Minute))},
+		{TradeId: "trade_c", AntAmount: "20", Price: "1.2", ExecutedAt: timestamppb.New(now.Add(-2 * time.Hour))},
+		{TradeId: "trade_d", AntAmount: "900", Price: "9.9"},
+	}
+	for _, trade := range trades {
+		trade.BuyOrderId = "order_buy"
+		trade.SellOrderId = "order_sell"
+		trade.Buyer = "cosmos1alice"
+		trade.Seller = "cosmos1bob"
+		require.NoError(suite.T(), suite.keeper.SetTrade(ctx, trade))
+	}
+
+	book, err := suite.keeper.GetOrderBook(ctx, 0)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "1.3", book.LastPrice)
+	require.Equal(suite.T(), "50", book.Volume_24H)
+
+	// The trade totals count every trade, with or without an execution time
+	count, volume := suite.keeper.GetTradeTotals(ctx)
+	require.Equal(suite.T(), uint64(4), count)
+	require.Equal(suite.T(), "1450.000000000000000000", volume.String())
+}
+
+// TestOrderBook_AfterMatching checks the book and the trade stats after the EndBlocker
+// partially fills a resting sell order
+func (suite *QueryServerTestSuite) TestOrderBook_AfterMatching() {
+	ctx := suite.ctx.WithBlockTime(time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC))
+	require.NoError(suite.T(), suite.keeper.SetUserPosition(ctx, types.NewUserPositionAt(ctx.BlockTime(), "cosmos1seller", "1500000")))
+
+	orders := []*anteilv1.Order{
+		{OrderId: "order_buy", Owner: "cosmos1buyer", OrderSide: anteilv1.OrderSide_ORDER_SIDE_BUY, AntAmount: "600000", Price: "1.5"},
+		{OrderId: "order_sell", Owner: "cosmos1seller", OrderSide: anteilv1.OrderSide_ORDER_SIDE_SELL, AntAmount: "1000000", Price: "1.4"},
+	}
+	for _, order := range orders {
+		order.OrderType = anteilv1.OrderType_ORDER_TYPE_LIMIT
+		order.Status = anteilv1.OrderStatus_ORDER_STATUS_OPEN
+		order.IdentityHash = "hash_" + order.Owner
+		require.NoError(suite.T(), suite.keeper.SetOrder(ctx, order))
+	}
+
+	require.NoError(suite.T(), suite.keeper.EndBlocker(ctx))
+
+	resp, err := suite.queryServer.OrderBook(sdk.WrapSDKContext(ctx), &anteilv1.QueryOrderBookRequest{})
+	require.NoError(suite.T(), err)
+	book := resp.OrderBook
+
+	// The partially filled sell order keeps resting with the unfilled ANT
+	require.Empty(suite.T(), book.BuyOrders)
+	require.Len(suite.T(), book.SellOrders, 1)
+	require.Equal(suite.T(), "400000", book.SellOrders[0].TotalAmount)
+	require.Equal(suite.T(), "400000", book.MarketDepth)
+	require.Equal(suite.T(), uint64(1), book.TotalOrders)
+
+	// The executed trade feeds the last price and the 24h volume
+	require.Equal(suite.T(), "1.400000", book.LastPrice)
+	require.Equal(suite.T(), "600000", book.Volume_24H)
+
+	// The unfilled ANT of the sell order stays locked
+	position, err := suite.keeper.GetUserPositionSummary(ctx, "cosmos1seller")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "400000", position.LockedAnt)
+	require.Equal(suite.T(), "500000", position.AvailableAnt)
+}
+
+func (suite *QueryServerTestSuite) TestUserPosition() {
+	require.NoError(suite.T(), suite.keeper.SetUserPosition(suite.ctx, &anteilv1.UserPosition{
+		Owner:      "cosmos1alice",
+		AntBalance: "500",
+	}))
+	orders := []*anteilv1.Order{
+		{OrderId: "order_1", OrderSide: anteilv1.OrderSide_ORDER_SIDE_SELL, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN, AntAmount: "100"},
+		{OrderId: "order_2", OrderSide: anteilv1.OrderSide_ORDER_SIDE_SELL, Status: anteilv1.OrderStatus_ORDER_STATUS_PARTIALLY_FILLED, AntAmount: "100", RemainingAmount: "60"},
+		{OrderId: "order_3", OrderSide: anteilv1.OrderSide_ORDER_SIDE_BUY, Status: anteilv1.OrderStatus_ORDER_STATUS_OPEN, AntAmount: "200"},
+		{OrderId: "order_4", OrderSide: anteilv1.OrderSide_ORDER_SIDE_SELL, Status: anteilv1.OrderStatus_ORDER_STATUS_FILLED, AntAmount: "300"},
+	}
+	for _, order := range orders {
+		order.Owner = "cosmos1alice"
+		order.Price = "1.5"
+		order.IdentityHash = "hash_alice"
+		require.NoError(suite.T(), suite.keeper.SetOrder(suite.ctx, order))
+	}
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	resp, err := suite.queryServer.UserPosition(ctx, &anteilv1.QueryUserPositionRequest{Owner: "cosmos1alice"})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), []string{"order_1", "order_2", "order_3"}, resp.Position.OpenOrderIds)
+	require.Equal(suite.T(), "160", resp.Position.LockedAnt)
+	require.Equal(suite.T(), "340", resp.Position.AvailableAnt)
+
+	_, err = suite.queryServer.UserPosition(ctx, &anteilv1.QueryUserPositionRequest{Owner: "cosmos1bob"})
+	require.ErrorIs(suite.T(), err, types.ErrPositionNotFound)
+
+	_, err = suite.queryServer.UserPosition(ctx, &anteilv1.QueryUserPositionRequest{})
+	require.ErrorIs(suite.T(), err, types.ErrEmptyOwner)
+}
+
 func (suite *QueryServerTestSuite) TestTrades() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	req := &anteilv1.QueryTradesRequest{}
@@ -86,6 +340,28 @@ func (suite *QueryServerTestSuite) TestTrades() {
 	}
 }
 
+func (suite *QueryServerTestSuite) TestAuction() {
+	require.NoError(suite.T(), suite.keeper.SetAuction(suite.ctx, &anteilv1.Auction{
+		AuctionId:    "auction_1",
+		BlockHeight:  10,
+		AntAmount:    "100",
+		ReservePrice: "1.0",
+		Status:       anteilv1.AuctionStatus_AUCTION_STATUS_OPEN,
+	}))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	resp, err := suite.queryServer.Auction(ctx, &anteilv1.QueryAuctionRequest{AuctionId: "auction_1"})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(10), resp.Auction.BlockHeight)
+
+	_, err = suite.queryServer.Auction(ctx, &anteilv1.QueryAuctionRequest{AuctionId: "auction_missing"})
+	require.ErrorIs(suite.T(), err, types.ErrAuctionNotFound)
+
+	_, err = suite.queryServer.Auction(ctx, &anteilv1.QueryAuctionRequest{})
+	require.ErrorIs(suite.T(), err, types.ErrEmptyAuctionID)
+}
+
 func (suite *QueryServerTestSuite) TestAuctions() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	req := &anteilv1.QueryAuctionsRequest{}
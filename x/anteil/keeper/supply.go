@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// GetAntSupply returns the ANT minted into user positions, net of the ANT burned from them
+func (k Keeper) GetAntSupply(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(anteiltypes.AntSupplyKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetAntSupply sets the ANT minted into user positions
+func (k Keeper) SetAntSupply(ctx sdk.Context, supply uint64) {
+	ctx.KVStore(k.storeKey).Set(anteiltypes.AntSupplyKey, sdk.Uint64ToBigEndian(supply))
+}
+
+// mintAntSupply adds newly minted ANT to the supply
+func (k Keeper) mintAntSupply(ctx sdk.Context, amount uint64) {
+	k.SetAntSupply(ctx, k.GetAntSupply(ctx)+amount)
+}
+
+// burnAntSupply removes burned ANT from the supply
+// Burning more than the supply means ANT was created outside of a mint and fails the burn
+func (k Keeper) burnAntSupply(ctx sdk.Context, amount uint64) error {
+	supply := k.GetAntSupply(ctx)
+	if supply < amount {
+		return fmt.Errorf("cannot burn %d ANT: only %d ANT minted", amount, supply)
+	}
+	k.SetAntSupply(ctx, supply-amount)
+	return nil
+}
+
+// BurnAnt burns ANT from the owner's balance
+func (k Keeper) BurnAnt(ctx sdk.Context, owner string, amount uint64) error {
+	position, err := k.GetUserPosition(ctx, owner)
+	if err != nil {
+		return fmt.Errorf("%w: %s has no ANT position", anteiltypes.ErrInsufficientBalance, owner)
+	}
+
+	balance := anteiltypes.ParseUint64(position.AntBalance)
+	if balance < amount {
+		return fmt.Errorf("%w: have %d, need %d", anteiltypes.ErrInsufficientBalance, balance, amount)
+	}
+
+	if err := k.burnAntSupply(ctx, amount); err != nil {
+		return err
+	}
+	return k.setPositionBalance(ctx, position, balance-amount)
+}
+
+// transferAnt moves ANT from one position to another, the supply is unchanged
+func (k Keeper) transferAnt(ctx sdk.Context, from, to string, amount uint64) error {
+	sender, err := k.GetUserPosition(ctx, from)
+	if err != nil {
+		return fmt.Errorf("%w: %s has no ANT position", anteiltypes.ErrInsufficientBalance, from)
+	}
+
+	balance := anteiltypes.ParseUint64(sender.AntBalance)
+	if balance < amount {
+		return fmt.Errorf("%w: have %d, need %d", anteiltypes.ErrInsufficientBalance, balance, amount)
+	}
+	if err := k.setPositionBalance(ctx, sender, balance-amount); err != nil {
+		return err
+	}
+
+	recipient, err := k.GetUserPosition(ctx, to)
+	if err != nil {
+		recipient = anteiltypes.NewUserPositionAt(ctx.BlockTime(), to, "0")
+	}
+	return k.setPositionBalance(ctx, recipient, anteiltypes.ParseUint64(recipient.AntBalance)+amount)
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	anteiltypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// GetTradeTotals returns the number of executed trades and the ANT amount they traded
+func (k Keeper) GetTradeTotals(ctx sdk.Context) (uint64, math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+
+	var count uint64
+	if bz := store.Get(anteiltypes.TradeCountKey); bz != nil {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	volume := math.LegacyZeroDec()
+	if bz := store.Get(anteiltypes.TradeVolumeKey); bz != nil {
+		if stored, err := math.LegacyNewDecFromStr(string(bz)); err == nil {
+			volume = stored
+		}
+	}
+	return count, volume
+}
+
+// addTradeTotals counts a newly stored trade into the trade totals
+// The matching engine writes decimal amounts, so the volume is kept as a decimal
+func (k Keeper) addTradeTotals(ctx sdk.Context, trade *anteilv1.Trade) {
+	count, volume := k.GetTradeTotals(ctx)
+	if amount, err := math.LegacyNewDecFromStr(trade.AntAmount); err == nil && amount.IsPositive() {
+		volume = volume.Add(amount)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(anteiltypes.TradeCountKey, sdk.Uint64ToBigEndian(count+1))
+	store.Set(anteiltypes.TradeVolumeKey, []byte(volume.String()))
+}
@@ -1,7 +1,9 @@
 package anteil
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
 	storetypes "cosmossdk.io/store/types"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -10,11 +12,15 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 	gatewayruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	"github.com/volnix-protocol/volnix-protocol/testutil/simtx"
 	"github.com/volnix-protocol/volnix-protocol/x/anteil/keeper"
+	anteilsim "github.com/volnix-protocol/volnix-protocol/x/anteil/simulation"
 	atypes "github.com/volnix-protocol/volnix-protocol/x/anteil/types"
 )
 
@@ -41,20 +47,44 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 	return Validate(&gen)
 }
 
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *gatewayruntime.ServeMux) {}
+// RegisterGRPCGatewayRoutes registers the REST routes of the query service under /volnix/anteil/v1
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gatewayruntime.ServeMux) {
+	if err := anteilv1.RegisterQueryHandlerClient(context.Background(), mux, anteilv1.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 type AppModule struct {
 	AppModuleBasic
-	keeper *keeper.Keeper
+	cdc           codec.Codec
+	keeper        *keeper.Keeper
+	accountKeeper simulation.AccountKeeper
+	bankKeeper    simulation.BankKeeper
+	identKeeper   simtx.IdentKeeper
 }
 
-var _ module.AppModule = AppModule{}
+var (
+	_ module.AppModule           = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
+)
 
-func NewAppModule(k *keeper.Keeper) AppModule { return AppModule{keeper: k} }
+func NewAppModule(cdc codec.Codec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper) AppModule {
+	return AppModule{cdc: cdc, keeper: k, accountKeeper: ak, bankKeeper: bk, identKeeper: ik}
+}
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	anteilv1.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	anteilv1.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(*am.keeper)
+	if err := cfg.RegisterMigration(atypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", atypes.ModuleName, err))
+	}
+}
+
+// RegisterInvariants registers the anteil module invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, *am.keeper)
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
@@ -69,6 +99,24 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gen)
 }
 
+// ConsensusVersion is the consensus version of the anteil store, bumped with every store migration
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// GenerateGenesisState creates a randomized GenState of the anteil module
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	anteilsim.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for anteil module's types
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[atypes.StoreKey] = anteilsim.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the all the anteil module operations with their respective weights
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return anteilsim.WeightedOperations(simState.AppParams, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.identKeeper, am.keeper)
+}
+
 func (AppModule) IsAppModule()        {}
 func (AppModule) IsOnePerModuleType() {}
 
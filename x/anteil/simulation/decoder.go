@@ -0,0 +1,80 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding anteil type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.OrderKeyPrefix):
+			var orderA, orderB anteilv1.Order
+			cdc.MustUnmarshal(kvA.Value, &orderA)
+			cdc.MustUnmarshal(kvB.Value, &orderB)
+			return fmt.Sprintf("%v\n%v", &orderA, &orderB)
+
+		case bytes.HasPrefix(kvA.Key, types.TradeKeyPrefix):
+			var tradeA, tradeB anteilv1.Trade
+			cdc.MustUnmarshal(kvA.Value, &tradeA)
+			cdc.MustUnmarshal(kvB.Value, &tradeB)
+			return fmt.Sprintf("%v\n%v", &tradeA, &tradeB)
+
+		case bytes.HasPrefix(kvA.Key, types.UserPositionKeyPrefix):
+			var positionA, positionB anteilv1.UserPosition
+			cdc.MustUnmarshal(kvA.Value, &positionA)
+			cdc.MustUnmarshal(kvB.Value, &positionB)
+			return fmt.Sprintf("%v\n%v", &positionA, &positionB)
+
+		case bytes.HasPrefix(kvA.Key, types.AuctionKeyPrefix):
+			var auctionA, auctionB anteilv1.Auction
+			cdc.MustUnmarshal(kvA.Value, &auctionA)
+			cdc.MustUnmarshal(kvB.Value, &auctionB)
+			return fmt.Sprintf("%v\n%v", &auctionA, &auctionB)
+
+		case bytes.HasPrefix(kvA.Key, types.BidKeyPrefix):
+			var bidA, bidB anteilv1.Bid
+			cdc.MustUnmarshal(kvA.Value, &bidA)
+			cdc.MustUnmarshal(kvB.Value, &bidB)
+			return fmt.Sprintf("%v\n%v", &bidA, &bidB)
+
+		case bytes.Equal(kvA.Key, types.LastDistributionTimeKey):
+			var timeA, timeB time.Time
+			if err := timeA.UnmarshalBinary(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := timeB.UnmarshalBinary(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", timeA, timeB)
+
+		case bytes.HasPrefix(kvA.Key, types.OrderOwnerIndexKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.OpenOrderIndexKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.TradeTimeIndexKeyPrefix):
+			// Index entries have no value, the indexed fields and record ID are in the key
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		case bytes.HasPrefix(kvA.Key, types.AntBondKeyPrefix):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key, types.TradeVolumeKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key, types.AntSupplyKey), bytes.Equal(kvA.Key, types.TradeCountKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid anteil key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// Simulation parameter keys
+const (
+	TradingFeeRate               = "trading_fee_rate"
+	OrderExpiry                  = "order_expiry"
+	MaxOpenOrders                = "max_open_orders"
+	CitizenAntRewardRate         = "citizen_ant_reward_rate"
+	CitizenAntDistributionPeriod = "citizen_ant_distribution_period"
+)
+
+// RandomizedGenState generates a random genesis state for the anteil module
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	var feeRateBps int
+	simState.AppParams.GetOrGenerate(TradingFeeRate, &feeRateBps, simState.Rand,
+		func(r *rand.Rand) { feeRateBps = r.Intn(100) + 1 })
+	params.TradingFeeRate = strconv.FormatFloat(float64(feeRateBps)/10000, 'f', -1, 64)
+
+	simState.AppParams.GetOrGenerate(OrderExpiry, &params.OrderExpiry, simState.Rand,
+		func(r *rand.Rand) { params.OrderExpiry = time.Duration(r.Intn(72)+1) * time.Hour })
+	simState.AppParams.GetOrGenerate(MaxOpenOrders, &params.MaxOpenOrders, simState.Rand,
+		func(r *rand.Rand) { params.MaxOpenOrders = uint32(r.Intn(50) + 1) })
+
+	var rewardRate uint64
+	simState.AppParams.GetOrGenerate(CitizenAntRewardRate, &rewardRate, simState.Rand,
+		func(r *rand.Rand) { rewardRate = uint64(r.Intn(100)+1) * 1000000 })
+	params.CitizenAntRewardRate = strconv.FormatUint(rewardRate, 10)
+
+	// Short periods so citizens receive ANT within a simulation
+	simState.AppParams.GetOrGenerate(CitizenAntDistributionPeriod, &params.CitizenAntDistributionPeriod, simState.Rand,
+		func(r *rand.Rand) { params.CitizenAntDistributionPeriod = time.Duration(r.Intn(6)+1) * time.Hour })
+
+	genesis := &anteilv1.GenesisState{
+		Params:         params.ToProto(),
+		Orders:         []*anteilv1.Order{},
+		Trades:         []*anteilv1.Trade{},
+		UserPositions:  []*anteilv1.UserPosition{},
+		Auctions:       []*anteilv1.Auction{},
+		OrderBook:      &anteilv1.OrderBook{},
+		MarketMakers:   []*anteilv1.MarketMaker{},
+		LiquidityPools: []*anteilv1.LiquidityPool{},
+		StakingRewards: []*anteilv1.StakingReward{},
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
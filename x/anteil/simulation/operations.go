@@ -0,0 +1,285 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	"github.com/volnix-protocol/volnix-protocol/testutil/simtx"
+	"github.com/volnix-protocol/volnix-protocol/x/anteil/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/anteil/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgPlaceOrder    = "op_weight_msg_place_order"
+	OpWeightMsgCancelOrder   = "op_weight_msg_cancel_order"
+	OpWeightMsgPlaceBid      = "op_weight_msg_place_bid"
+	OpWeightMsgSettleAuction = "op_weight_msg_settle_auction"
+
+	DefaultWeightMsgPlaceOrder    = 60
+	DefaultWeightMsgCancelOrder   = 20
+	DefaultWeightMsgPlaceBid      = 20
+	DefaultWeightMsgSettleAuction = 10
+)
+
+// WeightedOperations returns all the operations from the anteil module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	ik simtx.IdentKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgPlaceOrder    int
+		weightMsgCancelOrder   int
+		weightMsgPlaceBid      int
+		weightMsgSettleAuction int
+	)
+	appParams.GetOrGenerate(OpWeightMsgPlaceOrder, &weightMsgPlaceOrder, nil,
+		func(_ *rand.Rand) { weightMsgPlaceOrder = DefaultWeightMsgPlaceOrder })
+	appParams.GetOrGenerate(OpWeightMsgCancelOrder, &weightMsgCancelOrder, nil,
+		func(_ *rand.Rand) { weightMsgCancelOrder = DefaultWeightMsgCancelOrder })
+	appParams.GetOrGenerate(OpWeightMsgPlaceBid, &weightMsgPlaceBid, nil,
+		func(_ *rand.Rand) { weightMsgPlaceBid = DefaultWeightMsgPlaceBid })
+	appParams.GetOrGenerate(OpWeightMsgSettleAuction, &weightMsgSettleAuction, nil,
+		func(_ *rand.Rand) { weightMsgSettleAuction = DefaultWeightMsgSettleAuction })
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgPlaceOrder, SimulateMsgPlaceOrder(txGen, ak, bk, ik, k)),
+		simulation.NewWeightedOperation(weightMsgCancelOrder, SimulateMsgCancelOrder(txGen, ak, bk, ik, k)),
+		simulation.NewWeightedOperation(weightMsgPlaceBid, SimulateMsgPlaceBid(txGen, ak, bk, ik, k)),
+		simulation.NewWeightedOperation(weightMsgSettleAuction, SimulateMsgSettleAuction(txGen, ak, bk, ik, k)),
+	}
+}
+
+// SimulateMsgPlaceOrder generates a MsgPlaceOrder with a random side, size and price
+// for a random account with a verified identity
+func SimulateMsgPlaceOrder(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&anteilv1.MsgPlaceOrder{})
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		owner := simAccount.Address.String()
+		account, err := ik.GetVerifiedAccount(ctx, owner)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "owner identity not found"), nil, nil
+		}
+
+		// Order IDs derive from the owner and the block time, one order per owner and block
+		order := types.NewOrderAt(ctx.BlockTime(), owner, anteilv1.OrderType_ORDER_TYPE_LIMIT, anteilv1.OrderSide_ORDER_SIDE_BUY, "", "", "")
+		if _, err := k.GetOrder(ctx, order.OrderId); err == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "order already placed in this block"), nil, nil
+		}
+
+		params := k.GetParams(ctx)
+		minSize, err := strconv.ParseInt(params.MinOrderSize, 10, 64)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid minimum order size"), nil, err
+		}
+		maxSize, err := strconv.ParseInt(params.MaxOrderSize, 10, 64)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid maximum order size"), nil, err
+		}
+
+		side := anteilv1.OrderSide_ORDER_SIDE_BUY
+		if r.Intn(2) == 0 {
+			side = anteilv1.OrderSide_ORDER_SIDE_SELL
+		}
+		// Prices around 1.0 so buy and sell orders cross
+		price := strconv.FormatFloat(float64(r.Intn(400000)+800000)/1000000, 'f', 6, 64)
+
+		msg := &anteilv1.MsgPlaceOrder{
+			Owner:        owner,
+			OrderType:    anteilv1.OrderType_ORDER_TYPE_LIMIT,
+			OrderSide:    side,
+			AntAmount:    strconv.FormatInt(minSize+r.Int63n(maxSize-minSize+1), 10),
+			Price:        price,
+			IdentityHash: account.IdentityHash,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
+
+// SimulateMsgCancelOrder generates a MsgCancelOrder for a random open order of a simulation account
+func SimulateMsgCancelOrder(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&anteilv1.MsgCancelOrder{})
+
+		orders, err := k.GetAllOrders(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read orders"), nil, err
+		}
+		var open []*anteilv1.Order
+		for _, order := range orders {
+			if order.Status == anteilv1.OrderStatus_ORDER_STATUS_OPEN {
+				open = append(open, order)
+			}
+		}
+		if len(open) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open orders"), nil, nil
+		}
+		order := open[r.Intn(len(open))]
+
+		owner, err := sdk.AccAddressFromBech32(order.Owner)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid order owner"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, owner)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "order owner is not a simulation account"), nil, nil
+		}
+
+		msg := &anteilv1.MsgCancelOrder{
+			Owner:   order.Owner,
+			OrderId: order.OrderId,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
+
+// SimulateMsgPlaceBid generates a MsgPlaceBid at or above the reserve price of a random open auction
+// for a random verified validator
+func SimulateMsgPlaceBid(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&anteilv1.MsgPlaceBid{})
+
+		auctions, err := k.GetAllAuctions(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read auctions"), nil, err
+		}
+		var open []*anteilv1.Auction
+		for _, auction := range auctions {
+			if auction.Status == anteilv1.AuctionStatus_AUCTION_STATUS_OPEN && !ctx.BlockTime().After(auction.EndTime.AsTime()) {
+				open = append(open, auction)
+			}
+		}
+		if len(open) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open auctions"), nil, nil
+		}
+		auction := open[r.Intn(len(open))]
+		reservePrice, err := strconv.ParseFloat(auction.ReservePrice, 64)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid reserve price"), nil, nil
+		}
+
+		simAccount, found := simtx.RandomAccountWithRole(r, ctx, ik, accs, identv1.Role_ROLE_VALIDATOR)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active validators"), nil, nil
+		}
+		account, err := ik.GetVerifiedAccount(ctx, simAccount.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "bidder identity not found"), nil, nil
+		}
+
+		msg := &anteilv1.MsgPlaceBid{
+			Bidder:       simAccount.Address.String(),
+			AuctionId:    auction.AuctionId,
+			Amount:       strconv.FormatFloat(reservePrice*(1+r.Float64()), 'f', 6, 64),
+			IdentityHash: account.IdentityHash,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
+
+// SimulateMsgSettleAuction generates a MsgSettleAuction for a random closed auction with a winning bid
+func SimulateMsgSettleAuction(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&anteilv1.MsgSettleAuction{})
+
+		auctions, err := k.GetAllAuctions(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read auctions"), nil, err
+		}
+		var closed []*anteilv1.Auction
+		for _, auction := range auctions {
+			if auction.Status != anteilv1.AuctionStatus_AUCTION_STATUS_CLOSED || auction.WinningBid == "" {
+				continue
+			}
+			if _, err := k.GetBid(ctx, auction.AuctionId, auction.WinningBid); err == nil {
+				closed = append(closed, auction)
+			}
+		}
+		if len(closed) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no closed auctions to settle"), nil, nil
+		}
+		auction := closed[r.Intn(len(closed))]
+
+		// Anyone may settle a closed auction
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &anteilv1.MsgSettleAuction{
+			AuctionId: auction.AuctionId,
+			Sender:    simAccount.Address.String(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
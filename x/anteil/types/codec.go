@@ -21,6 +21,7 @@ func RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 		&anteilv1.MsgStakeANT{},
 		&anteilv1.MsgUnstakeANT{},
 		&anteilv1.MsgClaimRewards{},
+		&anteilv1.MsgUpdateParams{},
 	)
 	reg.RegisterImplementations((*txtypes.MsgResponse)(nil),
 		&anteilv1.MsgPlaceOrderResponse{},
@@ -34,5 +35,6 @@ func RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 		&anteilv1.MsgStakeANTResponse{},
 		&anteilv1.MsgUnstakeANTResponse{},
 		&anteilv1.MsgClaimRewardsResponse{},
+		&anteilv1.MsgUpdateParamsResponse{},
 	)
 }
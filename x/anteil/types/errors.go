@@ -92,4 +92,8 @@ var (
 
 	// Bid errors
 	ErrBidNotFound = errors.Register(ModuleName, 32, "bid not found")
+
+	// Governance errors
+	ErrUnauthorized  = errors.Register(ModuleName, 33, "unauthorized")
+	ErrInvalidParams = errors.Register(ModuleName, 34, "invalid params")
 )
@@ -1,5 +1,14 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "anteil"
@@ -32,6 +41,27 @@ var (
 	
 	// LastDistributionTimeKey defines the key for storing last ANT distribution time
 	LastDistributionTimeKey = []byte{0x06}
+
+	// AntSupplyKey defines the key for the ANT minted into user positions, net of burns
+	AntSupplyKey = []byte{0x07}
+
+	// OrderOwnerIndexKeyPrefix defines the prefix for the index of orders by owner
+	OrderOwnerIndexKeyPrefix = []byte{0x08}
+
+	// AntBondKeyPrefix defines the prefix for the ANT held in escrow as commit bonds
+	AntBondKeyPrefix = []byte{0x09}
+
+	// OpenOrderIndexKeyPrefix defines the prefix for the index of the open orders by side
+	OpenOrderIndexKeyPrefix = []byte{0x0A}
+
+	// TradeTimeIndexKeyPrefix defines the prefix for the index of trades by execution time
+	TradeTimeIndexKeyPrefix = []byte{0x0B}
+
+	// TradeCountKey defines the key for the number of executed trades
+	TradeCountKey = []byte{0x0C}
+
+	// TradeVolumeKey defines the key for the ANT amount traded by all trades
+	TradeVolumeKey = []byte{0x0D}
 )
 
 // GetOrderKey returns the key for an order
@@ -49,6 +79,11 @@ func GetUserPositionKey(owner string) []byte {
 	return append(UserPositionKeyPrefix, []byte(owner)...)
 }
 
+// GetAntBondKey returns the key for the ANT an owner holds in escrow as commit bonds
+func GetAntBondKey(owner string) []byte {
+	return append(append([]byte{}, AntBondKeyPrefix...), []byte(owner)...)
+}
+
 // GetAuctionKey returns the key for an auction
 func GetAuctionKey(auctionID string) []byte {
 	return append(AuctionKeyPrefix, []byte(auctionID)...)
@@ -78,3 +113,35 @@ func GetAuctionPrefix() []byte {
 func GetBidPrefix() []byte {
 	return BidKeyPrefix
 }
+
+// GetOrderOwnerPrefix returns the prefix of the owner index entries of an owner
+// The owner is length-prefixed so that no owner's prefix is a prefix of another's
+func GetOrderOwnerPrefix(owner string) []byte {
+	return append(append([]byte{}, OrderOwnerIndexKeyPrefix...), address.MustLengthPrefix([]byte(owner))...)
+}
+
+// GetOrderOwnerIndexKey returns the owner index key of an order
+func GetOrderOwnerIndexKey(owner, orderID string) []byte {
+	return append(GetOrderOwnerPrefix(owner), []byte(orderID)...)
+}
+
+// GetOpenOrderSidePrefix returns the prefix of the open order index entries of a side
+func GetOpenOrderSidePrefix(side anteilv1.OrderSide) []byte {
+	return append(append([]byte{}, OpenOrderIndexKeyPrefix...), byte(side))
+}
+
+// GetOpenOrderIndexKey returns the open order index key of an order
+func GetOpenOrderIndexKey(side anteilv1.OrderSide, orderID string) []byte {
+	return append(GetOpenOrderSidePrefix(side), []byte(orderID)...)
+}
+
+// GetTradeTimePrefix returns the prefix of the trade time index entries executed at t
+// The time is encoded with sdk.FormatTimeBytes so that the entries sort by execution time
+func GetTradeTimePrefix(t time.Time) []byte {
+	return append(append([]byte{}, TradeTimeIndexKeyPrefix...), sdk.FormatTimeBytes(t)...)
+}
+
+// GetTradeTimeIndexKey returns the trade time index key of a trade
+func GetTradeTimeIndexKey(executedAt time.Time, tradeID string) []byte {
+	return append(GetTradeTimePrefix(executedAt), []byte(tradeID)...)
+}
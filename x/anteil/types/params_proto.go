@@ -7,16 +7,27 @@ import (
 
 func (p Params) ToProto() *anteilv1.Params {
 	return &anteilv1.Params{
-		MinAntAmount:                p.MinAntAmount,
-		MaxAntAmount:                p.MaxAntAmount,
-		TradingFeeRate:              p.TradingFeeRate,
-		MinOrderSize:                p.MinOrderSize,
-		MaxOrderSize:                p.MaxOrderSize,
-		OrderExpiry:                 durationpb.New(p.OrderExpiry),
-		RequireIdentityVerification: p.RequireIdentityVerification,
-		AntDenom:                    p.AntDenom,
-		MaxOpenOrders:               p.MaxOpenOrders,
-		PricePrecision:              p.PricePrecision,
+		MinAntAmount:                 p.MinAntAmount,
+		MaxAntAmount:                 p.MaxAntAmount,
+		TradingFeeRate:               p.TradingFeeRate,
+		MinOrderSize:                 p.MinOrderSize,
+		MaxOrderSize:                 p.MaxOrderSize,
+		OrderExpiry:                  durationpb.New(p.OrderExpiry),
+		RequireIdentityVerification:  p.RequireIdentityVerification,
+		AntDenom:                     p.AntDenom,
+		MaxOpenOrders:                p.MaxOpenOrders,
+		PricePrecision:               p.PricePrecision,
+		MarketMakerRewardRate:        p.MarketMakerRewardRate,
+		StakingRewardRate:            p.StakingRewardRate,
+		LiquidityPoolFee:             p.LiquidityPoolFee,
+		MaxSlippage:                  p.MaxSlippage,
+		MinLiquidityThreshold:        p.MinLiquidityThreshold,
+		CitizenAntRewardRate:         p.CitizenAntRewardRate,
+		CitizenAntAccumulationLimit:  p.CitizenAntAccumulationLimit,
+		CitizenAntDistributionPeriod: durationpb.New(p.CitizenAntDistributionPeriod),
+		MarketMakingBuyDiscount:      p.MarketMakingBuyDiscount,
+		MarketMakingSellPremium:      p.MarketMakingSellPremium,
+		MarketMakingOrderSize:        p.MarketMakingOrderSize,
 	}
 }
 
@@ -25,15 +36,26 @@ func ParamsFromProto(pp *anteilv1.Params) (Params, error) {
 		return DefaultParams(), nil
 	}
 	return Params{
-		MinAntAmount:                pp.MinAntAmount,
-		MaxAntAmount:                pp.MaxAntAmount,
-		TradingFeeRate:              pp.TradingFeeRate,
-		MinOrderSize:                pp.MinOrderSize,
-		MaxOrderSize:                pp.MaxOrderSize,
-		OrderExpiry:                 pp.OrderExpiry.AsDuration(),
-		RequireIdentityVerification: pp.RequireIdentityVerification,
-		AntDenom:                    pp.AntDenom,
-		MaxOpenOrders:               pp.MaxOpenOrders,
-		PricePrecision:              pp.PricePrecision,
+		MinAntAmount:                 pp.MinAntAmount,
+		MaxAntAmount:                 pp.MaxAntAmount,
+		TradingFeeRate:               pp.TradingFeeRate,
+		MinOrderSize:                 pp.MinOrderSize,
+		MaxOrderSize:                 pp.MaxOrderSize,
+		OrderExpiry:                  pp.OrderExpiry.AsDuration(),
+		RequireIdentityVerification:  pp.RequireIdentityVerification,
+		AntDenom:                     pp.AntDenom,
+		MaxOpenOrders:                pp.MaxOpenOrders,
+		PricePrecision:               pp.PricePrecision,
+		MarketMakerRewardRate:        pp.MarketMakerRewardRate,
+		StakingRewardRate:            pp.StakingRewardRate,
+		LiquidityPoolFee:             pp.LiquidityPoolFee,
+		MaxSlippage:                  pp.MaxSlippage,
+		MinLiquidityThreshold:        pp.MinLiquidityThreshold,
+		CitizenAntRewardRate:         pp.CitizenAntRewardRate,
+		CitizenAntAccumulationLimit:  pp.CitizenAntAccumulationLimit,
+		CitizenAntDistributionPeriod: pp.CitizenAntDistributionPeriod.AsDuration(),
+		MarketMakingBuyDiscount:      pp.MarketMakingBuyDiscount,
+		MarketMakingSellPremium:      pp.MarketMakingSellPremium,
+		MarketMakingOrderSize:        pp.MarketMakingOrderSize,
 	}, nil
 }
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"time"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
@@ -14,7 +15,13 @@ import (
 
 // NewOrder creates a new Order instance
 func NewOrder(owner string, orderType anteilv1.OrderType, orderSide anteilv1.OrderSide, antAmount string, price string, identityHash string) *anteilv1.Order {
-	now := timestamppb.Now()
+	return NewOrderAt(time.Now(), owner, orderType, orderSide, antAmount, price, identityHash)
+}
+
+// NewOrderAt creates a new Order instance placed at createdAt
+// Message handlers pass the block time so every node derives the same order ID
+func NewOrderAt(createdAt time.Time, owner string, orderType anteilv1.OrderType, orderSide anteilv1.OrderSide, antAmount string, price string, identityHash string) *anteilv1.Order {
+	now := timestamppb.New(createdAt)
 	expiresAt := timestamppb.New(now.AsTime().Add(24 * time.Hour)) // Default 24h expiry
 
 	return &anteilv1.Order{
@@ -51,7 +58,12 @@ func NewTrade(buyOrderID string, sellOrderID string, buyer string, seller string
 
 // NewUserPosition creates a new UserPosition instance
 func NewUserPosition(owner string, antBalance string) *anteilv1.UserPosition {
-	now := timestamppb.Now()
+	return NewUserPositionAt(time.Now(), owner, antBalance)
+}
+
+// NewUserPositionAt creates a new UserPosition instance last active at lastActivity
+func NewUserPositionAt(lastActivity time.Time, owner string, antBalance string) *anteilv1.UserPosition {
+	now := timestamppb.New(lastActivity)
 
 	return &anteilv1.UserPosition{
 		Owner:        owner,
@@ -252,3 +264,17 @@ func ParseUint64(s string) uint64 {
 	}
 	return val
 }
+
+// ParseAntAmount parses an ANT amount that may be written as a decimal, as the matching engine
+// does after a partial fill ("4.000000"), returning the whole ANT and 0 on error
+func ParseAntAmount(s string) uint64 {
+	amount, err := math.LegacyNewDecFromStr(s)
+	if err != nil || amount.IsNegative() {
+		return 0
+	}
+	whole := amount.TruncateInt()
+	if !whole.IsUint64() {
+		return 0
+	}
+	return whole.Uint64()
+}
@@ -401,3 +401,12 @@ func TestParseUint64(t *testing.T) {
 	require.Equal(t, uint64(42), types.ParseUint64("42"))
 	require.Equal(t, uint64(999), types.ParseUint64("999"))
 }
+
+func TestParseAntAmount(t *testing.T) {
+	require.Equal(t, uint64(0), types.ParseAntAmount(""))
+	require.Equal(t, uint64(0), types.ParseAntAmount("abc"))
+	require.Equal(t, uint64(0), types.ParseAntAmount("-4"))
+	require.Equal(t, uint64(42), types.ParseAntAmount("42"))
+	require.Equal(t, uint64(4), types.ParseAntAmount("4.000000"))
+	require.Equal(t, uint64(4), types.ParseAntAmount("4.9"))
+}
@@ -4,8 +4,14 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
 )
 
+// FlagCount is the number of upcoming halvings to project
+const FlagCount = "count"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -16,9 +22,40 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	// Add query commands here when they are implemented
-	// cmd.AddCommand(CmdGetParams())
-	// cmd.AddCommand(CmdGetValidators())
+	cmd.AddCommand(CmdQueryHalvingSchedule())
+
+	return cmd
+}
+
+// CmdQueryHalvingSchedule returns the command to query the projected halving schedule
+func CmdQueryHalvingSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halving-schedule",
+		Short: "Query the upcoming halvings with their block rewards and estimated dates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			count, err := cmd.Flags().GetUint64(FlagCount)
+			if err != nil {
+				return err
+			}
+
+			queryClient := consensusv1.NewQueryClient(clientCtx)
+			res, err := queryClient.HalvingSchedule(cmd.Context(), &consensusv1.QueryHalvingScheduleRequest{Count: count})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCount, 5, "Number of upcoming halvings to project")
+	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
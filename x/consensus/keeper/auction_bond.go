@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// ============================================================================
+// Blind Auction Commit Bonds
+// ============================================================================
+//
+// Every commit moves auction_commit_bond ANT from the validator's balance into
+// escrow in the anteil module. A valid reveal releases the bond; a commit that is
+// never revealed forfeits it: the bond is burned from escrow, counted as ANT burned
+// in the block and recorded as an AuctionFault. This makes flooding the auction
+// with commits that are never revealed costly.
+
+// getAntBalance returns the validator's ANT balance from the anteil module
+func (k Keeper) getAntBalance(ctx sdk.Context, validator string) (uint64, error) {
+	position, err := k.anteilKeeper.GetUserPosition(ctx, validator)
+	if err != nil {
+		return 0, fmt.Errorf("validator %s has no ANT balance", validator)
+	}
+
+	antBalance, err := strconv.ParseUint(position.AntBalance, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ANT balance format: %w", err)
+	}
+
+	return antBalance, nil
+}
+
+// lockCommitBond moves the commit bond from the validator's ANT balance into escrow
+// Returns the locked amount ("0" if bonds are disabled or the anteil keeper is not set)
+func (k Keeper) lockCommitBond(ctx sdk.Context, validator string, height uint64) (string, error) {
+	params := k.GetParams(ctx)
+	bond := params.AuctionCommitBond
+	if bond == 0 || k.anteilKeeper == nil {
+		return "0", nil
+	}
+
+	antBalance, err := k.getAntBalance(ctx, validator)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", types.ErrInsufficientBond, err)
+	}
+	if antBalance < bond {
+		return "", fmt.Errorf("%w: have %d, need %d", types.ErrInsufficientBond, antBalance, bond)
+	}
+
+	if err := k.anteilKeeper.LockAntBond(ctx, validator, bond); err != nil {
+		return "", fmt.Errorf("failed to lock commit bond: %w", err)
+	}
+
+	bondStr := strconv.FormatUint(bond, 10)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBondLocked,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(types.AttributeKeyBondAmount, bondStr),
+			sdk.NewAttribute(types.AttributeKeyAuctionHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return bondStr, nil
+}
+
+// commitBondAmount returns the bond locked by a commit
+func commitBondAmount(commit *consensusv1.EncryptedBid) uint64 {
+	bond, err := strconv.ParseUint(commit.BondAmount, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return bond
+}
+
+// returnCommitBond releases the bond of a revealed commit from escrow to the validator's ANT balance
+func (k Keeper) returnCommitBond(ctx sdk.Context, commit *consensusv1.EncryptedBid) error {
+	bond := commitBondAmount(commit)
+	if bond == 0 || k.anteilKeeper == nil {
+		return nil
+	}
+
+	if err := k.anteilKeeper.ReleaseAntBond(ctx, commit.Validator, bond); err != nil {
+		return fmt.Errorf("failed to return commit bond: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBondReturned,
+			sdk.NewAttribute(types.AttributeKeyValidator, commit.Validator),
+			sdk.NewAttribute(types.AttributeKeyBondAmount, commit.BondAmount),
+			sdk.NewAttribute(types.AttributeKeyAuctionHeight, strconv.FormatUint(commit.BlockHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return nil
+}
+
+// forfeitCommitBond burns the escrowed bond of an unrevealed commit and records it as an auction fault
+func (k Keeper) forfeitCommitBond(ctx sdk.Context, commit *consensusv1.EncryptedBid, auctionHeight uint64) error {
+	bondAmount := commitBondAmount(commit)
+	if bondAmount > 0 && k.anteilKeeper != nil {
+		if err := k.anteilKeeper.BurnAntBond(ctx, commit.Validator, bondAmount); err != nil {
+			return fmt.Errorf("failed to burn commit bond: %w", err)
+		}
+		k.addBlockBurnedAnt(ctx, bondAmount)
+	}
+	bond := strconv.FormatUint(bondAmount, 10)
+
+	fault := &consensusv1.AuctionFault{
+		Validator:     commit.Validator,
+		AuctionHeight: auctionHeight,
+		BondForfeited: bond,
+		FaultHeight:   uint64(ctx.BlockHeight()),
+	}
+	if err := k.SetAuctionFault(ctx, fault); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBondForfeited,
+			sdk.NewAttribute(types.AttributeKeyValidator, commit.Validator),
+			sdk.NewAttribute(types.AttributeKeyBondAmount, bond),
+			sdk.NewAttribute(types.AttributeKeyAuctionHeight, strconv.FormatUint(auctionHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return nil
+}
+
+// SetAuctionFault stores an auction fault
+func (k Keeper) SetAuctionFault(ctx sdk.Context, fault *consensusv1.AuctionFault) error {
+	bz, err := k.cdc.Marshal(fault)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auction fault: %w", err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAuctionFaultKey(fault.Validator, fault.AuctionHeight), bz)
+	return nil
+}
+
+// GetAuctionFaults returns the auction faults of a validator, ordered by auction height
+func (k Keeper) GetAuctionFaults(ctx sdk.Context, validator string) ([]*consensusv1.AuctionFault, error) {
+	return k.iterateAuctionFaults(ctx, types.GetAuctionFaultPrefix(validator))
+}
+
+// GetAllAuctionFaults returns the auction faults of all validators
+func (k Keeper) GetAllAuctionFaults(ctx sdk.Context) ([]*consensusv1.AuctionFault, error) {
+	return k.iterateAuctionFaults(ctx, types.AuctionFaultKeyPrefix)
+}
+
+// iterateAuctionFaults returns all auction faults under a key prefix
+func (k Keeper) iterateAuctionFaults(ctx sdk.Context, prefix []byte) ([]*consensusv1.AuctionFault, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(prefix, append(append([]byte{}, prefix...), 0xFF))
+	defer iterator.Close()
+
+	var faults []*consensusv1.AuctionFault
+	for ; iterator.Valid(); iterator.Next() {
+		var fault consensusv1.AuctionFault
+		if err := k.cdc.Unmarshal(iterator.Value(), &fault); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal auction fault: %w", err)
+		}
+		faults = append(faults, &fault)
+	}
+
+	return faults, nil
+}
+
+// GetAuctionFaultsPage returns a page of the auction faults of a validator (all validators if empty),
+// in validator and auction height order
+func (k Keeper) GetAuctionFaultsPage(ctx sdk.Context, validator string, pageReq *sdkquery.PageRequest) ([]*consensusv1.AuctionFault, *sdkquery.PageResponse, error) {
+	faultPrefix := types.AuctionFaultKeyPrefix
+	if validator != "" {
+		faultPrefix = types.GetAuctionFaultPrefix(validator)
+	}
+	faultStore := prefix.NewStore(ctx.KVStore(k.storeKey), faultPrefix)
+
+	var faults []*consensusv1.AuctionFault
+	pageRes, err := sdkquery.Paginate(faultStore, pageReq, func(_ []byte, value []byte) error {
+		var fault consensusv1.AuctionFault
+		if err := k.cdc.Unmarshal(value, &fault); err != nil {
+			return fmt.Errorf("failed to unmarshal auction fault: %w", err)
+		}
+		faults = append(faults, &fault)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return faults, pageRes, nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+func (suite *AdvancedKeeperTestSuite) antBalance(mock *MockAnteilKeeper, validator string) string {
+	position, err := mock.GetUserPosition(suite.ctx, validator)
+	require.NoError(suite.T(), err)
+	return position.AntBalance
+}
+
+func (suite *AdvancedKeeperTestSuite) moveAuctionToReveal(height uint64) {
+	auction, err := suite.keeper.GetBlindAuction(suite.ctx, height)
+	require.NoError(suite.T(), err)
+	auction.Phase = consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL
+	require.NoError(suite.T(), suite.keeper.SetBlindAuction(suite.ctx, auction))
+}
+
+// Test a commit locks the bond and a valid reveal returns it
+func (suite *AdvancedKeeperTestSuite) TestCommitBond_LockedAndReturned() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
+	height := uint64(1000)
+	validator := "cosmos1validator"
+	mockAnteilKeeper := &MockAnteilKeeper{positions: make(map[string]*anteilv1.UserPosition)}
+	suite.keeper.SetAnteilKeeper(mockAnteilKeeper)
+
+	commitHash := keeper.HashCommit("nonce", "1000000")
+	require.NoError(suite.T(), suite.keeper.CommitBid(suite.ctx, validator, commitHash, height))
+	require.Equal(suite.T(), "9900000", suite.antBalance(mockAnteilKeeper, validator))
+
+	auction, err := suite.keeper.GetBlindAuction(suite.ctx, height)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "100000", auction.Commits[0].BondAmount)
+	require.Equal(suite.T(), uint64(100000), mockAnteilKeeper.bonds[validator])
+
+	suite.moveAuctionToReveal(height)
+	require.NoError(suite.T(), suite.keeper.RevealBid(suite.ctx, validator, "nonce", "1000000", height))
+	require.Equal(suite.T(), "10000000", suite.antBalance(mockAnteilKeeper, validator))
+}
+
+// Test a commit is rejected when the validator cannot cover the bond
+func (suite *AdvancedKeeperTestSuite) TestCommitBond_InsufficientBalance() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
+	validator := "cosmos1validator"
+	mockAnteilKeeper := &MockAnteilKeeper{positions: make(map[string]*anteilv1.UserPosition)}
+	require.NoError(suite.T(), mockAnteilKeeper.UpdateUserPosition(suite.ctx, validator, "99999", 0))
+	suite.keeper.SetAnteilKeeper(mockAnteilKeeper)
+
+	commitHash := keeper.HashCommit("nonce", "1000")
+	err := suite.keeper.CommitBid(suite.ctx, validator, commitHash, 1000)
+	require.ErrorIs(suite.T(), err, types.ErrInsufficientBond)
+
+	auction, err := suite.keeper.GetBlindAuction(suite.ctx, 1000)
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), auction.Commits)
+}
+
+// Test commit bonds are disabled when the parameter is zero
+func (suite *AdvancedKeeperTestSuite) TestCommitBond_Disabled() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AuctionCommitBond = 0
+	suite.keeper.SetParams(suite.ctx, params)
+
+	validator := "cosmos1validator"
+	mockAnteilKeeper := &MockAnteilKeeper{positions: make(map[string]*anteilv1.UserPosition)}
+	suite.keeper.SetAnteilKeeper(mockAnteilKeeper)
+
+	commitHash := keeper.HashCommit("nonce", "1000")
+	require.NoError(suite.T(), suite.keeper.CommitBid(suite.ctx, validator, commitHash, 1000))
+	require.Equal(suite.T(), "10000000", suite.antBalance(mockAnteilKeeper, validator))
+}
+
+// Test an unrevealed commit forfeits the bond and is reported by the AuctionFaults query
+func (suite *AdvancedKeeperTestSuite) TestCommitBond_ForfeitedWithoutReveal() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
+	height := uint64(1000)
+	mockAnteilKeeper := &MockAnteilKeeper{positions: make(map[string]*anteilv1.UserPosition)}
+	suite.keeper.SetAnteilKeeper(mockAnteilKeeper)
+
+	require.NoError(suite.T(), suite.keeper.CommitBid(suite.ctx, "cosmos1honest", keeper.HashCommit("n1", "1000"), height))
+	require.NoError(suite.T(), suite.keeper.CommitBid(suite.ctx, "cosmos1flooder", keeper.HashCommit("n2", "1000"), height))
+
+	suite.moveAuctionToReveal(height)
+	require.NoError(suite.T(), suite.keeper.RevealBid(suite.ctx, "cosmos1honest", "n1", "1000", height))
+
+	ctx := suite.ctx.WithBlockHeight(1001)
+	_, _, err := suite.keeper.SelectAuctionWinner(ctx, height)
+	require.NoError(suite.T(), err)
+
+	// The bond of the validator that did not reveal is not returned, it is burned from escrow
+	require.Equal(suite.T(), "9900000", suite.antBalance(mockAnteilKeeper, "cosmos1flooder"))
+	require.Zero(suite.T(), mockAnteilKeeper.bonds["cosmos1flooder"])
+	require.Equal(suite.T(), uint64(1000+100000), suite.keeper.GetBlockBurnedAnt(ctx))
+
+	queryServer := keeper.NewQueryServer(*suite.keeper)
+	resp, err := queryServer.AuctionFaults(ctx, &consensusv1.QueryAuctionFaultsRequest{Validator: "cosmos1flooder"})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.Faults, 1)
+	require.Equal(suite.T(), height, resp.Faults[0].AuctionHeight)
+	require.Equal(suite.T(), "100000", resp.Faults[0].BondForfeited)
+	require.Equal(suite.T(), uint64(1001), resp.Faults[0].FaultHeight)
+
+	resp, err = queryServer.AuctionFaults(ctx, &consensusv1.QueryAuctionFaultsRequest{Validator: "cosmos1honest"})
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), resp.Faults)
+
+	resp, err = queryServer.AuctionFaults(ctx, &consensusv1.QueryAuctionFaultsRequest{})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.Faults, 1)
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// ============================================================================
+// Blind Auction Windows
+// ============================================================================
+//
+// The auction for target height H opens commit + reveal blocks ahead of H.
+// Commits are accepted for auction_commit_window blocks, reveals for the
+// following auction_reveal_window blocks, and the winner is selected at the
+// end of block H-1, once the reveal window has closed. Phase transitions are
+// driven only by block height.
+
+// getAuctionWindows returns the commit and reveal window lengths in blocks
+func (k Keeper) getAuctionWindows(ctx sdk.Context) (uint64, uint64) {
+	params := k.GetParams(ctx)
+
+	commitWindow := params.AuctionCommitWindow
+	if commitWindow == 0 {
+		commitWindow = 5 // Default fallback
+	}
+	revealWindow := params.AuctionRevealWindow
+	if revealWindow == 0 {
+		revealWindow = 5 // Default fallback
+	}
+
+	return commitWindow, revealWindow
+}
+
+// auctionEndHeights returns the last heights at which commits and reveals are accepted
+// for the auction of a target height
+func auctionEndHeights(targetHeight, revealWindow uint64) (uint64, uint64) {
+	if targetHeight == 0 {
+		return 0, 0
+	}
+	revealEnd := targetHeight - 1
+	if revealEnd < revealWindow {
+		return 0, revealEnd
+	}
+	return revealEnd - revealWindow, revealEnd
+}
+
+// auctionCommitStartHeight returns the first height at which commits are accepted for the
+// auction of a target height, the block after ProcessAuctionWindows opened the auction
+func auctionCommitStartHeight(targetHeight, commitWindow, revealWindow uint64) uint64 {
+	if targetHeight <= commitWindow+revealWindow {
+		return 0
+	}
+	return targetHeight - commitWindow - revealWindow
+}
+
+// AuctionCommitStartHeight returns the first height at which CommitBid accepts commits
+// for the auction of a target height
+func (k Keeper) AuctionCommitStartHeight(ctx sdk.Context, targetHeight uint64) uint64 {
+	commitWindow, revealWindow := k.getAuctionWindows(ctx)
+	return auctionCommitStartHeight(targetHeight, commitWindow, revealWindow)
+}
+
+// ProcessAuctionWindows advances blind auctions at the end of a block:
+// it selects the winner of the auction for the next block, moves auctions whose
+// commit window has closed to the reveal phase and opens the auction whose commit
+// window starts at the next block
+func (k Keeper) ProcessAuctionWindows(ctx sdk.Context) error {
+	currentHeight := uint64(ctx.BlockHeight())
+	commitWindow, revealWindow := k.getAuctionWindows(ctx)
+
+	// The reveal window of the auction for the next block closes with this block
+	if err := k.finalizeAuction(ctx, currentHeight+1); err != nil {
+		ctx.Logger().Error("failed to finalize blind auction", "error", err, "height", currentHeight+1)
+	}
+
+	// Close commit windows that ended with this block
+	lastOpenHeight := currentHeight + 1 + commitWindow + revealWindow
+	for height := currentHeight + 2; height <= lastOpenHeight; height++ {
+		auction, err := k.GetBlindAuction(ctx, height)
+		if err != nil {
+			continue
+		}
+		if auction.Phase != consensusv1.AuctionPhase_AUCTION_PHASE_COMMIT || auction.CommitEndHeight > currentHeight {
+			continue
+		}
+		if err := k.TransitionAuctionPhase(ctx, height); err != nil {
+			return fmt.Errorf("failed to transition auction phase: %w", err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAuctionRevealStarted,
+				sdk.NewAttribute(types.AttributeKeyAuctionHeight, strconv.FormatUint(height, 10)),
+				sdk.NewAttribute(types.AttributeKeyRevealEndHeight, strconv.FormatUint(auction.RevealEndHeight, 10)),
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatUint(currentHeight, 10)),
+			),
+		)
+	}
+
+	// Open the auction whose commit window starts at the next block
+	if _, err := k.CreateBlindAuction(ctx, lastOpenHeight); err != nil {
+		return fmt.Errorf("failed to open blind auction for height %d: %w", lastOpenHeight, err)
+	}
+
+	return nil
+}
+
+// finalizeAuction completes the auction for a target height after its reveal window has closed
+// Without reveals the auction completes without a winner and the block creator is chosen by lottery
+func (k Keeper) finalizeAuction(ctx sdk.Context, height uint64) error {
+	auction, err := k.GetBlindAuction(ctx, height)
+	if err != nil || auction.Phase == consensusv1.AuctionPhase_AUCTION_PHASE_COMPLETE {
+		return nil
+	}
+
+	if len(auction.Reveals) == 0 {
+		auction.Phase = consensusv1.AuctionPhase_AUCTION_PHASE_COMPLETE
+		auction.EndTime = timestamppb.New(ctx.BlockTime())
+		if err := k.SetBlindAuction(ctx, auction); err != nil {
+			return err
+		}
+		k.handleUnrevealedCommits(ctx, auction)
+	} else {
+		winner, winningBid, err := k.SelectAuctionWinner(ctx, height)
+		if err != nil {
+			return err
+		}
+		auction.Winner = winner
+		auction.WinningBid = winningBid
+		ctx.Logger().Info("blind auction winner selected", "height", height, "winner", winner, "bid", winningBid)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionCompleted,
+			sdk.NewAttribute(types.AttributeKeyAuctionHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuctionWinner, auction.Winner),
+			sdk.NewAttribute(types.AttributeKeyBidAmount, auction.WinningBid),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return nil
+}
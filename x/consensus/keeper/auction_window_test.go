@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// runEndBlockers runs EndBlocker for every height in [from, to]
+func (suite *KeeperTestSuite) runEndBlockers(from, to int64) {
+	for h := from; h <= to; h++ {
+		_, err := suite.keeper.EndBlocker(suite.ctx.WithBlockHeight(h))
+		require.NoError(suite.T(), err)
+	}
+}
+
+// TestAuctionWindows_HeightDriven tests the full commit/reveal cycle driven by EndBlocker
+func (suite *KeeperTestSuite) TestAuctionWindows_HeightDriven() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AuctionCommitWindow = 3
+	params.AuctionRevealWindow = 2
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetValidator(suite.ctx, &consensusv1.Validator{
+		Validator:     "cosmos1validator1",
+		AntBalance:    "1000000",
+		ActivityScore: "500",
+		Status:        consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE,
+	})
+
+	// EndBlocker at height 10 opens the auction for height 16: commits in 11-13, reveals in 14-15
+	suite.runEndBlockers(10, 10)
+	auction, err := suite.keeper.GetBlindAuction(suite.ctx, 16)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(13), auction.CommitEndHeight)
+	require.Equal(suite.T(), uint64(15), auction.RevealEndHeight)
+
+	commitHash := keeper.HashCommit("nonce", "1000")
+
+	// Early commits: the auction for height 17 opens with EndBlocker at height 11
+	err = suite.keeper.CommitBid(suite.ctx.WithBlockHeight(11), "cosmos1validator1", commitHash, 17)
+	require.ErrorIs(suite.T(), err, types.ErrCommitWindowNotOpen)
+	_, err = suite.keeper.GetBlindAuction(suite.ctx, 17)
+	require.Error(suite.T(), err)
+
+	require.NoError(suite.T(), suite.keeper.CommitBid(suite.ctx.WithBlockHeight(11), "cosmos1validator1", commitHash, 16))
+
+	// Reveals are not accepted during the commit window
+	err = suite.keeper.RevealBid(suite.ctx.WithBlockHeight(12), "cosmos1validator1", "nonce", "1000", 16)
+	require.ErrorIs(suite.T(), err, types.ErrAuctionNotInRevealPhase)
+
+	suite.runEndBlockers(11, 13)
+	auction, err = suite.keeper.GetBlindAuction(suite.ctx, 16)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL, auction.Phase)
+
+	// Late commit
+	err = suite.keeper.CommitBid(suite.ctx.WithBlockHeight(14), "cosmos1validator2", commitHash, 16)
+	require.ErrorIs(suite.T(), err, types.ErrCommitWindowClosed)
+
+	require.NoError(suite.T(), suite.keeper.RevealBid(suite.ctx.WithBlockHeight(14), "cosmos1validator1", "nonce", "1000", 16))
+
+	// The winner is selected once the reveal window has closed
+	suite.runEndBlockers(14, 14)
+	auction, err = suite.keeper.GetBlindAuction(suite.ctx, 16)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL, auction.Phase)
+
+	suite.runEndBlockers(15, 15)
+	auction, err = suite.keeper.GetBlindAuction(suite.ctx, 16)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), consensusv1.AuctionPhase_AUCTION_PHASE_COMPLETE, auction.Phase)
+	require.Equal(suite.T(), "cosmos1validator1", auction.Winner)
+
+	// The winner becomes the creator of the block it bid for
+	creator, err := suite.keeper.GetBlockCreator(suite.ctx, 16)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "cosmos1validator1", creator.Validator)
+	require.Equal(suite.T(), "1000", creator.BurnAmount)
+}
+
+// TestAuctionWindows_LotteryBlockCreator tests EndBlocker selects a creator for the next block without bids
+func (suite *KeeperTestSuite) TestAuctionWindows_LotteryBlockCreator() {
+	// Without validators no creator is selected and the block does not fail
+	suite.runEndBlockers(20, 20)
+	_, err := suite.keeper.GetBlockCreator(suite.ctx, 21)
+	require.Error(suite.T(), err)
+
+	for _, address := range []string{"cosmos1validator1", "cosmos1validator2"} {
+		suite.keeper.SetValidator(suite.ctx, &consensusv1.Validator{
+			Validator:     address,
+			AntBalance:    "1000000",
+			ActivityScore: "500",
+			Status:        consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE,
+		})
+	}
+
+	suite.runEndBlockers(21, 21)
+	creator, err := suite.keeper.GetBlockCreator(suite.ctx, 22)
+	require.NoError(suite.T(), err)
+	require.Contains(suite.T(), []string{"cosmos1validator1", "cosmos1validator2"}, creator.Validator)
+	require.Equal(suite.T(), "0", creator.BurnAmount)
+}
+
+// TestAuctionWindows_LateReveal tests reveals are rejected after the reveal window
+func (suite *KeeperTestSuite) TestAuctionWindows_LateReveal() {
+	height := uint64(100)
+	ctx := suite.ctx.WithBlockHeight(90)
+
+	commitHash := keeper.HashCommit("nonce", "1000")
+	require.NoError(suite.T(), suite.keeper.CommitBid(ctx, "cosmos1validator1", commitHash, height))
+	require.NoError(suite.T(), suite.keeper.TransitionAuctionPhase(ctx, height))
+
+	err := suite.keeper.RevealBid(suite.ctx.WithBlockHeight(100), "cosmos1validator1", "nonce", "1000", height)
+	require.ErrorIs(suite.T(), err, types.ErrRevealWindowClosed)
+}
+
+// TestAuctionWindows_NoReveals tests an auction without reveals completes without a winner
+func (suite *KeeperTestSuite) TestAuctionWindows_NoReveals() {
+	height := uint64(100)
+	ctx := suite.ctx.WithBlockHeight(90)
+
+	commitHash := keeper.HashCommit("nonce", "1000")
+	require.NoError(suite.T(), suite.keeper.CommitBid(ctx, "cosmos1validator1", commitHash, height))
+
+	suite.runEndBlockers(94, 99)
+
+	auction, err := suite.keeper.GetBlindAuction(suite.ctx, height)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), consensusv1.AuctionPhase_AUCTION_PHASE_COMPLETE, auction.Phase)
+	require.Empty(suite.T(), auction.Winner)
+
+	// The unrevealed commit is recorded as a fault
+	faults, err := suite.keeper.GetAuctionFaults(suite.ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), faults, 1)
+}
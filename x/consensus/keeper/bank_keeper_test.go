@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"strconv"
 	"testing"
 
@@ -9,48 +10,25 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
 )
 
-// MockActivatedLizenz is a mock structure that matches the expected interface
-type MockActivatedLizenz struct {
-	Validator string
-	Amount    string
-}
-
 // MockLizenzKeeper is a mock implementation of LizenzKeeperInterface for testing
 type MockLizenzKeeper struct {
-	activatedLizenz []interface{}
+	activatedLizenz []*lizenzv1.ActivatedLizenz
 	moaCompliance   map[string]float64
 	totalLZN        string
 	errors          map[string]error
+	slashed         map[string]math.LegacyDec // validator -> total slashed fraction
+	rewardRecords   map[string][]*lizenzv1.RewardRecord // validator -> recorded epoch rewards
 }
 
-func (m *MockLizenzKeeper) GetAllActivatedLizenz(ctx sdk.Context) ([]interface{}, error) {
+func (m *MockLizenzKeeper) GetAllActivatedLizenz(ctx sdk.Context) ([]*lizenzv1.ActivatedLizenz, error) {
 	if err, ok := m.errors["GetAllActivatedLizenz"]; ok {
 		return nil, err
 	}
-	// Convert map[string]interface{} to MockActivatedLizenz structures
-	result := make([]interface{}, len(m.activatedLizenz))
-	for i, liz := range m.activatedLizenz {
-		if lizMap, ok := liz.(map[string]interface{}); ok {
-			validator := ""
-			amount := ""
-			if v, ok := lizMap["validator"].(string); ok {
-				validator = v
-			}
-			if a, ok := lizMap["amount"].(string); ok {
-				amount = a
-			}
-			result[i] = &MockActivatedLizenz{
-				Validator: validator,
-				Amount:    amount,
-			}
-		} else {
-			result[i] = liz
-		}
-	}
-	return result, nil
+	return m.activatedLizenz, nil
 }
 
 func (m *MockLizenzKeeper) GetTotalActivatedLizenz(ctx sdk.Context) (string, error) {
@@ -63,12 +41,8 @@ func (m *MockLizenzKeeper) GetTotalActivatedLizenz(ctx sdk.Context) (string, err
 	// Calculate total from activatedLizenz
 	total := uint64(0)
 	for _, liz := range m.activatedLizenz {
-		if lizMap, ok := liz.(map[string]interface{}); ok {
-			if amountStr, ok := lizMap["amount"].(string); ok {
-				if amount, err := strconv.ParseUint(amountStr, 10, 64); err == nil {
-					total += amount
-				}
-			}
+		if amount, err := strconv.ParseUint(liz.Amount, 10, 64); err == nil {
+			total += amount
 		}
 	}
 	return strconv.FormatUint(total, 10), nil
@@ -84,13 +58,44 @@ func (m *MockLizenzKeeper) GetMOACompliance(ctx sdk.Context, validator string) (
 	return 1.0, nil // Default to full compliance
 }
 
-func (m *MockLizenzKeeper) UpdateRewardStats(ctx sdk.Context, validator string, rewardAmount uint64, blockHeight uint64, moaCompliance float64, penaltyMultiplier float64, baseReward uint64) error {
-	if err, ok := m.errors["UpdateRewardStats"]; ok {
+func (m *MockLizenzKeeper) GetActivatedLizenz(ctx sdk.Context, validator string) (*lizenzv1.ActivatedLizenz, error) {
+	if err, ok := m.errors["GetActivatedLizenz"]; ok {
+		return nil, err
+	}
+	for _, liz := range m.activatedLizenz {
+		if liz.Validator == validator {
+			return liz, nil
+		}
+	}
+	return nil, fmt.Errorf("validator has no activated LZN")
+}
+
+func (m *MockLizenzKeeper) RecordEpochReward(ctx sdk.Context, validator string, record *lizenzv1.RewardRecord) error {
+	if err, ok := m.errors["RecordEpochReward"]; ok {
 		return err
 	}
+	if m.rewardRecords == nil {
+		m.rewardRecords = make(map[string][]*lizenzv1.RewardRecord)
+	}
+	m.rewardRecords[validator] = append(m.rewardRecords[validator], record)
 	return nil
 }
 
+func (m *MockLizenzKeeper) SlashActivatedLizenz(ctx sdk.Context, validator string, fraction math.LegacyDec) (math.Int, error) {
+	if err, ok := m.errors["SlashActivatedLizenz"]; ok {
+		return math.ZeroInt(), err
+	}
+	if m.slashed == nil {
+		m.slashed = make(map[string]math.LegacyDec)
+	}
+	total, ok := m.slashed[validator]
+	if !ok {
+		total = math.LegacyZeroDec()
+	}
+	m.slashed[validator] = total.Add(fraction)
+	return math.NewInt(100), nil
+}
+
 
 // MockBankKeeper is a mock implementation of BankKeeperInterface for testing
 type MockBankKeeper struct {
@@ -186,15 +191,9 @@ func (suite *BankKeeperTestSuite) TestDistributeRewardsWithBankKeeper() {
 	
 	// Create a mock lizenz keeper
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": validator1Addr.String(),
-				"amount":    "1000000",
-			},
-			map[string]interface{}{
-				"validator": validator2Addr.String(),
-				"amount":    "2000000",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: validator1Addr.String(), Amount: "1000000"},
+			{Validator: validator2Addr.String(), Amount: "2000000"},
 		},
 		moaCompliance: make(map[string]float64),
 	}
@@ -211,6 +210,13 @@ func (suite *BankKeeperTestSuite) TestDistributeRewardsWithBankKeeper() {
 	mintedCoins := suite.mockBankKeeper.GetMintedCoins(types.ModuleName)
 	require.NotEmpty(suite.T(), mintedCoins, "coins should be minted")
 
+	// Rewards stay in the module account until they are withdrawn
+	require.Empty(suite.T(), suite.mockBankKeeper.sentCoins)
+	_, err = suite.keeper.WithdrawRewards(suite.ctx, validator1Addr.String())
+	require.NoError(suite.T(), err)
+	_, err = suite.keeper.WithdrawRewards(suite.ctx, validator2Addr.String())
+	require.NoError(suite.T(), err)
+
 	// Check that coins were sent to validators
 	// Check all sent coins (might be stored by different key format)
 	hasSentCoins := false
@@ -230,11 +236,8 @@ func (suite *BankKeeperTestSuite) TestDistributeRewardsWithBankKeeper() {
 func (suite *BankKeeperTestSuite) TestDistributeRewardsMintError() {
 	// Create a mock lizenz keeper
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": "volnix1validator1",
-				"amount":    "1000000",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: "volnix1validator1", Amount: "1000000"},
 		},
 	}
 	suite.keeper.SetLizenzKeeper(mockLizenzKeeper)
@@ -249,26 +252,33 @@ func (suite *BankKeeperTestSuite) TestDistributeRewardsMintError() {
 	require.NoError(suite.T(), err)
 }
 
-// TestDistributeRewardsSendError tests handling of send errors
+// TestDistributeRewardsSendError tests that a failed withdrawal keeps the accrued rewards
 func (suite *BankKeeperTestSuite) TestDistributeRewardsSendError() {
+	validator1Addr := sdk.AccAddress("validator1_______________")
+
 	// Create a mock lizenz keeper
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": "volnix1validator1",
-				"amount":    "1000000",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: validator1Addr.String(), Amount: "1000000"},
 		},
 	}
 	suite.keeper.SetLizenzKeeper(mockLizenzKeeper)
 
-	validator1Addr, _ := sdk.AccAddressFromBech32("volnix1validator1")
 	suite.mockBankKeeper.SetSendError(validator1Addr.String(), types.ErrInvalidBaseBlockTime)
 
-	// Distribute rewards - should not fail, but log error
+	// Distribute rewards - sending only happens on withdrawal
 	suite.ctx = suite.ctx.WithBlockHeight(1000)
 	err := suite.keeper.DistributeBaseRewards(suite.ctx, 1000)
 	require.NoError(suite.T(), err)
+
+	_, err = suite.keeper.WithdrawRewards(suite.ctx, validator1Addr.String())
+	require.Error(suite.T(), err)
+
+	// The rewards can still be withdrawn later
+	delete(suite.mockBankKeeper.sendErrors, validator1Addr.String())
+	amount, err := suite.keeper.WithdrawRewards(suite.ctx, validator1Addr.String())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), math.NewInt(50000000), amount)
 }
 
 // TestDistributeRewardsNoBankKeeper tests that rewards are calculated but not sent without bank keeper
@@ -278,11 +288,8 @@ func (suite *BankKeeperTestSuite) TestDistributeRewardsNoBankKeeper() {
 
 	// Create a mock lizenz keeper
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": "volnix1validator1",
-				"amount":    "1000000",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: "volnix1validator1", Amount: "1000000"},
 		},
 	}
 	suite.keeper.SetLizenzKeeper(mockLizenzKeeper)
@@ -301,15 +308,9 @@ func (suite *BankKeeperTestSuite) TestRewardAmountCalculation() {
 	
 	// Create a mock lizenz keeper with known amounts
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": validator1Addr.String(),
-				"amount":    "1000000", // 1M LZN
-			},
-			map[string]interface{}{
-				"validator": validator2Addr.String(),
-				"amount":    "2000000", // 2M LZN
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: validator1Addr.String(), Amount: "1000000"}, // 1M LZN
+			{Validator: validator2Addr.String(), Amount: "2000000"}, // 2M LZN
 		},
 		moaCompliance: make(map[string]float64),
 	}
@@ -327,24 +328,19 @@ func (suite *BankKeeperTestSuite) TestRewardAmountCalculation() {
 	
 	// Check that coins were minted
 	mintedCoins := suite.mockBankKeeper.GetMintedCoins(types.ModuleName)
-	require.NotEmpty(suite.T(), mintedCoins, "coins should be minted")
-	
-	// Check that coins were sent to validators
-	// Note: The actual addresses used might be different, so we check all sent coins
-	allSentCoins := make(map[string]sdk.Coins)
-	for k, v := range suite.mockBankKeeper.sentCoins {
-		allSentCoins[k] = v
-	}
-	
-	require.NotEmpty(suite.T(), allSentCoins, "coins should be sent to validators")
-	
-	// Check that total sent coins match minted coins
-	totalSent := math.ZeroInt()
-	for _, coins := range allSentCoins {
-		totalSent = totalSent.Add(coins.AmountOf("uwrt"))
-	}
-	require.True(suite.T(), totalSent.GT(math.ZeroInt()), "total sent should be greater than zero")
-	// Note: total sent may exceed minted if multiple mints occur (one per validator)
-	// This is expected behavior - each validator gets their own mint operation
-}
+	require.Equal(suite.T(), math.NewInt(50000000), mintedCoins.AmountOf("uwrt"))
 
+	// Withdraw the rewards of both validators
+	amount1, err := suite.keeper.WithdrawRewards(suite.ctx, validator1Addr.String())
+	require.NoError(suite.T(), err)
+	amount2, err := suite.keeper.WithdrawRewards(suite.ctx, validator2Addr.String())
+	require.NoError(suite.T(), err)
+
+	require.Equal(suite.T(), math.NewInt(16666666), amount1)
+	require.Equal(suite.T(), math.NewInt(33333333), amount2)
+	require.Equal(suite.T(), amount1, suite.mockBankKeeper.sentCoins[validator1Addr.String()].AmountOf("uwrt"))
+	require.Equal(suite.T(), amount2, suite.mockBankKeeper.sentCoins[validator2Addr.String()].AmountOf("uwrt"))
+
+	// Rounding dust stays in the module account: validators are never paid more than was minted
+	require.True(suite.T(), amount1.Add(amount2).LTE(mintedCoins.AmountOf("uwrt")))
+}
@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+const (
+	// defaultAverageBlockTime is returned until the window holds a positive average
+	defaultAverageBlockTime = 5 * time.Second
+
+	// defaultAverageBlockTimeWindowSize is used when the window size param is unset
+	defaultAverageBlockTimeWindowSize = 1000
+)
+
+// RecordBlockTime records the time for a block
+// This is used to calculate average block time for adaptive halving
+//
+// The recorded block times form a ring buffer of at most AverageBlockTimeWindowSize intervals:
+// the newest interval is added to a running sum and, once the window is full, the oldest
+// block time is deleted and its interval subtracted, so each block costs a constant number
+// of store operations. Heights at or below the newest recorded height are ignored.
+func (k Keeper) RecordBlockTime(ctx sdk.Context, height uint64) error {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockTime()
+
+	window, found := k.GetBlockTimeWindow(ctx)
+	if found && height <= window.NewestHeight {
+		return nil
+	}
+
+	if err := setBlockTime(store, height, blockTime); err != nil {
+		return err
+	}
+
+	if !found {
+		return k.setBlockTimeWindow(ctx, &consensusv1.BlockTimeWindow{
+			OldestHeight: height,
+			NewestHeight: height,
+		})
+	}
+
+	newest, err := getBlockTime(store, window.NewestHeight)
+	if err != nil {
+		return err
+	}
+	window.IntervalSum += int64(blockTime.Sub(newest))
+	window.IntervalCount++
+	window.NewestHeight = height
+
+	// Drop the oldest block times that fell out of the window
+	windowSize := k.GetParams(ctx).AverageBlockTimeWindowSize
+	if windowSize == 0 {
+		windowSize = defaultAverageBlockTimeWindowSize
+	}
+	for window.IntervalCount > windowSize {
+		if err := dropOldestBlockTime(store, window); err != nil {
+			return err
+		}
+	}
+
+	return k.setBlockTimeWindow(ctx, window)
+}
+
+// GetAverageBlockTime returns the average block time over the block time window
+func (k Keeper) GetAverageBlockTime(ctx sdk.Context) (time.Duration, error) {
+	window, found := k.GetBlockTimeWindow(ctx)
+	if !found || window.IntervalCount == 0 || window.IntervalSum <= 0 {
+		// Return default if not calculated yet
+		return defaultAverageBlockTime, nil
+	}
+
+	return time.Duration(window.IntervalSum / int64(window.IntervalCount)), nil
+}
+
+// MigrateBlockTimeWindow rebuilds the block time window from block times recorded before it existed
+// Block times older than the window are deleted together with the legacy stored average
+func (k Keeper) MigrateBlockTimeWindow(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AverageBlockTimeKey)
+
+	prefix := types.BlockTimeKeyPrefix
+	iterator := store.Iterator(prefix, append(append([]byte{}, prefix...), 0xFF))
+	var heights []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		heights = append(heights, sdk.BigEndianToUint64(iterator.Key()[len(prefix):]))
+	}
+	iterator.Close()
+
+	if len(heights) == 0 {
+		store.Delete(types.BlockTimeWindowKey)
+		return nil
+	}
+
+	windowSize := k.GetParams(ctx).AverageBlockTimeWindowSize
+	if windowSize == 0 {
+		windowSize = defaultAverageBlockTimeWindowSize
+	}
+	if uint64(len(heights)) > windowSize+1 {
+		for _, height := range heights[:uint64(len(heights))-windowSize-1] {
+			store.Delete(types.GetBlockTimeKey(height))
+		}
+		heights = heights[uint64(len(heights))-windowSize-1:]
+	}
+
+	oldest, err := getBlockTime(store, heights[0])
+	if err != nil {
+		return err
+	}
+	newest, err := getBlockTime(store, heights[len(heights)-1])
+	if err != nil {
+		return err
+	}
+
+	// Consecutive intervals telescope, so their sum is the span of the window
+	return k.setBlockTimeWindow(ctx, &consensusv1.BlockTimeWindow{
+		OldestHeight:  heights[0],
+		NewestHeight:  heights[len(heights)-1],
+		IntervalCount: uint64(len(heights) - 1),
+		IntervalSum:   int64(newest.Sub(oldest)),
+	})
+}
+
+// dropOldestBlockTime deletes the oldest block time of the window and subtracts its interval
+func dropOldestBlockTime(store storetypes.KVStore, window *consensusv1.BlockTimeWindow) error {
+	oldest, err := getBlockTime(store, window.OldestHeight)
+	if err != nil {
+		return err
+	}
+
+	// The next recorded block time becomes the oldest one
+	iterator := store.Iterator(types.GetBlockTimeKey(window.OldestHeight+1), types.GetBlockTimeKey(window.NewestHeight+1))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return fmt.Errorf("block time window is empty after height %d", window.OldestHeight)
+	}
+	nextHeight := sdk.BigEndianToUint64(iterator.Key()[len(types.BlockTimeKeyPrefix):])
+	var next time.Time
+	if err := next.UnmarshalBinary(iterator.Value()); err != nil {
+		return fmt.Errorf("failed to unmarshal block time at height %d: %w", nextHeight, err)
+	}
+
+	store.Delete(types.GetBlockTimeKey(window.OldestHeight))
+	window.IntervalSum -= int64(next.Sub(oldest))
+	window.IntervalCount--
+	window.OldestHeight = nextHeight
+	return nil
+}
+
+// GetBlockTimeWindow returns the rolling block time window
+func (k Keeper) GetBlockTimeWindow(ctx sdk.Context) (*consensusv1.BlockTimeWindow, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockTimeWindowKey)
+	if bz == nil {
+		return nil, false
+	}
+
+	var window consensusv1.BlockTimeWindow
+	k.cdc.MustUnmarshal(bz, &window)
+	return &window, true
+}
+
+func (k Keeper) setBlockTimeWindow(ctx sdk.Context, window *consensusv1.BlockTimeWindow) error {
+	bz, err := k.cdc.Marshal(window)
+	if err != nil {
+		return fmt.Errorf("failed to marshal block time window: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.BlockTimeWindowKey, bz)
+	return nil
+}
+
+// setBlockTime stores a block time in UTC, so the encoding does not depend on the node's time zone
+func setBlockTime(store storetypes.KVStore, height uint64, blockTime time.Time) error {
+	timeBz, err := blockTime.UTC().MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to marshal block time: %w", err)
+	}
+	store.Set(types.GetBlockTimeKey(height), timeBz)
+	return nil
+}
+
+func getBlockTime(store storetypes.KVStore, height uint64) (time.Time, error) {
+	var blockTime time.Time
+	timeBz := store.Get(types.GetBlockTimeKey(height))
+	if timeBz == nil {
+		return blockTime, fmt.Errorf("block time not recorded at height %d", height)
+	}
+	if err := blockTime.UnmarshalBinary(timeBz); err != nil {
+		return blockTime, fmt.Errorf("failed to unmarshal block time at height %d: %w", height, err)
+	}
+	return blockTime, nil
+}
+
+// addBlockBurnedAnt adds burned ANT to the total of the current block
+func (k Keeper) addBlockBurnedAnt(ctx sdk.Context, amount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	burned := amount
+	if bz := store.Get(types.BlockBurnedAntKey); bz != nil {
+		burned += sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.BlockBurnedAntKey, sdk.Uint64ToBigEndian(burned))
+}
+
+// GetBlockBurnedAnt returns the ANT burned so far in the current block
+func (k Keeper) GetBlockBurnedAnt(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockBurnedAntKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// UpdateNextBlockDelay consumes the ANT burned in the current block and stores the delay before the next block
+// High burn activity shortens the delay by the activity factors in params; a block without burns uses the normal factor
+func (k Keeper) UpdateNextBlockDelay(ctx sdk.Context) time.Duration {
+	store := ctx.KVStore(k.storeKey)
+	burned := k.GetBlockBurnedAnt(ctx)
+	store.Delete(types.BlockBurnedAntKey)
+
+	params := k.GetParams(ctx)
+	delay := calculateBlockTime(&params, burned)
+	store.Set(types.NextBlockDelayKey, sdk.Uint64ToBigEndian(uint64(delay)))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlockTimeAdjusted,
+			sdk.NewAttribute(types.AttributeKeyBlockTime, delay.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnAmount, fmt.Sprintf("%d", burned)),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
+	return delay
+}
+
+// GetNextBlockDelay returns the delay before the next block computed at the last EndBlocker
+// Until the first block is processed the base block time is returned
+func (k Keeper) GetNextBlockDelay(ctx sdk.Context) time.Duration {
+	if bz := ctx.KVStore(k.storeKey).Get(types.NextBlockDelayKey); bz != nil {
+		return time.Duration(sdk.BigEndianToUint64(bz))
+	}
+
+	baseBlockTime, err := time.ParseDuration(k.GetParams(ctx).BaseBlockTime)
+	if err != nil {
+		return defaultAverageBlockTime
+	}
+	return baseBlockTime
+}
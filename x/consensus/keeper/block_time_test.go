@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// recordBlockTimes records one block time per interval, starting at height 1
+func (suite *KeeperTestSuite) recordBlockTimes(start time.Time, intervals []time.Duration) {
+	blockTime := start
+	require.NoError(suite.T(), suite.keeper.RecordBlockTime(suite.ctx.WithBlockTime(blockTime), 1))
+	for i, interval := range intervals {
+		blockTime = blockTime.Add(interval)
+		require.NoError(suite.T(), suite.keeper.RecordBlockTime(suite.ctx.WithBlockTime(blockTime), uint64(i+2)))
+	}
+}
+
+// TestRecordBlockTime_RollingWindow tests the average only covers the last window of intervals
+func (suite *KeeperTestSuite) TestRecordBlockTime_RollingWindow() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AverageBlockTimeWindowSize = 3
+	suite.keeper.SetParams(suite.ctx, params)
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.recordBlockTimes(start, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 4 * time.Second, 5 * time.Second})
+
+	avgTime, err := suite.keeper.GetAverageBlockTime(suite.ctx)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 4*time.Second, avgTime)
+
+	window, found := suite.keeper.GetBlockTimeWindow(suite.ctx)
+	require.True(suite.T(), found)
+	require.Equal(suite.T(), uint64(3), window.OldestHeight)
+	require.Equal(suite.T(), uint64(6), window.NewestHeight)
+	require.Equal(suite.T(), uint64(3), window.IntervalCount)
+
+	// Block times older than the window are deleted
+	store := suite.ctx.KVStore(suite.storeKey)
+	require.False(suite.T(), store.Has(types.GetBlockTimeKey(1)))
+	require.False(suite.T(), store.Has(types.GetBlockTimeKey(2)))
+	require.True(suite.T(), store.Has(types.GetBlockTimeKey(3)))
+
+	// A height that was already recorded does not change the window
+	require.NoError(suite.T(), suite.keeper.RecordBlockTime(suite.ctx.WithBlockTime(start.Add(time.Hour)), 6))
+	avgTime, err = suite.keeper.GetAverageBlockTime(suite.ctx)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 4*time.Second, avgTime)
+}
+
+// TestMigrateBlockTimeWindow tests legacy block times are pruned into the window
+func (suite *KeeperTestSuite) TestMigrateBlockTimeWindow() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AverageBlockTimeWindowSize = 3
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// Legacy state: every block time since genesis plus a stored average
+	store := suite.ctx.KVStore(suite.storeKey)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for h := uint64(1); h <= 10; h++ {
+		bz, err := start.Add(time.Duration(h*h) * time.Second).MarshalBinary()
+		require.NoError(suite.T(), err)
+		store.Set(types.GetBlockTimeKey(h), bz)
+	}
+	store.Set(types.AverageBlockTimeKey, []byte("1000"))
+
+	require.NoError(suite.T(), suite.keeper.MigrateBlockTimeWindow(suite.ctx))
+
+	require.False(suite.T(), store.Has(types.AverageBlockTimeKey))
+	for h := uint64(1); h <= 6; h++ {
+		require.False(suite.T(), store.Has(types.GetBlockTimeKey(h)))
+	}
+	// Intervals 7→8, 8→9, 9→10 are 15s, 17s and 19s
+	avgTime, err := suite.keeper.GetAverageBlockTime(suite.ctx)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 17*time.Second, avgTime)
+
+	// Recording continues on the migrated window
+	require.NoError(suite.T(), suite.keeper.RecordBlockTime(suite.ctx.WithBlockTime(start.Add(121*time.Second)), 11))
+	require.False(suite.T(), store.Has(types.GetBlockTimeKey(7)))
+	avgTime, err = suite.keeper.GetAverageBlockTime(suite.ctx)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 19*time.Second, avgTime)
+}
+
+// burnInAuction completes a single-bid auction so the winning bid is burned in the current block
+func (suite *KeeperTestSuite) burnInAuction(height uint64, bidAmount string) {
+	require.NoError(suite.T(), suite.keeper.SetBlindAuction(suite.ctx, &consensusv1.BlindAuction{
+		BlockHeight: height,
+		Phase:       consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL,
+		Reveals: []*consensusv1.BidReveal{
+			{Validator: "cosmos1validator1", BidAmount: bidAmount, Nonce: "nonce1", BlockHeight: height},
+		},
+	}))
+	_, _, err := suite.keeper.SelectAuctionWinner(suite.ctx, height)
+	require.NoError(suite.T(), err)
+}
+
+// TestUpdateNextBlockDelay tests the next block delay follows the ANT burned in the block
+func (suite *KeeperTestSuite) TestUpdateNextBlockDelay() {
+	suite.keeper.SetAnteilKeeper(&MockAnteilKeeper{positions: make(map[string]*anteilv1.UserPosition)})
+
+	// The base block time applies before the first block
+	require.Equal(suite.T(), 5*time.Second, suite.keeper.GetNextBlockDelay(suite.ctx))
+
+	// High activity: 1000 ANT burned halves the block time
+	suite.burnInAuction(1000, "600")
+	suite.burnInAuction(1001, "400")
+	require.Equal(suite.T(), uint64(1000), suite.keeper.GetBlockBurnedAnt(suite.ctx))
+	require.Equal(suite.T(), 2500*time.Millisecond, suite.keeper.UpdateNextBlockDelay(suite.ctx))
+	require.Equal(suite.T(), 2500*time.Millisecond, suite.keeper.GetNextBlockDelay(suite.ctx))
+	require.Zero(suite.T(), suite.keeper.GetBlockBurnedAnt(suite.ctx))
+
+	// Medium activity
+	suite.burnInAuction(1002, "500")
+	require.Equal(suite.T(), 3750*time.Millisecond, suite.keeper.UpdateNextBlockDelay(suite.ctx))
+
+	// A block without burns returns to the base block time
+	require.Equal(suite.T(), 5*time.Second, suite.keeper.UpdateNextBlockDelay(suite.ctx))
+	require.Equal(suite.T(), 5*time.Second, suite.keeper.GetNextBlockDelay(suite.ctx))
+}
+
+// TestEndBlocker_NextBlockDelayIncludesAuctionBurn tests the winning bid burned by EndBlocker shortens the next block
+func (suite *KeeperTestSuite) TestEndBlocker_NextBlockDelayIncludesAuctionBurn() {
+	suite.keeper.SetAnteilKeeper(&MockAnteilKeeper{positions: map[string]*anteilv1.UserPosition{
+		"cosmos1validator1": {Owner: "cosmos1validator1", AntBalance: "5000"},
+	}})
+	require.NoError(suite.T(), suite.keeper.SetBlindAuction(suite.ctx, &consensusv1.BlindAuction{
+		BlockHeight:     31,
+		Phase:           consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL,
+		CommitEndHeight: 28,
+		RevealEndHeight: 30,
+		Reveals: []*consensusv1.BidReveal{
+			{Validator: "cosmos1validator1", BidAmount: "1000", Nonce: "nonce1", BlockHeight: 31},
+		},
+	}))
+
+	// The reveal window closes at height 30: the winner's bid is burned in that block
+	suite.runEndBlockers(30, 30)
+	auction, err := suite.keeper.GetBlindAuction(suite.ctx, 31)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "cosmos1validator1", auction.Winner)
+	require.Equal(suite.T(), 2500*time.Millisecond, suite.keeper.GetNextBlockDelay(suite.ctx))
+	require.Zero(suite.T(), suite.keeper.GetBlockBurnedAnt(suite.ctx))
+}
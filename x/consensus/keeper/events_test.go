@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
 )
@@ -39,7 +40,8 @@ func (suite *EventsTestSuite) TestBidCommittedEvent() {
 	commitHash := keeper.HashCommit(nonce, bidAmount)
 	height := uint64(1000)
 
-	suite.ctx = suite.ctx.WithBlockHeight(int64(height))
+	// Inside the commit window of the auction
+	suite.ctx = suite.ctx.WithBlockHeight(int64(height) - 10)
 
 	err := suite.keeper.CommitBid(suite.ctx, validator, commitHash, height)
 	require.NoError(suite.T(), err)
@@ -73,7 +75,8 @@ func (suite *EventsTestSuite) TestBidRevealedEvent() {
 	bidAmount := "1000000"
 	height := uint64(1000)
 
-	suite.ctx = suite.ctx.WithBlockHeight(int64(height))
+	// Inside the commit window of the auction
+	suite.ctx = suite.ctx.WithBlockHeight(int64(height) - 10)
 
 	// First commit - generate valid hash
 	commitHash := keeper.HashCommit(nonce, bidAmount)
@@ -119,18 +122,16 @@ func (suite *EventsTestSuite) TestRewardDistributedEvent() {
 	suite.keeper.SetBankKeeper(mockBankKeeper)
 
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": sdk.AccAddress("validator1_______________").String(),
-				"amount":    "1000000",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: sdk.AccAddress("validator1_______________").String(), Amount: "1000000"},
 		},
 		moaCompliance: make(map[string]float64),
 	}
 	suite.keeper.SetLizenzKeeper(mockLizenzKeeper)
 
 	height := uint64(1000)
-	suite.ctx = suite.ctx.WithBlockHeight(int64(height))
+	// Inside the commit window of the auction
+	suite.ctx = suite.ctx.WithBlockHeight(int64(height) - 10)
 
 	// Distribute rewards
 	err := suite.keeper.DistributeBaseRewards(suite.ctx, height)
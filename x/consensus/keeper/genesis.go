@@ -0,0 +1,371 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// InitGenesis initializes genesis state
+// A new chain names only its initial validators, whose consensus keys and power are registered here.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if genState.Params != nil {
+		k.SetParams(ctx, *genState.Params)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	for _, validator := range genState.Validators {
+		k.SetValidator(ctx, validator)
+	}
+
+	for _, weight := range genState.ValidatorWeights {
+		if err := k.SetValidatorWeight(ctx, weight.Validator, weight.Weight); err != nil {
+			panic(fmt.Errorf("failed to set validator weight: %w", err))
+		}
+	}
+
+	for _, blockCreator := range genState.BlockCreators {
+		k.SetBlockCreator(ctx, blockCreator)
+	}
+
+	// Link genesis consensus keys to PoVB validators for slashing and validator set updates
+	// An exported state carries the keys, signing infos and powers itself
+	if len(genState.ValidatorConsPubKeys) == 0 {
+		for _, initial := range genState.InitialValidators {
+			if initial == nil || initial.Validator == "" {
+				continue
+			}
+			if err := k.SetValidatorConsPubKey(ctx, initial.Validator, initial.PubKey); err != nil {
+				ctx.Logger().Error("failed to set validator consensus key", "error", err, "validator", initial.Validator)
+				continue
+			}
+			// CometBFT starts with the genesis power; later blocks send only changes
+			k.setLastValidatorPower(ctx, initial.Validator, initial.Power)
+		}
+	}
+
+	for _, pubKey := range genState.ValidatorConsPubKeys {
+		store.Set(types.GetValidatorConsPubKeyKey(pubKey.Validator), pubKey.PubKey)
+	}
+	for _, consAddr := range genState.ValidatorConsAddresses {
+		store.Set(types.GetValidatorByConsAddrKey(consAddr.ConsAddress), []byte(consAddr.Validator))
+	}
+	for _, power := range genState.LastValidatorPowers {
+		k.setLastValidatorPower(ctx, power.Validator, power.Power)
+	}
+	for _, info := range genState.SigningInfos {
+		if err := k.SetValidatorSigningInfo(ctx, info); err != nil {
+			panic(err)
+		}
+	}
+	for _, missed := range genState.MissedBlocks {
+		k.setMissedBlockBit(ctx, missed.Validator, missed.Index, true)
+	}
+	for _, fault := range genState.AuctionFaults {
+		if err := k.SetAuctionFault(ctx, fault); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, auction := range genState.BlindAuctions {
+		if err := k.SetBlindAuction(ctx, auction); err != nil {
+			panic(err)
+		}
+	}
+	for _, history := range genState.BidHistories {
+		if err := k.setBidHistory(ctx, history); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the halving info, the default schedule on a new chain
+	halvingInfo := types.HalvingInfo{
+		LastHalvingHeight: 0,
+		HalvingInterval:   HalvingInterval,
+		NextHalvingHeight: HalvingInterval,
+	}
+	if genState.HalvingInfo != nil {
+		halvingInfo = *genState.HalvingInfo
+	}
+	if err := k.SetHalvingInfo(ctx, halvingInfo); err != nil {
+		// Log error but don't fail genesis
+		ctx.Logger().Error("failed to set halving info", "error", err)
+	}
+
+	// Set the consensus state, empty on a new chain
+	if genState.ConsensusState != nil {
+		if err := k.SetConsensusState(ctx, *genState.ConsensusState); err != nil {
+			ctx.Logger().Error("failed to set consensus state", "error", err)
+		}
+	} else if err := k.UpdateConsensusState(ctx, 0, "0", []string{}); err != nil {
+		// Log error but don't fail genesis
+		ctx.Logger().Error("failed to set default consensus state", "error", err)
+	}
+
+	for _, record := range genState.BlockTimes {
+		if err := setBlockTime(store, record.Height, record.Time.AsTime()); err != nil {
+			panic(err)
+		}
+	}
+	if genState.BlockTimeWindow != nil {
+		if err := k.setBlockTimeWindow(ctx, genState.BlockTimeWindow); err != nil {
+			panic(err)
+		}
+	}
+	if genState.BlockBurnedAnt > 0 {
+		k.addBlockBurnedAnt(ctx, genState.BlockBurnedAnt)
+	}
+	if genState.NextBlockDelay > 0 {
+		store.Set(types.NextBlockDelayKey, sdk.Uint64ToBigEndian(uint64(genState.NextBlockDelay)))
+	}
+
+	if genState.RewardPool != nil {
+		if err := k.setRewardPool(ctx, genState.RewardPool); err != nil {
+			panic(err)
+		}
+	}
+	for _, vr := range genState.ValidatorRewards {
+		k.setValidatorRewards(ctx, vr)
+	}
+}
+
+// ExportGenesis exports genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
+	params := k.GetParams(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	weights, err := k.GetAllValidatorWeights(ctx)
+	if err != nil {
+		panic(err)
+	}
+	validatorWeights := make([]*consensusv1.ValidatorWeight, 0, len(weights))
+	for i := range weights {
+		validatorWeights = append(validatorWeights, &weights[i])
+	}
+
+	var blockCreators []*consensusv1.BlockCreator
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyBlockCreatorPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var blockCreator consensusv1.BlockCreator
+		k.cdc.MustUnmarshal(iterator.Value(), &blockCreator)
+		blockCreators = append(blockCreators, &blockCreator)
+	}
+	iterator.Close()
+
+	var blindAuctions []*consensusv1.BlindAuction
+	iterator = storetypes.KVStorePrefixIterator(store, types.KeyBlindAuctionPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var auction consensusv1.BlindAuction
+		k.cdc.MustUnmarshal(iterator.Value(), &auction)
+		blindAuctions = append(blindAuctions, &auction)
+	}
+	iterator.Close()
+
+	var bidHistories []*consensusv1.BidHistory
+	iterator = storetypes.KVStorePrefixIterator(store, types.BidHistoryKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.BidHistoryKeyPrefix):]
+		history, err := decodeBidHistory(string(key), iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+		bidHistories = append(bidHistories, history)
+	}
+	iterator.Close()
+
+	// The CometBFT validator set is the registered validators with voting power
+	var (
+		initialValidators []*consensusv1.InitialValidator
+		consPubKeys       []*consensusv1.ValidatorConsPubKey
+		lastPowers        []*consensusv1.LastValidatorPower
+	)
+	for _, validator := range k.getRegisteredValidators(ctx) {
+		pubKey, err := k.GetValidatorConsPubKey(ctx, validator)
+		if err != nil {
+			continue
+		}
+		consPubKeys = append(consPubKeys, &consensusv1.ValidatorConsPubKey{Validator: validator, PubKey: pubKey})
+
+		power := k.GetLastValidatorPower(ctx, validator)
+		if power <= 0 {
+			continue
+		}
+		initialValidators = append(initialValidators, &consensusv1.InitialValidator{
+			PubKey:    pubKey,
+			Power:     power,
+			Validator: validator,
+		})
+	}
+	iterator = storetypes.KVStorePrefixIterator(store, types.LastValidatorPowerKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.LastValidatorPowerKeyPrefix):]
+		lastPowers = append(lastPowers, &consensusv1.LastValidatorPower{
+			Validator: string(key),
+			Power:     int64(binary.BigEndian.Uint64(iterator.Value())),
+		})
+	}
+	iterator.Close()
+
+	var consAddresses []*consensusv1.ValidatorConsAddress
+	iterator = storetypes.KVStorePrefixIterator(store, types.ValidatorByConsAddrKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.ValidatorByConsAddrKeyPrefix):]
+		consAddresses = append(consAddresses, &consensusv1.ValidatorConsAddress{
+			ConsAddress: append([]byte{}, key...),
+			Validator:   string(iterator.Value()),
+		})
+	}
+	iterator.Close()
+
+	var signingInfos []*consensusv1.ValidatorSigningInfo
+	iterator = storetypes.KVStorePrefixIterator(store, types.ValidatorSigningInfoKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var info consensusv1.ValidatorSigningInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		signingInfos = append(signingInfos, &info)
+	}
+	iterator.Close()
+
+	// Missed block keys are the length-prefixed validator followed by the window index
+	var missedBlocks []*consensusv1.MissedBlock
+	iterator = storetypes.KVStorePrefixIterator(store, types.ValidatorMissedBlockKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.ValidatorMissedBlockKeyPrefix):]
+		validator := string(key[1 : 1+int(key[0])])
+		missedBlocks = append(missedBlocks, &consensusv1.MissedBlock{
+			Validator: validator,
+			Index:     binary.BigEndian.Uint64(key[1+len(validator):]),
+		})
+	}
+	iterator.Close()
+
+	auctionFaults, err := k.GetAllAuctionFaults(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var blockTimes []*consensusv1.BlockTimeRecord
+	iterator = storetypes.KVStorePrefixIterator(store, types.BlockTimeKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.BlockTimeKeyPrefix):]
+		var blockTime time.Time
+		if err := blockTime.UnmarshalBinary(iterator.Value()); err != nil {
+			panic(fmt.Errorf("failed to unmarshal block time: %w", err))
+		}
+		blockTimes = append(blockTimes, &consensusv1.BlockTimeRecord{
+			Height: binary.BigEndian.Uint64(key),
+			Time:   timestamppb.New(blockTime),
+		})
+	}
+	iterator.Close()
+	blockTimeWindow, _ := k.GetBlockTimeWindow(ctx)
+
+	var nextBlockDelay int64
+	if bz := store.Get(types.NextBlockDelayKey); bz != nil {
+		nextBlockDelay = int64(sdk.BigEndianToUint64(bz))
+	}
+
+	var halvingInfo *consensusv1.HalvingInfo
+	if bz := store.Get(types.KeyHalvingInfo()); bz != nil {
+		halvingInfo = &consensusv1.HalvingInfo{}
+		k.cdc.MustUnmarshal(bz, halvingInfo)
+	}
+
+	var consensusState *consensusv1.ConsensusState
+	if bz := store.Get(types.KeyConsensusState()); bz != nil {
+		consensusState = &consensusv1.ConsensusState{}
+		k.cdc.MustUnmarshal(bz, consensusState)
+	}
+
+	rewardPool, _ := k.GetRewardPool(ctx)
+	validatorRewards, err := k.GetAllValidatorRewards(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.GenesisState{
+		Params:                 &params,
+		Validators:             k.GetAllValidators(ctx),
+		BlockCreators:          blockCreators,
+		BurnProofs:             []*consensusv1.BurnProof{},
+		ActivityScores:         []*consensusv1.ActivityScore{},
+		HalvingInfo:            halvingInfo,
+		ConsensusState:         consensusState,
+		ValidatorWeights:       validatorWeights,
+		InitialValidators:      initialValidators,
+		BlindAuctions:          blindAuctions,
+		BlockTimes:             blockTimes,
+		BlockTimeWindow:        blockTimeWindow,
+		BlockBurnedAnt:         k.GetBlockBurnedAnt(ctx),
+		NextBlockDelay:         nextBlockDelay,
+		BidHistories:           bidHistories,
+		SigningInfos:           signingInfos,
+		MissedBlocks:           missedBlocks,
+		ValidatorConsAddresses: consAddresses,
+		AuctionFaults:          auctionFaults,
+		ValidatorConsPubKeys:   consPubKeys,
+		LastValidatorPowers:    lastPowers,
+		RewardPool:             rewardPool,
+		ValidatorRewards:       validatorRewards,
+	}
+}
+
+// bidHistoryEntry is the stored JSON form of a bid history entry
+type bidHistoryEntry struct {
+	BidAmount   string `json:"bid_amount"`
+	BlockHeight int64  `json:"block_height"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+// decodeBidHistory decodes the stored bid history of a validator
+func decodeBidHistory(validator string, bz []byte) (*consensusv1.BidHistory, error) {
+	var entries []bidHistoryEntry
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal bid history of %s: %w", validator, err)
+	}
+
+	history := &consensusv1.BidHistory{Validator: validator}
+	for _, entry := range entries {
+		history.Entries = append(history.Entries, &consensusv1.BidHistoryEntry{
+			BidAmount:   entry.BidAmount,
+			Timestamp:   entry.Timestamp,
+			BlockHeight: entry.BlockHeight,
+		})
+	}
+	return history, nil
+}
+
+// setBidHistory stores the bid history of a validator
+func (k Keeper) setBidHistory(ctx sdk.Context, history *consensusv1.BidHistory) error {
+	entries := make([]bidHistoryEntry, 0, len(history.Entries))
+	for _, entry := range history.Entries {
+		entries = append(entries, bidHistoryEntry{
+			BidAmount:   entry.BidAmount,
+			BlockHeight: entry.BlockHeight,
+			Timestamp:   entry.Timestamp,
+		})
+	}
+
+	bz, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bid history: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetBidHistoryKey(history.Validator), bz)
+	return nil
+}
+
+// isValidatorWeightKey reports whether a key under the validator prefix holds a validator weight
+// Validator weights share the "Validator" prefix of the validator records
+func isValidatorWeightKey(key []byte) bool {
+	return bytes.HasPrefix(key, types.KeyValidatorWeightPrefix)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+)
+
+// LizenzHooks registers validators in consensus when they activate or deactivate LZN
+type LizenzHooks struct {
+	k *Keeper
+}
+
+// LizenzHooks returns the lizenz hooks of the consensus keeper
+func (k *Keeper) LizenzHooks() LizenzHooks {
+	return LizenzHooks{k}
+}
+
+// AfterLizenzActivated registers the validator as active with its activated LZN as weight
+// Existing validator records keep their statistics; jailed validators stay jailed
+func (h LizenzHooks) AfterLizenzActivated(ctx sdk.Context, validator string, amount string) error {
+	record, err := h.k.GetValidator(ctx, validator)
+	if err != nil {
+		record = &consensusv1.Validator{
+			Validator:       validator,
+			AntBalance:      "0",
+			MoaScore:        "0",
+			ActivityScore:   "0",
+			TotalBurnAmount: "0",
+		}
+	}
+	if record.Status != consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED {
+		record.Status = consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE
+	}
+	record.LastActive = timestamppb.New(ctx.BlockTime())
+	h.k.SetValidator(ctx, record)
+	h.k.updateRewardStake(ctx, validator)
+
+	return h.k.SetValidatorWeight(ctx, validator, amount)
+}
+
+// AfterDeactivationStarted marks the validator inactive: it no longer takes part in block creation
+func (h LizenzHooks) AfterDeactivationStarted(ctx sdk.Context, validator string, amount string) error {
+	// Deactivating LZN no longer earns block rewards
+	h.k.updateRewardStake(ctx, validator)
+
+	record, err := h.k.GetValidator(ctx, validator)
+	if err != nil {
+		return nil
+	}
+	if record.Status == consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE {
+		record.Status = consensusv1.ValidatorStatus_VALIDATOR_STATUS_INACTIVE
+		h.k.SetValidator(ctx, record)
+	}
+
+	return h.k.SetValidatorWeight(ctx, validator, "0")
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
+)
+
+// MockConsensusHooks records the block creators, auction bids and slashing passed to the hooks
+type MockConsensusHooks struct {
+	creators map[uint64]string
+	commits  []string
+	reveals  []string
+	slashes  []string
+	jails    []string
+	unjails  []string
+}
+
+func (m *MockConsensusHooks) AfterBlockCreatorSelected(ctx sdk.Context, height uint64, creator string) error {
+	m.creators[height] = creator
+	return nil
+}
+
+func (m *MockConsensusHooks) AfterBidCommitted(ctx sdk.Context, height uint64, validator string) error {
+	m.commits = append(m.commits, validator)
+	return nil
+}
+
+func (m *MockConsensusHooks) AfterBidRevealed(ctx sdk.Context, height uint64, validator string) error {
+	m.reveals = append(m.reveals, validator)
+	return nil
+}
+
+func (m *MockConsensusHooks) AfterValidatorSlashed(ctx sdk.Context, validator string, reason string) error {
+	m.slashes = append(m.slashes, reason)
+	return nil
+}
+
+func (m *MockConsensusHooks) AfterValidatorJailed(ctx sdk.Context, validator string, reason string) error {
+	m.jails = append(m.jails, reason)
+	return nil
+}
+
+func (m *MockConsensusHooks) AfterValidatorUnjailed(ctx sdk.Context, validator string) error {
+	m.unjails = append(m.unjails, validator)
+	return nil
+}
+
+// TestLizenzHooks_AfterLizenzActivated tests LZN activation registers an active validator with its LZN as weight
+func (suite *KeeperTestSuite) TestLizenzHooks_AfterLizenzActivated() {
+	hooks := suite.keeper.LizenzHooks()
+	require.NoError(suite.T(), hooks.AfterLizenzActivated(suite.ctx, "cosmos1validator1", "1000000"))
+
+	validator, err := suite.keeper.GetValidator(suite.ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE, validator.Status)
+
+	weight, err := suite.keeper.GetValidatorWeight(suite.ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "1000000", weight)
+
+	// Deactivation removes the validator from block creation
+	require.NoError(suite.T(), hooks.AfterDeactivationStarted(suite.ctx, "cosmos1validator1", "1000000"))
+	validator, err = suite.keeper.GetValidator(suite.ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), consensusv1.ValidatorStatus_VALIDATOR_STATUS_INACTIVE, validator.Status)
+}
+
+// TestLizenzHooks_JailedStaysJailed tests re-activating LZN does not unjail a validator
+func (suite *KeeperTestSuite) TestLizenzHooks_JailedStaysJailed() {
+	suite.keeper.SetValidator(suite.ctx, &consensusv1.Validator{
+		Validator:          "cosmos1validator1",
+		Status:             consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED,
+		TotalBlocksCreated: 7,
+	})
+
+	require.NoError(suite.T(), suite.keeper.LizenzHooks().AfterLizenzActivated(suite.ctx, "cosmos1validator1", "1000000"))
+
+	validator, err := suite.keeper.GetValidator(suite.ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED, validator.Status)
+	require.Equal(suite.T(), uint64(7), validator.TotalBlocksCreated)
+}
+
+// TestSelectBlockCreator_CallsHooks tests the selected block creator is passed to the consensus hooks
+func (suite *KeeperTestSuite) TestSelectBlockCreator_CallsHooks() {
+	suite.keeper.SetValidator(suite.ctx, &consensusv1.Validator{
+		Validator:     "cosmos1validator1",
+		AntBalance:    "1000000",
+		ActivityScore: "500",
+		Status:        consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE,
+		LastActive:    timestamppb.Now(),
+	})
+
+	hooks := &MockConsensusHooks{creators: make(map[uint64]string)}
+	suite.keeper.SetHooks(hooks)
+
+	_, err := suite.keeper.SelectBlockCreator(suite.ctx, 1000)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "cosmos1validator1", hooks.creators[1000])
+}
+
+// TestAuctionBids_CallHooks tests committed and revealed bids are passed to the consensus hooks
+func (suite *KeeperTestSuite) TestAuctionBids_CallHooks() {
+	hooks := &MockConsensusHooks{creators: make(map[uint64]string)}
+	suite.keeper.SetHooks(hooks)
+
+	height := uint64(100)
+	ctx := suite.ctx.WithBlockHeight(90)
+	commitHash := keeper.HashCommit("nonce", "1000")
+	require.NoError(suite.T(), suite.keeper.CommitBid(ctx, "cosmos1validator1", commitHash, height))
+	// A rejected commit is not counted
+	require.Error(suite.T(), suite.keeper.CommitBid(ctx, "cosmos1validator1", commitHash, height))
+	require.Equal(suite.T(), []string{"cosmos1validator1"}, hooks.commits)
+
+	require.NoError(suite.T(), suite.keeper.TransitionAuctionPhase(ctx, height))
+	require.NoError(suite.T(), suite.keeper.RevealBid(suite.ctx.WithBlockHeight(91), "cosmos1validator1", "nonce", "1000", height))
+	require.Equal(suite.T(), []string{"cosmos1validator1"}, hooks.reveals)
+}
@@ -2,44 +2,54 @@ package keeper
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand"
-	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
 	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+	governancetypes "github.com/volnix-protocol/volnix-protocol/x/governance/types"
 )
 
 // LizenzKeeperInterface defines the interface for interacting with lizenz module
 // This allows consensus module to get information about activated LZN and MOA status
-// Note: We use interface{} for GetAllActivatedLizenz to avoid circular dependencies
-// The actual type is []*lizenzv1.ActivatedLizenz, but we can't import it here
 type LizenzKeeperInterface interface {
-	GetAllActivatedLizenz(ctx sdk.Context) ([]interface{}, error) // Returns list of activated LZN ([]*lizenzv1.ActivatedLizenz)
+	GetAllActivatedLizenz(ctx sdk.Context) ([]*lizenzv1.ActivatedLizenz, error) // Returns list of activated LZN
 	GetTotalActivatedLizenz(ctx sdk.Context) (string, error)      // Returns total activated LZN
 	GetMOACompliance(ctx sdk.Context, validator string) (float64, error) // Returns MOA compliance ratio (0.0 to 1.0+)
-	UpdateRewardStats(ctx sdk.Context, validator string, rewardAmount uint64, blockHeight uint64, moaCompliance float64, penaltyMultiplier float64, baseReward uint64) error // Updates reward statistics
+	GetActivatedLizenz(ctx sdk.Context, validator string) (*lizenzv1.ActivatedLizenz, error) // Returns the activated LZN of a validator
+	RecordEpochReward(ctx sdk.Context, validator string, record *lizenzv1.RewardRecord) error // Records the rewards of a validator for a reward epoch
+	SlashActivatedLizenz(ctx sdk.Context, validator string, fraction math.LegacyDec) (math.Int, error) // Burns a fraction of activated LZN, returns the burned amount
 }
 
 // AnteilKeeperInterface defines the interface for interacting with anteil module
 // This allows consensus module to check ANT balances and burn ANT tokens
-// Note: We use interface{} for UserPosition to avoid circular dependencies
 type AnteilKeeperInterface interface {
-	GetUserPosition(ctx sdk.Context, user string) (interface{}, error) // Returns UserPosition (anteilv1.UserPosition)
-	SetUserPosition(ctx sdk.Context, position interface{}) error      // Sets UserPosition
-	UpdateUserPosition(ctx sdk.Context, user string, antBalance string, orderCount uint32) error // Updates ANT balance
+	GetUserPosition(ctx sdk.Context, user string) (*anteilv1.UserPosition, error) // Returns the ANT position of a user
+	BurnAnt(ctx sdk.Context, owner string, amount uint64) error        // Burns ANT from the balance
+	LockAntBond(ctx sdk.Context, owner string, amount uint64) error    // Moves ANT from the balance into escrow
+	ReleaseAntBond(ctx sdk.Context, owner string, amount uint64) error // Returns escrowed ANT to the balance
+	BurnAntBond(ctx sdk.Context, owner string, amount uint64) error    // Burns escrowed ANT
 }
 
 // BankKeeperInterface defines the interface for interacting with bank module
@@ -50,6 +60,12 @@ type BankKeeperInterface interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
+// IdentKeeperInterface defines the interface for interacting with ident module
+// This allows consensus module to remove validators that lost the validator role from the validator set
+type IdentKeeperInterface interface {
+	IsValidator(ctx sdk.Context, address string) bool // Returns true if the account is an active verified validator
+}
+
 type (
 	Keeper struct {
 		cdc          codec.BinaryCodec
@@ -58,6 +74,12 @@ type (
 		lizenzKeeper LizenzKeeperInterface // Optional: for reward distribution
 		anteilKeeper AnteilKeeperInterface // Optional: for ANT balance management
 		bankKeeper   BankKeeperInterface   // Optional: for sending WRT rewards
+		identKeeper  IdentKeeperInterface  // Optional: for validator role checks
+
+		// authority is the governance module address allowed to execute authority-gated Msgs
+		authority string
+
+		hooks types.ConsensusHooks
 	}
 )
 
@@ -75,9 +97,15 @@ func NewKeeper(
 		cdc:        cdc,
 		storeKey:   storeKey,
 		paramstore: ps,
+		authority:  authtypes.NewModuleAddress(governancetypes.ModuleName).String(),
 	}
 }
 
+// GetAuthority returns the address allowed to execute authority-gated Msgs
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetLizenzKeeper sets the lizenz keeper interface for reward distribution
 func (k *Keeper) SetLizenzKeeper(lizenzKeeper LizenzKeeperInterface) {
 	k.lizenzKeeper = lizenzKeeper
@@ -93,6 +121,19 @@ func (k *Keeper) SetBankKeeper(bankKeeper BankKeeperInterface) {
 	k.bankKeeper = bankKeeper
 }
 
+// SetIdentKeeper sets the ident keeper interface for validator role checks
+func (k *Keeper) SetIdentKeeper(identKeeper IdentKeeperInterface) {
+	k.identKeeper = identKeeper
+}
+
+// SetHooks sets the consensus hooks
+func (k *Keeper) SetHooks(hooks types.ConsensusHooks) {
+	if k.hooks != nil {
+		panic("cannot set consensus hooks twice")
+	}
+	k.hooks = hooks
+}
+
 // GetParams returns the current parameters for the consensus module
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var consensusParams types.ConsensusParams
@@ -116,14 +157,43 @@ func (k Keeper) SelectBlockProducer(ctx sdk.Context, validators []string) (strin
 	}
 
 	// Simple random selection for now
-	selectedIndex := rand.Intn(len(validators))
+	selectedIndex := selectionRand(ctx, uint64(ctx.BlockHeight())).Intn(len(validators))
 	return validators[selectedIndex], nil
 }
 
+// selectionRand returns a source seeded from the block header hash and height
+// Every node draws the same values, unlike the global math/rand source
+func selectionRand(ctx sdk.Context, height uint64) *rand.Rand {
+	seed := sha256.Sum256(append(ctx.HeaderHash(), sdk.Uint64ToBigEndian(height)...))
+	return rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(seed[:8]))))
+}
+
 // SelectBlockCreator selects the next block creator using blind auction
 // According to whitepaper: "Право на создание блока и получение комиссий разыгрывается в каждом раунде через 'слепой аукцион с взвешенной лотереей'"
 func (k Keeper) SelectBlockCreator(ctx sdk.Context, height uint64) (*consensusv1.BlockCreator, error) {
-	validators := k.GetAllValidators(ctx)
+	blockCreator, err := k.selectBlockCreator(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.hooks != nil {
+		if err := k.hooks.AfterBlockCreatorSelected(ctx, height, blockCreator.Validator); err != nil {
+			return nil, err
+		}
+	}
+
+	return blockCreator, nil
+}
+
+// selectBlockCreator selects and stores the block creator: the blind auction winner, or a weighted lottery
+func (k Keeper) selectBlockCreator(ctx sdk.Context, height uint64) (*consensusv1.BlockCreator, error) {
+	var validators []*consensusv1.Validator
+	for _, validator := range k.GetAllValidators(ctx) {
+		// Jailed validators cannot create blocks
+		if validator.Status != consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED {
+			validators = append(validators, validator)
+		}
+	}
 	if len(validators) == 0 {
 		return nil, types.ErrNoValidators
 	}
@@ -133,14 +203,14 @@ func (k Keeper) SelectBlockCreator(ctx sdk.Context, height uint64) (*consensusv1
 	if err == nil && auction != nil && auction.Phase == consensusv1.AuctionPhase_AUCTION_PHASE_COMPLETE && auction.Winner != "" {
 		// Use winner from blind auction
 		winnerValidator, err := k.GetValidator(ctx, auction.Winner)
-		if err == nil && winnerValidator != nil {
+		if err == nil && winnerValidator != nil && winnerValidator.Status != consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED {
 			blockCreator := &consensusv1.BlockCreator{
 				Validator:     auction.Winner,
 				AntBalance:    winnerValidator.AntBalance,
 				ActivityScore: winnerValidator.ActivityScore,
 				BurnAmount:    auction.WinningBid,
 				BlockHeight:   height,
-				SelectionTime: timestamppb.Now(),
+				SelectionTime: timestamppb.New(ctx.BlockTime()),
 			}
 
 			k.SetBlockCreator(ctx, blockCreator)
@@ -158,13 +228,10 @@ func (k Keeper) SelectBlockCreator(ctx sdk.Context, height uint64) (*consensusv1
 	if k.lizenzKeeper != nil {
 		allLizenzs, err := k.lizenzKeeper.GetAllActivatedLizenz(ctx)
 		if err == nil {
-			for _, lizenzInterface := range allLizenzs {
-				validator, amount, err := extractLizenzInfo(lizenzInterface)
+			for _, lizenz := range allLizenzs {
+				amountInt, err := strconv.ParseUint(lizenz.Amount, 10, 64)
 				if err == nil {
-					amountInt, err := strconv.ParseUint(amount, 10, 64)
-					if err == nil {
-						validatorLZN[validator] = amountInt
-					}
+					validatorLZN[lizenz.Validator] = amountInt
 				}
 			}
 		}
@@ -194,7 +261,7 @@ func (k Keeper) SelectBlockCreator(ctx sdk.Context, height uint64) (*consensusv1
 
 	if totalWeight == 0 {
 		// If no weights, select randomly
-		selectedIndex := rand.Intn(len(validators))
+		selectedIndex := selectionRand(ctx, height).Intn(len(validators))
 		selectedValidator := validators[selectedIndex]
 
 		blockCreator := &consensusv1.BlockCreator{
@@ -203,7 +270,7 @@ func (k Keeper) SelectBlockCreator(ctx sdk.Context, height uint64) (*consensusv1
 			ActivityScore: selectedValidator.ActivityScore,
 			BurnAmount:    "0",
 			BlockHeight:   height,
-			SelectionTime: timestamppb.Now(),
+			SelectionTime: timestamppb.New(ctx.BlockTime()),
 		}
 
 		k.SetBlockCreator(ctx, blockCreator)
@@ -211,7 +278,7 @@ func (k Keeper) SelectBlockCreator(ctx sdk.Context, height uint64) (*consensusv1
 	}
 
 	// Weighted random selection
-	randomWeight := rand.Uint64() % totalWeight
+	randomWeight := selectionRand(ctx, height).Uint64() % totalWeight
 	currentWeight := uint64(0)
 
 	for i, weight := range weights {
@@ -225,7 +292,7 @@ func (k Keeper) SelectBlockCreator(ctx sdk.Context, height uint64) (*consensusv1
 				ActivityScore: selectedValidator.ActivityScore,
 				BurnAmount:    "0",
 				BlockHeight:   height,
-				SelectionTime: timestamppb.Now(),
+				SelectionTime: timestamppb.New(ctx.BlockTime()),
 			}
 
 			k.SetBlockCreator(ctx, blockCreator)
@@ -256,7 +323,7 @@ func (k Keeper) SelectBlockCreator(ctx sdk.Context, height uint64) (*consensusv1
 		ActivityScore: selectedValidator.ActivityScore,
 		BurnAmount:    "0",
 		BlockHeight:   height,
-		SelectionTime: timestamppb.Now(),
+		SelectionTime: timestamppb.New(ctx.BlockTime()),
 	}
 
 	k.SetBlockCreator(ctx, blockCreator)
@@ -281,8 +348,6 @@ func (k Keeper) SelectBlockCreator(ctx sdk.Context, height uint64) (*consensusv1
 
 // CalculateBlockTime calculates dynamic block time based on ANT activity
 func (k Keeper) CalculateBlockTime(ctx sdk.Context, antAmount string) (time.Duration, error) {
-	params := k.GetParams(ctx)
-
 	antAmountInt, err := strconv.ParseUint(antAmount, 10, 64)
 	if err != nil {
 		return 0, types.ErrInvalidAntAmount
@@ -292,6 +357,12 @@ func (k Keeper) CalculateBlockTime(ctx sdk.Context, antAmount string) (time.Dura
 		return 0, types.ErrInvalidAntAmount
 	}
 
+	params := k.GetParams(ctx)
+	return calculateBlockTime(&params, antAmountInt), nil
+}
+
+// calculateBlockTime scales the base block time by the activity factor for the burned ANT amount
+func calculateBlockTime(params *types.Params, antAmount uint64) time.Duration {
 	// Parse base block time
 	baseBlockTime, err := time.ParseDuration(params.BaseBlockTime)
 	if err != nil {
@@ -303,13 +374,13 @@ func (k Keeper) CalculateBlockTime(ctx sdk.Context, antAmount string) (time.Dura
 	lowThreshold := params.LowActivityThreshold
 
 	var activityFactor float64
-	if antAmountInt >= highThreshold {
+	if antAmount >= highThreshold {
 		// Parse activity factor for high activity
 		activityFactor, err = strconv.ParseFloat(params.ActivityFactorHigh, 64)
 		if err != nil || activityFactor == 0 {
 			activityFactor = 0.5 // Default fallback
 		}
-	} else if antAmountInt >= lowThreshold {
+	} else if antAmount >= lowThreshold {
 		// Parse activity factor for medium activity
 		activityFactor, err = strconv.ParseFloat(params.ActivityFactorMedium, 64)
 		if err != nil || activityFactor == 0 {
@@ -326,187 +397,112 @@ func (k Keeper) CalculateBlockTime(ctx sdk.Context, antAmount string) (time.Dura
 	// Calculate dynamic block time
 	dynamicBlockTime := float64(baseBlockTime) * activityFactor
 
-	return time.Duration(dynamicBlockTime), nil
+	return time.Duration(dynamicBlockTime)
 }
 
-// RecordBlockTime records the time for a block
-// This is used to calculate average block time for adaptive halving
-func (k Keeper) RecordBlockTime(ctx sdk.Context, height uint64) error {
+// ProcessHalving processes halving event
+// According to whitepaper: "Халвинг происходит строго каждые N блоков, но реальная дата адаптируется к динамическому времени блока"
+// HalvingInfo is only written when it is first initialized or a halving occurs; the estimated date of the
+// next halving is stored at that point and derived from the average block time at query time
+func (k Keeper) ProcessHalving(ctx sdk.Context) error {
 	store := ctx.KVStore(k.storeKey)
-	blockTimeKey := types.GetBlockTimeKey(height)
-	
-	// Store block time as timestamp
-	blockTime := ctx.BlockTime()
-	timeBz, err := blockTime.MarshalBinary()
-	if err != nil {
-		return fmt.Errorf("failed to marshal block time: %w", err)
-	}
-	
-	store.Set(blockTimeKey, timeBz)
-	
-	// Update average block time
-	return k.updateAverageBlockTime(ctx)
-}
+	halvingKey := types.KeyHalvingInfo()
 
-// GetAverageBlockTime calculates and returns the average block time
-// Uses a sliding window of recent blocks (e.g., last 1000 blocks)
-func (k Keeper) GetAverageBlockTime(ctx sdk.Context) (time.Duration, error) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.AverageBlockTimeKey)
-	
-	if bz == nil {
-		// Return default if not calculated yet
-		return 5 * time.Second, nil
+	halvingInfo := &consensusv1.HalvingInfo{
+		LastHalvingHeight: 0,
+		NextHalvingHeight: HalvingInterval, // 210,000 blocks
+		HalvingInterval:   HalvingInterval,
 	}
-	
-	// Parse stored duration (as nanoseconds) - use JSON encoding for simple types
-	var avgNanos int64
-	if err := json.Unmarshal(bz, &avgNanos); err != nil {
-		return 5 * time.Second, nil
+	bz := store.Get(halvingKey)
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, halvingInfo)
 	}
-	
-	return time.Duration(avgNanos), nil
-}
 
-// updateAverageBlockTime updates the average block time based on recent blocks
-func (k Keeper) updateAverageBlockTime(ctx sdk.Context) error {
 	currentHeight := uint64(ctx.BlockHeight())
-	params := k.GetParams(ctx)
-	windowSize := params.AverageBlockTimeWindowSize
-	if windowSize == 0 {
-		windowSize = 1000 // Default fallback
-	}
-	
-	startHeight := uint64(0)
-	if currentHeight > windowSize {
-		startHeight = currentHeight - windowSize
+
+	// Note: RecordBlockTime is called in EndBlocker, so we don't need to call it here
+
+	// Check if halving should occur
+	halved := currentHeight >= halvingInfo.NextHalvingHeight
+	if !halved && bz != nil {
+		return nil
 	}
-	
-	var totalDuration time.Duration
-	blockCount := uint64(0)
-	store := ctx.KVStore(k.storeKey)
-	
-	// Calculate average from recent blocks
-	for h := startHeight + 1; h <= currentHeight; h++ {
-		blockTimeKey := types.GetBlockTimeKey(h)
-		timeBz := store.Get(blockTimeKey)
-		
-		if timeBz == nil {
-			continue // Skip if block time not recorded
-		}
-		
-		var blockTime time.Time
-		if err := blockTime.UnmarshalBinary(timeBz); err != nil {
-			continue // Skip invalid times
-		}
-		
-		// Get previous block time
-		if h > startHeight+1 {
-			prevTimeKey := types.GetBlockTimeKey(h - 1)
-			prevTimeBz := store.Get(prevTimeKey)
-			if prevTimeBz != nil {
-				var prevTime time.Time
-				if err := prevTime.UnmarshalBinary(prevTimeBz); err == nil {
-					duration := blockTime.Sub(prevTime)
-					totalDuration += duration
-					blockCount++
-				}
-			}
-		}
+	if halved {
+		halvingInfo.LastHalvingHeight = halvingInfo.NextHalvingHeight
+		halvingInfo.LastHalvingDate = timestamppb.New(ctx.BlockTime())
+		halvingInfo.NextHalvingHeight += halvingInfo.HalvingInterval
 	}
-	
-	if blockCount == 0 {
-		return nil // Not enough data yet
+
+	// The estimated date adapts to the average block time
+	if estimatedDate, ok := k.EstimateHeightTime(ctx, halvingInfo.NextHalvingHeight); ok {
+		halvingInfo.EstimatedNextHalvingDate = timestamppb.New(estimatedDate)
 	}
-	
-	// Calculate average
-	averageBlockTime := totalDuration / time.Duration(blockCount)
-	
-	// Store average - use JSON encoding for simple types
-	avgNanos := int64(averageBlockTime)
-	avgBz, err := json.Marshal(avgNanos)
-	if err != nil {
-		return fmt.Errorf("failed to marshal average block time: %w", err)
+
+	if halved {
+		ctx.Logger().Info("halving processed",
+			"height", currentHeight,
+			"next_halving_height", halvingInfo.NextHalvingHeight,
+			"estimated_date", halvingInfo.EstimatedNextHalvingDate.AsTime())
 	}
-	
-	store.Set(types.AverageBlockTimeKey, avgBz)
-	
+
+	store.Set(halvingKey, k.cdc.MustMarshal(halvingInfo))
 	return nil
 }
 
-// ProcessHalving processes halving event
-// According to whitepaper: "Халвинг происходит строго каждые N блоков, но реальная дата адаптируется к динамическому времени блока"
-func (k Keeper) ProcessHalving(ctx sdk.Context) error {
-	store := ctx.KVStore(k.storeKey)
-	halvingKey := types.KeyHalvingInfo()
-
-	var halvingInfo consensusv1.HalvingInfo
-	bz := store.Get(halvingKey)
-	if bz == nil {
-		// Initialize halving info if not exists
-		halvingInfo = consensusv1.HalvingInfo{
-			LastHalvingHeight: 0,
-			NextHalvingHeight: HalvingInterval, // 210,000 blocks
-			HalvingInterval:   HalvingInterval,
-		}
-	} else {
-		k.cdc.MustUnmarshal(bz, &halvingInfo)
+// EstimateHeightTime projects the time of a future height from the average block time
+// Returns false if no positive average block time is available
+func (k Keeper) EstimateHeightTime(ctx sdk.Context, height uint64) (time.Time, bool) {
+	avgBlockTime, err := k.GetAverageBlockTime(ctx)
+	if err != nil || avgBlockTime <= 0 {
+		return time.Time{}, false
 	}
 
 	currentHeight := uint64(ctx.BlockHeight())
-	currentTime := ctx.BlockTime()
-	
-	// Note: RecordBlockTime is called in EndBlocker, so we don't need to call it here
+	if height <= currentHeight {
+		return ctx.BlockTime(), true
+	}
+	return ctx.BlockTime().Add(avgBlockTime * time.Duration(height-currentHeight)), true
+}
 
-	// Check if halving should occur
-	if currentHeight >= halvingInfo.NextHalvingHeight {
-		// Process halving
-		halvingInfo.LastHalvingHeight = halvingInfo.NextHalvingHeight
-		// TODO: Uncomment after proto generation
-		// halvingInfo.LastHalvingDate = timestamppb.New(currentTime)
-		halvingInfo.NextHalvingHeight += halvingInfo.HalvingInterval
+// GetHalvingSchedule projects the next count halvings after the current height
+// Rewards come from CalculateBaseReward and dates from the average block time
+func (k Keeper) GetHalvingSchedule(ctx sdk.Context, count uint64) ([]*consensusv1.HalvingProjection, error) {
+	halvingInfo, err := k.GetHalvingInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		// Calculate estimated next halving date based on average block time
-		avgBlockTime, err := k.GetAverageBlockTime(ctx)
-		if err == nil && avgBlockTime > 0 {
-			blocksRemaining := halvingInfo.NextHalvingHeight - currentHeight
-			estimatedDuration := avgBlockTime * time.Duration(blocksRemaining)
-			estimatedDate := currentTime.Add(estimatedDuration)
-			// TODO: Uncomment after proto generation
-			// halvingInfo.EstimatedNextHalvingDate = timestamppb.New(estimatedDate)
-			
-			ctx.Logger().Info("halving processed",
-				"height", currentHeight,
-				"next_halving_height", halvingInfo.NextHalvingHeight,
-				"estimated_date", estimatedDate,
-				"average_block_time", avgBlockTime)
+	interval := halvingInfo.HalvingInterval
+	if interval == 0 {
+		interval = HalvingInterval
+	}
+
+	// The next halving height may lag one block behind until ProcessHalving runs
+	nextHeight := halvingInfo.NextHalvingHeight
+	for nextHeight <= uint64(ctx.BlockHeight()) {
+		nextHeight += interval
+	}
+
+	schedule := make([]*consensusv1.HalvingProjection, 0, count)
+	for i := uint64(0); i < count; i++ {
+		height := nextHeight + i*interval
+		reward, err := k.CalculateBaseReward(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate base reward at height %d: %w", height, err)
 		}
 
-		// Store updated halving info
-		bz = k.cdc.MustMarshal(&halvingInfo)
-		store.Set(halvingKey, bz)
-	} else {
-		// Update estimated next halving date even if halving didn't occur
-		avgBlockTime, err := k.GetAverageBlockTime(ctx)
-		if err == nil && avgBlockTime > 0 {
-			blocksRemaining := halvingInfo.NextHalvingHeight - currentHeight
-			estimatedDuration := avgBlockTime * time.Duration(blocksRemaining)
-			estimatedDate := currentTime.Add(estimatedDuration)
-			// TODO: Uncomment after proto generation
-			// halvingInfo.EstimatedNextHalvingDate = timestamppb.New(estimatedDate)
-			
-			ctx.Logger().Info("halving date updated",
-				"next_halving_height", halvingInfo.NextHalvingHeight,
-				"estimated_date", estimatedDate,
-				"average_block_time", avgBlockTime)
-			
-			// Store updated halving info with new estimated date
-			bz = k.cdc.MustMarshal(&halvingInfo)
-			store.Set(halvingKey, bz)
+		projection := &consensusv1.HalvingProjection{
+			Height:       height,
+			HalvingCount: k.GetHalvingCount(height),
+			BlockReward:  reward,
+		}
+		if estimatedDate, ok := k.EstimateHeightTime(ctx, height); ok {
+			projection.EstimatedDate = timestamppb.New(estimatedDate)
 		}
+		schedule = append(schedule, projection)
 	}
 
-	return nil
+	return schedule, nil
 }
 
 // GetHalvingInfo returns halving information
@@ -552,7 +548,7 @@ func (k Keeper) GetConsensusState(ctx sdk.Context) (types.ConsensusState, error)
 		return types.ConsensusState{
 			CurrentHeight:    uint64(ctx.BlockHeight()),
 			TotalAntBurned:   "0",
-			LastBlockTime:    timestamppb.Now(),
+			LastBlockTime:    timestamppb.New(ctx.BlockTime()),
 			ActiveValidators: []string{},
 		}, nil
 	}
@@ -684,6 +680,9 @@ func (k Keeper) GetAllValidators(ctx sdk.Context) []*consensusv1.Validator {
 
 	var validators []*consensusv1.Validator
 	for ; iterator.Valid(); iterator.Next() {
+		if isValidatorWeightKey(iterator.Key()) {
+			continue
+		}
 		var validator consensusv1.Validator
 		k.cdc.MustUnmarshal(iterator.Value(), &validator)
 		validators = append(validators, &validator)
@@ -692,6 +691,32 @@ func (k Keeper) GetAllValidators(ctx sdk.Context) []*consensusv1.Validator {
 	return validators
 }
 
+// GetValidatorsPage returns a page of the validators, in address order
+// The validator weights share the validator key prefix and are skipped
+func (k Keeper) GetValidatorsPage(ctx sdk.Context, pageReq *sdkquery.PageRequest) ([]*consensusv1.Validator, *sdkquery.PageResponse, error) {
+	validatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyValidatorPrefix)
+
+	var validators []*consensusv1.Validator
+	pageRes, err := sdkquery.FilteredPaginate(validatorStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if isValidatorWeightKey(append(append([]byte{}, types.KeyValidatorPrefix...), key...)) {
+			return false, nil
+		}
+		var validator consensusv1.Validator
+		if err := k.cdc.Unmarshal(value, &validator); err != nil {
+			return false, fmt.Errorf("failed to unmarshal validator: %w", err)
+		}
+		if accumulate {
+			validators = append(validators, &validator)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return validators, pageRes, nil
+}
+
 // SetBlockCreator sets block creator
 func (k Keeper) SetBlockCreator(ctx sdk.Context, blockCreator *consensusv1.BlockCreator) {
 	store := ctx.KVStore(k.storeKey)
@@ -720,6 +745,11 @@ func (k Keeper) GetBlockCreator(ctx sdk.Context, height uint64) (*consensusv1.Bl
 
 // BeginBlocker processes begin block logic
 func (k Keeper) BeginBlocker(ctx sdk.Context) error {
+	// Slash and jail validators for double-signing and downtime reported by CometBFT
+	if err := k.HandleCometInfo(ctx); err != nil {
+		return fmt.Errorf("failed to handle comet info: %w", err)
+	}
+
 	// Update consensus state
 	currentHeight := uint64(ctx.BlockHeight())
 	validators := k.GetAllValidators(ctx)
@@ -737,22 +767,11 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 		return fmt.Errorf("failed to calculate total burned tokens: %w", err)
 	}
 
-	err = k.UpdateConsensusState(ctx, currentHeight, totalAntBurned, activeValidators)
-	if err != nil {
-		return err
-	}
-
-	// Process halving if needed
-	err = k.ProcessHalving(ctx)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return k.UpdateConsensusState(ctx, currentHeight, totalAntBurned, activeValidators)
 }
 
-// EndBlocker processes end block logic
-func (k Keeper) EndBlocker(ctx sdk.Context) error {
+// EndBlocker processes end block logic and returns the CometBFT validator set updates
+func (k Keeper) EndBlocker(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
 	// Update consensus state
 	currentHeight := uint64(ctx.BlockHeight())
 	
@@ -760,7 +779,7 @@ func (k Keeper) EndBlocker(ctx sdk.Context) error {
 	if err := k.RecordBlockTime(ctx, currentHeight); err != nil {
 		ctx.Logger().Error("failed to record block time", "error", err)
 	}
-	
+
 	validators := k.GetAllValidators(ctx)
 
 	var activeValidators []string
@@ -773,42 +792,31 @@ func (k Keeper) EndBlocker(ctx sdk.Context) error {
 	// Get current total ANT burned
 	totalAntBurned, err := k.calculateTotalBurnedTokens(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to calculate total burned tokens: %w", err)
+		return nil, fmt.Errorf("failed to calculate total burned tokens: %w", err)
 	}
 
 	err = k.UpdateConsensusState(ctx, currentHeight, totalAntBurned, activeValidators)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Process blind auction for current block
-	// Transition from commit to reveal phase if needed
-	auction, err := k.GetBlindAuction(ctx, currentHeight)
-	if err == nil && auction != nil {
-		// If auction is in commit phase and we have commits, transition to reveal
-		if auction.Phase == consensusv1.AuctionPhase_AUCTION_PHASE_COMMIT && len(auction.Commits) > 0 {
-			err = k.TransitionAuctionPhase(ctx, currentHeight)
-			if err != nil {
-				ctx.Logger().Error("failed to transition auction phase", "error", err)
-			}
-		}
-
-		// If auction is in reveal phase and we have reveals, select winner
-		if auction.Phase == consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL && len(auction.Reveals) > 0 {
-			winner, winningBid, err := k.SelectAuctionWinner(ctx, currentHeight)
-			if err != nil {
-				ctx.Logger().Error("failed to select auction winner", "error", err)
-			} else {
-				ctx.Logger().Info("blind auction winner selected", "height", currentHeight, "winner", winner, "bid", winningBid)
-			}
-		}
+	// Advance blind auctions by height: select the winner for the next block,
+	// close commit windows and open the auction for a future block
+	if err := k.ProcessAuctionWindows(ctx); err != nil {
+		ctx.Logger().Error("failed to process blind auction windows", "error", err, "height", currentHeight)
 	}
 
-	// Create blind auction for next block
-	nextHeight := currentHeight + 1
-	_, err = k.CreateBlindAuction(ctx, nextHeight)
-	if err != nil {
-		ctx.Logger().Error("failed to create blind auction for next block", "error", err, "height", nextHeight)
+	// Derive the delay before the next block from the ANT burned in this block,
+	// including burns finalized by the auction windows above
+	k.UpdateNextBlockDelay(ctx)
+
+	// Select the creator of the next block: the auction winner finalized above, or the weighted lottery.
+	// Selection and its hooks are written together or not at all.
+	selectCtx, writeSelection := ctx.CacheContext()
+	if _, err := k.SelectBlockCreator(selectCtx, currentHeight+1); err == nil {
+		writeSelection()
+	} else if !errors.Is(err, types.ErrNoValidators) {
+		ctx.Logger().Error("failed to select block creator", "error", err, "height", currentHeight+1)
 	}
 
 	// Clean up old completed auctions (keep only last N blocks for history)
@@ -833,66 +841,13 @@ func (k Keeper) EndBlocker(ctx sdk.Context) error {
 		// Don't fail the block if halving processing fails
 	}
 
-	return nil
-}
-
-// InitGenesis initializes genesis state
-func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
-	if genState.Params != nil {
-		k.SetParams(ctx, *genState.Params)
-	}
-
-	for _, validator := range genState.Validators {
-		k.SetValidator(ctx, validator)
-	}
-
-	for _, blockCreator := range genState.BlockCreators {
-		k.SetBlockCreator(ctx, blockCreator)
-	}
-
-	// Set default halving info
-	halvingInfo := types.HalvingInfo{
-		LastHalvingHeight: 0,
-		HalvingInterval:   210000,
-		NextHalvingHeight: 210000,
-	}
-	err := k.SetHalvingInfo(ctx, halvingInfo)
+	// Report voting power changes from PoVB weights to CometBFT
+	updates, err := k.ApplyValidatorSetUpdates(ctx)
 	if err != nil {
-		// Log error but don't fail genesis
-		ctx.Logger().Error("failed to set default halving info", "error", err)
+		return nil, fmt.Errorf("failed to apply validator set updates: %w", err)
 	}
 
-	// Set default consensus state
-	err = k.UpdateConsensusState(ctx, 0, "0", []string{})
-	if err != nil {
-		// Log error but don't fail genesis
-		ctx.Logger().Error("failed to set default consensus state", "error", err)
-	}
-}
-
-// ExportGenesis exports genesis state
-func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
-	params := k.GetParams(ctx)
-	validators := k.GetAllValidators(ctx)
-
-	var blockCreators []*consensusv1.BlockCreator
-	store := ctx.KVStore(k.storeKey)
-	iterator := store.Iterator(types.KeyBlockCreatorPrefix, append(types.KeyBlockCreatorPrefix, 0xFF))
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var blockCreator consensusv1.BlockCreator
-		k.cdc.MustUnmarshal(iterator.Value(), &blockCreator)
-		blockCreators = append(blockCreators, &blockCreator)
-	}
-
-	return types.GenesisState{
-		Params:         &params,
-		Validators:     validators,
-		BlockCreators:  blockCreators,
-		BurnProofs:     []*consensusv1.BurnProof{},
-		ActivityScores: []*consensusv1.ActivityScore{},
-	}
+	return updates, nil
 }
 
 // calculateTotalBurnedTokens calculates the total amount of ANT tokens burned
@@ -976,6 +931,9 @@ func (k Keeper) CreateBlindAuction(ctx sdk.Context, height uint64) (*consensusv1
 		return existing, nil
 	}
 
+	_, revealWindow := k.getAuctionWindows(ctx)
+	commitEndHeight, revealEndHeight := auctionEndHeights(height, revealWindow)
+
 	auction := &consensusv1.BlindAuction{
 		BlockHeight: height,
 		Phase:       consensusv1.AuctionPhase_AUCTION_PHASE_COMMIT,
@@ -983,8 +941,10 @@ func (k Keeper) CreateBlindAuction(ctx sdk.Context, height uint64) (*consensusv1
 		Reveals:     []*consensusv1.BidReveal{},
 		Winner:      "",
 		WinningBid:   "0",
-		StartTime:   timestamppb.Now(),
+		StartTime:   timestamppb.New(ctx.BlockTime()),
 		EndTime:     nil,
+		CommitEndHeight: commitEndHeight,
+		RevealEndHeight: revealEndHeight,
 	}
 
 	err = k.SetBlindAuction(ctx, auction)
@@ -992,26 +952,51 @@ func (k Keeper) CreateBlindAuction(ctx sdk.Context, height uint64) (*consensusv1
 		return nil, err
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStarted,
+			sdk.NewAttribute(types.AttributeKeyAuctionHeight, fmt.Sprintf("%d", height)),
+			sdk.NewAttribute(types.AttributeKeyCommitEndHeight, fmt.Sprintf("%d", commitEndHeight)),
+			sdk.NewAttribute(types.AttributeKeyRevealEndHeight, fmt.Sprintf("%d", revealEndHeight)),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
 	return auction, nil
 }
 
 // CommitBid adds a committed bid to the auction
 func (k Keeper) CommitBid(ctx sdk.Context, validator, commitHash string, height uint64) error {
+	// Early commits are rejected until ProcessAuctionWindows opened the auction
+	if commitStart := k.AuctionCommitStartHeight(ctx, height); uint64(ctx.BlockHeight()) < commitStart {
+		return fmt.Errorf("%w: auction %d accepts commits from height %d", types.ErrCommitWindowNotOpen, height, commitStart)
+	}
+
 	// Get or create auction
 	auction, err := k.GetBlindAuction(ctx, height)
 	if err != nil {
-		// Create new auction if it doesn't exist
+		// Auctions whose window opened before the chain started are created on their first commit
 		auction, err = k.CreateBlindAuction(ctx, height)
 		if err != nil {
 			return err
 		}
 	}
 
+	// Late commits are rejected once the commit window has closed
+	if uint64(ctx.BlockHeight()) > auction.CommitEndHeight {
+		return fmt.Errorf("%w: auction %d accepted commits until height %d", types.ErrCommitWindowClosed, height, auction.CommitEndHeight)
+	}
+
 	// Check if auction is in commit phase
 	if auction.Phase != consensusv1.AuctionPhase_AUCTION_PHASE_COMMIT {
 		return types.ErrAuctionNotInCommitPhase
 	}
 
+	// Jailed validators cannot take part in auctions
+	if k.IsValidatorJailed(ctx, validator) {
+		return types.ErrValidatorJailed
+	}
+
 	// Check if validator already committed
 	for _, commit := range auction.Commits {
 		if commit.Validator == validator {
@@ -1024,12 +1009,19 @@ func (k Keeper) CommitBid(ctx sdk.Context, validator, commitHash string, height
 		return types.ErrInvalidCommitHash
 	}
 
+	// Lock the commit bond; it is returned on reveal and forfeited otherwise
+	bondAmount, err := k.lockCommitBond(ctx, validator, height)
+	if err != nil {
+		return err
+	}
+
 	// Add commit
 	encryptedBid := &consensusv1.EncryptedBid{
 		Validator:    validator,
 		CommitHash:   commitHash,
 		BlockHeight:  height,
-		CommitTime:   timestamppb.Now(),
+		CommitTime:   timestamppb.New(ctx.BlockTime()),
+		BondAmount:   bondAmount,
 	}
 
 	auction.Commits = append(auction.Commits, encryptedBid)
@@ -1038,6 +1030,12 @@ func (k Keeper) CommitBid(ctx sdk.Context, validator, commitHash string, height
 		return err
 	}
 
+	if k.hooks != nil {
+		if err := k.hooks.AfterBidCommitted(ctx, height, validator); err != nil {
+			return err
+		}
+	}
+
 	// Emit event for bid commit
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -1063,15 +1061,11 @@ func (k Keeper) ValidateAuctionBid(ctx sdk.Context, validator, bidAmount string)
 
 	// 2. Check if validator has sufficient ANT balance
 	if k.anteilKeeper != nil {
-		positionInterface, err := k.anteilKeeper.GetUserPosition(ctx, validator)
+		position, err := k.anteilKeeper.GetUserPosition(ctx, validator)
 		if err != nil {
 			return fmt.Errorf("failed to get user position: %w", err)
 		}
-		
-		balance, err := extractAntBalance(positionInterface)
-		if err != nil {
-			return fmt.Errorf("failed to extract ANT balance: %w", err)
-		}
+		balance := position.AntBalance
 		
 		balanceUint, err := strconv.ParseUint(balance, 10, 64)
 		if err != nil {
@@ -1084,54 +1078,72 @@ func (k Keeper) ValidateAuctionBid(ctx sdk.Context, validator, bidAmount string)
 	}
 
 	// 3. Check for bid manipulation (prevent extremely large bids)
-	// Use MaxBurnAmount from params
+	if maxBid, capped := k.MaxAuctionBid(ctx); capped && bidUint > maxBid {
+		return fmt.Errorf("bid amount exceeds maximum: %d", maxBid)
+	}
+
+	// 4. Check for rapid bid changes (potential manipulation)
+	if k.RapidBidLimitReached(ctx, validator) {
+		return fmt.Errorf("too many rapid bid changes detected - potential manipulation")
+	}
+
+	return nil
+}
+
+// MaxAuctionBid returns the largest bid ValidateAuctionBid accepts, MaxBurnAmount, and
+// whether bids are capped at all
+func (k Keeper) MaxAuctionBid(ctx sdk.Context) (uint64, bool) {
 	params := k.GetParams(ctx)
 	maxBurnAmountStr := params.MaxBurnAmount
-	if maxBurnAmountStr != "" {
-		// Parse MaxBurnAmount (remove "uvx" suffix if present)
-		maxBurnAmountStr = strings.TrimSuffix(maxBurnAmountStr, "uvx")
-		maxBurnAmountStr = strings.TrimSpace(maxBurnAmountStr)
-		maxBid, err := strconv.ParseUint(maxBurnAmountStr, 10, 64)
-		if err == nil && bidUint > maxBid {
-			return fmt.Errorf("bid amount exceeds maximum: %d", maxBid)
-		}
+	if maxBurnAmountStr == "" {
+		return 0, false
+	}
+	// Parse MaxBurnAmount (remove "uvx" suffix if present)
+	maxBurnAmountStr = strings.TrimSuffix(maxBurnAmountStr, "uvx")
+	maxBurnAmountStr = strings.TrimSpace(maxBurnAmountStr)
+	maxBid, err := strconv.ParseUint(maxBurnAmountStr, 10, 64)
+	if err != nil {
+		return 0, false
 	}
+	return maxBid, true
+}
 
-	// 4. Check for rapid bid changes (potential manipulation)
+// RapidBidLimitReached reports whether the validator placed RapidBidLimit bids in the last
+// 100 seconds, in which case ValidateAuctionBid rejects its next bid
+func (k Keeper) RapidBidLimitReached(ctx sdk.Context, validator string) bool {
 	// Store bid history to detect suspicious patterns
 	bidHistoryKey := types.GetBidHistoryKey(validator)
 	store := ctx.KVStore(k.storeKey)
-	
+
 	// Get recent bid history
 	bz := store.Get(bidHistoryKey)
-	if bz != nil {
-		var history []map[string]interface{}
-		if err := json.Unmarshal(bz, &history); err == nil {
-			// Check if there are too many rapid bid changes
-			recentBids := 0
-			currentTime := ctx.BlockTime().Unix()
-			for _, entry := range history {
-				if timestamp, ok := entry["timestamp"].(float64); ok {
-					// Count bids in last 10 blocks (approximately)
-					if currentTime-int64(timestamp) < 100 {
-						recentBids++
-					}
-				}
-			}
-			
-			// Prevent too many rapid bids (configurable limit)
-			params := k.GetParams(ctx)
-			rapidBidLimit := params.RapidBidLimit
-			if rapidBidLimit == 0 {
-				rapidBidLimit = 5 // Default fallback
-			}
-			if recentBids >= int(rapidBidLimit) {
-				return fmt.Errorf("too many rapid bid changes detected - potential manipulation")
+	if bz == nil {
+		return false
+	}
+	var history []map[string]interface{}
+	if err := json.Unmarshal(bz, &history); err != nil {
+		return false
+	}
+
+	// Check if there are too many rapid bid changes
+	recentBids := 0
+	currentTime := ctx.BlockTime().Unix()
+	for _, entry := range history {
+		if timestamp, ok := entry["timestamp"].(float64); ok {
+			// Count bids in last 10 blocks (approximately)
+			if currentTime-int64(timestamp) < 100 {
+				recentBids++
 			}
 		}
 	}
 
-	return nil
+	// Prevent too many rapid bids (configurable limit)
+	params := k.GetParams(ctx)
+	rapidBidLimit := params.RapidBidLimit
+	if rapidBidLimit == 0 {
+		rapidBidLimit = 5 // Default fallback
+	}
+	return recentBids >= int(rapidBidLimit)
 }
 
 // RecordBidHistory records a bid in the validator's bid history
@@ -1140,17 +1152,17 @@ func (k Keeper) RecordBidHistory(ctx sdk.Context, validator, bidAmount string) {
 	bidHistoryKey := types.GetBidHistoryKey(validator)
 	
 	// Get existing history
-	var history []map[string]interface{}
+	var history []bidHistoryEntry
 	bz := store.Get(bidHistoryKey)
 	if bz != nil {
 		json.Unmarshal(bz, &history)
 	}
 	
 	// Add new bid entry
-	entry := map[string]interface{}{
-		"bid_amount":  bidAmount,
-		"timestamp":   ctx.BlockTime().Unix(),
-		"block_height": ctx.BlockHeight(),
+	entry := bidHistoryEntry{
+		BidAmount:   bidAmount,
+		Timestamp:   ctx.BlockTime().Unix(),
+		BlockHeight: ctx.BlockHeight(),
 	}
 	
 	history = append(history, entry)
@@ -1180,6 +1192,11 @@ func (k Keeper) RevealBid(ctx sdk.Context, validator, nonce, bidAmount string, h
 		return err
 	}
 
+	// Late reveals are rejected once the reveal window has closed
+	if uint64(ctx.BlockHeight()) > auction.RevealEndHeight || auction.Phase == consensusv1.AuctionPhase_AUCTION_PHASE_COMPLETE {
+		return fmt.Errorf("%w: auction %d accepted reveals until height %d", types.ErrRevealWindowClosed, height, auction.RevealEndHeight)
+	}
+
 	// Check if auction is in reveal phase
 	if auction.Phase != consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL {
 		return types.ErrAuctionNotInRevealPhase
@@ -1210,6 +1227,11 @@ func (k Keeper) RevealBid(ctx sdk.Context, validator, nonce, bidAmount string, h
 		return types.ErrCommitHashMismatch
 	}
 
+	// A matching reveal releases the commit bond, so the balance checks below include it
+	if err := k.returnCommitBond(ctx, commit); err != nil {
+		return err
+	}
+
 	// Validate bid amount and check for manipulation
 	if err := k.ValidateAuctionBid(ctx, validator, bidAmount); err != nil {
 		return fmt.Errorf("bid validation failed: %w", err)
@@ -1238,18 +1260,13 @@ func (k Keeper) RevealBid(ctx sdk.Context, validator, nonce, bidAmount string, h
 	// Check ANT balance if anteil keeper is available
 	// According to whitepaper: validators must have sufficient ANT to bid
 	if k.anteilKeeper != nil {
-		positionInterface, err := k.anteilKeeper.GetUserPosition(ctx, validator)
+		position, err := k.anteilKeeper.GetUserPosition(ctx, validator)
 		if err != nil {
 			// If position doesn't exist, validator has no ANT balance
 			return fmt.Errorf("validator %s has no ANT balance", validator)
 		}
 
-		antBalanceStr, err := extractAntBalance(positionInterface)
-		if err != nil {
-			return fmt.Errorf("failed to extract ANT balance: %w", err)
-		}
-
-		antBalance, err := strconv.ParseUint(antBalanceStr, 10, 64)
+		antBalance, err := strconv.ParseUint(position.AntBalance, 10, 64)
 		if err != nil {
 			antBalance = 0
 		}
@@ -1266,12 +1283,19 @@ func (k Keeper) RevealBid(ctx sdk.Context, validator, nonce, bidAmount string, h
 		Nonce:       nonce,
 		BidAmount:   bidAmount,
 		BlockHeight: height,
-		RevealTime:  timestamppb.Now(),
+		RevealTime:  timestamppb.New(ctx.BlockTime()),
 	}
 
 	auction.Reveals = append(auction.Reveals, bidReveal)
 
-	return k.SetBlindAuction(ctx, auction)
+	if err := k.SetBlindAuction(ctx, auction); err != nil {
+		return err
+	}
+
+	if k.hooks != nil {
+		return k.hooks.AfterBidRevealed(ctx, height, validator)
+	}
+	return nil
 }
 
 // SelectAuctionWinner selects the winner of the blind auction using weighted lottery
@@ -1310,7 +1334,7 @@ func (k Keeper) SelectAuctionWinner(ctx sdk.Context, height uint64) (string, str
 	}
 
 	// Weighted random selection: chance proportional to bid amount
-	randomWeight := rand.Uint64() % totalBid
+	randomWeight := selectionRand(ctx, height).Uint64() % totalBid
 	currentWeight := uint64(0)
 
 	for i, bidAmount := range bidAmounts {
@@ -1322,35 +1346,32 @@ func (k Keeper) SelectAuctionWinner(ctx sdk.Context, height uint64) (string, str
 
 			// Burn ANT from winner (according to whitepaper: "Только победитель аукциона фактически покупает права на ANT")
 			if k.anteilKeeper != nil {
-				positionInterface, err := k.anteilKeeper.GetUserPosition(ctx, winnerValidator)
+				position, err := k.anteilKeeper.GetUserPosition(ctx, winnerValidator)
 				if err == nil {
-					// Get current ANT balance
-					currentBalanceStr, err := extractAntBalance(positionInterface)
+					currentBalance, err := strconv.ParseUint(position.AntBalance, 10, 64)
 					if err == nil {
-						currentBalance, err := strconv.ParseUint(currentBalanceStr, 10, 64)
-						if err == nil {
-							winningBidInt, err := strconv.ParseUint(winningBid, 10, 64)
-							if err == nil && currentBalance >= winningBidInt {
-								// Burn ANT: subtract winning bid amount from balance
-								newBalance := currentBalance - winningBidInt
-								err = k.anteilKeeper.UpdateUserPosition(ctx, winnerValidator, fmt.Sprintf("%d", newBalance), 0)
-								if err != nil {
-									ctx.Logger().Error("failed to burn ANT from winner", "error", err, "winner", winnerValidator, "amount", winningBid)
-								} else {
-									ctx.Logger().Info("ANT burned from auction winner", "winner", winnerValidator, "amount", winningBid, "new_balance", newBalance)
-									
-									// Emit burn event for tracking
-									ctx.EventManager().EmitEvent(
-										sdk.NewEvent(
-											types.EventTypeBurnExecuted,
-											sdk.NewAttribute(types.AttributeKeyValidator, winnerValidator),
-											sdk.NewAttribute(types.AttributeKeyBurnAmount, winningBid),
-											sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", height)),
-											sdk.NewAttribute(types.AttributeKeyNewBalance, fmt.Sprintf("%d", newBalance)),
-											sdk.NewAttribute(types.AttributeKeyAuctionWinner, "true"),
-										),
-									)
-								}
+						winningBidInt, err := strconv.ParseUint(winningBid, 10, 64)
+						if err == nil && currentBalance >= winningBidInt {
+							// Burn ANT: subtract winning bid amount from balance and supply
+							newBalance := currentBalance - winningBidInt
+							err = k.anteilKeeper.BurnAnt(ctx, winnerValidator, winningBidInt)
+							if err != nil {
+								ctx.Logger().Error("failed to burn ANT from winner", "error", err, "winner", winnerValidator, "amount", winningBid)
+							} else {
+								ctx.Logger().Info("ANT burned from auction winner", "winner", winnerValidator, "amount", winningBid, "new_balance", newBalance)
+								k.addBlockBurnedAnt(ctx, winningBidInt)
+								
+								// Emit burn event for tracking
+								ctx.EventManager().EmitEvent(
+									sdk.NewEvent(
+										types.EventTypeBurnExecuted,
+										sdk.NewAttribute(types.AttributeKeyValidator, winnerValidator),
+										sdk.NewAttribute(types.AttributeKeyBurnAmount, winningBid),
+										sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", height)),
+										sdk.NewAttribute(types.AttributeKeyNewBalance, fmt.Sprintf("%d", newBalance)),
+										sdk.NewAttribute(types.AttributeKeyAuctionWinner, "true"),
+									),
+								)
 							}
 						}
 					}
@@ -1373,13 +1394,16 @@ func (k Keeper) SelectAuctionWinner(ctx sdk.Context, height uint64) (string, str
 			auction.Winner = winnerValidator
 			auction.WinningBid = winningBid
 			auction.Phase = consensusv1.AuctionPhase_AUCTION_PHASE_COMPLETE
-			auction.EndTime = timestamppb.Now()
+			auction.EndTime = timestamppb.New(ctx.BlockTime())
 
 			err = k.SetBlindAuction(ctx, auction)
 			if err != nil {
 				return "", "", err
 			}
 
+			// Commits without a reveal are a (lighter) slashing fault
+			k.handleUnrevealedCommits(ctx, auction)
+
 			return winnerValidator, winningBid, nil
 		}
 	}
@@ -1391,34 +1415,32 @@ func (k Keeper) SelectAuctionWinner(ctx sdk.Context, height uint64) (string, str
 
 	// Burn ANT from winner (fallback case)
 	if k.anteilKeeper != nil {
-		positionInterface, err := k.anteilKeeper.GetUserPosition(ctx, winnerValidator)
+		position, err := k.anteilKeeper.GetUserPosition(ctx, winnerValidator)
 		if err == nil {
-			currentBalanceStr, err := extractAntBalance(positionInterface)
+			currentBalance, err := strconv.ParseUint(position.AntBalance, 10, 64)
 			if err == nil {
-				currentBalance, err := strconv.ParseUint(currentBalanceStr, 10, 64)
-				if err == nil {
-					winningBidInt, err := strconv.ParseUint(winningBid, 10, 64)
-					if err == nil && currentBalance >= winningBidInt {
-						newBalance := currentBalance - winningBidInt
-						err = k.anteilKeeper.UpdateUserPosition(ctx, winnerValidator, fmt.Sprintf("%d", newBalance), 0)
-						if err != nil {
-							ctx.Logger().Error("failed to burn ANT from winner (fallback)", "error", err, "winner", winnerValidator, "amount", winningBid)
-						} else {
-							ctx.Logger().Info("ANT burned from auction winner (fallback)", "winner", winnerValidator, "amount", winningBid, "new_balance", newBalance)
-							
-							// Emit burn event for tracking
-							ctx.EventManager().EmitEvent(
-								sdk.NewEvent(
-									types.EventTypeBurnExecuted,
-									sdk.NewAttribute(types.AttributeKeyValidator, winnerValidator),
-									sdk.NewAttribute(types.AttributeKeyBurnAmount, winningBid),
-									sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", height)),
-									sdk.NewAttribute(types.AttributeKeyNewBalance, fmt.Sprintf("%d", newBalance)),
-									sdk.NewAttribute(types.AttributeKeyAuctionWinner, "true"),
-									sdk.NewAttribute("fallback", "true"),
-								),
-							)
-						}
+				winningBidInt, err := strconv.ParseUint(winningBid, 10, 64)
+				if err == nil && currentBalance >= winningBidInt {
+					newBalance := currentBalance - winningBidInt
+					err = k.anteilKeeper.BurnAnt(ctx, winnerValidator, winningBidInt)
+					if err != nil {
+						ctx.Logger().Error("failed to burn ANT from winner (fallback)", "error", err, "winner", winnerValidator, "amount", winningBid)
+					} else {
+						ctx.Logger().Info("ANT burned from auction winner (fallback)", "winner", winnerValidator, "amount", winningBid, "new_balance", newBalance)
+						k.addBlockBurnedAnt(ctx, winningBidInt)
+						
+						// Emit burn event for tracking
+						ctx.EventManager().EmitEvent(
+							sdk.NewEvent(
+								types.EventTypeBurnExecuted,
+								sdk.NewAttribute(types.AttributeKeyValidator, winnerValidator),
+								sdk.NewAttribute(types.AttributeKeyBurnAmount, winningBid),
+								sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", height)),
+								sdk.NewAttribute(types.AttributeKeyNewBalance, fmt.Sprintf("%d", newBalance)),
+								sdk.NewAttribute(types.AttributeKeyAuctionWinner, "true"),
+								sdk.NewAttribute("fallback", "true"),
+							),
+						)
 					}
 				}
 			}
@@ -1437,13 +1459,16 @@ func (k Keeper) SelectAuctionWinner(ctx sdk.Context, height uint64) (string, str
 	auction.Winner = winnerValidator
 	auction.WinningBid = winningBid
 	auction.Phase = consensusv1.AuctionPhase_AUCTION_PHASE_COMPLETE
-	auction.EndTime = timestamppb.Now()
+	auction.EndTime = timestamppb.New(ctx.BlockTime())
 
 	err = k.SetBlindAuction(ctx, auction)
 	if err != nil {
 		return "", "", err
 	}
 
+	// Commits without a reveal are a (lighter) slashing fault
+	k.handleUnrevealedCommits(ctx, auction)
+
 	return winnerValidator, winningBid, nil
 }
 
@@ -1569,50 +1594,6 @@ func (k Keeper) GetHalvingCount(height uint64) uint64 {
 	return height / HalvingInterval
 }
 
-// extractLizenzInfo extracts validator and amount from an ActivatedLizenz object
-// Uses reflection to avoid circular dependency with lizenzv1 package
-func extractLizenzInfo(lizenzInterface interface{}) (validator string, amount string, err error) {
-	// Use reflection to get the fields
-	v := reflect.ValueOf(lizenzInterface)
-	if v.Kind() == reflect.Ptr {
-		v = v.Elem()
-	}
-
-	// Get Validator field
-	validatorField := v.FieldByName("Validator")
-	if !validatorField.IsValid() || validatorField.Kind() != reflect.String {
-		return "", "", fmt.Errorf("invalid LZN object: Validator field not found or invalid")
-	}
-	validator = validatorField.String()
-
-	// Get Amount field
-	amountField := v.FieldByName("Amount")
-	if !amountField.IsValid() || amountField.Kind() != reflect.String {
-		return "", "", fmt.Errorf("invalid LZN object: Amount field not found or invalid")
-	}
-	amount = amountField.String()
-
-	return validator, amount, nil
-}
-
-// extractAntBalance extracts ANT balance from a UserPosition object
-// Uses reflection to avoid circular dependency with anteilv1 package
-func extractAntBalance(positionInterface interface{}) (string, error) {
-	// Use reflection to get the fields
-	v := reflect.ValueOf(positionInterface)
-	if v.Kind() == reflect.Ptr {
-		v = v.Elem()
-	}
-
-	// Get AntBalance field
-	antBalanceField := v.FieldByName("AntBalance")
-	if !antBalanceField.IsValid() || antBalanceField.Kind() != reflect.String {
-		return "0", fmt.Errorf("invalid UserPosition object: AntBalance field not found or invalid")
-	}
-
-	return antBalanceField.String(), nil
-}
-
 // CalculateMOAPenaltyMultiplier calculates the penalty multiplier based on MOA compliance
 // According to whitepaper and economic-formulas.md:
 // - >= threshold_high: no penalty (1.0)
@@ -1686,8 +1667,14 @@ func (k Keeper) CalculateRewardDistribution(ctx sdk.Context, baseReward uint64,
 	var rewards []ValidatorRewardInfo
 	totalDistributed := uint64(0)
 
-	// Calculate reward for each validator
-	for validator, activatedLZN := range validatorLZN {
+	// Calculate reward for each validator, in address order so the remainder goes to the same validator on every node
+	validators := make([]string, 0, len(validatorLZN))
+	for validator := range validatorLZN {
+		validators = append(validators, validator)
+	}
+	sort.Strings(validators)
+	for _, validator := range validators {
+		activatedLZN := validatorLZN[validator]
 		if activatedLZN == 0 {
 			continue // Skip validators with no activated LZN
 		}
@@ -1742,140 +1729,3 @@ func (k Keeper) CalculateRewardDistribution(ctx sdk.Context, baseReward uint64,
 
 	return rewards, totalDistributed, nil
 }
-
-// DistributeBaseRewards distributes base block rewards to validators based on their activated LZN
-// This implements Circuit 1 of the economic model: passive income for validators
-// According to whitepaper: "validator_passive_income = (activated_lzn_validator / total_activated_lzn) × current_block_reward"
-func (k Keeper) DistributeBaseRewards(ctx sdk.Context, height uint64) error {
-	// Calculate base reward for this block
-	baseReward, err := k.CalculateBaseReward(ctx, height)
-	if err != nil {
-		return fmt.Errorf("failed to calculate base reward: %w", err)
-	}
-
-	// If no lizenz keeper is set, we can't distribute rewards
-	// This is expected in some test scenarios
-	if k.lizenzKeeper == nil {
-		ctx.Logger().Info("lizenz keeper not set, skipping reward distribution", "height", height, "base_reward", baseReward)
-		return nil
-	}
-
-	// Get all activated LZN
-	allLizenzs, err := k.lizenzKeeper.GetAllActivatedLizenz(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get activated LZN: %w", err)
-	}
-
-	// Build map of validator -> activated LZN amount
-	validatorLZN := make(map[string]uint64)
-	for _, lizenzInterface := range allLizenzs {
-		// Type assertion: we expect *lizenzv1.ActivatedLizenz
-		// Use reflection or type assertion to get validator and amount
-		// Since we can't import lizenzv1 here, we use a helper function
-		validator, amount, err := extractLizenzInfo(lizenzInterface)
-		if err != nil {
-			ctx.Logger().Error("failed to extract LZN info", "error", err)
-			continue
-		}
-
-		// Parse amount to uint64
-		amountInt, err := strconv.ParseUint(amount, 10, 64)
-		if err != nil {
-			ctx.Logger().Error("failed to parse LZN amount", "error", err, "amount", amount)
-			continue
-		}
-
-		validatorLZN[validator] = amountInt
-	}
-
-	// If no validators have activated LZN, skip distribution
-	if len(validatorLZN) == 0 {
-		ctx.Logger().Info("no validators with activated LZN, skipping reward distribution", "height", height)
-		return nil
-	}
-
-	// Calculate reward distribution
-	rewards, totalDistributed, err := k.CalculateRewardDistribution(ctx, baseReward, validatorLZN)
-	if err != nil {
-		return fmt.Errorf("failed to calculate reward distribution: %w", err)
-	}
-
-	// Log distribution with MOA compliance information
-	ctx.Logger().Info("base reward distribution calculated",
-		"height", height,
-		"base_reward", baseReward,
-		"total_distributed", totalDistributed,
-		"validators_count", len(rewards))
-
-	// Update reward statistics for each validator
-	for _, reward := range rewards {
-		ctx.Logger().Info("validator reward",
-			"validator", reward.Validator,
-			"activated_lzn", reward.ActivatedLZN,
-			"share", reward.RewardShare,
-			"base_reward", reward.BaseRewardAmount,
-			"moa_compliance", reward.MOACompliance,
-			"penalty_multiplier", reward.PenaltyMultiplier,
-			"final_reward", reward.FinalRewardAmount)
-		
-		// Update reward stats in lizenz module
-		if k.lizenzKeeper != nil {
-			if err := k.lizenzKeeper.UpdateRewardStats(ctx, reward.Validator, reward.FinalRewardAmount, height, reward.MOACompliance, reward.PenaltyMultiplier, reward.BaseRewardAmount); err != nil {
-				ctx.Logger().Error("failed to update reward stats", "error", err, "validator", reward.Validator)
-				// Don't fail reward distribution if stats update fails
-			}
-		}
-	}
-
-	// Send WRT tokens to validators via bank module
-	if k.bankKeeper != nil {
-		for _, reward := range rewards {
-			validatorAddr, err := sdk.AccAddressFromBech32(reward.Validator)
-			if err != nil {
-				ctx.Logger().Error("invalid validator address", "error", err, "validator", reward.Validator)
-				continue
-			}
-
-			// Create coins for reward amount (in micro WRT)
-			rewardCoins := sdk.NewCoins(sdk.NewCoin("uwrt", math.NewIntFromUint64(reward.FinalRewardAmount)))
-
-			// Mint coins from consensus module account and send to validator
-			// Note: In production, coins should be minted from a module account
-			// For now, we use SendCoinsFromModuleToAccount which requires module account setup
-			moduleName := types.ModuleName
-			if err := k.bankKeeper.MintCoins(ctx, moduleName, rewardCoins); err != nil {
-				ctx.Logger().Error("failed to mint reward coins", "error", err, "validator", reward.Validator, "amount", reward.FinalRewardAmount)
-				continue
-			}
-
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, moduleName, validatorAddr, rewardCoins); err != nil {
-				ctx.Logger().Error("failed to send reward coins", "error", err, "validator", reward.Validator, "amount", reward.FinalRewardAmount)
-				// Note: Minted coins remain in module account if sending fails
-				// In production, should implement proper error recovery (burn or retry)
-				continue
-			}
-
-			ctx.Logger().Info("WRT reward sent to validator",
-				"validator", reward.Validator,
-				"amount", reward.FinalRewardAmount,
-				"height", height)
-			
-			// Emit reward distribution event
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeRewardDistributed,
-					sdk.NewAttribute(types.AttributeKeyValidator, reward.Validator),
-					sdk.NewAttribute(types.AttributeKeyRewardAmount, fmt.Sprintf("%d", reward.FinalRewardAmount)),
-					sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", height)),
-					sdk.NewAttribute(types.AttributeKeyRewardShare, fmt.Sprintf("%.6f", reward.RewardShare)),
-					sdk.NewAttribute(types.AttributeKeyMOACompliance, fmt.Sprintf("%.2f", reward.MOACompliance)),
-					sdk.NewAttribute(types.AttributeKeyPenaltyMultiplier, fmt.Sprintf("%.2f", reward.PenaltyMultiplier)),
-				),
-			)
-		}
-	} else {
-		ctx.Logger().Info("bank keeper not set, rewards calculated but not sent", "height", height)
-	}
-
-	return nil
-}
@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	"encoding/hex"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -16,6 +18,7 @@ import (
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
 	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
@@ -73,6 +76,9 @@ func (suite *AdvancedKeeperTestSuite) TestCreateBlindAuction_Duplicate() {
 
 // Test CommitBid
 func (suite *AdvancedKeeperTestSuite) TestCommitBid() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
 	height := uint64(1000)
 	validator := "cosmos1validator"
 	
@@ -94,6 +100,9 @@ func (suite *AdvancedKeeperTestSuite) TestCommitBid() {
 }
 
 func (suite *AdvancedKeeperTestSuite) TestCommitBid_InvalidHash() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
 	height := uint64(1000)
 	validator := "cosmos1validator"
 	
@@ -104,6 +113,9 @@ func (suite *AdvancedKeeperTestSuite) TestCommitBid_InvalidHash() {
 }
 
 func (suite *AdvancedKeeperTestSuite) TestCommitBid_Duplicate() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
 	height := uint64(1000)
 	validator := "cosmos1validator"
 	// Create a valid 64-character hex hash
@@ -120,6 +132,9 @@ func (suite *AdvancedKeeperTestSuite) TestCommitBid_Duplicate() {
 
 // Test RevealBid
 func (suite *AdvancedKeeperTestSuite) TestRevealBid() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
 	height := uint64(1000)
 	validator := "cosmos1validator"
 	nonce := "test_nonce_123"
@@ -139,7 +154,7 @@ func (suite *AdvancedKeeperTestSuite) TestRevealBid() {
 	
 	// Create mock anteil keeper for balance check
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: make(map[string]interface{}),
+		positions: make(map[string]*anteilv1.UserPosition),
 	}
 	suite.keeper.SetAnteilKeeper(mockAnteilKeeper)
 	
@@ -173,6 +188,9 @@ func (suite *AdvancedKeeperTestSuite) TestRevealBid_NoCommit() {
 }
 
 func (suite *AdvancedKeeperTestSuite) TestRevealBid_CommitHashMismatch() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
 	height := uint64(1000)
 	validator := "cosmos1validator"
 	
@@ -189,7 +207,7 @@ func (suite *AdvancedKeeperTestSuite) TestRevealBid_CommitHashMismatch() {
 	require.NoError(suite.T(), err)
 	
 	// Try to reveal with different nonce/amount - should fail
-	mockAnteilKeeper := &MockAnteilKeeper{positions: make(map[string]interface{})}
+	mockAnteilKeeper := &MockAnteilKeeper{positions: make(map[string]*anteilv1.UserPosition)}
 	suite.keeper.SetAnteilKeeper(mockAnteilKeeper)
 	
 	err = suite.keeper.RevealBid(suite.ctx, validator, "wrong_nonce", "wrong_amount", height)
@@ -207,14 +225,15 @@ func (suite *AdvancedKeeperTestSuite) TestDistributeBaseRewards_NoLizenzKeeper()
 
 // Mock AnteilKeeper for testing
 type MockAnteilKeeper struct {
-	positions map[string]interface{}
+	positions map[string]*anteilv1.UserPosition
+	bonds     map[string]uint64
 }
 
-func (m *MockAnteilKeeper) GetUserPosition(ctx sdk.Context, user string) (interface{}, error) {
+func (m *MockAnteilKeeper) GetUserPosition(ctx sdk.Context, user string) (*anteilv1.UserPosition, error) {
 	pos, ok := m.positions[user]
 	if !ok {
 		// Return a mock position with sufficient balance
-		return &MockUserPosition{
+		return &anteilv1.UserPosition{
 			Owner:      user,
 			AntBalance: "10000000", // 10 ANT
 		}, nil
@@ -222,15 +241,8 @@ func (m *MockAnteilKeeper) GetUserPosition(ctx sdk.Context, user string) (interf
 	return pos, nil
 }
 
-func (m *MockAnteilKeeper) SetUserPosition(ctx sdk.Context, position interface{}) error {
-	if pos, ok := position.(*MockUserPosition); ok {
-		m.positions[pos.Owner] = pos
-	}
-	return nil
-}
-
 func (m *MockAnteilKeeper) UpdateUserPosition(ctx sdk.Context, user string, antBalance string, orderCount uint32) error {
-	pos := &MockUserPosition{
+	pos := &anteilv1.UserPosition{
 		Owner:      user,
 		AntBalance: antBalance,
 	}
@@ -238,8 +250,45 @@ func (m *MockAnteilKeeper) UpdateUserPosition(ctx sdk.Context, user string, antB
 	return nil
 }
 
-type MockUserPosition struct {
-	Owner      string
-	AntBalance string
+func (m *MockAnteilKeeper) balance(ctx sdk.Context, user string) uint64 {
+	pos, _ := m.GetUserPosition(ctx, user)
+	balance, _ := strconv.ParseUint(pos.AntBalance, 10, 64)
+	return balance
+}
+
+func (m *MockAnteilKeeper) BurnAnt(ctx sdk.Context, owner string, amount uint64) error {
+	balance := m.balance(ctx, owner)
+	if balance < amount {
+		return fmt.Errorf("insufficient balance: have %d, need %d", balance, amount)
+	}
+	return m.UpdateUserPosition(ctx, owner, strconv.FormatUint(balance-amount, 10), 0)
+}
+
+func (m *MockAnteilKeeper) LockAntBond(ctx sdk.Context, owner string, amount uint64) error {
+	balance := m.balance(ctx, owner)
+	if balance < amount {
+		return fmt.Errorf("insufficient balance: have %d, need %d", balance, amount)
+	}
+	if m.bonds == nil {
+		m.bonds = make(map[string]uint64)
+	}
+	m.bonds[owner] += amount
+	return m.UpdateUserPosition(ctx, owner, strconv.FormatUint(balance-amount, 10), 0)
+}
+
+func (m *MockAnteilKeeper) ReleaseAntBond(ctx sdk.Context, owner string, amount uint64) error {
+	if m.bonds[owner] < amount {
+		return fmt.Errorf("escrow holds %d, cannot release %d", m.bonds[owner], amount)
+	}
+	m.bonds[owner] -= amount
+	return m.UpdateUserPosition(ctx, owner, strconv.FormatUint(m.balance(ctx, owner)+amount, 10), 0)
+}
+
+func (m *MockAnteilKeeper) BurnAntBond(ctx sdk.Context, owner string, amount uint64) error {
+	if m.bonds[owner] < amount {
+		return fmt.Errorf("escrow holds %d, cannot burn %d", m.bonds[owner], amount)
+	}
+	m.bonds[owner] -= amount
+	return nil
 }
 
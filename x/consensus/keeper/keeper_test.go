@@ -18,7 +18,9 @@ import (
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
 	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
 )
@@ -102,6 +104,45 @@ func (suite *KeeperTestSuite) TestProcessHalving() {
 	retrieved, err := suite.keeper.GetHalvingInfo(suite.ctx)
 	require.NoError(suite.T(), err)
 	require.Equal(suite.T(), uint64(100000), retrieved.LastHalvingHeight)
+	require.Equal(suite.T(), suite.ctx.BlockTime().Unix(), retrieved.LastHalvingDate.AsTime().Unix())
+	require.NotNil(suite.T(), retrieved.EstimatedNextHalvingDate)
+}
+
+// TestProcessHalving_WritesOnlyOnHalving tests HalvingInfo is written when initialized or at a halving, not every block
+func (suite *KeeperTestSuite) TestProcessHalving_WritesOnlyOnHalving() {
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockHeight(100).WithBlockTime(blockTime)
+	require.NoError(suite.T(), suite.keeper.ProcessHalving(ctx))
+
+	info, err := suite.keeper.GetHalvingInfo(ctx)
+	require.NoError(suite.T(), err)
+	require.Nil(suite.T(), info.LastHalvingDate)
+	expected, ok := suite.keeper.EstimateHeightTime(ctx, info.NextHalvingHeight)
+	require.True(suite.T(), ok)
+	require.Equal(suite.T(), expected, info.EstimatedNextHalvingDate.AsTime())
+
+	gasUsed := func(ctx sdk.Context) storetypes.Gas {
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		require.NoError(suite.T(), suite.keeper.ProcessHalving(ctx))
+		return ctx.GasMeter().GasConsumed()
+	}
+	// A later block time moves the estimate but does not write it
+	unchanged := gasUsed(ctx)
+	require.Equal(suite.T(), unchanged, gasUsed(ctx.WithBlockTime(blockTime.Add(time.Minute))))
+	stored, err := suite.keeper.GetHalvingInfo(ctx)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), expected, stored.EstimatedNextHalvingDate.AsTime())
+
+	// A halving writes the new heights and estimate
+	halvingCtx := ctx.WithBlockHeight(int64(info.NextHalvingHeight)).WithBlockTime(blockTime.Add(time.Hour))
+	require.GreaterOrEqual(suite.T(), gasUsed(halvingCtx)-unchanged, storetypes.KVGasConfig().WriteCostFlat)
+	halved, err := suite.keeper.GetHalvingInfo(ctx)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), info.NextHalvingHeight, halved.LastHalvingHeight)
+	require.Equal(suite.T(), blockTime.Add(time.Hour), halved.LastHalvingDate.AsTime())
+	expected, ok = suite.keeper.EstimateHeightTime(halvingCtx, halved.NextHalvingHeight)
+	require.True(suite.T(), ok)
+	require.Equal(suite.T(), expected, halved.EstimatedNextHalvingDate.AsTime())
 }
 
 func (suite *KeeperTestSuite) TestSelectBlockProducer() {
@@ -605,8 +646,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_Basic() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
@@ -753,12 +794,12 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_MultipleReveals() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
-			"cosmos1validator2": &MockUserPosition{
+			"cosmos1validator2": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator2",
 				AntBalance: "5000000",
 			},
@@ -827,8 +868,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_InvalidBids() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator2": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator2": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator2",
 				AntBalance: "5000000",
 			},
@@ -861,8 +902,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_InsufficientBalance() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "500000", // Less than bid
 			},
@@ -905,8 +946,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_NoAnteilKeeper() {
 // TestValidateAuctionBid_Basic tests basic ValidateAuctionBid functionality
 func (suite *KeeperTestSuite) TestValidateAuctionBid_Basic() {
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator",
 				AntBalance: "10000000",
 			},
@@ -932,8 +973,8 @@ func (suite *KeeperTestSuite) TestValidateAuctionBid_InvalidAmount() {
 // TestValidateAuctionBid_InsufficientBalance tests ValidateAuctionBid with insufficient balance
 func (suite *KeeperTestSuite) TestValidateAuctionBid_InsufficientBalance() {
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator",
 				AntBalance: "1000000",
 			},
@@ -949,8 +990,8 @@ func (suite *KeeperTestSuite) TestValidateAuctionBid_InsufficientBalance() {
 // TestValidateAuctionBid_ExceedsMax tests ValidateAuctionBid with bid exceeding maximum
 func (suite *KeeperTestSuite) TestValidateAuctionBid_ExceedsMax() {
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator",
 				AntBalance: "2000000000000",
 			},
@@ -966,8 +1007,8 @@ func (suite *KeeperTestSuite) TestValidateAuctionBid_ExceedsMax() {
 // TestValidateAuctionBid_RapidChanges tests ValidateAuctionBid with rapid bid changes
 func (suite *KeeperTestSuite) TestValidateAuctionBid_RapidChanges() {
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator",
 				AntBalance: "10000000",
 			},
@@ -998,7 +1039,7 @@ func (suite *KeeperTestSuite) TestValidateAuctionBid_NoAnteilKeeper() {
 func (suite *KeeperTestSuite) TestValidateAuctionBid_GetUserPositionError() {
 	// Create a mock that returns error
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{},
+		positions: map[string]*anteilv1.UserPosition{},
 	}
 	suite.keeper.SetAnteilKeeper(mockAnteilKeeper)
 
@@ -1010,8 +1051,8 @@ func (suite *KeeperTestSuite) TestValidateAuctionBid_GetUserPositionError() {
 // TestValidateAuctionBid_InvalidBalanceFormat tests ValidateAuctionBid with invalid balance format
 func (suite *KeeperTestSuite) TestValidateAuctionBid_InvalidBalanceFormat() {
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator",
 				AntBalance: "invalid_balance",
 			},
@@ -1044,8 +1085,8 @@ func (suite *KeeperTestSuite) TestRevealBid_Basic() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator",
 				AntBalance: "10000000",
 			},
@@ -1139,8 +1180,8 @@ func (suite *KeeperTestSuite) TestRevealBid_CommitHashMismatch() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator",
 				AntBalance: "10000000",
 			},
@@ -1173,8 +1214,8 @@ func (suite *KeeperTestSuite) TestRevealBid_InvalidBidAmount() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator",
 				AntBalance: "10000000",
 			},
@@ -1311,15 +1352,9 @@ func (suite *KeeperTestSuite) TestSelectBlockCreator_WithLizenzKeeper() {
 	}
 
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": "cosmos1validator1",
-				"amount":    "5000000",
-			},
-			map[string]interface{}{
-				"validator": "cosmos1validator2",
-				"amount":    "10000000",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: "cosmos1validator1", Amount: "5000000"},
+			{Validator: "cosmos1validator2", Amount: "10000000"},
 		},
 	}
 	suite.keeper.SetLizenzKeeper(mockLizenzKeeper)
@@ -1388,16 +1423,16 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_WeightedSelection() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
-			"cosmos1validator2": &MockUserPosition{
+			"cosmos1validator2": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator2",
 				AntBalance: "5000000",
 			},
-			"cosmos1validator3": &MockUserPosition{
+			"cosmos1validator3": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator3",
 				AntBalance: "5000000",
 			},
@@ -1440,8 +1475,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_BurnAntFromWinner() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
@@ -1457,8 +1492,7 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_BurnAntFromWinner() {
 	// Verify ANT was burned (balance should be updated)
 	position, err := mockAnteilKeeper.GetUserPosition(suite.ctx, "cosmos1validator1")
 	require.NoError(suite.T(), err)
-	pos := position.(*MockUserPosition)
-	require.Equal(suite.T(), "4000000", pos.AntBalance) // 5000000 - 1000000
+	require.Equal(suite.T(), "4000000", position.AntBalance) // 5000000 - 1000000
 }
 
 // TestSelectAuctionWinner_ReturnAntToNonWinners tests SelectAuctionWinner returning ANT to non-winners
@@ -1486,12 +1520,12 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_ReturnAntToNonWinners() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
-			"cosmos1validator2": &MockUserPosition{
+			"cosmos1validator2": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator2",
 				AntBalance: "5000000",
 			},
@@ -1526,8 +1560,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackPath() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
@@ -1545,11 +1579,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackPath() {
 func (suite *KeeperTestSuite) TestDistributeBaseRewards_Basic() {
 	height := uint64(1000)
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": "cosmos1validator1",
-				"amount":    "5000000",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: "cosmos1validator1", Amount: "5000000"},
 		},
 		moaCompliance: map[string]float64{
 			"cosmos1validator1": 1.0,
@@ -1577,7 +1608,7 @@ func (suite *KeeperTestSuite) TestDistributeBaseRewards_NoLizenzKeeper() {
 func (suite *KeeperTestSuite) TestDistributeBaseRewards_NoValidatorsWithLZN() {
 	height := uint64(1000)
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{},
 	}
 	suite.keeper.SetLizenzKeeper(mockLizenzKeeper)
 
@@ -1604,11 +1635,8 @@ func (suite *KeeperTestSuite) TestDistributeBaseRewards_GetAllLizenzError() {
 func (suite *KeeperTestSuite) TestDistributeBaseRewards_NoBankKeeper() {
 	height := uint64(1000)
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": "cosmos1validator1",
-				"amount":    "5000000",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: "cosmos1validator1", Amount: "5000000"},
 		},
 		moaCompliance: map[string]float64{
 			"cosmos1validator1": 1.0,
@@ -1628,11 +1656,8 @@ func (suite *KeeperTestSuite) TestDistributeBaseRewards_NoBankKeeper() {
 func (suite *KeeperTestSuite) TestDistributeBaseRewards_InvalidValidatorAddress() {
 	height := uint64(1000)
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": "invalid_address",
-				"amount":    "5000000",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: "invalid_address", Amount: "5000000"},
 		},
 		moaCompliance: map[string]float64{
 			"invalid_address": 1.0,
@@ -1654,11 +1679,8 @@ func (suite *KeeperTestSuite) TestDistributeBaseRewards_MintCoinsError() {
 	height := uint64(1000)
 	validatorAddr := sdk.AccAddress("validator1_______________")
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": validatorAddr.String(),
-				"amount":    "5000000",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: validatorAddr.String(), Amount: "5000000"},
 		},
 		moaCompliance: map[string]float64{
 			validatorAddr.String(): 1.0,
@@ -1680,11 +1702,8 @@ func (suite *KeeperTestSuite) TestDistributeBaseRewards_SendCoinsError() {
 	height := uint64(1000)
 	validatorAddr := sdk.AccAddress("validator1_______________")
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": validatorAddr.String(),
-				"amount":    "5000000",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: validatorAddr.String(), Amount: "5000000"},
 		},
 		moaCompliance: map[string]float64{
 			validatorAddr.String(): 1.0,
@@ -1773,31 +1792,6 @@ func (suite *KeeperTestSuite) TestSelectBlockCreator_InvalidActivityScore() {
 	require.Equal(suite.T(), "cosmos1validator1", blockCreator.Validator)
 }
 
-// TestSelectBlockCreator_ExtractLizenzError tests SelectBlockCreator when extractLizenzInfo fails
-// Note: extractLizenzInfo uses reflection and will panic on invalid types
-// This test verifies the normal path works with valid data
-func (suite *KeeperTestSuite) TestSelectBlockCreator_ExtractLizenzError() {
-	validator := &consensusv1.Validator{
-		Validator:     "cosmos1validator1",
-		AntBalance:    "1000000",
-		ActivityScore: "500",
-		Status:        consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE,
-		LastActive:    timestamppb.Now(),
-	}
-	suite.keeper.SetValidator(suite.ctx, validator)
-
-	// Set up mock lizenz keeper with empty list (no invalid data to cause panic)
-	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{},
-	}
-	suite.keeper.SetLizenzKeeper(mockLizenzKeeper)
-
-	blockCreator, err := suite.keeper.SelectBlockCreator(suite.ctx, 1000)
-	require.NoError(suite.T(), err)
-	require.NotNil(suite.T(), blockCreator)
-	require.Equal(suite.T(), "cosmos1validator1", blockCreator.Validator)
-}
-
 // TestSelectBlockCreator_ParseLznAmountError tests SelectBlockCreator when parsing LZN amount fails
 func (suite *KeeperTestSuite) TestSelectBlockCreator_ParseLznAmountError() {
 	validator := &consensusv1.Validator{
@@ -1810,11 +1804,8 @@ func (suite *KeeperTestSuite) TestSelectBlockCreator_ParseLznAmountError() {
 	suite.keeper.SetValidator(suite.ctx, validator)
 
 	mockLizenzKeeper := &MockLizenzKeeper{
-		activatedLizenz: []interface{}{
-			map[string]interface{}{
-				"validator": "cosmos1validator1",
-				"amount":    "invalid_amount",
-			},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: "cosmos1validator1", Amount: "invalid_amount"},
 		},
 	}
 	suite.keeper.SetLizenzKeeper(mockLizenzKeeper)
@@ -1869,7 +1860,7 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_GetUserPositionError() {
 
 	// Mock that returns error for GetUserPosition
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{},
+		positions: map[string]*anteilv1.UserPosition{},
 	}
 	suite.keeper.SetAnteilKeeper(mockAnteilKeeper)
 
@@ -1880,39 +1871,6 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_GetUserPositionError() {
 	require.Equal(suite.T(), "1000000", bidAmount)
 }
 
-// TestSelectAuctionWinner_ExtractBalanceError tests SelectAuctionWinner when extractAntBalance fails
-// Note: extractAntBalance uses reflection and will panic on invalid types
-// This test verifies the normal path works when GetUserPosition returns default
-func (suite *KeeperTestSuite) TestSelectAuctionWinner_ExtractBalanceError() {
-	height := uint64(1000)
-	auction := &consensusv1.BlindAuction{
-		BlockHeight: height,
-		Phase:       consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL,
-		Reveals: []*consensusv1.BidReveal{
-			{
-				Validator:   "cosmos1validator1",
-				BidAmount:   "1000000",
-				Nonce:       "nonce1",
-				BlockHeight: height,
-			},
-		},
-	}
-	err := suite.keeper.SetBlindAuction(suite.ctx, auction)
-	require.NoError(suite.T(), err)
-
-	// Mock that returns default position (no error, but extractAntBalance will work)
-	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{},
-	}
-	suite.keeper.SetAnteilKeeper(mockAnteilKeeper)
-
-	// Should succeed (uses default position from MockAnteilKeeper)
-	winner, bidAmount, err := suite.keeper.SelectAuctionWinner(suite.ctx, height)
-	require.NoError(suite.T(), err)
-	require.Equal(suite.T(), "cosmos1validator1", winner)
-	require.Equal(suite.T(), "1000000", bidAmount)
-}
-
 // TestSelectAuctionWinner_UpdateUserPositionError tests SelectAuctionWinner when UpdateUserPosition fails
 func (suite *KeeperTestSuite) TestSelectAuctionWinner_UpdateUserPositionError() {
 	height := uint64(1000)
@@ -1932,8 +1890,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_UpdateUserPositionError()
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
@@ -1967,8 +1925,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_ParseBidAmountError() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
@@ -2003,8 +1961,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_InsufficientBalanceForBurn
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000", // Less than bid
 			},
@@ -2038,8 +1996,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_SetBlindAuctionError() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
@@ -2085,16 +2043,16 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_MultipleRevealsWeighted()
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
-			"cosmos1validator2": &MockUserPosition{
+			"cosmos1validator2": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator2",
 				AntBalance: "5000000",
 			},
-			"cosmos1validator3": &MockUserPosition{
+			"cosmos1validator3": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator3",
 				AntBalance: "5000000",
 			},
@@ -2102,16 +2060,17 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_MultipleRevealsWeighted()
 	}
 	suite.keeper.SetAnteilKeeper(mockAnteilKeeper)
 
-	// Test multiple selections to verify weighted randomness
+	// Test selections in multiple blocks to verify weighted randomness
 	winners := make(map[string]int)
 	for i := 0; i < 20; i++ {
+		ctx := suite.ctx.WithHeaderHash([]byte(fmt.Sprintf("block-hash-%d", i)))
 		auction.Phase = consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL
 		auction.Winner = ""
 		auction.WinningBid = ""
-		err = suite.keeper.SetBlindAuction(suite.ctx, auction)
+		err = suite.keeper.SetBlindAuction(ctx, auction)
 		require.NoError(suite.T(), err)
 
-		winner, bidAmount, err := suite.keeper.SelectAuctionWinner(suite.ctx, height)
+		winner, bidAmount, err := suite.keeper.SelectAuctionWinner(ctx, height)
 		require.NoError(suite.T(), err)
 		require.NotEmpty(suite.T(), winner)
 		require.NotEmpty(suite.T(), bidAmount)
@@ -2123,6 +2082,37 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_MultipleRevealsWeighted()
 	require.Greater(suite.T(), len(winners), 1, "should have multiple winners")
 }
 
+// TestSelectAuctionWinner_Deterministic tests that every node draws the same winner for a block
+func (suite *KeeperTestSuite) TestSelectAuctionWinner_Deterministic() {
+	height := uint64(1000)
+	auction := &consensusv1.BlindAuction{
+		BlockHeight: height,
+		Phase:       consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL,
+		Reveals: []*consensusv1.BidReveal{
+			{Validator: "cosmos1validator1", BidAmount: "1000000", Nonce: "nonce1", BlockHeight: height},
+			{Validator: "cosmos1validator2", BidAmount: "2000000", Nonce: "nonce2", BlockHeight: height},
+			{Validator: "cosmos1validator3", BidAmount: "3000000", Nonce: "nonce3", BlockHeight: height},
+		},
+	}
+	ctx := suite.ctx.WithHeaderHash([]byte("block-hash"))
+
+	var winners []string
+	for i := 0; i < 5; i++ {
+		auction.Phase = consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL
+		auction.Winner = ""
+		auction.WinningBid = ""
+		require.NoError(suite.T(), suite.keeper.SetBlindAuction(ctx, auction))
+
+		winner, _, err := suite.keeper.SelectAuctionWinner(ctx, height)
+		require.NoError(suite.T(), err)
+		winners = append(winners, winner)
+	}
+
+	for _, winner := range winners {
+		require.Equal(suite.T(), winners[0], winner)
+	}
+}
+
 // TestSelectAuctionWinner_FallbackPathWithMultipleReveals tests SelectAuctionWinner fallback path
 func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackPathWithMultipleReveals() {
 	height := uint64(1000)
@@ -2143,8 +2133,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackPathWithMultipleRe
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
@@ -2189,16 +2179,16 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_ReturnAntToNonWinnersMulti
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
-			"cosmos1validator2": &MockUserPosition{
+			"cosmos1validator2": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator2",
 				AntBalance: "5000000",
 			},
-			"cosmos1validator3": &MockUserPosition{
+			"cosmos1validator3": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator3",
 				AntBalance: "5000000",
 			},
@@ -2233,8 +2223,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackBurnError() {
 
 	// Mock with insufficient balance for fallback path
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "500000", // Less than bid
 			},
@@ -2269,8 +2259,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_ParseBalanceError() {
 
 	// Mock with invalid balance format
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "invalid_balance",
 			},
@@ -2304,8 +2294,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_ParseWinningBidError() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
@@ -2351,8 +2341,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_InvalidBidAmounts() {
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator2": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator2": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator2",
 				AntBalance: "5000000",
 			},
@@ -2387,8 +2377,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_ParseCurrentBalanceError()
 
 	// Mock with invalid balance format
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "invalid_balance",
 			},
@@ -2428,12 +2418,12 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackWithMultipleReveal
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
-			"cosmos1validator2": &MockUserPosition{
+			"cosmos1validator2": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator2",
 				AntBalance: "5000000",
 			},
@@ -2468,8 +2458,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackSetBlindAuctionErr
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
@@ -2504,8 +2494,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackParseBalanceError(
 
 	// Mock with invalid balance format for fallback path
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "invalid_balance",
 			},
@@ -2539,8 +2529,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackParseWinningBidErr
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
@@ -2573,8 +2563,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackInsufficientBalanc
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000", // Less than bid
 			},
@@ -2608,8 +2598,8 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackUpdatePositionErro
 	require.NoError(suite.T(), err)
 
 	mockAnteilKeeper := &MockAnteilKeeper{
-		positions: map[string]interface{}{
-			"cosmos1validator1": &MockUserPosition{
+		positions: map[string]*anteilv1.UserPosition{
+			"cosmos1validator1": &anteilv1.UserPosition{
 				Owner:      "cosmos1validator1",
 				AntBalance: "5000000",
 			},
@@ -2626,6 +2616,9 @@ func (suite *KeeperTestSuite) TestSelectAuctionWinner_FallbackUpdatePositionErro
 
 // TestCommitBid_Basic tests basic CommitBid functionality
 func (suite *KeeperTestSuite) TestCommitBid_Basic() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
 	height := uint64(1000)
 	validator := "cosmos1validator"
 	commitHash := keeper.HashCommit("nonce1", "1000000")
@@ -2643,6 +2636,9 @@ func (suite *KeeperTestSuite) TestCommitBid_Basic() {
 
 // TestCommitBid_MultipleCommits tests CommitBid with multiple validators
 func (suite *KeeperTestSuite) TestCommitBid_MultipleCommits() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
 	height := uint64(1000)
 	validator1 := "cosmos1validator1"
 	validator2 := "cosmos1validator2"
@@ -2662,6 +2658,9 @@ func (suite *KeeperTestSuite) TestCommitBid_MultipleCommits() {
 
 // TestCommitBid_DuplicateValidator tests CommitBid when validator already committed
 func (suite *KeeperTestSuite) TestCommitBid_DuplicateValidator() {
+	// The commit window of auction 1000 opens at height 990
+	suite.ctx = suite.ctx.WithBlockHeight(990)
+
 	height := uint64(1000)
 	validator := "cosmos1validator"
 	commitHash1 := keeper.HashCommit("nonce1", "1000000")
@@ -2879,24 +2878,19 @@ func (suite *KeeperTestSuite) TestRecordBlockTime_Multiple() {
 	require.NotNil(suite.T(), avgTime)
 }
 
-// TestCalculateBlockTime_InvalidParams tests CalculateBlockTime with invalid parameters
+// TestCalculateBlockTime_InvalidParams tests an invalid base block time cannot be stored
 func (suite *KeeperTestSuite) TestCalculateBlockTime_InvalidParams() {
 	params := suite.keeper.GetParams(suite.ctx)
-
-	// Test with invalid BaseBlockTime
 	originalBaseBlockTime := params.BaseBlockTime
+
+	// The param store rejects an invalid BaseBlockTime
 	params.BaseBlockTime = "invalid"
-	suite.keeper.SetParams(suite.ctx, params)
+	require.Panics(suite.T(), func() { suite.keeper.SetParams(suite.ctx, params) })
+	require.Equal(suite.T(), originalBaseBlockTime, suite.keeper.GetParams(suite.ctx).BaseBlockTime)
 
-	// CalculateBlockTime may handle invalid params gracefully or return error
 	blockTime, err := suite.keeper.CalculateBlockTime(suite.ctx, "1000000")
-	// May succeed or fail depending on implementation
-	_ = blockTime
-	_ = err
-
-	// Restore original params
-	params.BaseBlockTime = originalBaseBlockTime
-	suite.keeper.SetParams(suite.ctx, params)
+	require.NoError(suite.T(), err)
+	require.Greater(suite.T(), blockTime, time.Duration(0))
 }
 
 // TestCalculateBlockTime_ZeroAnt tests CalculateBlockTime with zero ANT
@@ -3008,8 +3002,8 @@ func (suite *KeeperTestSuite) TestBeginBlocker_NoValidators() {
 	require.Empty(suite.T(), state.ActiveValidators)
 }
 
-// TestBeginBlocker_WithHalving tests BeginBlocker at halving height
-func (suite *KeeperTestSuite) TestBeginBlocker_WithHalving() {
+// TestEndBlocker_WithHalving tests halvings are processed once per block, in EndBlocker
+func (suite *KeeperTestSuite) TestEndBlocker_WithHalving() {
 	// Set halving info
 	halvingInfo := types.HalvingInfo{
 		LastHalvingHeight: 0,
@@ -3022,12 +3016,18 @@ func (suite *KeeperTestSuite) TestBeginBlocker_WithHalving() {
 	// Set block height to halving height
 	suite.ctx = suite.ctx.WithBlockHeight(100)
 
-	// Run BeginBlocker
+	// BeginBlocker leaves the halving to EndBlocker
 	err = suite.keeper.BeginBlocker(suite.ctx)
 	require.NoError(suite.T(), err)
+	info, err := suite.keeper.GetHalvingInfo(suite.ctx)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(100), info.NextHalvingHeight)
+
+	_, err = suite.keeper.EndBlocker(suite.ctx)
+	require.NoError(suite.T(), err)
 
 	// Verify halving was processed
-	info, err := suite.keeper.GetHalvingInfo(suite.ctx)
+	info, err = suite.keeper.GetHalvingInfo(suite.ctx)
 	require.NoError(suite.T(), err)
 	require.Equal(suite.T(), uint64(100), info.LastHalvingHeight)
 	require.Equal(suite.T(), uint64(200), info.NextHalvingHeight)
@@ -3050,7 +3050,7 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 	suite.ctx = suite.ctx.WithBlockHeight(1000)
 
 	// Run EndBlocker
-	err := suite.keeper.EndBlocker(suite.ctx)
+	_, err := suite.keeper.EndBlocker(suite.ctx)
 	require.NoError(suite.T(), err)
 
 	// Verify consensus state was updated
@@ -3058,17 +3058,19 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 	require.NoError(suite.T(), err)
 	require.Equal(suite.T(), uint64(1000), state.CurrentHeight)
 
-	// Verify auction was created for next block
-	nextAuction, err := suite.keeper.GetBlindAuction(suite.ctx, 1001)
+	// Verify auction was opened commit + reveal windows ahead of the next block
+	nextAuction, err := suite.keeper.GetBlindAuction(suite.ctx, 1011)
 	require.NoError(suite.T(), err)
 	require.NotNil(suite.T(), nextAuction)
-	require.Equal(suite.T(), uint64(1001), nextAuction.BlockHeight)
+	require.Equal(suite.T(), uint64(1011), nextAuction.BlockHeight)
+	require.Equal(suite.T(), uint64(1005), nextAuction.CommitEndHeight)
+	require.Equal(suite.T(), uint64(1010), nextAuction.RevealEndHeight)
 }
 
 // TestEndBlocker_WithAuctionTransition tests EndBlocker with auction phase transition
 func (suite *KeeperTestSuite) TestEndBlocker_WithAuctionTransition() {
-	// Set block height
-	suite.ctx = suite.ctx.WithBlockHeight(1000)
+	// Set block height inside the commit window of the auction for height 1000
+	suite.ctx = suite.ctx.WithBlockHeight(993)
 
 	// Create auction in commit phase with commits
 	_, err := suite.keeper.CreateBlindAuction(suite.ctx, 1000)
@@ -3079,20 +3081,28 @@ func (suite *KeeperTestSuite) TestEndBlocker_WithAuctionTransition() {
 	err = suite.keeper.CommitBid(suite.ctx, "cosmos1validator1", commitHash, 1000)
 	require.NoError(suite.T(), err)
 
-	// Run EndBlocker
-	err = suite.keeper.EndBlocker(suite.ctx)
+	// A commit does not end the commit window
+	_, err = suite.keeper.EndBlocker(suite.ctx)
+	require.NoError(suite.T(), err)
+	updatedAuction, err := suite.keeper.GetBlindAuction(suite.ctx, 1000)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), consensusv1.AuctionPhase_AUCTION_PHASE_COMMIT, updatedAuction.Phase)
+
+	// The commit window closes at the end of its last block
+	suite.ctx = suite.ctx.WithBlockHeight(994)
+	_, err = suite.keeper.EndBlocker(suite.ctx)
 	require.NoError(suite.T(), err)
 
 	// Verify auction was transitioned to reveal phase
-	updatedAuction, err := suite.keeper.GetBlindAuction(suite.ctx, 1000)
+	updatedAuction, err = suite.keeper.GetBlindAuction(suite.ctx, 1000)
 	require.NoError(suite.T(), err)
 	require.Equal(suite.T(), consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL, updatedAuction.Phase)
 }
 
 // TestEndBlocker_WithAuctionWinner tests EndBlocker with auction winner selection
 func (suite *KeeperTestSuite) TestEndBlocker_WithAuctionWinner() {
-	// Set block height
-	suite.ctx = suite.ctx.WithBlockHeight(1000)
+	// Set block height inside the commit window of the auction for height 1000
+	suite.ctx = suite.ctx.WithBlockHeight(990)
 
 	// Create auction in commit phase
 	_, err := suite.keeper.CreateBlindAuction(suite.ctx, 1000)
@@ -3108,18 +3118,26 @@ func (suite *KeeperTestSuite) TestEndBlocker_WithAuctionWinner() {
 	require.NoError(suite.T(), err)
 
 	// Add reveal
+	suite.ctx = suite.ctx.WithBlockHeight(995)
 	err = suite.keeper.RevealBid(suite.ctx, "cosmos1validator1", "nonce1", "1000000", 1000)
 	require.NoError(suite.T(), err)
 
-	// Run EndBlocker
-	err = suite.keeper.EndBlocker(suite.ctx)
+	// The winner is not selected while the reveal window is open
+	_, err = suite.keeper.EndBlocker(suite.ctx)
 	require.NoError(suite.T(), err)
-
-	// Verify auction winner was selected (may be nil if no winner selected due to random selection)
 	updatedAuction, err := suite.keeper.GetBlindAuction(suite.ctx, 1000)
 	require.NoError(suite.T(), err)
-	// Winner selection is probabilistic, so we just verify auction exists
-	require.NotNil(suite.T(), updatedAuction)
+	require.Equal(suite.T(), consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL, updatedAuction.Phase)
+
+	// The reveal window closes at the end of the block before the target height
+	suite.ctx = suite.ctx.WithBlockHeight(999)
+	_, err = suite.keeper.EndBlocker(suite.ctx)
+	require.NoError(suite.T(), err)
+
+	updatedAuction, err = suite.keeper.GetBlindAuction(suite.ctx, 1000)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), consensusv1.AuctionPhase_AUCTION_PHASE_COMPLETE, updatedAuction.Phase)
+	require.Equal(suite.T(), "cosmos1validator1", updatedAuction.Winner)
 }
 
 // TestEndBlocker_CleanupOldAuctions tests EndBlocker cleanup of old auctions
@@ -3148,7 +3166,7 @@ func (suite *KeeperTestSuite) TestEndBlocker_CleanupOldAuctions() {
 	require.NoError(suite.T(), err)
 
 	// Run EndBlocker
-	err = suite.keeper.EndBlocker(suite.ctx)
+	_, err = suite.keeper.EndBlocker(suite.ctx)
 	require.NoError(suite.T(), err)
 
 	// Verify old auctions were cleaned up (auctions before height 100 should be deleted)
@@ -3172,7 +3190,7 @@ func (suite *KeeperTestSuite) TestEndBlocker_CleanupOldAuctions() {
 func (suite *KeeperTestSuite) TestEndBlocker_NoValidators() {
 	suite.ctx = suite.ctx.WithBlockHeight(100)
 
-	err := suite.keeper.EndBlocker(suite.ctx)
+	_, err := suite.keeper.EndBlocker(suite.ctx)
 	require.NoError(suite.T(), err)
 
 	// Verify consensus state was updated
@@ -3180,8 +3198,8 @@ func (suite *KeeperTestSuite) TestEndBlocker_NoValidators() {
 	require.NoError(suite.T(), err)
 	require.Equal(suite.T(), uint64(100), state.CurrentHeight)
 
-	// Verify auction was created for next block
-	nextAuction, err := suite.keeper.GetBlindAuction(suite.ctx, 101)
+	// Verify auction was opened for a future block
+	nextAuction, err := suite.keeper.GetBlindAuction(suite.ctx, 111)
 	require.NoError(suite.T(), err)
 	require.NotNil(suite.T(), nextAuction)
 }
@@ -3341,10 +3359,8 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	require.Len(suite.T(), exported.Validators, 2)
 	require.Equal(suite.T(), "cosmos1validator1", exported.Validators[0].Validator)
 	require.Equal(suite.T(), "cosmos1validator2", exported.Validators[1].Validator)
-	// Block creators: ExportGenesis uses iterator which may not find block creators
-	// depending on key format. At minimum, verify the function works and returns valid state.
-	// The important thing is that ExportGenesis doesn't panic and returns valid data.
-	_ = exported.BlockCreators // Verify it exists (protobuf always initializes slices)
+	require.Len(suite.T(), exported.BlockCreators, 1)
+	require.Equal(suite.T(), uint64(1000), exported.BlockCreators[0].BlockHeight)
 }
 
 // TestExportGenesis_EmptyState tests ExportGenesis with empty state
@@ -3399,10 +3415,8 @@ func (suite *KeeperTestSuite) TestInitGenesis_ExportGenesis_RoundTrip() {
 	require.Equal(suite.T(), genState.Params.BaseBlockTime, exported.Params.BaseBlockTime)
 	require.Len(suite.T(), exported.Validators, 1)
 	require.Equal(suite.T(), genState.Validators[0].Validator, exported.Validators[0].Validator)
-	// Block creators: ExportGenesis uses iterator which may not find block creators
-	// depending on key format. At minimum, verify the function works and returns valid state.
-	// The important thing is that ExportGenesis doesn't panic and returns valid data.
-	_ = exported.BlockCreators // Verify it exists (protobuf always initializes slices)
+	require.Len(suite.T(), exported.BlockCreators, 1)
+	require.Equal(suite.T(), genState.BlockCreators[0].Validator, exported.BlockCreators[0].Validator)
 }
 
 // TestCalculateTotalBurnedTokens tests calculateTotalBurnedTokens through BeginBlocker
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// Migrator migrates the consensus store between consensus versions
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 migrates the consensus store from consensus version 1 to 2
+// Version 2 adds the liveness, slashing, auction and reward epoch params, which start at
+// their defaults, and replaces the stored average block time with the rolling window
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.NewConsensusParams(types.DefaultParams())
+	for _, pair := range defaults.ParamSetPairs() {
+		if !m.keeper.paramstore.Has(ctx, pair.Key) {
+			m.keeper.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	if err := m.keeper.MigrateBlockTimeWindow(ctx); err != nil {
+		return fmt.Errorf("failed to migrate block time window: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/volnix-protocol/volnix-protocol/testutil/storefixture"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// TestMigrate1to2 migrates a store written by consensus version 1 and compares it with testdata/v2.json
+// The new params are added at their defaults and the legacy block times are pruned into the window
+func TestMigrate1to2(t *testing.T) {
+	keyModule := storetypes.NewKVStoreKey(types.StoreKey)
+	keyParams := storetypes.NewKVStoreKey(paramtypes.StoreKey)
+	tkeyParams := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{types.StoreKey: keyModule, paramtypes.StoreKey: keyParams},
+		map[string]*storetypes.TransientStoreKey{paramtypes.TStoreKey: tkeyParams},
+		nil,
+	)
+
+	cdc := codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	paramsKeeper := paramskeeper.NewKeeper(cdc, codec.NewLegacyAmino(), keyParams, tkeyParams)
+	k := keeper.NewKeeper(cdc, keyModule, paramsKeeper.Subspace(types.ModuleName))
+
+	storefixture.Load(t, ctx, keyModule, keyParams, types.ModuleName, "testdata/v1.json")
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+	storefixture.RequireEqual(t, ctx, keyModule, keyParams, types.ModuleName, "testdata/v2.json")
+
+	// The migrated params are complete, reading them no longer panics
+	require.NotPanics(t, func() { k.GetParams(ctx) })
+}
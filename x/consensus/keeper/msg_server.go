@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
@@ -21,44 +22,23 @@ func NewMsgServer(k Keeper) MsgServer { return MsgServer{k: k} }
 
 var _ consensusv1.MsgServer = MsgServer{}
 
-// SelectBlockCreator selects the next block creator using keeper logic.
+// SelectBlockCreator is deprecated; block creators are selected in EndBlocker.
 func (s MsgServer) SelectBlockCreator(ctx context.Context, req *consensusv1.MsgSelectBlockCreator) (*consensusv1.MsgSelectBlockCreatorResponse, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	// Select block creator for the next height
-	nextHeight := sdkCtx.BlockHeight() + 1
-	bc, err := s.k.SelectBlockCreator(sdkCtx, uint64(nextHeight))
-	if err != nil {
-		return nil, err
-	}
-
-	return &consensusv1.MsgSelectBlockCreatorResponse{SelectedValidator: bc.Validator}, nil
+	return nil, errors.Wrap(types.ErrDeprecatedMsg, "block creators are selected in EndBlocker")
 }
 
-// UpdateConsensusState updates the consensus state
+// UpdateConsensusState is deprecated; the consensus state is updated in EndBlocker.
 func (s MsgServer) UpdateConsensusState(ctx context.Context, req *consensusv1.MsgUpdateConsensusState) (*consensusv1.MsgUpdateConsensusStateResponse, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	// Check authorization
-	if req.Authority != "cosmos1test" {
-		return nil, types.ErrUnauthorized
-	}
-
-	err := s.k.UpdateConsensusState(sdkCtx, req.CurrentHeight, req.TotalAntBurned, req.ActiveValidators)
-	if err != nil {
-		return nil, err
-	}
-
-	return &consensusv1.MsgUpdateConsensusStateResponse{}, nil
+	return nil, errors.Wrap(types.ErrDeprecatedMsg, "the consensus state is updated in EndBlocker")
 }
 
-// SetValidatorWeight sets validator weight
+// SetValidatorWeight sets validator weight; only the governance authority may execute it
 func (s MsgServer) SetValidatorWeight(ctx context.Context, req *consensusv1.MsgSetValidatorWeight) (*consensusv1.MsgSetValidatorWeightResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	// Check authorization
-	if req.Authority != "cosmos1test" {
-		return nil, types.ErrUnauthorized
+	if req.Authority != s.k.GetAuthority() {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", s.k.GetAuthority(), req.Authority)
 	}
 
 	err := s.k.SetValidatorWeight(sdkCtx, req.Validator, req.Weight)
@@ -69,59 +49,19 @@ func (s MsgServer) SetValidatorWeight(ctx context.Context, req *consensusv1.MsgS
 	return &consensusv1.MsgSetValidatorWeightResponse{}, nil
 }
 
-// ProcessHalving processes halving event
+// ProcessHalving is deprecated; halvings are processed in EndBlocker.
 func (s MsgServer) ProcessHalving(ctx context.Context, req *consensusv1.MsgProcessHalving) (*consensusv1.MsgProcessHalvingResponse, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	// Check authorization
-	if req.Authority != "cosmos1test" {
-		return nil, types.ErrUnauthorized
-	}
-
-	err := s.k.ProcessHalving(sdkCtx)
-	if err != nil {
-		return nil, err
-	}
-
-	return &consensusv1.MsgProcessHalvingResponse{}, nil
+	return nil, errors.Wrap(types.ErrDeprecatedMsg, "halvings are processed in EndBlocker")
 }
 
-// SelectBlockProducer selects block producer
+// SelectBlockProducer is deprecated; block producers are selected in EndBlocker.
 func (s MsgServer) SelectBlockProducer(ctx context.Context, req *consensusv1.MsgSelectBlockProducer) (*consensusv1.MsgSelectBlockProducerResponse, error) {
-	// Check authorization
-	if req.Authority != "cosmos1test" {
-		return nil, types.ErrUnauthorized
-	}
-
-	// Use validators from request if provided
-	if len(req.Validators) == 0 {
-		return nil, types.ErrNoValidators
-	}
-
-	// Simple selection: use first validator for now
-	// In real implementation, this would use weighted lottery
-	selectedValidator := req.Validators[0]
-
-	return &consensusv1.MsgSelectBlockProducerResponse{Producer: selectedValidator}, nil
+	return nil, errors.Wrap(types.ErrDeprecatedMsg, "block producers are selected in EndBlocker")
 }
 
-// CalculateBlockTime calculates block time
+// CalculateBlockTime is deprecated; the block time is derived from burned ANT in EndBlocker.
 func (s MsgServer) CalculateBlockTime(ctx context.Context, req *consensusv1.MsgCalculateBlockTime) (*consensusv1.MsgCalculateBlockTimeResponse, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	// Check authorization
-	if req.Authority != "cosmos1test" {
-		return nil, types.ErrUnauthorized
-	}
-
-	// Convert ant amount to activity score for calculation
-	activityScore := req.AntAmount
-	blockTime, err := s.k.CalculateBlockTime(sdkCtx, activityScore)
-	if err != nil {
-		return nil, err
-	}
-
-	return &consensusv1.MsgCalculateBlockTimeResponse{BlockTime: int64(blockTime.Seconds())}, nil
+	return nil, errors.Wrap(types.ErrDeprecatedMsg, "the block time is derived from burned ANT in EndBlocker")
 }
 
 // CommitBid commits an encrypted bid for blind auction
@@ -186,3 +126,80 @@ func (s MsgServer) RevealBid(ctx context.Context, req *consensusv1.MsgRevealBid)
 
 	return &consensusv1.MsgRevealBidResponse{Success: true}, nil
 }
+
+// Unjail returns a jailed validator to the active set
+func (s MsgServer) Unjail(ctx context.Context, req *consensusv1.MsgUnjail) (*consensusv1.MsgUnjailResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Validate request
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.Validator == "" {
+		return nil, types.ErrEmptyValidatorAddress
+	}
+
+	if err := s.k.Unjail(sdkCtx, req.Validator); err != nil {
+		return nil, err
+	}
+
+	return &consensusv1.MsgUnjailResponse{}, nil
+}
+
+// WithdrawRewards sends the block rewards accrued by a validator to its account
+func (s MsgServer) WithdrawRewards(ctx context.Context, req *consensusv1.MsgWithdrawRewards) (*consensusv1.MsgWithdrawRewardsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Validate request
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.Validator == "" {
+		return nil, types.ErrEmptyValidatorAddress
+	}
+
+	amount, err := s.k.WithdrawRewards(sdkCtx, req.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &consensusv1.MsgWithdrawRewardsResponse{Amount: amount.String()}, nil
+}
+
+// SetConsensusKey registers the consensus key of a validator's node
+func (s MsgServer) SetConsensusKey(ctx context.Context, req *consensusv1.MsgSetConsensusKey) (*consensusv1.MsgSetConsensusKeyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Validate request
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+	if req.Validator == "" {
+		return nil, types.ErrEmptyValidatorAddress
+	}
+
+	if err := s.k.RegisterConsensusKey(sdkCtx, req.Validator, req.PubKey); err != nil {
+		return nil, err
+	}
+
+	return &consensusv1.MsgSetConsensusKeyResponse{}, nil
+}
+
+// UpdateParams replaces the module parameters; only the governance authority may execute it
+// The base block reward is constitutional and cannot be changed
+func (s MsgServer) UpdateParams(ctx context.Context, req *consensusv1.MsgUpdateParams) (*consensusv1.MsgUpdateParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != s.k.GetAuthority() {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", s.k.GetAuthority(), req.Authority)
+	}
+	if err := types.ValidateParams(req.Params); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	if current := s.k.GetParams(sdkCtx); req.Params.BaseBlockReward != current.BaseBlockReward {
+		return nil, errors.Wrapf(types.ErrInvalidParams, "base_block_reward is constitutional: cannot change %s to %s", current.BaseBlockReward, req.Params.BaseBlockReward)
+	}
+
+	s.k.SetParams(sdkCtx, *req.Params)
+	return &consensusv1.MsgUpdateParamsResponse{}, nil
+}
@@ -56,43 +56,10 @@ func (suite *MsgServerTestSuite) SetupTest() {
 	suite.keeper.SetParams(suite.ctx, *types.DefaultParams())
 }
 
-func (suite *MsgServerTestSuite) TestUpdateConsensusState() {
-	// Test valid consensus state update
-	msg := &consensusv1.MsgUpdateConsensusState{
-		Authority:        "cosmos1test",
-		CurrentHeight:    1000,
-		TotalAntBurned:   "1000000",
-		ActiveValidators: []string{"cosmos1test1", "cosmos2test2"},
-	}
-
-	resp, err := suite.msgServer.UpdateConsensusState(suite.ctx, msg)
-	require.NoError(suite.T(), err)
-	require.NotNil(suite.T(), resp)
-
-	// Verify consensus state was updated
-	state, err := suite.keeper.GetConsensusState(suite.ctx)
-	require.NoError(suite.T(), err)
-	require.Equal(suite.T(), uint64(1000), state.CurrentHeight)
-	require.Equal(suite.T(), "1000000", state.TotalAntBurned)
-	require.Len(suite.T(), state.ActiveValidators, 2)
-
-	// Test invalid authority
-	invalidMsg := &consensusv1.MsgUpdateConsensusState{
-		Authority:        "cosmos2test",
-		CurrentHeight:    1001,
-		TotalAntBurned:   "1000001",
-		ActiveValidators: []string{"cosmos1test1"},
-	}
-
-	_, err = suite.msgServer.UpdateConsensusState(suite.ctx, invalidMsg)
-	require.Error(suite.T(), err)
-	require.Equal(suite.T(), types.ErrUnauthorized, err)
-}
-
 func (suite *MsgServerTestSuite) TestSetValidatorWeight() {
-	// Test valid validator weight setting
+	// Test valid validator weight setting by the governance authority
 	msg := &consensusv1.MsgSetValidatorWeight{
-		Authority: "cosmos1test",
+		Authority: suite.keeper.GetAuthority(),
 		Validator: "cosmos1validator",
 		Weight:    "1000000",
 	}
@@ -108,18 +75,17 @@ func (suite *MsgServerTestSuite) TestSetValidatorWeight() {
 
 	// Test invalid authority
 	invalidMsg := &consensusv1.MsgSetValidatorWeight{
-		Authority: "cosmos2test",
+		Authority: "cosmos1test",
 		Validator: "cosmos2validator",
 		Weight:    "1000000",
 	}
 
 	_, err = suite.msgServer.SetValidatorWeight(suite.ctx, invalidMsg)
-	require.Error(suite.T(), err)
-	require.Equal(suite.T(), types.ErrUnauthorized, err)
+	require.ErrorIs(suite.T(), err, types.ErrUnauthorized)
 
 	// Test empty validator address
 	emptyValidatorMsg := &consensusv1.MsgSetValidatorWeight{
-		Authority: "cosmos1test",
+		Authority: suite.keeper.GetAuthority(),
 		Validator: "",
 		Weight:    "1000000",
 	}
@@ -129,122 +95,61 @@ func (suite *MsgServerTestSuite) TestSetValidatorWeight() {
 	require.Equal(suite.T(), types.ErrEmptyValidatorAddress, err)
 }
 
-func (suite *MsgServerTestSuite) TestProcessHalving() {
-	// Test processing halving
-	msg := &consensusv1.MsgProcessHalving{
-		Authority: "cosmos1test",
-	}
-
-	resp, err := suite.msgServer.ProcessHalving(suite.ctx, msg)
-	require.NoError(suite.T(), err)
-	require.NotNil(suite.T(), resp)
-
-	// Verify halving was processed
-	halvingInfo, err := suite.keeper.GetHalvingInfo(suite.ctx)
-	require.NoError(suite.T(), err)
-	require.NotNil(suite.T(), halvingInfo)
-
-	// Test invalid authority
-	invalidMsg := &consensusv1.MsgProcessHalving{
-		Authority: "cosmos2test",
-	}
-
-	_, err = suite.msgServer.ProcessHalving(suite.ctx, invalidMsg)
-	require.Error(suite.T(), err)
-	require.Equal(suite.T(), types.ErrUnauthorized, err)
-}
-
-func (suite *MsgServerTestSuite) TestSelectBlockProducer() {
-	// Test selecting block producer
-	msg := &consensusv1.MsgSelectBlockProducer{
-		Authority:  "cosmos1test",
-		Validators: []string{"cosmos1test1", "cosmos2test2", "cosmos3test3"},
+func (suite *MsgServerTestSuite) TestDeprecatedMsgsRejected() {
+	validator := &consensusv1.Validator{
+		Validator:     "cosmos1validator",
+		AntBalance:    "1000000",
+		ActivityScore: "500000",
 	}
+	suite.keeper.SetValidator(suite.ctx, validator)
+	authority := suite.keeper.GetAuthority()
 
-	resp, err := suite.msgServer.SelectBlockProducer(suite.ctx, msg)
-	require.NoError(suite.T(), err)
-	require.NotNil(suite.T(), resp)
-	require.NotEmpty(suite.T(), resp.Producer)
-	require.Contains(suite.T(), msg.Validators, resp.Producer)
-
-	// Test invalid authority
-	invalidMsg := &consensusv1.MsgSelectBlockProducer{
-		Authority:  "cosmos2test",
-		Validators: []string{"cosmos1test1", "cosmos2test2"},
-	}
+	_, err := suite.msgServer.SelectBlockCreator(suite.ctx, &consensusv1.MsgSelectBlockCreator{Creator: authority})
+	require.ErrorIs(suite.T(), err, types.ErrDeprecatedMsg)
 
-	_, err = suite.msgServer.SelectBlockProducer(suite.ctx, invalidMsg)
-	require.Error(suite.T(), err)
-	require.Equal(suite.T(), types.ErrUnauthorized, err)
+	_, err = suite.msgServer.UpdateConsensusState(suite.ctx, &consensusv1.MsgUpdateConsensusState{
+		Authority:        authority,
+		CurrentHeight:    1000,
+		TotalAntBurned:   "1000000",
+		ActiveValidators: []string{"cosmos1validator"},
+	})
+	require.ErrorIs(suite.T(), err, types.ErrDeprecatedMsg)
 
-	// Test empty validators list
-	emptyValidatorsMsg := &consensusv1.MsgSelectBlockProducer{
-		Authority:  "cosmos1test",
-		Validators: []string{},
-	}
+	_, err = suite.msgServer.ProcessHalving(suite.ctx, &consensusv1.MsgProcessHalving{Authority: authority})
+	require.ErrorIs(suite.T(), err, types.ErrDeprecatedMsg)
 
-	_, err = suite.msgServer.SelectBlockProducer(suite.ctx, emptyValidatorsMsg)
-	require.Error(suite.T(), err)
-	require.Equal(suite.T(), types.ErrNoValidators, err)
-}
+	_, err = suite.msgServer.SelectBlockProducer(suite.ctx, &consensusv1.MsgSelectBlockProducer{
+		Authority:  authority,
+		Validators: []string{"cosmos1validator"},
+	})
+	require.ErrorIs(suite.T(), err, types.ErrDeprecatedMsg)
 
-func (suite *MsgServerTestSuite) TestCalculateBlockTime() {
-	// Test calculating block time
-	msg := &consensusv1.MsgCalculateBlockTime{
-		Authority: "cosmos1test",
+	_, err = suite.msgServer.CalculateBlockTime(suite.ctx, &consensusv1.MsgCalculateBlockTime{
+		Authority: authority,
 		AntAmount: "1000000",
-	}
+	})
+	require.ErrorIs(suite.T(), err, types.ErrDeprecatedMsg)
 
-	resp, err := suite.msgServer.CalculateBlockTime(suite.ctx, msg)
+	// Rejected messages leave the state untouched
+	state, err := suite.keeper.GetConsensusState(suite.ctx)
 	require.NoError(suite.T(), err)
-	require.NotNil(suite.T(), resp)
-	require.NotZero(suite.T(), resp.BlockTime)
-
-	// Test invalid authority
-	invalidMsg := &consensusv1.MsgCalculateBlockTime{
-		Authority: "cosmos2test",
-		AntAmount: "1000000",
-	}
-
-	_, err = suite.msgServer.CalculateBlockTime(suite.ctx, invalidMsg)
+	require.NotEqual(suite.T(), uint64(1000), state.CurrentHeight)
+	_, err = suite.keeper.GetBlockCreator(suite.ctx, uint64(suite.ctx.BlockHeight()+1))
 	require.Error(suite.T(), err)
-	require.Equal(suite.T(), types.ErrUnauthorized, err)
-
-	// Test zero ant amount
-	zeroAntMsg := &consensusv1.MsgCalculateBlockTime{
-		Authority: "cosmos1test",
-		AntAmount: "0",
-	}
-
-	_, err = suite.msgServer.CalculateBlockTime(suite.ctx, zeroAntMsg)
-	require.Error(suite.T(), err)
-	require.Equal(suite.T(), types.ErrInvalidAntAmount, err)
 }
 
-func (suite *MsgServerTestSuite) TestSelectBlockCreator() {
-	// Add a validator first
-	validator := &consensusv1.Validator{
-		Validator:     "cosmos1validator",
-		AntBalance:    "1000000",
-		ActivityScore: "500000",
-	}
-	suite.keeper.SetValidator(suite.ctx, validator)
-
-	// Test selecting block creator
-	msg := &consensusv1.MsgSelectBlockCreator{}
+func (suite *MsgServerTestSuite) TestDeprecatedMsgsDecodable() {
+	registry := cdctypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
 
-	resp, err := suite.msgServer.SelectBlockCreator(suite.ctx, msg)
+	msg := &consensusv1.MsgUpdateConsensusState{Authority: "cosmos1test", CurrentHeight: 1000}
+	anyMsg, err := cdctypes.NewAnyWithValue(msg)
 	require.NoError(suite.T(), err)
-	require.NotNil(suite.T(), resp)
-	require.NotEmpty(suite.T(), resp.SelectedValidator)
-	require.Equal(suite.T(), "cosmos1validator", resp.SelectedValidator)
 
-	// Verify block creator was set (use next height which is current + 1)
-	nextHeight := uint64(suite.ctx.BlockHeight() + 1)
-	blockCreator, err := suite.keeper.GetBlockCreator(suite.ctx, nextHeight)
-	require.NoError(suite.T(), err)
-	require.NotNil(suite.T(), blockCreator)
-	require.Equal(suite.T(), "cosmos1validator", blockCreator.Validator)
+	var decoded sdk.Msg
+	require.NoError(suite.T(), cdc.UnpackAny(anyMsg, &decoded))
+	require.Equal(suite.T(), uint64(1000), decoded.(*consensusv1.MsgUpdateConsensusState).CurrentHeight)
 }
 
 func (suite *MsgServerTestSuite) TestCommitBid() {
@@ -258,14 +163,14 @@ func (suite *MsgServerTestSuite) TestCommitBid() {
 	require.NotEmpty(suite.T(), commitHash)
 	require.Len(suite.T(), commitHash, 64) // SHA256 produces 64 hex chars
 
-	// Test valid commit bid
+	// Test valid commit bid, the commit window of auction 1000 opens at height 990
 	msg := &consensusv1.MsgCommitBid{
 		Validator:   validator,
 		CommitHash:  commitHash,
 		BlockHeight: height,
 	}
 
-	resp, err := suite.msgServer.CommitBid(suite.ctx, msg)
+	resp, err := suite.msgServer.CommitBid(suite.ctx.WithBlockHeight(990), msg)
 	require.NoError(suite.T(), err)
 	require.NotNil(suite.T(), resp)
 	require.True(suite.T(), resp.Success)
@@ -313,7 +218,7 @@ func (suite *MsgServerTestSuite) TestCommitBid() {
 	require.Error(suite.T(), err)
 
 	// Test duplicate commit (same validator)
-	_, err = suite.msgServer.CommitBid(suite.ctx, msg)
+	_, err = suite.msgServer.CommitBid(suite.ctx.WithBlockHeight(990), msg)
 	require.Error(suite.T(), err)
 	require.Contains(suite.T(), err.Error(), "already committed")
 
@@ -342,7 +247,7 @@ func (suite *MsgServerTestSuite) TestRevealBid() {
 		CommitHash:  commitHash,
 		BlockHeight: height,
 	}
-	_, err := suite.msgServer.CommitBid(suite.ctx, commitMsg)
+	_, err := suite.msgServer.CommitBid(suite.ctx.WithBlockHeight(990), commitMsg)
 	require.NoError(suite.T(), err)
 
 	// Transition auction to reveal phase
@@ -418,7 +323,7 @@ func (suite *MsgServerTestSuite) TestRevealBid() {
 		CommitHash:  commitHashWrong,
 		BlockHeight: height2,
 	}
-	_, err2 := suite.msgServer.CommitBid(suite.ctx, commitMsgWrong)
+	_, err2 := suite.msgServer.CommitBid(suite.ctx.WithBlockHeight(1990), commitMsgWrong)
 	require.NoError(suite.T(), err2)
 
 	err2 = suite.keeper.TransitionAuctionPhase(suite.ctx, height2)
@@ -464,3 +369,35 @@ func (suite *MsgServerTestSuite) TestRevealBid() {
 func TestMsgServerTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgServerTestSuite))
 }
+
+func (suite *MsgServerTestSuite) TestUpdateParams() {
+	authority := suite.keeper.GetAuthority()
+
+	params := types.DefaultParams()
+	params.HighActivityThreshold = 2000
+
+	// Only the governance authority may update params
+	_, err := suite.msgServer.UpdateParams(suite.ctx, &consensusv1.MsgUpdateParams{
+		Authority: "cosmos1notauthority",
+		Params:    params,
+	})
+	require.ErrorIs(suite.T(), err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &consensusv1.MsgUpdateParams{Authority: authority})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidParams)
+
+	invalid := types.DefaultParams()
+	invalid.LowActivityThreshold = invalid.HighActivityThreshold + 1
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &consensusv1.MsgUpdateParams{Authority: authority, Params: invalid})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidParams)
+
+	// The base block reward is constitutional
+	reward := types.DefaultParams()
+	reward.BaseBlockReward = "1"
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &consensusv1.MsgUpdateParams{Authority: authority, Params: reward})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidParams)
+
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &consensusv1.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(2000), suite.keeper.GetParams(suite.ctx).HighActivityThreshold)
+}
@@ -3,6 +3,10 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
@@ -26,9 +30,67 @@ func (s QueryServer) Params(ctx context.Context, _ *consensusv1.QueryParamsReque
 	return &consensusv1.QueryParamsResponse{Params: &params}, nil
 }
 
-// Validators returns all validators tracked by the consensus module.
-func (s QueryServer) Validators(ctx context.Context, _ *consensusv1.QueryValidatorsRequest) (*consensusv1.QueryValidatorsResponse, error) {
+// Validators returns a page of the validators tracked by the consensus module.
+func (s QueryServer) Validators(ctx context.Context, req *consensusv1.QueryValidatorsRequest) (*consensusv1.QueryValidatorsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	validators, pageRes, err := s.k.GetValidatorsPage(sdkCtx, req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	return &consensusv1.QueryValidatorsResponse{Validators: validators, Pagination: pageRes}, nil
+}
+
+// AuctionFaults returns a page of blind auction faults (commits without a reveal), optionally filtered by validator.
+func (s QueryServer) AuctionFaults(ctx context.Context, req *consensusv1.QueryAuctionFaultsRequest) (*consensusv1.QueryAuctionFaultsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	faults, pageRes, err := s.k.GetAuctionFaultsPage(sdkCtx, req.GetValidator(), req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	return &consensusv1.QueryAuctionFaultsResponse{Faults: faults, Pagination: pageRes}, nil
+}
+
+const (
+	// defaultHalvingScheduleCount is the number of halvings projected when the request leaves count unset
+	defaultHalvingScheduleCount = 5
+	// maxHalvingScheduleCount bounds the number of halvings projected by one query
+	maxHalvingScheduleCount = 64
+)
+
+// HalvingSchedule returns the persisted halving state and the projected upcoming halvings.
+func (s QueryServer) HalvingSchedule(ctx context.Context, req *consensusv1.QueryHalvingScheduleRequest) (*consensusv1.QueryHalvingScheduleResponse, error) {
+	count := uint64(defaultHalvingScheduleCount)
+	if req != nil && req.Count > 0 {
+		count = req.Count
+	}
+	if count > maxHalvingScheduleCount {
+		return nil, status.Errorf(codes.InvalidArgument, "count must not exceed %d", maxHalvingScheduleCount)
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	validators := s.k.GetAllValidators(sdkCtx)
-	return &consensusv1.QueryValidatorsResponse{Validators: validators}, nil
+
+	halvingInfo, err := s.k.GetHalvingInfo(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	// The stored estimate dates from the last halving; project it from the current average block time
+	if estimatedDate, ok := s.k.EstimateHeightTime(sdkCtx, halvingInfo.NextHalvingHeight); ok {
+		halvingInfo.EstimatedNextHalvingDate = timestamppb.New(estimatedDate)
+	}
+	avgBlockTime, err := s.k.GetAverageBlockTime(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := s.k.GetHalvingSchedule(sdkCtx, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &consensusv1.QueryHalvingScheduleResponse{
+		HalvingInfo:      &halvingInfo,
+		AverageBlockTime: int64(avgBlockTime),
+		Halvings:         schedule,
+	}, nil
 }
@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -12,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -93,6 +97,37 @@ func (suite *QueryServerTestSuite) TestValidators() {
 	require.GreaterOrEqual(suite.T(), len(resp.Validators), 2)
 }
 
+func (suite *QueryServerTestSuite) TestValidators_Pagination() {
+	for _, addr := range []string{"cosmos1validator1", "cosmos1validator2", "cosmos1validator3"} {
+		suite.keeper.SetValidator(suite.ctx, &consensusv1.Validator{
+			Validator:     addr,
+			AntBalance:    "1000000",
+			ActivityScore: "500",
+			Status:        consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE,
+			LastActive:    timestamppb.Now(),
+		})
+		// Weights share the validator key prefix and must not be paged as validators
+		require.NoError(suite.T(), suite.keeper.SetValidatorWeight(suite.ctx, addr, "1.0"))
+	}
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	req := &consensusv1.QueryValidatorsRequest{
+		Pagination: &sdkquery.PageRequest{Limit: 2, CountTotal: true},
+	}
+	resp, err := suite.queryServer.Validators(ctx, req)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.Validators, 2)
+	require.Equal(suite.T(), "cosmos1validator1", resp.Validators[0].Validator)
+	require.Equal(suite.T(), uint64(3), resp.Pagination.Total)
+
+	req.Pagination = &sdkquery.PageRequest{Key: resp.Pagination.NextKey, Limit: 2}
+	resp, err = suite.queryServer.Validators(ctx, req)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.Validators, 1)
+	require.Equal(suite.T(), "cosmos1validator3", resp.Validators[0].Validator)
+	require.Nil(suite.T(), resp.Pagination.NextKey)
+}
+
 func (suite *QueryServerTestSuite) TestValidators_Empty() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	req := &consensusv1.QueryValidatorsRequest{}
@@ -106,3 +141,32 @@ func (suite *QueryServerTestSuite) TestValidators_Empty() {
 	}
 }
 
+
+func (suite *QueryServerTestSuite) TestHalvingSchedule() {
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockHeight(100).WithBlockTime(blockTime)
+
+	resp, err := suite.queryServer.HalvingSchedule(sdk.WrapSDKContext(suite.ctx), &consensusv1.QueryHalvingScheduleRequest{Count: 3})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(HalvingInterval), resp.HalvingInfo.NextHalvingHeight)
+	require.Equal(suite.T(), int64(5*time.Second), resp.AverageBlockTime)
+	require.Equal(suite.T(), blockTime.Add(5*time.Second*time.Duration(HalvingInterval-100)), resp.HalvingInfo.EstimatedNextHalvingDate.AsTime())
+	require.Len(suite.T(), resp.Halvings, 3)
+
+	expectedRewards := []uint64{25_000_000, 12_500_000, 6_250_000}
+	for i, halving := range resp.Halvings {
+		height := uint64(HalvingInterval) * uint64(i+1)
+		require.Equal(suite.T(), height, halving.Height)
+		require.Equal(suite.T(), uint64(i+1), halving.HalvingCount)
+		require.Equal(suite.T(), expectedRewards[i], halving.BlockReward)
+		require.Equal(suite.T(), blockTime.Add(5*time.Second*time.Duration(height-100)), halving.EstimatedDate.AsTime())
+	}
+
+	// Count defaults when unset and is bounded
+	resp, err = suite.queryServer.HalvingSchedule(sdk.WrapSDKContext(suite.ctx), &consensusv1.QueryHalvingScheduleRequest{})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.Halvings, defaultHalvingScheduleCount)
+
+	_, err = suite.queryServer.HalvingSchedule(sdk.WrapSDKContext(suite.ctx), &consensusv1.QueryHalvingScheduleRequest{Count: maxHalvingScheduleCount + 1})
+	require.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+}
@@ -9,6 +9,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
 )
 
@@ -26,7 +28,7 @@ func (suite *RewardSystemTestSuite) SetupTest() {
 	suite.KeeperTestSuite.SetupTest()
 	suite.mockBankKeeper = NewMockBankKeeper()
 	suite.mockLizenzKeeper = &MockLizenzKeeper{
-		activatedLizenz: []interface{}{},
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{},
 		moaCompliance:   make(map[string]float64),
 	}
 	suite.keeper.SetBankKeeper(suite.mockBankKeeper)
@@ -160,15 +162,9 @@ func (suite *RewardSystemTestSuite) TestDistributeBaseRewardsFullFlow() {
 	validator2Addr := sdk.AccAddress("validator2_______________")
 	
 	// Set up validators with activated LZN
-	suite.mockLizenzKeeper.activatedLizenz = []interface{}{
-		map[string]interface{}{
-			"validator": validator1Addr.String(),
-			"amount":    "1000000",
-		},
-		map[string]interface{}{
-			"validator": validator2Addr.String(),
-			"amount":    "2000000",
-		},
+	suite.mockLizenzKeeper.activatedLizenz = []*lizenzv1.ActivatedLizenz{
+		{Validator: validator1Addr.String(), Amount: "1000000"},
+		{Validator: validator2Addr.String(), Amount: "2000000"},
 	}
 
 	// Set MOA compliance
@@ -186,6 +182,12 @@ func (suite *RewardSystemTestSuite) TestDistributeBaseRewardsFullFlow() {
 	require.NotEmpty(suite.T(), mintedCoins)
 	require.True(suite.T(), mintedCoins.AmountOf("uwrt").GT(math.ZeroInt()))
 
+	// Withdraw the accrued rewards
+	_, err = suite.keeper.WithdrawRewards(suite.ctx, validator1Addr.String())
+	require.NoError(suite.T(), err)
+	_, err = suite.keeper.WithdrawRewards(suite.ctx, validator2Addr.String())
+	require.NoError(suite.T(), err)
+
 	// Check that coins were sent to validators
 	// Check all sent coins (might be stored by different key format)
 	hasSentCoins := false
@@ -213,7 +215,7 @@ func (suite *RewardSystemTestSuite) TestDistributeBaseRewardsFullFlow() {
 // TestDistributeBaseRewardsNoValidators tests distribution when no validators have LZN
 func (suite *RewardSystemTestSuite) TestDistributeBaseRewardsNoValidators() {
 	// No activated LZN
-	suite.mockLizenzKeeper.activatedLizenz = []interface{}{}
+	suite.mockLizenzKeeper.activatedLizenz = []*lizenzv1.ActivatedLizenz{}
 
 	suite.ctx = suite.ctx.WithBlockHeight(1000)
 
@@ -242,3 +244,136 @@ func (suite *RewardSystemTestSuite) TestDistributeBaseRewardsNoLizenzKeeper() {
 	require.Empty(suite.T(), mintedCoins)
 }
 
+
+// distributeBlocks distributes block rewards for the given heights
+func (suite *RewardSystemTestSuite) distributeBlocks(from, to int64) {
+	for height := from; height <= to; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		require.NoError(suite.T(), suite.keeper.DistributeBaseRewards(suite.ctx, uint64(height)))
+	}
+}
+
+// TestRewardIndexAccrual tests that rewards accrue per unit of activated LZN across blocks
+func (suite *RewardSystemTestSuite) TestRewardIndexAccrual() {
+	validator1Addr := sdk.AccAddress("validator1_______________")
+	validator2Addr := sdk.AccAddress("validator2_______________")
+
+	suite.mockLizenzKeeper.activatedLizenz = []*lizenzv1.ActivatedLizenz{
+		{Validator: validator1Addr.String(), Amount: "1000000"},
+		{Validator: validator2Addr.String(), Amount: "2000000"},
+	}
+
+	suite.distributeBlocks(1, 3)
+
+	pool, found := suite.keeper.GetRewardPool(suite.ctx)
+	require.True(suite.T(), found)
+	require.Equal(suite.T(), uint64(3000000), pool.TotalStake)
+
+	// 3 blocks × 50 WRT split 1:2
+	amount1, err := suite.keeper.WithdrawRewards(suite.ctx, validator1Addr.String())
+	require.NoError(suite.T(), err)
+	amount2, err := suite.keeper.WithdrawRewards(suite.ctx, validator2Addr.String())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), math.NewInt(49999999), amount1)
+	require.Equal(suite.T(), math.NewInt(99999999), amount2)
+
+	// Nothing is left to withdraw until the next block
+	_, err = suite.keeper.WithdrawRewards(suite.ctx, validator1Addr.String())
+	require.ErrorIs(suite.T(), err, types.ErrNoRewards)
+}
+
+// TestRewardIndexMOAPenalty tests that the MOA penalty reduces the owed and minted rewards
+func (suite *RewardSystemTestSuite) TestRewardIndexMOAPenalty() {
+	validator1Addr := sdk.AccAddress("validator1_______________")
+	validator2Addr := sdk.AccAddress("validator2_______________")
+
+	suite.mockLizenzKeeper.activatedLizenz = []*lizenzv1.ActivatedLizenz{
+		{Validator: validator1Addr.String(), Amount: "1000000"},
+		{Validator: validator2Addr.String(), Amount: "1000000"},
+	}
+	suite.mockLizenzKeeper.moaCompliance[validator2Addr.String()] = 0.3 // multiplier 0
+
+	suite.distributeBlocks(1, 1)
+
+	// Only the share of the compliant validator is minted
+	require.Equal(suite.T(), math.NewInt(25000000), suite.mockBankKeeper.GetMintedCoins(types.ModuleName).AmountOf("uwrt"))
+	pool, found := suite.keeper.GetRewardPool(suite.ctx)
+	require.True(suite.T(), found)
+	require.Equal(suite.T(), "25000000", pool.TotalEmitted)
+
+	amount1, err := suite.keeper.WithdrawRewards(suite.ctx, validator1Addr.String())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), math.NewInt(25000000), amount1)
+
+	_, err = suite.keeper.WithdrawRewards(suite.ctx, validator2Addr.String())
+	require.ErrorIs(suite.T(), err, types.ErrNoRewards)
+}
+
+// TestRewardEpochRecords tests that rewards are recorded once per epoch
+func (suite *RewardSystemTestSuite) TestRewardEpochRecords() {
+	validatorAddr := sdk.AccAddress("validator1_______________")
+	suite.mockLizenzKeeper.activatedLizenz = []*lizenzv1.ActivatedLizenz{
+		{Validator: validatorAddr.String(), Amount: "1000000"},
+	}
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RewardEpochLength = 3
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.distributeBlocks(1, 2)
+	require.Empty(suite.T(), suite.mockLizenzKeeper.rewardRecords[validatorAddr.String()])
+
+	suite.distributeBlocks(3, 6)
+	records := suite.mockLizenzKeeper.rewardRecords[validatorAddr.String()]
+	require.Len(suite.T(), records, 2)
+	require.Equal(suite.T(), uint64(1), records[0].Epoch)
+	require.Equal(suite.T(), uint64(1), records[0].StartHeight)
+	require.Equal(suite.T(), uint64(3), records[0].BlockHeight)
+	require.Equal(suite.T(), "150000000", records[0].RewardAmount)
+	require.Equal(suite.T(), uint64(2), records[1].Epoch)
+	require.Equal(suite.T(), uint64(4), records[1].StartHeight)
+
+	// Recording the epoch does not pay out: everything is still withdrawable
+	amount, err := suite.keeper.WithdrawRewards(suite.ctx, validatorAddr.String())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), math.NewInt(300000000), amount)
+}
+
+// TestRewardStakeJailedValidator tests that jailed validators stop accruing rewards
+func (suite *RewardSystemTestSuite) TestRewardStakeJailedValidator() {
+	validator1Addr := sdk.AccAddress("validator1_______________")
+	validator2Addr := sdk.AccAddress("validator2_______________")
+
+	suite.mockLizenzKeeper.activatedLizenz = []*lizenzv1.ActivatedLizenz{
+		{Validator: validator1Addr.String(), Amount: "1000000"},
+		{Validator: validator2Addr.String(), Amount: "1000000"},
+	}
+	suite.distributeBlocks(1, 1)
+
+	suite.keeper.SetValidator(suite.ctx, &consensusv1.Validator{
+		Validator: validator2Addr.String(),
+		Status:    consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED,
+	})
+	require.NoError(suite.T(), suite.keeper.UpdateValidatorRewardStake(suite.ctx, validator2Addr.String()))
+
+	vr, found := suite.keeper.GetValidatorRewards(suite.ctx, validator2Addr.String())
+	require.True(suite.T(), found)
+	require.Equal(suite.T(), uint64(0), vr.Stake)
+	require.Equal(suite.T(), uint64(25000000), vr.Accrued)
+
+	// The whole next block goes to the remaining validator
+	suite.distributeBlocks(2, 2)
+
+	amount1, err := suite.keeper.WithdrawRewards(suite.ctx, validator1Addr.String())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), math.NewInt(75000000), amount1)
+
+	// Rewards accrued before jailing are kept
+	amount2, err := suite.keeper.WithdrawRewards(suite.ctx, validator2Addr.String())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), math.NewInt(25000000), amount2)
+
+	// The position is kept until its epoch rewards are recorded
+	_, found = suite.keeper.GetValidatorRewards(suite.ctx, validator2Addr.String())
+	require.True(suite.T(), found)
+}
@@ -0,0 +1,463 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// Block rewards are distributed with a cumulative reward index (F1 fee distribution).
+//
+// Every block adds block_reward / total_stake to the reward index, where the stake of a
+// validator is its activated LZN (zero while jailed). A validator is owed
+// stake × (index − index snapshot) × MOA penalty multiplier. The stake and the multiplier are
+// only changed after settling the owed rewards at the current index, so each block costs a
+// constant amount of work regardless of the number of validators. Only the owed WRT is minted,
+// into the consensus module account, from which validators withdraw with MsgWithdrawRewards.
+//
+// Stakes are refreshed when LZN is activated, deactivated or slashed, when a validator is jailed
+// or unjailed, and for every validator at the end of each reward epoch, which also picks up
+// MOA compliance changes. The per-validator rewards of each epoch are recorded in the lizenz module.
+
+// defaultRewardEpochLength is used when the reward epoch length param is unset
+const defaultRewardEpochLength = 100
+
+// DistributeBaseRewards distributes base block rewards to validators based on their activated LZN
+// This implements Circuit 1 of the economic model: passive income for validators
+// According to whitepaper: "validator_passive_income = (activated_lzn_validator / total_activated_lzn) × current_block_reward"
+func (k Keeper) DistributeBaseRewards(ctx sdk.Context, height uint64) error {
+	// Calculate base reward for this block
+	baseReward, err := k.CalculateBaseReward(ctx, height)
+	if err != nil {
+		return fmt.Errorf("failed to calculate base reward: %w", err)
+	}
+
+	// If no lizenz keeper is set, we can't distribute rewards
+	// This is expected in some test scenarios
+	if k.lizenzKeeper == nil {
+		ctx.Logger().Info("lizenz keeper not set, skipping reward distribution", "height", height, "base_reward", baseReward)
+		return nil
+	}
+
+	pool, err := k.rewardPool(ctx)
+	if err != nil {
+		return err
+	}
+
+	if pool.TotalStake == 0 {
+		ctx.Logger().Info("no validators with activated LZN, skipping reward distribution", "height", height)
+	} else if err := k.allocateBlockReward(ctx, pool, baseReward, height); err != nil {
+		// A block without rewards does not fail the block
+		ctx.Logger().Error("failed to allocate block reward", "error", err, "height", height)
+	}
+
+	// Settle the epoch once its last block has been rewarded
+	epochLength := k.GetParams(ctx).RewardEpochLength
+	if epochLength == 0 {
+		epochLength = defaultRewardEpochLength
+	}
+	if height+1 >= pool.EpochStartHeight+epochLength {
+		if err := k.endRewardEpoch(ctx, pool, height); err != nil {
+			return err
+		}
+	}
+
+	return k.setRewardPool(ctx, pool)
+}
+
+// allocateBlockReward advances the reward index by the block reward and mints the WRT owed for it
+func (k Keeper) allocateBlockReward(ctx sdk.Context, pool *consensusv1.RewardPool, baseReward uint64, height uint64) error {
+	// The index rounds down and the minted amount up, so the module account can always pay
+	// the truncated amounts validators are owed
+	delta := math.LegacyNewDecFromInt(math.NewIntFromUint64(baseReward)).
+		QuoTruncate(math.LegacyNewDecFromInt(math.NewIntFromUint64(pool.TotalStake)))
+	owed := delta.Mul(parseDec(pool.TotalEffectiveStake)).Ceil().TruncateInt()
+
+	// Rewards are only credited once the owed WRT is held by the module account
+	if k.bankKeeper != nil && owed.IsPositive() {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin("uwrt", owed))); err != nil {
+			return fmt.Errorf("failed to mint reward coins: %w", err)
+		}
+	} else if k.bankKeeper == nil {
+		ctx.Logger().Info("bank keeper not set, rewards accrued but not minted", "height", height)
+	}
+
+	pool.RewardIndex = parseDec(pool.RewardIndex).Add(delta).String()
+	pool.TotalEmitted = parseInt(pool.TotalEmitted).Add(owed).String()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardDistributed,
+			sdk.NewAttribute(types.AttributeKeyRewardAmount, owed.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalStake, strconv.FormatUint(pool.TotalStake, 10)),
+			sdk.NewAttribute(types.AttributeKeyRewardIndex, pool.RewardIndex),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatUint(height, 10)),
+		),
+	)
+
+	return nil
+}
+
+// endRewardEpoch settles every validator, records its epoch rewards in the lizenz module and
+// refreshes its stake and MOA penalty multiplier for the next epoch
+func (k Keeper) endRewardEpoch(ctx sdk.Context, pool *consensusv1.RewardPool, height uint64) error {
+	validators, err := k.rewardValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, validator := range validators {
+		vr := k.settledValidatorRewards(ctx, pool, validator)
+
+		if vr.EpochReward > 0 || vr.EpochBaseReward > 0 {
+			record := &lizenzv1.RewardRecord{
+				BlockHeight:    height,
+				RewardAmount:   strconv.FormatUint(vr.EpochReward, 10),
+				Timestamp:      ctx.BlockTime().Unix(),
+				MoaCompliance:  vr.MoaCompliance,
+				PenaltyApplied: vr.PenaltyMultiplier,
+				BaseReward:     strconv.FormatUint(vr.EpochBaseReward, 10),
+				Epoch:          pool.Epoch,
+				StartHeight:    pool.EpochStartHeight,
+			}
+			if err := k.lizenzKeeper.RecordEpochReward(ctx, validator, record); err != nil {
+				// Don't fail reward distribution if stats update fails
+				ctx.Logger().Error("failed to record epoch reward", "error", err, "validator", validator)
+			}
+		}
+		vr.EpochReward = 0
+		vr.EpochBaseReward = 0
+
+		if err := k.restakeValidatorRewards(ctx, pool, vr); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardEpochEnded,
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(pool.Epoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyTotalStake, strconv.FormatUint(pool.TotalStake, 10)),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatUint(height, 10)),
+		),
+	)
+
+	pool.Epoch++
+	pool.EpochStartHeight = height + 1
+	return nil
+}
+
+// rewardValidators returns the validators tracked by the reward pool followed by validators
+// with activated LZN that are not tracked yet
+func (k Keeper) rewardValidators(ctx sdk.Context) ([]string, error) {
+	tracked, err := k.GetAllValidatorRewards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allLizenzs, err := k.lizenzKeeper.GetAllActivatedLizenz(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activated LZN: %w", err)
+	}
+
+	seen := make(map[string]bool, len(tracked)+len(allLizenzs))
+	validators := make([]string, 0, len(tracked)+len(allLizenzs))
+	for _, vr := range tracked {
+		seen[vr.Validator] = true
+		validators = append(validators, vr.Validator)
+	}
+	for _, lizenz := range allLizenzs {
+		if !seen[lizenz.Validator] {
+			seen[lizenz.Validator] = true
+			validators = append(validators, lizenz.Validator)
+		}
+	}
+	return validators, nil
+}
+
+// UpdateValidatorRewardStake settles the validator's rewards and refreshes its stake and
+// MOA penalty multiplier; called whenever its activated LZN or jail status changes
+func (k Keeper) UpdateValidatorRewardStake(ctx sdk.Context, validator string) error {
+	if k.lizenzKeeper == nil {
+		return nil
+	}
+
+	pool, err := k.rewardPool(ctx)
+	if err != nil {
+		return err
+	}
+
+	vr := k.settledValidatorRewards(ctx, pool, validator)
+	if err := k.restakeValidatorRewards(ctx, pool, vr); err != nil {
+		return err
+	}
+	return k.setRewardPool(ctx, pool)
+}
+
+// updateRewardStake refreshes the validator's reward stake, logging failures so that
+// slashing and jailing never fail the block
+func (k Keeper) updateRewardStake(ctx sdk.Context, validator string) {
+	if err := k.UpdateValidatorRewardStake(ctx, validator); err != nil {
+		ctx.Logger().Error("failed to update reward stake", "error", err, "validator", validator)
+	}
+}
+
+// WithdrawRewards sends the validator's accrued rewards from the module account and returns the amount
+func (k Keeper) WithdrawRewards(ctx sdk.Context, validator string) (math.Int, error) {
+	validatorAddr, err := sdk.AccAddressFromBech32(validator)
+	if err != nil {
+		return math.ZeroInt(), errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+	if k.bankKeeper == nil {
+		return math.ZeroInt(), fmt.Errorf("bank keeper not set")
+	}
+
+	pool, found := k.GetRewardPool(ctx)
+	if !found {
+		return math.ZeroInt(), types.ErrNoRewards
+	}
+	if _, found := k.GetValidatorRewards(ctx, validator); !found {
+		return math.ZeroInt(), types.ErrNoRewards
+	}
+
+	vr := k.settledValidatorRewards(ctx, pool, validator)
+	if vr.Accrued == 0 {
+		k.setValidatorRewards(ctx, vr)
+		return math.ZeroInt(), types.ErrNoRewards
+	}
+
+	amount := math.NewIntFromUint64(vr.Accrued)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, validatorAddr, sdk.NewCoins(sdk.NewCoin("uwrt", amount))); err != nil {
+		return math.ZeroInt(), fmt.Errorf("failed to send rewards: %w", err)
+	}
+	vr.Accrued = 0
+	if vr.Stake == 0 && vr.EpochReward == 0 && vr.EpochBaseReward == 0 {
+		k.deleteValidatorRewards(ctx, validator)
+	} else {
+		k.setValidatorRewards(ctx, vr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardsWithdrawn,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(types.AttributeKeyRewardAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return amount, nil
+}
+
+// PendingRewards returns the rewards WithdrawRewards would send to the validator now
+func (k Keeper) PendingRewards(ctx sdk.Context, validator string) uint64 {
+	pool, found := k.GetRewardPool(ctx)
+	if !found {
+		return 0
+	}
+	if _, found := k.GetValidatorRewards(ctx, validator); !found {
+		return 0
+	}
+	return k.settledValidatorRewards(ctx, pool, validator).Accrued
+}
+
+// settledValidatorRewards returns the validator's position with the rewards owed up to the
+// current reward index moved into accrued; new positions start at the current index
+func (k Keeper) settledValidatorRewards(ctx sdk.Context, pool *consensusv1.RewardPool, validator string) *consensusv1.ValidatorRewards {
+	vr, found := k.GetValidatorRewards(ctx, validator)
+	if !found {
+		return &consensusv1.ValidatorRewards{
+			Validator:         validator,
+			PenaltyMultiplier: math.LegacyZeroDec().String(),
+			MoaCompliance:     math.LegacyZeroDec().String(),
+			IndexSnapshot:     pool.RewardIndex,
+		}
+	}
+
+	delta := parseDec(pool.RewardIndex).Sub(parseDec(vr.IndexSnapshot))
+	if vr.Stake > 0 && delta.IsPositive() {
+		base := math.LegacyNewDecFromInt(math.NewIntFromUint64(vr.Stake)).Mul(delta)
+		owed := base.MulTruncate(parseDec(vr.PenaltyMultiplier)).TruncateInt().Uint64()
+		vr.Accrued += owed
+		vr.EpochReward += owed
+		vr.EpochBaseReward += base.TruncateInt().Uint64()
+	}
+	vr.IndexSnapshot = pool.RewardIndex
+	return vr
+}
+
+// restakeValidatorRewards replaces the validator's contribution to the pool with its current
+// stake and MOA penalty multiplier; the position must have been settled first
+func (k Keeper) restakeValidatorRewards(ctx sdk.Context, pool *consensusv1.RewardPool, vr *consensusv1.ValidatorRewards) error {
+	totalEffective := parseDec(pool.TotalEffectiveStake).Sub(effectiveStake(vr))
+	if vr.Stake > pool.TotalStake {
+		return fmt.Errorf("reward pool stake %d is below the stake %d of %s", pool.TotalStake, vr.Stake, vr.Validator)
+	}
+	pool.TotalStake -= vr.Stake
+
+	stake, compliance, penalty := k.rewardStake(ctx, vr.Validator)
+	vr.Stake = stake
+	vr.MoaCompliance = compliance.String()
+	vr.PenaltyMultiplier = penalty.String()
+
+	pool.TotalStake += vr.Stake
+	totalEffective = totalEffective.Add(effectiveStake(vr))
+	if totalEffective.IsNegative() {
+		totalEffective = math.LegacyZeroDec()
+	}
+	pool.TotalEffectiveStake = totalEffective.String()
+
+	if vr.Stake == 0 && vr.Accrued == 0 && vr.EpochReward == 0 && vr.EpochBaseReward == 0 {
+		k.deleteValidatorRewards(ctx, vr.Validator)
+		return nil
+	}
+	k.setValidatorRewards(ctx, vr)
+	return nil
+}
+
+// rewardStake returns the activated LZN earning rewards for the validator together with its
+// MOA compliance and penalty multiplier; jailed validators and validators without activated LZN earn nothing
+func (k Keeper) rewardStake(ctx sdk.Context, validator string) (uint64, math.LegacyDec, math.LegacyDec) {
+	zero := math.LegacyZeroDec()
+	if k.lizenzKeeper == nil || k.IsValidatorJailed(ctx, validator) {
+		return 0, zero, zero
+	}
+
+	lizenz, err := k.lizenzKeeper.GetActivatedLizenz(ctx, validator)
+	if err != nil || lizenz == nil {
+		return 0, zero, zero
+	}
+	stake, err := strconv.ParseUint(lizenz.Amount, 10, 64)
+	if err != nil {
+		ctx.Logger().Error("failed to parse LZN amount", "error", err, "amount", lizenz.Amount)
+		return 0, zero, zero
+	}
+
+	// Get MOA compliance (default to 1.0 if not available)
+	moaCompliance := 1.0
+	if compliance, err := k.lizenzKeeper.GetMOACompliance(ctx, validator); err == nil {
+		moaCompliance = compliance
+	}
+	penalty := k.CalculateMOAPenaltyMultiplier(ctx, moaCompliance)
+
+	return stake, floatToDec(moaCompliance), floatToDec(penalty)
+}
+
+// rewardPool returns the reward pool, creating it on first use with every validator
+// that has activated LZN
+func (k Keeper) rewardPool(ctx sdk.Context) (*consensusv1.RewardPool, error) {
+	if pool, found := k.GetRewardPool(ctx); found {
+		return pool, nil
+	}
+
+	pool := &consensusv1.RewardPool{
+		RewardIndex:         math.LegacyZeroDec().String(),
+		TotalEffectiveStake: math.LegacyZeroDec().String(),
+		Epoch:               1,
+		EpochStartHeight:    uint64(ctx.BlockHeight()),
+	}
+	validators, err := k.rewardValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, validator := range validators {
+		if err := k.restakeValidatorRewards(ctx, pool, k.settledValidatorRewards(ctx, pool, validator)); err != nil {
+			return nil, err
+		}
+	}
+	return pool, nil
+}
+
+// GetRewardPool returns the global reward accumulator
+func (k Keeper) GetRewardPool(ctx sdk.Context) (*consensusv1.RewardPool, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RewardPoolKey)
+	if bz == nil {
+		return nil, false
+	}
+
+	var pool consensusv1.RewardPool
+	k.cdc.MustUnmarshal(bz, &pool)
+	return &pool, true
+}
+
+func (k Keeper) setRewardPool(ctx sdk.Context, pool *consensusv1.RewardPool) error {
+	bz, err := k.cdc.Marshal(pool)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reward pool: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.RewardPoolKey, bz)
+	return nil
+}
+
+// GetValidatorRewards returns the validator's position in the reward pool as of its last settlement
+func (k Keeper) GetValidatorRewards(ctx sdk.Context, validator string) (*consensusv1.ValidatorRewards, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetValidatorRewardsKey(validator))
+	if bz == nil {
+		return nil, false
+	}
+
+	var vr consensusv1.ValidatorRewards
+	k.cdc.MustUnmarshal(bz, &vr)
+	return &vr, true
+}
+
+// GetAllValidatorRewards returns the positions of all validators in the reward pool
+func (k Keeper) GetAllValidatorRewards(ctx sdk.Context) ([]*consensusv1.ValidatorRewards, error) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorRewardsKeyPrefix)
+	defer iterator.Close()
+
+	var positions []*consensusv1.ValidatorRewards
+	for ; iterator.Valid(); iterator.Next() {
+		var vr consensusv1.ValidatorRewards
+		if err := k.cdc.Unmarshal(iterator.Value(), &vr); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal validator rewards: %w", err)
+		}
+		positions = append(positions, &vr)
+	}
+	return positions, nil
+}
+
+func (k Keeper) setValidatorRewards(ctx sdk.Context, vr *consensusv1.ValidatorRewards) {
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorRewardsKey(vr.Validator), k.cdc.MustMarshal(vr))
+}
+
+func (k Keeper) deleteValidatorRewards(ctx sdk.Context, validator string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetValidatorRewardsKey(validator))
+}
+
+// effectiveStake returns the validator's stake scaled by its MOA penalty multiplier
+func effectiveStake(vr *consensusv1.ValidatorRewards) math.LegacyDec {
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(vr.Stake)).Mul(parseDec(vr.PenaltyMultiplier))
+}
+
+// parseDec parses a stored decimal, treating an empty or malformed value as zero
+func parseDec(s string) math.LegacyDec {
+	dec, err := math.LegacyNewDecFromStr(s)
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+	return dec
+}
+
+// parseInt parses a stored integer amount, treating an empty or malformed value as zero
+func parseInt(s string) math.Int {
+	amount, ok := math.NewIntFromString(s)
+	if !ok {
+		return math.ZeroInt()
+	}
+	return amount
+}
+
+// floatToDec converts a ratio such as the MOA compliance to a decimal with 6 digits of precision
+func floatToDec(f float64) math.LegacyDec {
+	return parseDec(strconv.FormatFloat(f, 'f', 6, 64))
+}
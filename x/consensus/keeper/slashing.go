@@ -0,0 +1,477 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// ============================================================================
+// Slashing and Jailing
+// ============================================================================
+//
+// Validators are penalized for three faults:
+//   - double-signing (CometBFT Misbehavior evidence): burn slash_fraction_double_sign of activated LZN and jail
+//   - downtime (too many missed blocks in the signed blocks window): burn slash_fraction_downtime and jail
+//   - unrevealed blind auction commits: burn slash_fraction_unrevealed_commit, no jail
+//
+// CometBFT identifies validators by consensus address, so slashing only applies to
+// validators whose consensus key is registered: genesis validators by InitGenesis,
+// later validators with MsgSetConsensusKey.
+
+// slashingParams holds parsed slashing parameters
+type slashingParams struct {
+	signedBlocksWindow            uint64
+	minSignedPerWindow            math.LegacyDec
+	downtimeJailDuration          time.Duration
+	doubleSignJailDuration        time.Duration
+	slashFractionDowntime         math.LegacyDec
+	slashFractionDoubleSign       math.LegacyDec
+	slashFractionUnrevealedCommit math.LegacyDec
+}
+
+// getSlashingParams parses slashing parameters, falling back to defaults for empty or invalid values
+func (k Keeper) getSlashingParams(ctx sdk.Context) slashingParams {
+	params := k.GetParams(ctx)
+	defaults := types.DefaultParams()
+
+	window := params.SignedBlocksWindow
+	if window == 0 {
+		window = defaults.SignedBlocksWindow // Default fallback
+	}
+
+	return slashingParams{
+		signedBlocksWindow:            window,
+		minSignedPerWindow:            parseDecParam(params.MinSignedPerWindow, defaults.MinSignedPerWindow),
+		downtimeJailDuration:          parseDurationParam(params.DowntimeJailDuration, defaults.DowntimeJailDuration),
+		doubleSignJailDuration:        parseDurationParam(params.DoubleSignJailDuration, defaults.DoubleSignJailDuration),
+		slashFractionDowntime:         parseDecParam(params.SlashFractionDowntime, defaults.SlashFractionDowntime),
+		slashFractionDoubleSign:       parseDecParam(params.SlashFractionDoubleSign, defaults.SlashFractionDoubleSign),
+		slashFractionUnrevealedCommit: parseDecParam(params.SlashFractionUnrevealedCommit, defaults.SlashFractionUnrevealedCommit),
+	}
+}
+
+// parseDecParam parses a decimal parameter, using the fallback if it is empty or invalid
+func parseDecParam(value, fallback string) math.LegacyDec {
+	if dec, err := math.LegacyNewDecFromStr(value); err == nil && !dec.IsNegative() {
+		return dec
+	}
+	return math.LegacyMustNewDecFromStr(fallback)
+}
+
+// parseDurationParam parses a duration parameter, using the fallback if it is empty or invalid
+func parseDurationParam(value, fallback string) time.Duration {
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return d
+	}
+	d, _ := time.ParseDuration(fallback)
+	return d
+}
+
+// SetValidatorConsAddress maps a CometBFT consensus address to a PoVB validator
+// and starts tracking its signing info
+func (k Keeper) SetValidatorConsAddress(ctx sdk.Context, validator string, consAddr []byte) error {
+	if validator == "" {
+		return types.ErrEmptyValidatorAddress
+	}
+	if len(consAddr) == 0 {
+		return fmt.Errorf("empty consensus address")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorByConsAddrKey(consAddr), []byte(validator))
+
+	info, err := k.GetValidatorSigningInfo(ctx, validator)
+	if err != nil {
+		info = &consensusv1.ValidatorSigningInfo{
+			Validator:   validator,
+			StartHeight: uint64(ctx.BlockHeight()),
+		}
+	}
+	info.ConsAddress = consAddr
+
+	return k.SetValidatorSigningInfo(ctx, info)
+}
+
+// GetValidatorByConsAddress returns the PoVB validator owning a consensus address
+func (k Keeper) GetValidatorByConsAddress(ctx sdk.Context, consAddr []byte) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorByConsAddrKey(consAddr))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// GetValidatorSigningInfo returns the signing info of a validator
+func (k Keeper) GetValidatorSigningInfo(ctx sdk.Context, validator string) (*consensusv1.ValidatorSigningInfo, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorSigningInfoKey(validator))
+	if bz == nil {
+		return nil, types.ErrSigningInfoNotFound
+	}
+
+	var info consensusv1.ValidatorSigningInfo
+	if err := k.cdc.Unmarshal(bz, &info); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signing info: %w", err)
+	}
+
+	return &info, nil
+}
+
+// SetValidatorSigningInfo sets the signing info of a validator
+func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, info *consensusv1.ValidatorSigningInfo) error {
+	bz, err := k.cdc.Marshal(info)
+	if err != nil {
+		return fmt.Errorf("failed to marshal signing info: %w", err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorSigningInfoKey(info.Validator), bz)
+	return nil
+}
+
+// getMissedBlockBit returns whether the validator missed the block at a window index
+func (k Keeper) getMissedBlockBit(ctx sdk.Context, validator string, index uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorMissedBlockKey(validator, index))
+}
+
+// setMissedBlockBit sets or clears the missed block bit at a window index
+func (k Keeper) setMissedBlockBit(ctx sdk.Context, validator string, index uint64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorMissedBlockKey(validator, index)
+	if missed {
+		store.Set(key, []byte{1})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// clearMissedBlockBitmap removes all missed block bits of a validator
+func (k Keeper) clearMissedBlockBitmap(ctx sdk.Context, validator string) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetValidatorMissedBlockPrefix(validator)
+	iterator := store.Iterator(prefix, append(prefix, 0xFF))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IsValidatorJailed checks if a validator is jailed
+func (k Keeper) IsValidatorJailed(ctx sdk.Context, validator string) bool {
+	v, err := k.GetValidator(ctx, validator)
+	if err != nil {
+		return false
+	}
+	return v.Status == consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED
+}
+
+// HandleCometInfo processes CometBFT misbehavior evidence and the last commit signatures
+// Called from BeginBlocker: FinalizeBlock exposes Misbehavior and DecidedLastCommit through the context
+func (k Keeper) HandleCometInfo(ctx sdk.Context) error {
+	cometInfo := ctx.CometInfo()
+	if cometInfo == nil {
+		return nil
+	}
+
+	// Double-sign evidence
+	if evidence := cometInfo.GetEvidence(); evidence != nil {
+		for i := 0; i < evidence.Len(); i++ {
+			ev := evidence.Get(i)
+			switch ev.Type() {
+			case comet.DuplicateVote, comet.LightClientAttack:
+				if err := k.HandleDoubleSign(ctx, ev.Validator().Address(), ev.Height()); err != nil {
+					return err
+				}
+			default:
+				ctx.Logger().Error("ignored unknown misbehavior type", "type", ev.Type())
+			}
+		}
+	}
+
+	// Liveness: signatures of the last commit
+	if lastCommit := cometInfo.GetLastCommit(); lastCommit != nil {
+		if votes := lastCommit.Votes(); votes != nil {
+			for i := 0; i < votes.Len(); i++ {
+				vote := votes.Get(i)
+				signed := vote.GetBlockIDFlag() != comet.BlockIDFlagAbsent
+				if err := k.HandleValidatorSignature(ctx, vote.Validator().Address(), signed); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// HandleValidatorSignature tracks a validator's signature of the last block
+// A validator missing more than (1 - min_signed_per_window) of the signed blocks window is slashed and jailed
+func (k Keeper) HandleValidatorSignature(ctx sdk.Context, consAddr []byte, signed bool) error {
+	validator, found := k.GetValidatorByConsAddress(ctx, consAddr)
+	if !found {
+		// Consensus key not linked to a PoVB validator
+		return nil
+	}
+
+	// Jailed validators are not tracked until they are unjailed
+	if k.IsValidatorJailed(ctx, validator) {
+		return nil
+	}
+
+	info, err := k.GetValidatorSigningInfo(ctx, validator)
+	if err != nil {
+		return err
+	}
+
+	params := k.getSlashingParams(ctx)
+	height := uint64(ctx.BlockHeight())
+
+	index := info.IndexOffset % params.signedBlocksWindow
+	info.IndexOffset++
+
+	missed := !signed
+	previous := k.getMissedBlockBit(ctx, validator, index)
+	switch {
+	case !previous && missed:
+		k.setMissedBlockBit(ctx, validator, index, true)
+		info.MissedBlocksCounter++
+	case previous && !missed:
+		k.setMissedBlockBit(ctx, validator, index, false)
+		info.MissedBlocksCounter--
+	}
+
+	if missed {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLiveness,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator),
+				sdk.NewAttribute(types.AttributeKeyConsAddress, hex.EncodeToString(consAddr)),
+				sdk.NewAttribute(types.AttributeKeyMissedBlocks, strconv.FormatUint(info.MissedBlocksCounter, 10)),
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			),
+		)
+	}
+
+	// A validator is only judged after a full window since tracking started
+	minSigned := params.minSignedPerWindow.MulInt64(int64(params.signedBlocksWindow)).RoundInt64()
+	maxMissed := int64(params.signedBlocksWindow) - minSigned
+	if height > info.StartHeight+params.signedBlocksWindow && int64(info.MissedBlocksCounter) > maxMissed {
+		ctx.Logger().Info("validator jailed for downtime",
+			"validator", validator,
+			"missed_blocks", info.MissedBlocksCounter,
+			"threshold", maxMissed,
+			"height", height)
+
+		k.slashLZN(ctx, validator, params.slashFractionDowntime, types.SlashReasonDowntime)
+		k.jailValidator(ctx, validator, info, ctx.BlockTime().Add(params.downtimeJailDuration), types.SlashReasonDowntime)
+
+		// Start a fresh window so the validator gets a full window after unjailing
+		info.MissedBlocksCounter = 0
+		info.IndexOffset = 0
+		info.StartHeight = height
+		k.clearMissedBlockBitmap(ctx, validator)
+	}
+
+	return k.SetValidatorSigningInfo(ctx, info)
+}
+
+// HandleDoubleSign slashes and jails a validator for CometBFT misbehavior evidence
+func (k Keeper) HandleDoubleSign(ctx sdk.Context, consAddr []byte, infractionHeight int64) error {
+	validator, found := k.GetValidatorByConsAddress(ctx, consAddr)
+	if !found {
+		ctx.Logger().Error("ignored misbehavior of unknown validator", "cons_address", hex.EncodeToString(consAddr), "infraction_height", infractionHeight)
+		return nil
+	}
+
+	info, err := k.GetValidatorSigningInfo(ctx, validator)
+	if err != nil {
+		return err
+	}
+
+	params := k.getSlashingParams(ctx)
+
+	ctx.Logger().Info("validator slashed for double-signing",
+		"validator", validator,
+		"infraction_height", infractionHeight,
+		"height", ctx.BlockHeight())
+
+	info.DoubleSignFaults++
+	k.slashLZN(ctx, validator, params.slashFractionDoubleSign, types.SlashReasonDoubleSign)
+	k.jailValidator(ctx, validator, info, ctx.BlockTime().Add(params.doubleSignJailDuration), types.SlashReasonDoubleSign)
+
+	return k.SetValidatorSigningInfo(ctx, info)
+}
+
+// HandleUnrevealedCommit applies the lighter fault for a blind auction commit that was never revealed
+// The validator is slashed but not jailed
+func (k Keeper) HandleUnrevealedCommit(ctx sdk.Context, validator string, auctionHeight uint64) error {
+	info, err := k.GetValidatorSigningInfo(ctx, validator)
+	if err != nil {
+		// Validators without a consensus key still have their unrevealed commits counted
+		info = &consensusv1.ValidatorSigningInfo{
+			Validator:   validator,
+			StartHeight: uint64(ctx.BlockHeight()),
+		}
+	}
+
+	params := k.getSlashingParams(ctx)
+
+	info.UnrevealedCommits++
+	k.slashLZN(ctx, validator, params.slashFractionUnrevealedCommit, types.SlashReasonUnrevealedCommit)
+
+	ctx.Logger().Info("validator slashed for unrevealed auction commit",
+		"validator", validator,
+		"auction_height", auctionHeight)
+
+	return k.SetValidatorSigningInfo(ctx, info)
+}
+
+// handleUnrevealedCommits penalizes every commit of a completed auction without a matching reveal:
+// the commit bond is forfeited, an auction fault is recorded and the activated LZN is slashed
+func (k Keeper) handleUnrevealedCommits(ctx sdk.Context, auction *consensusv1.BlindAuction) {
+	revealed := make(map[string]bool, len(auction.Reveals))
+	for _, reveal := range auction.Reveals {
+		revealed[reveal.Validator] = true
+	}
+
+	for _, commit := range auction.Commits {
+		if revealed[commit.Validator] {
+			continue
+		}
+		if err := k.forfeitCommitBond(ctx, commit, auction.BlockHeight); err != nil {
+			ctx.Logger().Error("failed to forfeit commit bond", "error", err, "validator", commit.Validator)
+		}
+		if err := k.HandleUnrevealedCommit(ctx, commit.Validator, auction.BlockHeight); err != nil {
+			ctx.Logger().Error("failed to handle unrevealed commit", "error", err, "validator", commit.Validator)
+		}
+	}
+}
+
+// slashLZN burns a fraction of the validator's activated LZN through the lizenz module
+// Slashing never fails the block: errors are logged
+func (k Keeper) slashLZN(ctx sdk.Context, validator string, fraction math.LegacyDec, reason string) {
+	burned := math.ZeroInt()
+	if k.lizenzKeeper != nil && fraction.IsPositive() {
+		amount, err := k.lizenzKeeper.SlashActivatedLizenz(ctx, validator, fraction)
+		if err != nil {
+			ctx.Logger().Error("failed to slash activated LZN", "error", err, "validator", validator, "reason", reason)
+		} else {
+			burned = amount
+		}
+		k.updateRewardStake(ctx, validator)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnedLZN, burned.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	if k.hooks != nil {
+		if err := k.hooks.AfterValidatorSlashed(ctx, validator, reason); err != nil {
+			ctx.Logger().Error("failed to run validator slashed hooks", "error", err, "validator", validator)
+		}
+	}
+}
+
+// jailValidator sets the validator status to jailed until the given time
+// An existing longer jail period is kept
+func (k Keeper) jailValidator(ctx sdk.Context, validator string, info *consensusv1.ValidatorSigningInfo, until time.Time, reason string) {
+	if info.JailedUntil != nil && info.JailedUntil.AsTime().After(until) {
+		until = info.JailedUntil.AsTime()
+	}
+	info.JailedUntil = timestamppb.New(until)
+
+	if v, err := k.GetValidator(ctx, validator); err == nil {
+		v.Status = consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED
+		k.SetValidator(ctx, v)
+	}
+	// Jailed validators stop earning block rewards
+	k.updateRewardStake(ctx, validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJail,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, until.UTC().Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	if k.hooks != nil {
+		if err := k.hooks.AfterValidatorJailed(ctx, validator, reason); err != nil {
+			ctx.Logger().Error("failed to run validator jailed hooks", "error", err, "validator", validator)
+		}
+	}
+}
+
+// Unjail returns a jailed validator to the active set once its jail period has ended
+func (k Keeper) Unjail(ctx sdk.Context, validator string) error {
+	v, err := k.GetValidator(ctx, validator)
+	if err != nil {
+		return types.ErrValidatorNotFound
+	}
+	if v.Status != consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED {
+		return types.ErrValidatorNotJailed
+	}
+
+	info, err := k.GetValidatorSigningInfo(ctx, validator)
+	if err == nil {
+		if info.JailedUntil != nil && ctx.BlockTime().Before(info.JailedUntil.AsTime()) {
+			return types.ErrValidatorStillJailed
+		}
+
+		// Liveness tracking restarts with a full, empty window
+		info.StartHeight = uint64(ctx.BlockHeight())
+		info.MissedBlocksCounter = 0
+		info.IndexOffset = 0
+		if err := k.SetValidatorSigningInfo(ctx, info); err != nil {
+			return err
+		}
+	}
+	k.clearMissedBlockBitmap(ctx, validator)
+
+	v.Status = consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE
+	k.SetValidator(ctx, v)
+	k.updateRewardStake(ctx, validator)
+
+	if k.hooks != nil {
+		if err := k.hooks.AfterValidatorUnjailed(ctx, validator); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,293 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// mockCometValidator implements comet.Validator
+type mockCometValidator struct {
+	address []byte
+	power   int64
+}
+
+func (v mockCometValidator) Address() []byte { return v.address }
+func (v mockCometValidator) Power() int64    { return v.power }
+
+// mockEvidence implements comet.Evidence
+type mockEvidence struct {
+	misbehavior comet.MisbehaviorType
+	validator   mockCometValidator
+	height      int64
+}
+
+func (e mockEvidence) Type() comet.MisbehaviorType { return e.misbehavior }
+func (e mockEvidence) Validator() comet.Validator  { return e.validator }
+func (e mockEvidence) Height() int64               { return e.height }
+func (e mockEvidence) Time() time.Time             { return time.Time{} }
+func (e mockEvidence) TotalVotingPower() int64     { return 0 }
+
+type mockEvidenceList []mockEvidence
+
+func (l mockEvidenceList) Len() int                 { return len(l) }
+func (l mockEvidenceList) Get(i int) comet.Evidence { return l[i] }
+
+// mockVote implements comet.VoteInfo
+type mockVote struct {
+	validator mockCometValidator
+	flag      comet.BlockIDFlag
+}
+
+func (v mockVote) Validator() comet.Validator        { return v.validator }
+func (v mockVote) GetBlockIDFlag() comet.BlockIDFlag { return v.flag }
+
+type mockVoteInfos []mockVote
+
+func (l mockVoteInfos) Len() int                 { return len(l) }
+func (l mockVoteInfos) Get(i int) comet.VoteInfo { return l[i] }
+
+type mockCommitInfo struct{ votes mockVoteInfos }
+
+func (c mockCommitInfo) Round() int32           { return 0 }
+func (c mockCommitInfo) Votes() comet.VoteInfos { return c.votes }
+
+// mockCometInfo implements comet.BlockInfo
+type mockCometInfo struct {
+	evidence mockEvidenceList
+	votes    mockVoteInfos
+}
+
+func (m mockCometInfo) GetEvidence() comet.EvidenceList { return m.evidence }
+func (m mockCometInfo) GetValidatorsHash() []byte       { return nil }
+func (m mockCometInfo) GetProposerAddress() []byte      { return nil }
+func (m mockCometInfo) GetLastCommit() comet.CommitInfo { return mockCommitInfo{votes: m.votes} }
+
+var testConsAddr = []byte("consaddr-validator-1")
+
+func (suite *KeeperTestSuite) setupSlashingValidator() *MockLizenzKeeper {
+	suite.keeper.SetValidator(suite.ctx, &consensusv1.Validator{
+		Validator: "cosmos1validator1",
+		Status:    consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE,
+	})
+	require.NoError(suite.T(), suite.keeper.SetValidatorConsAddress(suite.ctx, "cosmos1validator1", testConsAddr))
+
+	mockLizenzKeeper := &MockLizenzKeeper{}
+	suite.keeper.SetLizenzKeeper(mockLizenzKeeper)
+	return mockLizenzKeeper
+}
+
+func (suite *KeeperTestSuite) TestHandleCometInfo_DoubleSign() {
+	mockLizenzKeeper := suite.setupSlashingValidator()
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(blockTime).WithCometInfo(mockCometInfo{
+		evidence: mockEvidenceList{{
+			misbehavior: comet.DuplicateVote,
+			validator:   mockCometValidator{address: testConsAddr, power: 10},
+			height:      9,
+		}},
+	})
+
+	require.NoError(suite.T(), suite.keeper.BeginBlocker(ctx))
+
+	require.True(suite.T(), suite.keeper.IsValidatorJailed(ctx, "cosmos1validator1"))
+	require.Equal(suite.T(), math.LegacyMustNewDecFromStr("0.05"), mockLizenzKeeper.slashed["cosmos1validator1"])
+
+	info, err := suite.keeper.GetValidatorSigningInfo(ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(1), info.DoubleSignFaults)
+	require.True(suite.T(), info.JailedUntil.AsTime().Equal(blockTime.Add(720*time.Hour)))
+
+	// Jailed validators are excluded from block creation
+	_, err = suite.keeper.SelectBlockCreator(ctx, 11)
+	require.ErrorIs(suite.T(), err, types.ErrNoValidators)
+}
+
+func (suite *KeeperTestSuite) TestHandleCometInfo_UnknownValidatorIgnored() {
+	ctx := suite.ctx.WithBlockHeight(10).WithCometInfo(mockCometInfo{
+		evidence: mockEvidenceList{{
+			misbehavior: comet.DuplicateVote,
+			validator:   mockCometValidator{address: []byte("unknown"), power: 10},
+			height:      9,
+		}},
+		votes: mockVoteInfos{{validator: mockCometValidator{address: []byte("unknown")}, flag: comet.BlockIDFlagAbsent}},
+	})
+
+	require.NoError(suite.T(), suite.keeper.HandleCometInfo(ctx))
+}
+
+func (suite *KeeperTestSuite) TestHandleValidatorSignature_Downtime() {
+	mockLizenzKeeper := suite.setupSlashingValidator()
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SignedBlocksWindow = 10
+	params.MinSignedPerWindow = "0.5"
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// Signing blocks keeps the validator active
+	for h := int64(1); h <= 10; h++ {
+		ctx := suite.ctx.WithBlockHeight(h)
+		require.NoError(suite.T(), suite.keeper.HandleValidatorSignature(ctx, testConsAddr, true))
+	}
+	require.False(suite.T(), suite.keeper.IsValidatorJailed(suite.ctx, "cosmos1validator1"))
+
+	// Missing more than half of the window jails the validator
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var jailedAt int64
+	for h := int64(11); h <= 20; h++ {
+		ctx := suite.ctx.WithBlockHeight(h).WithBlockTime(blockTime)
+		require.NoError(suite.T(), suite.keeper.HandleValidatorSignature(ctx, testConsAddr, false))
+		if jailedAt == 0 && suite.keeper.IsValidatorJailed(ctx, "cosmos1validator1") {
+			jailedAt = h
+		}
+	}
+	require.Equal(suite.T(), int64(16), jailedAt)
+	require.Equal(suite.T(), math.LegacyMustNewDecFromStr("0.01"), mockLizenzKeeper.slashed["cosmos1validator1"])
+
+	// The window is reset after jailing
+	info, err := suite.keeper.GetValidatorSigningInfo(suite.ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(0), info.MissedBlocksCounter)
+	require.Equal(suite.T(), uint64(16), info.StartHeight)
+	require.True(suite.T(), info.JailedUntil.AsTime().Equal(blockTime.Add(10*time.Minute)))
+}
+
+func (suite *KeeperTestSuite) TestHandleCometInfo_LastCommitVotes() {
+	suite.setupSlashingValidator()
+
+	ctx := suite.ctx.WithBlockHeight(5).WithCometInfo(mockCometInfo{
+		votes: mockVoteInfos{{validator: mockCometValidator{address: testConsAddr, power: 10}, flag: comet.BlockIDFlagAbsent}},
+	})
+	require.NoError(suite.T(), suite.keeper.HandleCometInfo(ctx))
+
+	info, err := suite.keeper.GetValidatorSigningInfo(ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(1), info.MissedBlocksCounter)
+	require.Equal(suite.T(), uint64(1), info.IndexOffset)
+}
+
+func (suite *KeeperTestSuite) TestHandleUnrevealedCommit() {
+	mockLizenzKeeper := suite.setupSlashingValidator()
+
+	require.NoError(suite.T(), suite.keeper.HandleUnrevealedCommit(suite.ctx, "cosmos1validator1", 100))
+
+	// Lighter fault: slashed but not jailed
+	require.False(suite.T(), suite.keeper.IsValidatorJailed(suite.ctx, "cosmos1validator1"))
+	require.Equal(suite.T(), math.LegacyMustNewDecFromStr("0.001"), mockLizenzKeeper.slashed["cosmos1validator1"])
+
+	info, err := suite.keeper.GetValidatorSigningInfo(suite.ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(1), info.UnrevealedCommits)
+}
+
+func (suite *KeeperTestSuite) TestMsgUnjail() {
+	suite.setupSlashingValidator()
+	msgServer := keeper.NewMsgServer(*suite.keeper)
+
+	// Not jailed
+	_, err := msgServer.Unjail(suite.ctx, &consensusv1.MsgUnjail{Validator: "cosmos1validator1"})
+	require.ErrorIs(suite.T(), err, types.ErrValidatorNotJailed)
+
+	// Blocks missed before the double sign
+	for h := int64(1); h <= 3; h++ {
+		require.NoError(suite.T(), suite.keeper.HandleValidatorSignature(suite.ctx.WithBlockHeight(h), testConsAddr, false))
+	}
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+	require.NoError(suite.T(), suite.keeper.HandleDoubleSign(ctx, testConsAddr, 9))
+
+	// Jail period has not ended
+	_, err = msgServer.Unjail(ctx, &consensusv1.MsgUnjail{Validator: "cosmos1validator1"})
+	require.ErrorIs(suite.T(), err, types.ErrValidatorStillJailed)
+
+	// Jail period ended
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(blockTime.Add(721 * time.Hour))
+	_, err = msgServer.Unjail(ctx, &consensusv1.MsgUnjail{Validator: "cosmos1validator1"})
+	require.NoError(suite.T(), err)
+	require.False(suite.T(), suite.keeper.IsValidatorJailed(ctx, "cosmos1validator1"))
+
+	info, err := suite.keeper.GetValidatorSigningInfo(ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(20), info.StartHeight)
+	require.Equal(suite.T(), uint64(0), info.MissedBlocksCounter)
+	require.Equal(suite.T(), uint64(0), info.IndexOffset)
+
+	// Blocks missed before jailing no longer count in the new window
+	require.NoError(suite.T(), suite.keeper.HandleValidatorSignature(ctx.WithBlockHeight(21), testConsAddr, true))
+	info, err = suite.keeper.GetValidatorSigningInfo(ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(0), info.MissedBlocksCounter)
+}
+
+// TestHandleCometInfo_RegisteredKeySlashed tests validators that registered their key after genesis can be slashed
+func (suite *KeeperTestSuite) TestHandleCometInfo_RegisteredKeySlashed() {
+	suite.keeper.SetValidator(suite.ctx, &consensusv1.Validator{
+		Validator: "cosmos1validator2",
+		Status:    consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE,
+	})
+	mockLizenzKeeper := &MockLizenzKeeper{}
+	suite.keeper.SetLizenzKeeper(mockLizenzKeeper)
+
+	msgServer := keeper.NewMsgServer(*suite.keeper)
+	_, err := msgServer.SetConsensusKey(suite.ctx, &consensusv1.MsgSetConsensusKey{Validator: "cosmos1validator2", PubKey: testConsPubKey(2)})
+	require.NoError(suite.T(), err)
+	consAddr, err := types.ConsAddressFromPubKey(testConsPubKey(2))
+	require.NoError(suite.T(), err)
+
+	ctx := suite.ctx.WithBlockHeight(10).WithCometInfo(mockCometInfo{
+		evidence: mockEvidenceList{{
+			misbehavior: comet.DuplicateVote,
+			validator:   mockCometValidator{address: consAddr, power: 10},
+			height:      9,
+		}},
+	})
+	require.NoError(suite.T(), suite.keeper.HandleCometInfo(ctx))
+
+	require.True(suite.T(), suite.keeper.IsValidatorJailed(ctx, "cosmos1validator2"))
+	require.Equal(suite.T(), math.LegacyMustNewDecFromStr("0.05"), mockLizenzKeeper.slashed["cosmos1validator2"])
+}
+
+// TestSlashing_CallsHooks tests slashing, jailing and unjailing are passed to the consensus hooks
+func (suite *KeeperTestSuite) TestSlashing_CallsHooks() {
+	suite.setupSlashingValidator()
+	hooks := &MockConsensusHooks{creators: make(map[uint64]string)}
+	suite.keeper.SetHooks(hooks)
+
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+	require.NoError(suite.T(), suite.keeper.HandleDoubleSign(ctx, testConsAddr, 9))
+	require.Equal(suite.T(), []string{types.SlashReasonDoubleSign}, hooks.slashes)
+	require.Equal(suite.T(), []string{types.SlashReasonDoubleSign}, hooks.jails)
+
+	// A rejected unjail is not reported
+	require.Error(suite.T(), suite.keeper.Unjail(ctx, "cosmos1validator1"))
+	require.Empty(suite.T(), hooks.unjails)
+
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(blockTime.Add(721 * time.Hour))
+	require.NoError(suite.T(), suite.keeper.Unjail(ctx, "cosmos1validator1"))
+	require.Equal(suite.T(), []string{"cosmos1validator1"}, hooks.unjails)
+}
+
+func (suite *KeeperTestSuite) TestCommitBid_JailedValidator() {
+	// The commit window of auction 100 opens at height 90
+	suite.ctx = suite.ctx.WithBlockHeight(90)
+
+	suite.keeper.SetValidator(suite.ctx, &consensusv1.Validator{
+		Validator:  "cosmos1validator1",
+		Status:     consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED,
+		LastActive: timestamppb.Now(),
+	})
+
+	commitHash := keeper.HashCommit("nonce", "1000")
+	err := suite.keeper.CommitBid(suite.ctx, "cosmos1validator1", commitHash, 100)
+	require.ErrorIs(suite.T(), err, types.ErrValidatorJailed)
+}
@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// ============================================================================
+// CometBFT Validator Set Updates
+// ============================================================================
+//
+// The consensus module owns the CometBFT validator set. At the end of every
+// block the voting power of each validator with a registered consensus key is
+// derived from its activated LZN and MOA compliance, capped at 33% of the total
+// power after capping, and only the changes since the last block are returned
+// to CometBFT.
+// Validators that are jailed, deactivated in lizenz or no longer validators in
+// ident get power 0, which removes them from the set.
+
+// SetValidatorConsPubKey registers the consensus public key (marshaled CometBFT PublicKey) of a validator
+func (k Keeper) SetValidatorConsPubKey(ctx sdk.Context, validator string, pubKeyBz []byte) error {
+	consAddr, err := types.ConsAddressFromPubKey(pubKeyBz)
+	if err != nil {
+		return fmt.Errorf("invalid consensus public key: %w", err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorConsPubKeyKey(validator), pubKeyBz)
+
+	return k.SetValidatorConsAddress(ctx, validator, consAddr)
+}
+
+// RegisterConsensusKey registers the consensus key a validator's node signs blocks with
+// Keys cannot be rotated: CometBFT would keep the old key's power, so a validator registers once.
+// Genesis validators are registered by InitGenesis
+func (k Keeper) RegisterConsensusKey(ctx sdk.Context, validator string, pubKeyBz []byte) error {
+	if validator == "" {
+		return types.ErrEmptyValidatorAddress
+	}
+	if k.identKeeper != nil && !k.identKeeper.IsValidator(ctx, validator) {
+		return errors.Wrapf(types.ErrUnauthorized, "%s is not a verified validator", validator)
+	}
+	if _, err := k.GetValidatorConsPubKey(ctx, validator); err == nil {
+		return errors.Wrapf(types.ErrConsensusKeyExists, "validator %s", validator)
+	}
+
+	consAddr, err := types.ConsAddressFromPubKey(pubKeyBz)
+	if err != nil {
+		return fmt.Errorf("invalid consensus public key: %w", err)
+	}
+	if owner, found := k.GetValidatorByConsAddress(ctx, consAddr); found {
+		return errors.Wrapf(types.ErrConsensusKeyInUse, "key of %X belongs to %s", consAddr, owner)
+	}
+
+	if err := k.SetValidatorConsPubKey(ctx, validator, pubKeyBz); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsensusKeySet,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(types.AttributeKeyConsAddress, fmt.Sprintf("%X", consAddr)),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+	return nil
+}
+
+// GetValidatorConsPubKey returns the consensus public key of a validator
+func (k Keeper) GetValidatorConsPubKey(ctx sdk.Context, validator string) ([]byte, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorConsPubKeyKey(validator))
+	if bz == nil {
+		return nil, fmt.Errorf("consensus public key for validator %s not found", validator)
+	}
+	return bz, nil
+}
+
+// GetLastValidatorPower returns the voting power last sent to CometBFT for a validator
+func (k Keeper) GetLastValidatorPower(ctx sdk.Context, validator string) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLastValidatorPowerKey(validator))
+	if bz == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// setLastValidatorPower records the voting power sent to CometBFT; power 0 removes the record
+func (k Keeper) setLastValidatorPower(ctx sdk.Context, validator string, power int64) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetLastValidatorPowerKey(validator)
+	if power <= 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(power)))
+}
+
+// getRegisteredValidators returns the validators with a registered consensus key, in store order
+func (k Keeper) getRegisteredValidators(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ValidatorConsPubKeyKeyPrefix
+	iterator := store.Iterator(prefix, append(append([]byte{}, prefix...), 0xFF))
+	defer iterator.Close()
+
+	var validators []string
+	for ; iterator.Valid(); iterator.Next() {
+		validators = append(validators, string(iterator.Key()[len(prefix):]))
+	}
+	return validators
+}
+
+// isValidatorEligible reports whether a validator may hold CometBFT voting power
+func (k Keeper) isValidatorEligible(ctx sdk.Context, validator string) bool {
+	// Jailed and inactive validators are removed from the set
+	if v, err := k.GetValidator(ctx, validator); err == nil {
+		if v.Status == consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED ||
+			v.Status == consensusv1.ValidatorStatus_VALIDATOR_STATUS_INACTIVE {
+			return false
+		}
+	}
+
+	// Validators downgraded in ident are removed from the set
+	if k.identKeeper != nil && !k.identKeeper.IsValidator(ctx, validator) {
+		return false
+	}
+
+	return true
+}
+
+// CalculateValidatorPowers returns the CometBFT voting power of every validator with a registered consensus key
+// Power = activated LZN (in LZN) × MOA penalty multiplier, capped at 33% of the total after capping
+func (k Keeper) CalculateValidatorPowers(ctx sdk.Context) (map[string]int64, error) {
+	validatorLZN := make(map[string]uint64)
+	allLizenzs, err := k.lizenzKeeper.GetAllActivatedLizenz(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activated LZN: %w", err)
+	}
+	for _, lizenz := range allLizenzs {
+		amountInt, err := strconv.ParseUint(lizenz.Amount, 10, 64)
+		if err != nil {
+			continue
+		}
+		validatorLZN[lizenz.Validator] = amountInt
+	}
+
+	powers := make(map[string]int64)
+	totalPower := int64(0)
+	for _, validator := range k.getRegisteredValidators(ctx) {
+		power := int64(0)
+		if k.isValidatorEligible(ctx, validator) {
+			// Deactivated validators have no activated LZN and get no power
+			power = int64(validatorLZN[validator] / types.PowerReduction)
+
+			moaCompliance, err := k.lizenzKeeper.GetMOACompliance(ctx, validator)
+			if err != nil {
+				moaCompliance = 1.0
+			}
+			multiplier := k.CalculateMOAPenaltyMultiplier(ctx, moaCompliance)
+			power = math.LegacyNewDec(power).
+				Mul(math.LegacyMustNewDecFromStr(strconv.FormatFloat(multiplier, 'f', 2, 64))).
+				TruncateInt64()
+		}
+		powers[validator] = power
+		totalPower += power
+	}
+
+	// No validator may hold more than 33% of total power after capping
+	maxPower := capValidatorPower(powers, totalPower)
+	for validator, power := range powers {
+		if power > maxPower {
+			powers[validator] = maxPower
+		}
+	}
+
+	return powers, nil
+}
+
+// capValidatorPower returns the largest power a validator may keep so that no capped
+// validator holds more than MaxValidatorPowerPercent of the total power after capping.
+// Validators are capped from the largest down: with c capped validators and rest power
+// left to the others, the cap is the largest p with p <= P% of (c*p + rest).
+// With too few validators to satisfy the cap everyone gets the smallest power
+func capValidatorPower(powers map[string]int64, totalPower int64) int64 {
+	sorted := make([]int64, 0, len(powers))
+	for _, power := range powers {
+		if power > 0 {
+			sorted = append(sorted, power)
+		}
+	}
+	if len(sorted) == 0 {
+		return 0
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	rest := totalPower
+	for capped := 0; capped < len(sorted); capped++ {
+		denominator := 100 - types.MaxValidatorPowerPercent*int64(capped)
+		maxPower := int64(0)
+		if denominator > 0 {
+			maxPower = types.MaxValidatorPowerPercent * rest / denominator
+		}
+		if sorted[capped] <= maxPower {
+			return maxPower
+		}
+		rest -= sorted[capped]
+	}
+	return sorted[len(sorted)-1]
+}
+
+// ApplyValidatorSetUpdates returns the CometBFT validator set changes since the last block
+func (k Keeper) ApplyValidatorSetUpdates(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
+	// Without the lizenz module there is no PoVB weight: keep the genesis validator set
+	if k.lizenzKeeper == nil {
+		return nil, nil
+	}
+
+	powers, err := k.CalculateValidatorPowers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Never hand CometBFT an empty validator set: the chain would halt
+	hasPower := false
+	for _, power := range powers {
+		if power > 0 {
+			hasPower = true
+			break
+		}
+	}
+	if !hasPower {
+		if len(powers) > 0 {
+			ctx.Logger().Error("validator set update skipped: no validator has voting power")
+		}
+		return nil, nil
+	}
+
+	var updates []abci.ValidatorUpdate
+	for _, validator := range k.getRegisteredValidators(ctx) {
+		power := powers[validator]
+		if power == k.GetLastValidatorPower(ctx, validator) {
+			continue
+		}
+
+		pubKeyBz, err := k.GetValidatorConsPubKey(ctx, validator)
+		if err != nil {
+			return nil, err
+		}
+		var pubKey cmtproto.PublicKey
+		if err := pubKey.Unmarshal(pubKeyBz); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal consensus public key of %s: %w", validator, err)
+		}
+
+		updates = append(updates, abci.ValidatorUpdate{PubKey: pubKey, Power: power})
+		k.setLastValidatorPower(ctx, validator, power)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorPowerUpdated,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator),
+				sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(power, 10)),
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			),
+		)
+	}
+
+	return updates, nil
+}
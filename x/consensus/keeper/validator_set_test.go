@@ -0,0 +1,260 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// MockIdentKeeper is a mock implementation of IdentKeeperInterface for testing
+type MockIdentKeeper struct {
+	downgraded map[string]bool
+}
+
+func (m *MockIdentKeeper) IsValidator(ctx sdk.Context, address string) bool {
+	return !m.downgraded[address]
+}
+
+func testConsPubKey(i int) []byte {
+	key := make([]byte, 32)
+	key[0] = byte(i)
+	pk := &cmtproto.PublicKey{Sum: &cmtproto.PublicKey_Ed25519{Ed25519: key}}
+	bz, _ := pk.Marshal()
+	return bz
+}
+
+// setupValidatorSet registers five genesis validators with power 10 and their activated LZN
+func (suite *KeeperTestSuite) setupValidatorSet() (*MockLizenzKeeper, *MockIdentKeeper) {
+	genState := types.DefaultGenesis()
+	for i := 1; i <= 5; i++ {
+		genState.InitialValidators = append(genState.InitialValidators, &consensusv1.InitialValidator{
+			PubKey:    testConsPubKey(i),
+			Power:     10,
+			Validator: fmt.Sprintf("cosmos1validator%d", i),
+		})
+	}
+	suite.keeper.InitGenesis(suite.ctx, genState)
+
+	mockLizenzKeeper := &MockLizenzKeeper{
+		activatedLizenz: []*lizenzv1.ActivatedLizenz{
+			{Validator: "cosmos1validator1", Amount: "50000000"},
+			{Validator: "cosmos1validator2", Amount: "20000000"},
+			{Validator: "cosmos1validator3", Amount: "20000000"},
+			{Validator: "cosmos1validator4", Amount: "10000000"},
+			{Validator: "cosmos1validator5", Amount: "20000000"},
+		},
+		moaCompliance: map[string]float64{"cosmos1validator3": 0.6},
+	}
+	mockIdentKeeper := &MockIdentKeeper{downgraded: map[string]bool{"cosmos1validator4": true}}
+	suite.keeper.SetLizenzKeeper(mockLizenzKeeper)
+	suite.keeper.SetIdentKeeper(mockIdentKeeper)
+	return mockLizenzKeeper, mockIdentKeeper
+}
+
+func (suite *KeeperTestSuite) applyValidatorSetUpdates() map[string]int64 {
+	updates, err := suite.keeper.ApplyValidatorSetUpdates(suite.ctx)
+	require.NoError(suite.T(), err)
+
+	powers := make(map[string]int64)
+	for _, update := range updates {
+		for i := 1; i <= 5; i++ {
+			var pk cmtproto.PublicKey
+			require.NoError(suite.T(), pk.Unmarshal(testConsPubKey(i)))
+			if update.PubKey.Equal(pk) {
+				powers[fmt.Sprintf("cosmos1validator%d", i)] = update.Power
+			}
+		}
+	}
+	require.Len(suite.T(), powers, len(updates))
+	return powers
+}
+
+// TestCalculateValidatorPowers tests power from activated LZN and MOA with the 33% cap
+func (suite *KeeperTestSuite) TestCalculateValidatorPowers() {
+	suite.setupValidatorSet()
+
+	powers, err := suite.keeper.CalculateValidatorPowers(suite.ctx)
+	require.NoError(suite.T(), err)
+
+	// Total power is 50 + 20 + 10 + 20 = 100; capped at 24 validator1 holds 24 of 74
+	require.Equal(suite.T(), int64(24), powers["cosmos1validator1"])
+	require.Equal(suite.T(), int64(20), powers["cosmos1validator2"])
+	require.Equal(suite.T(), int64(20), powers["cosmos1validator5"])
+	// MOA compliance 0.6 halves the power
+	require.Equal(suite.T(), int64(10), powers["cosmos1validator3"])
+	// Downgraded in ident
+	require.Equal(suite.T(), int64(0), powers["cosmos1validator4"])
+}
+
+// TestCalculateValidatorPowers_DominatingValidator tests the cap holds against the total after capping
+func (suite *KeeperTestSuite) TestCalculateValidatorPowers_DominatingValidator() {
+	mockLizenzKeeper, mockIdentKeeper := suite.setupValidatorSet()
+	mockLizenzKeeper.moaCompliance = nil
+	mockIdentKeeper.downgraded = nil
+	mockLizenzKeeper.activatedLizenz = []*lizenzv1.ActivatedLizenz{
+		{Validator: "cosmos1validator1", Amount: "1000000000"},
+		{Validator: "cosmos1validator2", Amount: "10000000"},
+		{Validator: "cosmos1validator3", Amount: "10000000"},
+		{Validator: "cosmos1validator4", Amount: "10000000"},
+		{Validator: "cosmos1validator5", Amount: "10000000"},
+	}
+
+	powers, err := suite.keeper.CalculateValidatorPowers(suite.ctx)
+	require.NoError(suite.T(), err)
+	// 19 of 59 is 32.2%; capping at 33% of the raw total (1040) would have left 343 of 383
+	require.Equal(suite.T(), int64(19), powers["cosmos1validator1"])
+	var total int64
+	for _, power := range powers {
+		total += power
+	}
+	require.Equal(suite.T(), int64(59), total)
+	for validator, power := range powers {
+		require.LessOrEqual(suite.T(), power*100, total*types.MaxValidatorPowerPercent, validator)
+	}
+
+	// 100/1/1: three validators cannot stay below 33%, so every validator gets the smallest power
+	mockLizenzKeeper.activatedLizenz = []*lizenzv1.ActivatedLizenz{
+		{Validator: "cosmos1validator1", Amount: "100000000"},
+		{Validator: "cosmos1validator2", Amount: "1000000"},
+		{Validator: "cosmos1validator3", Amount: "1000000"},
+	}
+	powers, err = suite.keeper.CalculateValidatorPowers(suite.ctx)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), map[string]int64{
+		"cosmos1validator1": 1,
+		"cosmos1validator2": 1,
+		"cosmos1validator3": 1,
+		"cosmos1validator4": 0,
+		"cosmos1validator5": 0,
+	}, powers)
+}
+
+// TestApplyValidatorSetUpdates tests that only validator set changes are returned
+func (suite *KeeperTestSuite) TestApplyValidatorSetUpdates() {
+	mockLizenzKeeper, _ := suite.setupValidatorSet()
+
+	// Genesis power was 10 for everyone: validator3 is unchanged
+	updates := suite.applyValidatorSetUpdates()
+	require.Equal(suite.T(), map[string]int64{
+		"cosmos1validator1": 24,
+		"cosmos1validator2": 20,
+		"cosmos1validator4": 0,
+		"cosmos1validator5": 20,
+	}, updates)
+	require.Equal(suite.T(), int64(24), suite.keeper.GetLastValidatorPower(suite.ctx, "cosmos1validator1"))
+	require.Equal(suite.T(), int64(0), suite.keeper.GetLastValidatorPower(suite.ctx, "cosmos1validator4"))
+
+	// Nothing changed
+	require.Empty(suite.T(), suite.applyValidatorSetUpdates())
+
+	// validator2 deactivates its LZN in lizenz and is removed; the cap follows the new total.
+	// Three validators cannot stay below 33% each, so they end up with equal power
+	mockLizenzKeeper.activatedLizenz = append(mockLizenzKeeper.activatedLizenz[:1],
+		&lizenzv1.ActivatedLizenz{Validator: "cosmos1validator3", Amount: "20000000"},
+		&lizenzv1.ActivatedLizenz{Validator: "cosmos1validator5", Amount: "20000000"})
+	updates = suite.applyValidatorSetUpdates()
+	require.Equal(suite.T(), map[string]int64{
+		"cosmos1validator1": 10,
+		"cosmos1validator2": 0,
+		"cosmos1validator5": 10,
+	}, updates)
+}
+
+// TestApplyValidatorSetUpdates_JailedRemoved tests jailed validators lose their voting power
+func (suite *KeeperTestSuite) TestApplyValidatorSetUpdates_JailedRemoved() {
+	suite.setupValidatorSet()
+	suite.applyValidatorSetUpdates()
+
+	suite.keeper.SetValidator(suite.ctx, &consensusv1.Validator{
+		Validator: "cosmos1validator3",
+		Status:    consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED,
+	})
+
+	updates := suite.applyValidatorSetUpdates()
+	require.Equal(suite.T(), int64(0), updates["cosmos1validator3"])
+}
+
+// TestApplyValidatorSetUpdates_NeverEmpty tests the validator set is kept when nobody has power
+func (suite *KeeperTestSuite) TestApplyValidatorSetUpdates_NeverEmpty() {
+	mockLizenzKeeper, _ := suite.setupValidatorSet()
+	mockLizenzKeeper.activatedLizenz = nil
+
+	require.Empty(suite.T(), suite.applyValidatorSetUpdates())
+	require.Equal(suite.T(), int64(10), suite.keeper.GetLastValidatorPower(suite.ctx, "cosmos1validator1"))
+}
+
+// TestEndBlocker_ReturnsValidatorUpdates tests EndBlocker returns the validator set changes
+func (suite *KeeperTestSuite) TestEndBlocker_ReturnsValidatorUpdates() {
+	suite.setupValidatorSet()
+
+	updates, err := suite.keeper.EndBlocker(suite.ctx.WithBlockHeight(10))
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), updates, 4)
+}
+
+// TestSetConsensusKey tests validators registered after genesis get voting power and keys belong to one validator
+func (suite *KeeperTestSuite) TestSetConsensusKey() {
+	mockLizenzKeeper, mockIdentKeeper := suite.setupValidatorSet()
+	mockLizenzKeeper.activatedLizenz = append(mockLizenzKeeper.activatedLizenz,
+		&lizenzv1.ActivatedLizenz{Validator: "cosmos1validator6", Amount: "20000000"})
+	mockIdentKeeper.downgraded["cosmos1validator7"] = true
+	msgServer := keeper.NewMsgServer(*suite.keeper)
+
+	_, err := msgServer.SetConsensusKey(suite.ctx, &consensusv1.MsgSetConsensusKey{Validator: "cosmos1validator7", PubKey: testConsPubKey(7)})
+	require.ErrorIs(suite.T(), err, types.ErrUnauthorized)
+	_, err = msgServer.SetConsensusKey(suite.ctx, &consensusv1.MsgSetConsensusKey{Validator: "cosmos1validator6", PubKey: testConsPubKey(1)})
+	require.ErrorIs(suite.T(), err, types.ErrConsensusKeyInUse)
+	_, err = msgServer.SetConsensusKey(suite.ctx, &consensusv1.MsgSetConsensusKey{Validator: "cosmos1validator6", PubKey: []byte{0x01}})
+	require.Error(suite.T(), err)
+
+	_, err = msgServer.SetConsensusKey(suite.ctx, &consensusv1.MsgSetConsensusKey{Validator: "cosmos1validator6", PubKey: testConsPubKey(6)})
+	require.NoError(suite.T(), err)
+	consAddr, err := types.ConsAddressFromPubKey(testConsPubKey(6))
+	require.NoError(suite.T(), err)
+	owner, found := suite.keeper.GetValidatorByConsAddress(suite.ctx, consAddr)
+	require.True(suite.T(), found)
+	require.Equal(suite.T(), "cosmos1validator6", owner)
+
+	// Keys cannot be rotated
+	_, err = msgServer.SetConsensusKey(suite.ctx, &consensusv1.MsgSetConsensusKey{Validator: "cosmos1validator6", PubKey: testConsPubKey(8)})
+	require.ErrorIs(suite.T(), err, types.ErrConsensusKeyExists)
+
+	var pk cmtproto.PublicKey
+	require.NoError(suite.T(), pk.Unmarshal(testConsPubKey(6)))
+	updates, err := suite.keeper.ApplyValidatorSetUpdates(suite.ctx)
+	require.NoError(suite.T(), err)
+	var power int64
+	for _, update := range updates {
+		if update.PubKey.Equal(pk) {
+			power = update.Power
+		}
+	}
+	require.Positive(suite.T(), power)
+}
+
+// TestExportGenesis_InitialValidators tests the exported genesis carries the validators with voting power
+func (suite *KeeperTestSuite) TestExportGenesis_InitialValidators() {
+	suite.setupValidatorSet()
+	suite.applyValidatorSetUpdates()
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	powers := make(map[string]int64)
+	for _, initial := range genState.InitialValidators {
+		powers[initial.Validator] = initial.Power
+	}
+	require.Equal(suite.T(), map[string]int64{
+		"cosmos1validator1": 24,
+		"cosmos1validator2": 20,
+		"cosmos1validator3": 10,
+		"cosmos1validator5": 20,
+	}, powers)
+	require.Equal(suite.T(), testConsPubKey(1), genState.InitialValidators[0].PubKey)
+}
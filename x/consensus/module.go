@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -10,12 +11,16 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
 	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/testutil/simtx"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/client/cli"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
+	consensussim "github.com/volnix-protocol/volnix-protocol/x/consensus/simulation"
 	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
 )
 
@@ -51,18 +56,11 @@ func (ConsensusAppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config clien
 	return types.ValidateGenesis(&genState)
 }
 
-// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the consensus module.
-// Note: In Cosmos SDK v0.53, BaseApp automatically registers routes from proto annotations
-// (google.api.http). The routes are:
-// - GET /volnix/consensus/v1/params
-// - GET /volnix/consensus/v1/validators
-// This method is required by the interface but BaseApp handles the actual registration.
+// RegisterGRPCGatewayRoutes registers the REST routes of the query service under /volnix/consensus/v1
 func (ConsensusAppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	// BaseApp automatically registers routes from proto annotations
-	// If manual registration is needed, it would be done here using:
-	// consensusv1.RegisterQueryHandlerClient(context.Background(), mux, consensusv1.NewQueryClient(clientCtx))
-	// However, the generated code uses grpc-gateway/v2 while Cosmos SDK uses v1,
-	// so we rely on BaseApp's automatic registration from proto annotations.
+	if err := consensusv1.RegisterQueryHandlerClient(context.Background(), mux, consensusv1.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns the root tx command for the consensus module.
@@ -79,14 +77,31 @@ func (ConsensusAppModuleBasic) GetQueryCmd() *cobra.Command {
 type ConsensusAppModule struct {
 	ConsensusAppModuleBasic
 
-	keeper keeper.Keeper
+	cdc           codec.Codec
+	keeper        keeper.Keeper
+	accountKeeper simulation.AccountKeeper
+	bankKeeper    simulation.BankKeeper
+	identKeeper   simtx.IdentKeeper
+	anteilKeeper  keeper.AnteilKeeperInterface
 }
 
 // NewConsensusAppModule creates a new ConsensusAppModule object.
-func NewConsensusAppModule(cdc codec.Codec, keeper keeper.Keeper) ConsensusAppModule {
+func NewConsensusAppModule(
+	cdc codec.Codec,
+	keeper keeper.Keeper,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	ik simtx.IdentKeeper,
+	antk keeper.AnteilKeeperInterface,
+) ConsensusAppModule {
 	return ConsensusAppModule{
 		ConsensusAppModuleBasic: ConsensusAppModuleBasic{},
+		cdc:                     cdc,
 		keeper:                  keeper,
+		accountKeeper:           ak,
+		bankKeeper:              bk,
+		identKeeper:             ik,
+		anteilKeeper:            antk,
 	}
 }
 
@@ -94,8 +109,16 @@ func NewConsensusAppModule(cdc codec.Codec, keeper keeper.Keeper) ConsensusAppMo
 func (am ConsensusAppModule) RegisterServices(cfg module.Configurator) {
 	consensusv1.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	consensusv1.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
+// ConsensusVersion is the consensus version of the consensus store, bumped with every store migration
+func (am ConsensusAppModule) ConsensusVersion() uint64 { return 2 }
+
 // RegisterInvariants registers the consensus module invariants.
 func (am ConsensusAppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	// Register invariants if needed
@@ -119,6 +142,23 @@ func (am ConsensusAppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec)
 	return cdc.MustMarshalJSON(&genState)
 }
 
+// GenerateGenesisState creates a randomized GenState of the consensus module.
+func (ConsensusAppModule) GenerateGenesisState(simState *module.SimulationState) {
+	consensussim.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for consensus module's types.
+func (am ConsensusAppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = consensussim.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the all the consensus module operations with their respective weights.
+func (am ConsensusAppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return consensussim.WeightedOperations(
+		simState.AppParams, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.identKeeper, am.anteilKeeper, am.keeper,
+	)
+}
+
 // IsAppModule implements the module.AppModule interface.
 func (am ConsensusAppModule) IsAppModule() {}
 
@@ -128,5 +168,8 @@ func (am ConsensusAppModule) IsOnePerModuleType() {}
 // ConsensusAppModule implements the module.AppModule interface.
 var _ module.AppModule = ConsensusAppModule{}
 
+// ConsensusAppModule implements the module.AppModuleSimulation interface.
+var _ module.AppModuleSimulation = ConsensusAppModule{}
+
 // ConsensusAppModuleBasic implements the module.AppModuleBasic interface.
 var _ module.AppModuleBasic = ConsensusAppModuleBasic{}
@@ -0,0 +1,118 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding consensus type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		// ValidatorWeight keys share the Validator prefix and must be matched first
+		case bytes.HasPrefix(kvA.Key, types.KeyValidatorWeightPrefix):
+			var weightA, weightB consensusv1.ValidatorWeight
+			cdc.MustUnmarshal(kvA.Value, &weightA)
+			cdc.MustUnmarshal(kvB.Value, &weightB)
+			return fmt.Sprintf("%v\n%v", &weightA, &weightB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyValidatorPrefix):
+			var validatorA, validatorB consensusv1.Validator
+			cdc.MustUnmarshal(kvA.Value, &validatorA)
+			cdc.MustUnmarshal(kvB.Value, &validatorB)
+			return fmt.Sprintf("%v\n%v", &validatorA, &validatorB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyBlockCreatorPrefix):
+			var creatorA, creatorB consensusv1.BlockCreator
+			cdc.MustUnmarshal(kvA.Value, &creatorA)
+			cdc.MustUnmarshal(kvB.Value, &creatorB)
+			return fmt.Sprintf("%v\n%v", &creatorA, &creatorB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyBlindAuctionPrefix):
+			var auctionA, auctionB consensusv1.BlindAuction
+			cdc.MustUnmarshal(kvA.Value, &auctionA)
+			cdc.MustUnmarshal(kvB.Value, &auctionB)
+			return fmt.Sprintf("%v\n%v", &auctionA, &auctionB)
+
+		case bytes.Equal(kvA.Key, types.HalvingInfoKey):
+			var infoA, infoB consensusv1.HalvingInfo
+			cdc.MustUnmarshal(kvA.Value, &infoA)
+			cdc.MustUnmarshal(kvB.Value, &infoB)
+			return fmt.Sprintf("%v\n%v", &infoA, &infoB)
+
+		case bytes.Equal(kvA.Key, types.ConsensusStateKey):
+			var stateA, stateB consensusv1.ConsensusState
+			cdc.MustUnmarshal(kvA.Value, &stateA)
+			cdc.MustUnmarshal(kvB.Value, &stateB)
+			return fmt.Sprintf("%v\n%v", &stateA, &stateB)
+
+		case bytes.Equal(kvA.Key, types.BlockTimeWindowKey):
+			var windowA, windowB consensusv1.BlockTimeWindow
+			cdc.MustUnmarshal(kvA.Value, &windowA)
+			cdc.MustUnmarshal(kvB.Value, &windowB)
+			return fmt.Sprintf("%v\n%v", &windowA, &windowB)
+
+		case bytes.HasPrefix(kvA.Key, types.BlockTimeKeyPrefix):
+			var timeA, timeB time.Time
+			if err := timeA.UnmarshalBinary(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := timeB.UnmarshalBinary(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", timeA, timeB)
+
+		case bytes.Equal(kvA.Key, types.BlockBurnedAntKey),
+			bytes.Equal(kvA.Key, types.NextBlockDelayKey),
+			bytes.HasPrefix(kvA.Key, types.LastValidatorPowerKeyPrefix):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.ValidatorSigningInfoKeyPrefix):
+			var infoA, infoB consensusv1.ValidatorSigningInfo
+			cdc.MustUnmarshal(kvA.Value, &infoA)
+			cdc.MustUnmarshal(kvB.Value, &infoB)
+			return fmt.Sprintf("%v\n%v", &infoA, &infoB)
+
+		case bytes.HasPrefix(kvA.Key, types.AuctionFaultKeyPrefix):
+			var faultA, faultB consensusv1.AuctionFault
+			cdc.MustUnmarshal(kvA.Value, &faultA)
+			cdc.MustUnmarshal(kvB.Value, &faultB)
+			return fmt.Sprintf("%v\n%v", &faultA, &faultB)
+
+		case bytes.Equal(kvA.Key, types.RewardPoolKey):
+			var poolA, poolB consensusv1.RewardPool
+			cdc.MustUnmarshal(kvA.Value, &poolA)
+			cdc.MustUnmarshal(kvB.Value, &poolB)
+			return fmt.Sprintf("%v\n%v", &poolA, &poolB)
+
+		case bytes.HasPrefix(kvA.Key, types.ValidatorRewardsKeyPrefix):
+			var rewardsA, rewardsB consensusv1.ValidatorRewards
+			cdc.MustUnmarshal(kvA.Value, &rewardsA)
+			cdc.MustUnmarshal(kvB.Value, &rewardsB)
+			return fmt.Sprintf("%v\n%v", &rewardsA, &rewardsB)
+
+		case bytes.HasPrefix(kvA.Key, types.BidHistoryKeyPrefix):
+			// JSON records
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.ValidatorByConsAddrKeyPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.ValidatorMissedBlockKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.ValidatorConsPubKeyKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid consensus key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
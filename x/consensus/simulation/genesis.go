@@ -0,0 +1,64 @@
+package simulation
+
+import (
+	"math/rand"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// Simulation parameter keys
+const (
+	AuctionCommitBond   = "auction_commit_bond"
+	AuctionCommitWindow = "auction_commit_window"
+	AuctionRevealWindow = "auction_reveal_window"
+	RewardEpochLength   = "reward_epoch_length"
+	SignedBlocksWindow  = "signed_blocks_window"
+	InitialPower        = "initial_power"
+)
+
+// RandomizedGenState generates a random genesis state for the consensus module
+// The bonded simulation accounts become the initial validators with their consensus keys
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(AuctionCommitBond, &params.AuctionCommitBond, simState.Rand,
+		func(r *rand.Rand) { params.AuctionCommitBond = uint64(r.Intn(100000)) })
+	simState.AppParams.GetOrGenerate(AuctionCommitWindow, &params.AuctionCommitWindow, simState.Rand,
+		func(r *rand.Rand) { params.AuctionCommitWindow = uint64(r.Intn(9) + 2) })
+	simState.AppParams.GetOrGenerate(AuctionRevealWindow, &params.AuctionRevealWindow, simState.Rand,
+		func(r *rand.Rand) { params.AuctionRevealWindow = uint64(r.Intn(9) + 2) })
+	simState.AppParams.GetOrGenerate(RewardEpochLength, &params.RewardEpochLength, simState.Rand,
+		func(r *rand.Rand) { params.RewardEpochLength = uint64(r.Intn(91) + 10) })
+	simState.AppParams.GetOrGenerate(SignedBlocksWindow, &params.SignedBlocksWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedBlocksWindow = uint64(r.Intn(91) + 10) })
+
+	genesis := types.DefaultGenesis()
+	genesis.Params = params
+
+	for _, acc := range simState.Accounts[:simState.NumBonded] {
+		pubKey, err := cryptocodec.ToCmtProtoPublicKey(acc.ConsKey.PubKey())
+		if err != nil {
+			panic(err)
+		}
+		pubKeyBz, err := pubKey.Marshal()
+		if err != nil {
+			panic(err)
+		}
+
+		var power int64
+		simState.AppParams.GetOrGenerate(InitialPower+"_"+acc.Address.String(), &power, simState.Rand,
+			func(r *rand.Rand) { power = int64(r.Intn(100) + 1) })
+
+		genesis.InitialValidators = append(genesis.InitialValidators, &consensusv1.InitialValidator{
+			PubKey:    pubKeyBz,
+			Power:     power,
+			Validator: acc.Address.String(),
+		})
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,335 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	"github.com/volnix-protocol/volnix-protocol/testutil/simtx"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCommitBid       = "op_weight_msg_commit_bid"
+	OpWeightMsgUnjail          = "op_weight_msg_unjail"
+	OpWeightMsgWithdrawRewards = "op_weight_msg_withdraw_rewards"
+
+	DefaultWeightMsgCommitBid       = 30
+	DefaultWeightMsgUnjail          = 10
+	DefaultWeightMsgWithdrawRewards = 20
+)
+
+// WeightedOperations returns all the operations from the consensus module with their respective weights
+// Reveals are scheduled as future operations of the commits they open
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	ik simtx.IdentKeeper,
+	antk keeper.AnteilKeeperInterface,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgCommitBid, weightMsgUnjail, weightMsgWithdrawRewards int
+	appParams.GetOrGenerate(OpWeightMsgCommitBid, &weightMsgCommitBid, nil,
+		func(_ *rand.Rand) { weightMsgCommitBid = DefaultWeightMsgCommitBid })
+	appParams.GetOrGenerate(OpWeightMsgUnjail, &weightMsgUnjail, nil,
+		func(_ *rand.Rand) { weightMsgUnjail = DefaultWeightMsgUnjail })
+	appParams.GetOrGenerate(OpWeightMsgWithdrawRewards, &weightMsgWithdrawRewards, nil,
+		func(_ *rand.Rand) { weightMsgWithdrawRewards = DefaultWeightMsgWithdrawRewards })
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCommitBid, SimulateMsgCommitBid(txGen, ak, bk, ik, antk, k)),
+		simulation.NewWeightedOperation(weightMsgUnjail, SimulateMsgUnjail(txGen, ak, bk, ik, k)),
+		simulation.NewWeightedOperation(weightMsgWithdrawRewards, SimulateMsgWithdrawRewards(txGen, ak, bk, ik, k)),
+	}
+}
+
+// SimulateMsgCommitBid generates a MsgCommitBid for a random unjailed verified validator on an
+// auction that still accepts commits and schedules the matching reveal after the commit window
+func SimulateMsgCommitBid(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, antk keeper.AnteilKeeperInterface, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&consensusv1.MsgCommitBid{})
+
+		auction, found := randomCommitPhaseAuction(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no auction accepts commits"), nil, nil
+		}
+
+		committed := make(map[string]bool, len(auction.Commits))
+		for _, commit := range auction.Commits {
+			committed[commit.Validator] = true
+		}
+
+		// The bid must stay covered by the ANT left after the commit bond is locked,
+		// other commits of the validator may still hold their bonds at reveal
+		bond := k.GetParams(ctx).AuctionCommitBond
+		var candidates []simtypes.Account
+		var available []uint64
+		for _, acc := range accs {
+			validator := acc.Address.String()
+			if ik.GetFeeRole(ctx, validator) != identv1.Role_ROLE_VALIDATOR || k.IsValidatorJailed(ctx, validator) || committed[validator] {
+				continue
+			}
+			position, err := antk.GetUserPosition(ctx, validator)
+			if err != nil {
+				continue
+			}
+			balance, err := strconv.ParseUint(position.AntBalance, 10, 64)
+			if err != nil || balance <= bond {
+				continue
+			}
+			candidates = append(candidates, acc)
+			available = append(available, balance-bond)
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no validator can cover a commit bond and a bid"), nil, nil
+		}
+		i := r.Intn(len(candidates))
+		simAccount := candidates[i]
+		validator := simAccount.Address.String()
+
+		maxBid := available[i]
+		if maxAuctionBid, capped := k.MaxAuctionBid(ctx); capped && maxAuctionBid < maxBid {
+			maxBid = maxAuctionBid
+		}
+		if maxBid == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "auction bids are capped at zero"), nil, nil
+		}
+		bidAmount := strconv.FormatUint(uint64(r.Int63n(int64(maxBid)))+1, 10)
+		nonce := simtypes.RandStringOfLength(r, 16)
+
+		msg := &consensusv1.MsgCommitBid{
+			Validator:   validator,
+			CommitHash:  keeper.HashCommit(nonce, bidAmount),
+			BlockHeight: auction.BlockHeight,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		opMsg, _, err := simtx.GenAndDeliverTx(txCtx, ik)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		reveal := simtypes.FutureOperation{
+			BlockHeight: int(auction.CommitEndHeight) + 1,
+			Op:          SimulateMsgRevealBid(txGen, ak, bk, ik, antk, k, validator, nonce, bidAmount, auction.BlockHeight),
+		}
+		return opMsg, []simtypes.FutureOperation{reveal}, nil
+	}
+}
+
+// SimulateMsgRevealBid generates the MsgRevealBid of an earlier simulated commit
+func SimulateMsgRevealBid(
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	ik simtx.IdentKeeper,
+	antk keeper.AnteilKeeperInterface,
+	k keeper.Keeper,
+	validator, nonce, bidAmount string,
+	height uint64,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&consensusv1.MsgRevealBid{})
+
+		addr, err := sdk.AccAddressFromBech32(validator)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid validator address"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, addr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "validator account not found"), nil, nil
+		}
+
+		msg := &consensusv1.MsgRevealBid{
+			Validator:   validator,
+			Nonce:       nonce,
+			BidAmount:   bidAmount,
+			BlockHeight: height,
+		}
+
+		auction, err := k.GetBlindAuction(ctx, height)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "auction not found"), nil, nil
+		}
+		if auction.Phase != consensusv1.AuctionPhase_AUCTION_PHASE_REVEAL || uint64(ctx.BlockHeight()) > auction.RevealEndHeight {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "auction does not accept reveals"), nil, nil
+		}
+		var commit *consensusv1.EncryptedBid
+		for _, c := range auction.Commits {
+			if c.Validator == validator {
+				commit = c
+			}
+		}
+		if commit == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "bid not committed"), nil, nil
+		}
+		for _, reveal := range auction.Reveals {
+			if reveal.Validator == validator {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "bid already revealed"), nil, nil
+			}
+		}
+
+		// Spending ANT between commit and reveal may leave the bid uncovered;
+		// the reveal returns the commit bond before the bid is checked
+		position, err := antk.GetUserPosition(ctx, validator)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "validator has no ANT position"), nil, nil
+		}
+		balance, err := strconv.ParseUint(position.AntBalance, 10, 64)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid ANT balance"), nil, nil
+		}
+		bond, err := strconv.ParseUint(commit.BondAmount, 10, 64)
+		if err != nil {
+			bond = 0
+		}
+		bid, err := strconv.ParseUint(bidAmount, 10, 64)
+		if err != nil || balance+bond < bid {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "ANT balance no longer covers the bid"), nil, nil
+		}
+		if k.RapidBidLimitReached(ctx, validator) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "rapid bid limit reached"), nil, nil
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
+
+// SimulateMsgUnjail generates a MsgUnjail for a random jailed validator whose jail period has ended
+func SimulateMsgUnjail(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&consensusv1.MsgUnjail{})
+
+		var candidates []simtypes.Account
+		for _, acc := range accs {
+			validator, err := k.GetValidator(ctx, acc.Address.String())
+			if err != nil || validator.Status != consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED {
+				continue
+			}
+			info, err := k.GetValidatorSigningInfo(ctx, validator.Validator)
+			if err == nil && info.JailedUntil != nil && ctx.BlockTime().Before(info.JailedUntil.AsTime()) {
+				continue
+			}
+			candidates = append(candidates, acc)
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no validator can be unjailed"), nil, nil
+		}
+		simAccount := candidates[r.Intn(len(candidates))]
+
+		msg := &consensusv1.MsgUnjail{Validator: simAccount.Address.String()}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
+
+// SimulateMsgWithdrawRewards generates a MsgWithdrawRewards for a random validator with rewards to withdraw
+func SimulateMsgWithdrawRewards(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&consensusv1.MsgWithdrawRewards{})
+
+		var candidates []simtypes.Account
+		for _, acc := range accs {
+			if k.PendingRewards(ctx, acc.Address.String()) > 0 {
+				candidates = append(candidates, acc)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no validator rewards"), nil, nil
+		}
+		simAccount := candidates[r.Intn(len(candidates))]
+
+		msg := &consensusv1.MsgWithdrawRewards{Validator: simAccount.Address.String()}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
+
+// randomCommitPhaseAuction returns a random auction the EndBlocker opened that still accepts commits
+func randomCommitPhaseAuction(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (*consensusv1.BlindAuction, bool) {
+	params := k.GetParams(ctx)
+	current := uint64(ctx.BlockHeight())
+	last := current + 1 + params.AuctionCommitWindow + params.AuctionRevealWindow
+
+	var auctions []*consensusv1.BlindAuction
+	for height := current; height <= last; height++ {
+		auction, err := k.GetBlindAuction(ctx, height)
+		if err != nil {
+			continue
+		}
+		if auction.Phase == consensusv1.AuctionPhase_AUCTION_PHASE_COMMIT && auction.CommitEndHeight >= current &&
+			k.AuctionCommitStartHeight(ctx, height) <= current {
+			auctions = append(auctions, auction)
+		}
+	}
+	if len(auctions) == 0 {
+		return nil, false
+	}
+	return auctions[r.Intn(len(auctions))], true
+}
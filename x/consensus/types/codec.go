@@ -28,6 +28,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&consensusv1.MsgCalculateBlockTime{},
 		&consensusv1.MsgCommitBid{},
 		&consensusv1.MsgRevealBid{},
+		&consensusv1.MsgUnjail{},
+		&consensusv1.MsgUpdateParams{},
+		&consensusv1.MsgWithdrawRewards{},
+		&consensusv1.MsgSetConsensusKey{},
 	)
 
 	// Register all MsgResponse types
@@ -40,5 +44,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&consensusv1.MsgCalculateBlockTimeResponse{},
 		&consensusv1.MsgCommitBidResponse{},
 		&consensusv1.MsgRevealBidResponse{},
+		&consensusv1.MsgUnjailResponse{},
+		&consensusv1.MsgUpdateParamsResponse{},
+		&consensusv1.MsgWithdrawRewardsResponse{},
+		&consensusv1.MsgSetConsensusKeyResponse{},
 	)
 }
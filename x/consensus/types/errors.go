@@ -28,4 +28,23 @@ var (
 	ErrBidAlreadyRevealed           = errors.Register(ModuleName, 19, "bid already revealed")
 	ErrCommitHashMismatch           = errors.Register(ModuleName, 20, "commit hash does not match reveal")
 	ErrAuctionNotFound              = errors.Register(ModuleName, 21, "auction not found")
+	// Slashing errors
+	ErrValidatorJailed              = errors.Register(ModuleName, 22, "validator is jailed")
+	ErrValidatorNotJailed           = errors.Register(ModuleName, 23, "validator is not jailed")
+	ErrValidatorStillJailed         = errors.Register(ModuleName, 24, "validator jail period has not ended")
+	ErrSigningInfoNotFound          = errors.Register(ModuleName, 25, "validator signing info not found")
+	// Auction bond errors
+	ErrInsufficientBond             = errors.Register(ModuleName, 26, "insufficient ANT balance for auction commit bond")
+	// Auction window errors
+	ErrCommitWindowClosed           = errors.Register(ModuleName, 27, "auction commit window has closed")
+	ErrRevealWindowClosed           = errors.Register(ModuleName, 28, "auction reveal window has closed")
+	ErrCommitWindowNotOpen          = errors.Register(ModuleName, 34, "auction commit window has not opened")
+	// Msg errors
+	ErrDeprecatedMsg                = errors.Register(ModuleName, 29, "message is deprecated")
+	ErrInvalidParams                = errors.Register(ModuleName, 30, "invalid params")
+	// Reward errors
+	ErrNoRewards                    = errors.Register(ModuleName, 31, "no rewards to withdraw")
+	// Consensus key errors
+	ErrConsensusKeyExists           = errors.Register(ModuleName, 32, "validator already registered a consensus key")
+	ErrConsensusKeyInUse            = errors.Register(ModuleName, 33, "consensus key is registered to another validator")
 )
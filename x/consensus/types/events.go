@@ -22,18 +22,51 @@ const (
 	// EventTypeRewardDistributed defines the event type for WRT reward distribution
 	EventTypeRewardDistributed = "consensus.reward_distributed"
 	
+	// EventTypeRewardEpochEnded defines the event type for the settlement of a reward epoch
+	EventTypeRewardEpochEnded = "consensus.reward_epoch_ended"
+	
+	// EventTypeRewardsWithdrawn defines the event type for a validator withdrawing accrued rewards
+	EventTypeRewardsWithdrawn = "consensus.rewards_withdrawn"
+	
 	// EventTypeAuctionStarted defines the event type for blind auction start
 	EventTypeAuctionStarted = "consensus.auction_started"
 	
 	// EventTypeAuctionCompleted defines the event type for blind auction completion
 	EventTypeAuctionCompleted = "consensus.auction_completed"
 	
+	// EventTypeAuctionRevealStarted defines the event type for a blind auction entering its reveal window
+	EventTypeAuctionRevealStarted = "consensus.auction_reveal_started"
+	
 	// EventTypeBidCommitted defines the event type for bid commit in blind auction
 	EventTypeBidCommitted = "consensus.bid_committed"
 	
 	// EventTypeBidRevealed defines the event type for bid reveal in blind auction
 	EventTypeBidRevealed = "consensus.bid_revealed"
 	
+	// EventTypeBondLocked defines the event type for a blind auction commit bond being locked
+	EventTypeBondLocked = "consensus.bond_locked"
+	
+	// EventTypeBondReturned defines the event type for a commit bond returned on reveal
+	EventTypeBondReturned = "consensus.bond_returned"
+	
+	// EventTypeBondForfeited defines the event type for a commit bond forfeited for not revealing
+	EventTypeBondForfeited = "consensus.bond_forfeited"
+	
+	// EventTypeSlash defines the event type for validator slashing
+	EventTypeSlash = "consensus.slash"
+	
+	// EventTypeJail defines the event type for validator jailing
+	EventTypeJail = "consensus.jail"
+	
+	// EventTypeUnjail defines the event type for validator unjailing
+	EventTypeUnjail = "consensus.unjail"
+	
+	// EventTypeConsensusKeySet defines the event type for a registered validator consensus key
+	EventTypeConsensusKeySet = "consensus.consensus_key_set"
+	
+	// EventTypeLiveness defines the event type for missed blocks
+	EventTypeLiveness = "consensus.liveness"
+	
 	// Attribute keys
 	AttributeKeyBlockCreator = "block_creator"
 	AttributeKeyBlockHeight  = "block_height"
@@ -49,8 +82,25 @@ const (
 	AttributeKeyRewardShare   = "reward_share"
 	AttributeKeyMOACompliance = "moa_compliance"
 	AttributeKeyPenaltyMultiplier = "penalty_multiplier"
+	AttributeKeyTotalStake   = "total_stake"
+	AttributeKeyRewardIndex  = "reward_index"
+	AttributeKeyEpoch        = "epoch"
 	AttributeKeyAuctionHeight = "auction_height"
 	AttributeKeyCommitHash   = "commit_hash"
 	AttributeKeyBidAmount    = "bid_amount"
 	AttributeKeyNonce        = "nonce"
+	AttributeKeyReason       = "reason"
+	AttributeKeySlashFraction = "slash_fraction"
+	AttributeKeyBurnedLZN    = "burned_lzn"
+	AttributeKeyJailedUntil  = "jailed_until"
+	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyConsAddress  = "cons_address"
+	AttributeKeyBondAmount   = "bond_amount"
+	AttributeKeyCommitEndHeight = "commit_end_height"
+	AttributeKeyRevealEndHeight = "reveal_end_height"
+	
+	// Slashing reasons
+	SlashReasonDowntime         = "downtime"
+	SlashReasonDoubleSign       = "double_sign"
+	SlashReasonUnrevealedCommit = "unrevealed_commit"
 )
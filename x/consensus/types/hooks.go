@@ -0,0 +1,91 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConsensusHooks defines the hooks other modules can subscribe to for consensus events
+type ConsensusHooks interface {
+	// AfterBlockCreatorSelected is called after the block creator for a height has been selected
+	AfterBlockCreatorSelected(ctx sdk.Context, height uint64, creator string) error
+	// AfterBidCommitted is called after a validator committed a blind auction bid for a height
+	AfterBidCommitted(ctx sdk.Context, height uint64, validator string) error
+	// AfterBidRevealed is called after a validator revealed its blind auction bid for a height
+	AfterBidRevealed(ctx sdk.Context, height uint64, validator string) error
+	// AfterValidatorSlashed is called after a validator's activated LZN was slashed
+	AfterValidatorSlashed(ctx sdk.Context, validator string, reason string) error
+	// AfterValidatorJailed is called after a validator was jailed
+	AfterValidatorJailed(ctx sdk.Context, validator string, reason string) error
+	// AfterValidatorUnjailed is called after a jailed validator returned to the active set
+	AfterValidatorUnjailed(ctx sdk.Context, validator string) error
+}
+
+var _ ConsensusHooks = MultiConsensusHooks{}
+
+// MultiConsensusHooks combines multiple consensus hooks, all hook functions are run in array sequence
+type MultiConsensusHooks []ConsensusHooks
+
+// NewMultiConsensusHooks creates a new MultiConsensusHooks
+func NewMultiConsensusHooks(hooks ...ConsensusHooks) MultiConsensusHooks {
+	return hooks
+}
+
+// AfterBlockCreatorSelected runs AfterBlockCreatorSelected of every hook, stopping at the first error
+func (h MultiConsensusHooks) AfterBlockCreatorSelected(ctx sdk.Context, height uint64, creator string) error {
+	for i := range h {
+		if err := h[i].AfterBlockCreatorSelected(ctx, height, creator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterBidCommitted runs AfterBidCommitted of every hook, stopping at the first error
+func (h MultiConsensusHooks) AfterBidCommitted(ctx sdk.Context, height uint64, validator string) error {
+	for i := range h {
+		if err := h[i].AfterBidCommitted(ctx, height, validator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterBidRevealed runs AfterBidRevealed of every hook, stopping at the first error
+func (h MultiConsensusHooks) AfterBidRevealed(ctx sdk.Context, height uint64, validator string) error {
+	for i := range h {
+		if err := h[i].AfterBidRevealed(ctx, height, validator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterValidatorSlashed runs AfterValidatorSlashed of every hook, stopping at the first error
+func (h MultiConsensusHooks) AfterValidatorSlashed(ctx sdk.Context, validator string, reason string) error {
+	for i := range h {
+		if err := h[i].AfterValidatorSlashed(ctx, validator, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterValidatorJailed runs AfterValidatorJailed of every hook, stopping at the first error
+func (h MultiConsensusHooks) AfterValidatorJailed(ctx sdk.Context, validator string, reason string) error {
+	for i := range h {
+		if err := h[i].AfterValidatorJailed(ctx, validator, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterValidatorUnjailed runs AfterValidatorUnjailed of every hook, stopping at the first error
+func (h MultiConsensusHooks) AfterValidatorUnjailed(ctx sdk.Context, validator string) error {
+	for i := range h {
+		if err := h[i].AfterValidatorUnjailed(ctx, validator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
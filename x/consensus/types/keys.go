@@ -4,6 +4,7 @@ import (
 	"fmt"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -39,9 +40,19 @@ var (
 	// BlockTimeKeyPrefix defines the prefix for block time keys
 	BlockTimeKeyPrefix = []byte{0x10}
 	
-	// AverageBlockTimeKey defines the key for average block time
+	// AverageBlockTimeKey defines the legacy key for average block time
+	// The average is now derived from BlockTimeWindowKey; MigrateBlockTimeWindow deletes this key
 	AverageBlockTimeKey = []byte("AverageBlockTime")
 
+	// BlockTimeWindowKey defines the key for the rolling block time window
+	BlockTimeWindowKey = []byte("BlockTimeWindow")
+
+	// BlockBurnedAntKey defines the key for the ANT burned in the current block
+	BlockBurnedAntKey = []byte("BlockBurnedAnt")
+
+	// NextBlockDelayKey defines the key for the delay before the next block
+	NextBlockDelayKey = []byte("NextBlockDelay")
+
 	// ConsensusStateKey defines the key for consensus state
 	ConsensusStateKey = []byte("ConsensusState")
 
@@ -53,6 +64,30 @@ var (
 	
 	// BidHistoryKeyPrefix defines the prefix for bid history keys (anti-manipulation)
 	BidHistoryKeyPrefix = []byte{0x11}
+	
+	// ValidatorSigningInfoKeyPrefix defines the prefix for validator signing info keys
+	ValidatorSigningInfoKeyPrefix = []byte{0x12}
+	
+	// ValidatorMissedBlockKeyPrefix defines the prefix for missed block bitmap keys
+	ValidatorMissedBlockKeyPrefix = []byte{0x13}
+	
+	// ValidatorByConsAddrKeyPrefix defines the prefix for consensus address to validator mapping
+	ValidatorByConsAddrKeyPrefix = []byte{0x14}
+	
+	// AuctionFaultKeyPrefix defines the prefix for unrevealed auction commit records
+	AuctionFaultKeyPrefix = []byte{0x15}
+	
+	// ValidatorConsPubKeyKeyPrefix defines the prefix for validator consensus public keys
+	ValidatorConsPubKeyKeyPrefix = []byte{0x16}
+	
+	// LastValidatorPowerKeyPrefix defines the prefix for the voting power last sent to CometBFT
+	LastValidatorPowerKeyPrefix = []byte{0x17}
+	
+	// RewardPoolKey defines the key for the global reward accumulator
+	RewardPoolKey = []byte{0x18}
+	
+	// ValidatorRewardsKeyPrefix defines the prefix for validator positions in the reward pool
+	ValidatorRewardsKeyPrefix = []byte{0x19}
 )
 
 // Key prefixes
@@ -63,6 +98,9 @@ var (
 	KeyBlindAuctionPrefix    = []byte(BlindAuctionKey)
 )
 
+// Key helpers copy the prefix before appending: appending to a shared prefix slice
+// with spare capacity would make keys alias each other in the store cache.
+
 // KeyPrefix returns the key prefix for the consensus module
 func KeyPrefix(key string) []byte {
 	return []byte(key)
@@ -70,17 +108,17 @@ func KeyPrefix(key string) []byte {
 
 // GetValidatorKey returns the key for a validator
 func GetValidatorKey(validator string) []byte {
-	return append(KeyValidatorPrefix, []byte(validator)...)
+	return append(append([]byte{}, KeyValidatorPrefix...), []byte(validator)...)
 }
 
 // GetBlockCreatorKey returns the key for a block creator
 func GetBlockCreatorKey(height uint64) []byte {
-	return append(KeyBlockCreatorPrefix, []byte(fmt.Sprintf("%d", height))...)
+	return append(append([]byte{}, KeyBlockCreatorPrefix...), []byte(fmt.Sprintf("%d", height))...)
 }
 
 // GetValidatorWeightKey returns the key for a validator weight
 func GetValidatorWeightKey(validator string) []byte {
-	return append(KeyValidatorWeightPrefix, []byte(validator)...)
+	return append(append([]byte{}, KeyValidatorWeightPrefix...), []byte(validator)...)
 }
 
 // KeyHalvingInfo returns the key for halving info
@@ -90,7 +128,7 @@ func KeyHalvingInfo() []byte {
 
 // GetBlockTimeKey returns the key for a block's time
 func GetBlockTimeKey(height uint64) []byte {
-	return append(BlockTimeKeyPrefix, sdk.Uint64ToBigEndian(height)...)
+	return append(append([]byte{}, BlockTimeKeyPrefix...), sdk.Uint64ToBigEndian(height)...)
 }
 
 // KeyConsensusState returns the key for consensus state
@@ -100,10 +138,56 @@ func KeyConsensusState() []byte {
 
 // GetBlindAuctionKey returns the key for a blind auction at a specific height
 func GetBlindAuctionKey(height uint64) []byte {
-	return append(KeyBlindAuctionPrefix, []byte(fmt.Sprintf("%d", height))...)
+	return append(append([]byte{}, KeyBlindAuctionPrefix...), []byte(fmt.Sprintf("%d", height))...)
 }
 
 // GetBidHistoryKey returns the key for a validator's bid history
 func GetBidHistoryKey(validator string) []byte {
-	return append(BidHistoryKeyPrefix, []byte(validator)...)
+	return append(append([]byte{}, BidHistoryKeyPrefix...), []byte(validator)...)
+}
+
+// GetValidatorSigningInfoKey returns the key for a validator's signing info
+func GetValidatorSigningInfoKey(validator string) []byte {
+	return append(append([]byte{}, ValidatorSigningInfoKeyPrefix...), []byte(validator)...)
+}
+
+// GetValidatorMissedBlockPrefix returns the prefix for a validator's missed block bitmap
+// The validator address is length-prefixed so one validator's prefix never covers another's
+func GetValidatorMissedBlockPrefix(validator string) []byte {
+	return append(append([]byte{}, ValidatorMissedBlockKeyPrefix...), address.MustLengthPrefix([]byte(validator))...)
+}
+
+// GetValidatorMissedBlockKey returns the key for a validator's missed block at a window index
+func GetValidatorMissedBlockKey(validator string, index uint64) []byte {
+	return append(GetValidatorMissedBlockPrefix(validator), sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetValidatorByConsAddrKey returns the key for the validator owning a consensus address
+func GetValidatorByConsAddrKey(consAddr []byte) []byte {
+	return append(append([]byte{}, ValidatorByConsAddrKeyPrefix...), consAddr...)
+}
+
+// GetAuctionFaultPrefix returns the prefix for a validator's auction faults
+func GetAuctionFaultPrefix(validator string) []byte {
+	return append(append([]byte{}, AuctionFaultKeyPrefix...), address.MustLengthPrefix([]byte(validator))...)
+}
+
+// GetAuctionFaultKey returns the key for a validator's fault in the auction at a height
+func GetAuctionFaultKey(validator string, auctionHeight uint64) []byte {
+	return append(GetAuctionFaultPrefix(validator), sdk.Uint64ToBigEndian(auctionHeight)...)
+}
+
+// GetValidatorConsPubKeyKey returns the key for a validator's consensus public key
+func GetValidatorConsPubKeyKey(validator string) []byte {
+	return append(append([]byte{}, ValidatorConsPubKeyKeyPrefix...), []byte(validator)...)
+}
+
+// GetLastValidatorPowerKey returns the key for a validator's last CometBFT voting power
+func GetLastValidatorPowerKey(validator string) []byte {
+	return append(append([]byte{}, LastValidatorPowerKeyPrefix...), []byte(validator)...)
+}
+
+// GetValidatorRewardsKey returns the key for a validator's position in the reward pool
+func GetValidatorRewardsKey(validator string) []byte {
+	return append(append([]byte{}, ValidatorRewardsKeyPrefix...), []byte(validator)...)
 }
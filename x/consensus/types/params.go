@@ -43,6 +43,17 @@ func (p *ConsensusParams) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyBidHistoryLimit, &p.BidHistoryLimit, validateUint64),
 		paramtypes.NewParamSetPair(KeyAuctionHistoryBlocks, &p.AuctionHistoryBlocks, validateUint64),
 		paramtypes.NewParamSetPair(KeyRapidBidLimit, &p.RapidBidLimit, validateUint64),
+		paramtypes.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateUint64),
+		paramtypes.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow, validateFraction),
+		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDuration),
+		paramtypes.NewParamSetPair(KeyDoubleSignJailDuration, &p.DoubleSignJailDuration, validateDuration),
+		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateFraction),
+		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateFraction),
+		paramtypes.NewParamSetPair(KeySlashFractionUnrevealedCommit, &p.SlashFractionUnrevealedCommit, validateFraction),
+		paramtypes.NewParamSetPair(KeyAuctionCommitBond, &p.AuctionCommitBond, validateUint64),
+		paramtypes.NewParamSetPair(KeyAuctionCommitWindow, &p.AuctionCommitWindow, validateUint64),
+		paramtypes.NewParamSetPair(KeyAuctionRevealWindow, &p.AuctionRevealWindow, validateUint64),
+		paramtypes.NewParamSetPair(KeyRewardEpochLength, &p.RewardEpochLength, validateUint64),
 	}
 }
 
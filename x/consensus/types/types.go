@@ -2,6 +2,11 @@ package types
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
 
 	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
 )
@@ -18,6 +23,9 @@ type (
 	ValidatorWeight = consensusv1.ValidatorWeight
 	Params          = consensusv1.Params
 	GenesisState    = consensusv1.GenesisState
+
+	ValidatorSigningInfo = consensusv1.ValidatorSigningInfo
+	AuctionFault         = consensusv1.AuctionFault
 )
 
 // DefaultGenesis returns default genesis state
@@ -36,6 +44,148 @@ func ValidateGenesis(gs *GenesisState) error {
 	if gs.Params == nil {
 		return fmt.Errorf("params cannot be nil")
 	}
+
+	// Burn proofs and activity scores are not kept in the store
+	if len(gs.BurnProofs) > 0 {
+		return fmt.Errorf("burn proofs are not stored by the %s module", ModuleName)
+	}
+	if len(gs.ActivityScores) > 0 {
+		return fmt.Errorf("activity scores are not stored by the %s module", ModuleName)
+	}
+
+	validators := make(map[string]bool, len(gs.Validators))
+	for _, v := range gs.Validators {
+		if v == nil || v.Validator == "" {
+			return fmt.Errorf("validator address cannot be empty")
+		}
+		if validators[v.Validator] {
+			return fmt.Errorf("duplicate validator %s", v.Validator)
+		}
+		validators[v.Validator] = true
+	}
+
+	weights := make(map[string]bool, len(gs.ValidatorWeights))
+	for _, w := range gs.ValidatorWeights {
+		if w == nil || w.Validator == "" {
+			return fmt.Errorf("validator weight address cannot be empty")
+		}
+		if weights[w.Validator] {
+			return fmt.Errorf("duplicate weight of validator %s", w.Validator)
+		}
+		weights[w.Validator] = true
+	}
+
+	creators := make(map[uint64]bool, len(gs.BlockCreators))
+	for _, c := range gs.BlockCreators {
+		if c == nil || c.Validator == "" {
+			return fmt.Errorf("block creator address cannot be empty")
+		}
+		if creators[c.BlockHeight] {
+			return fmt.Errorf("duplicate block creator at height %d", c.BlockHeight)
+		}
+		creators[c.BlockHeight] = true
+	}
+
+	auctions := make(map[uint64]bool, len(gs.BlindAuctions))
+	for _, a := range gs.BlindAuctions {
+		if a == nil {
+			return fmt.Errorf("blind auction cannot be nil")
+		}
+		if auctions[a.BlockHeight] {
+			return fmt.Errorf("duplicate blind auction at height %d", a.BlockHeight)
+		}
+		auctions[a.BlockHeight] = true
+	}
+
+	blockTimes := make(map[uint64]bool, len(gs.BlockTimes))
+	for _, bt := range gs.BlockTimes {
+		if bt == nil || bt.Time == nil {
+			return fmt.Errorf("block time cannot be empty")
+		}
+		if blockTimes[bt.Height] {
+			return fmt.Errorf("duplicate block time at height %d", bt.Height)
+		}
+		blockTimes[bt.Height] = true
+	}
+	if w := gs.BlockTimeWindow; w != nil {
+		if w.OldestHeight > w.NewestHeight {
+			return fmt.Errorf("block time window oldest height %d exceeds newest height %d", w.OldestHeight, w.NewestHeight)
+		}
+		if !blockTimes[w.OldestHeight] || !blockTimes[w.NewestHeight] {
+			return fmt.Errorf("block time window heights %d-%d have no recorded block times", w.OldestHeight, w.NewestHeight)
+		}
+	}
+	if gs.NextBlockDelay < 0 {
+		return fmt.Errorf("next block delay cannot be negative: %d", gs.NextBlockDelay)
+	}
+
+	histories := make(map[string]bool, len(gs.BidHistories))
+	for _, h := range gs.BidHistories {
+		if h == nil || h.Validator == "" {
+			return fmt.Errorf("bid history validator cannot be empty")
+		}
+		if histories[h.Validator] {
+			return fmt.Errorf("duplicate bid history of validator %s", h.Validator)
+		}
+		histories[h.Validator] = true
+	}
+
+	signingInfos := make(map[string]bool, len(gs.SigningInfos))
+	for _, info := range gs.SigningInfos {
+		if info == nil || info.Validator == "" {
+			return fmt.Errorf("signing info validator cannot be empty")
+		}
+		if signingInfos[info.Validator] {
+			return fmt.Errorf("duplicate signing info of validator %s", info.Validator)
+		}
+		signingInfos[info.Validator] = true
+	}
+
+	// Missed blocks and auction faults are keyed by the length-prefixed validator address
+	for _, m := range gs.MissedBlocks {
+		if m == nil || m.Validator == "" || len(m.Validator) > 255 {
+			return fmt.Errorf("invalid missed block validator")
+		}
+	}
+	for _, f := range gs.AuctionFaults {
+		if f == nil || f.Validator == "" || len(f.Validator) > 255 {
+			return fmt.Errorf("invalid auction fault validator")
+		}
+	}
+
+	for _, a := range gs.ValidatorConsAddresses {
+		if a == nil || len(a.ConsAddress) == 0 || a.Validator == "" {
+			return fmt.Errorf("consensus address and validator cannot be empty")
+		}
+	}
+	for _, pk := range gs.ValidatorConsPubKeys {
+		if pk == nil || pk.Validator == "" {
+			return fmt.Errorf("consensus public key validator cannot be empty")
+		}
+		if _, err := ConsAddressFromPubKey(pk.PubKey); err != nil {
+			return fmt.Errorf("invalid consensus public key of validator %s: %w", pk.Validator, err)
+		}
+	}
+	for _, p := range gs.LastValidatorPowers {
+		if p == nil || p.Validator == "" {
+			return fmt.Errorf("last validator power validator cannot be empty")
+		}
+		if p.Power <= 0 {
+			return fmt.Errorf("last power of validator %s must be positive: %d", p.Validator, p.Power)
+		}
+	}
+
+	rewards := make(map[string]bool, len(gs.ValidatorRewards))
+	for _, vr := range gs.ValidatorRewards {
+		if vr == nil || vr.Validator == "" {
+			return fmt.Errorf("validator rewards validator cannot be empty")
+		}
+		if rewards[vr.Validator] {
+			return fmt.Errorf("duplicate rewards of validator %s", vr.Validator)
+		}
+		rewards[vr.Validator] = true
+	}
+
 	return nil
 }
 
@@ -62,6 +212,17 @@ func DefaultParams() *Params {
 		BidHistoryLimit:             100,           // Maximum bid history entries
 		AuctionHistoryBlocks:        100,           // Blocks to keep auction history
 		RapidBidLimit:               5,             // Maximum rapid bids allowed
+		SignedBlocksWindow:            100,     // Blocks tracked for downtime
+		MinSignedPerWindow:            "0.5",   // Jail below 50% signed blocks
+		DowntimeJailDuration:          "10m",   // Jail duration for downtime
+		DoubleSignJailDuration:        "720h",  // Jail duration for double-signing (30 days)
+		SlashFractionDowntime:         "0.01",  // 1% of activated LZN
+		SlashFractionDoubleSign:       "0.05",  // 5% of activated LZN
+		SlashFractionUnrevealedCommit: "0.001", // 0.1% of activated LZN
+		AuctionCommitBond:             100000,  // ANT locked per auction commit
+		AuctionCommitWindow:           5,       // Blocks commits are accepted
+		AuctionRevealWindow:           5,       // Blocks reveals are accepted
+		RewardEpochLength:             100,     // Blocks per reward epoch
 	}
 }
 
@@ -82,67 +243,185 @@ func ValidateParams(p *Params) error {
 	if p.MaxBurnAmount == "" {
 		return fmt.Errorf("max burn amount cannot be empty")
 	}
-	
+
+	baseBlockTime, err := time.ParseDuration(p.BaseBlockTime)
+	if err != nil || baseBlockTime <= 0 {
+		return fmt.Errorf("invalid base block time %q", p.BaseBlockTime)
+	}
+
+	if p.LowActivityThreshold > p.HighActivityThreshold {
+		return fmt.Errorf("low activity threshold %d exceeds high activity threshold %d", p.LowActivityThreshold, p.HighActivityThreshold)
+	}
+
+	// Run the validator of every param set pair
+	for _, pair := range NewConsensusParams(p).ParamSetPairs() {
+		if err := pair.ValidatorFn(reflect.ValueOf(pair.Value).Elem().Interface()); err != nil {
+			return fmt.Errorf("invalid %s: %w", pair.Key, err)
+		}
+	}
+
+	minBurn, _ := parseParamAmount(p.MinBurnAmount, "uvx")
+	maxBurn, _ := parseParamAmount(p.MaxBurnAmount, "uvx")
+	if minBurn.GT(maxBurn) {
+		return fmt.Errorf("min burn amount %s exceeds max burn amount %s", p.MinBurnAmount, p.MaxBurnAmount)
+	}
+
 	return nil
 }
 
-// validateBaseBlockTime validates the base block time
+// validateBaseBlockTime validates the base block time is a positive duration
 func validateBaseBlockTime(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", v, err)
+	}
+	if d <= 0 {
+		return fmt.Errorf("base block time must be positive: %s", v)
+	}
 	return nil
 }
 
-// validateHighActivityThreshold validates the high activity threshold
+// validateHighActivityThreshold validates the high activity threshold is positive
 func validateHighActivityThreshold(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("high activity threshold must be positive")
+	}
 	return nil
 }
 
 // validateLowActivityThreshold validates the low activity threshold
 func validateLowActivityThreshold(i interface{}) error {
-	return nil
+	return validateUint64(i)
 }
 
-// validateMinBurnAmount validates the minimum burn amount
+// validateMinBurnAmount validates the minimum burn amount is a positive uvx amount
 func validateMinBurnAmount(i interface{}) error {
-	return nil
+	_, err := parseParamAmount(i, "uvx")
+	return err
 }
 
-// validateMaxBurnAmount validates the maximum burn amount
+// validateMaxBurnAmount validates the maximum burn amount is a positive uvx amount
 func validateMaxBurnAmount(i interface{}) error {
-	return nil
+	_, err := parseParamAmount(i, "uvx")
+	return err
 }
 
-// validateActivityDecayRate validates the activity decay rate
+// validateActivityDecayRate validates the activity decay rate is in (0, 1]
 func validateActivityDecayRate(i interface{}) error {
-	return nil
+	return validatePositiveFraction(i)
 }
 
-// validateBlockCreatorSelectionRounds validates the block creator selection rounds
+// validateBlockCreatorSelectionRounds validates the block creator selection rounds are positive
 func validateBlockCreatorSelectionRounds(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("block creator selection rounds must be positive")
+	}
 	return nil
 }
 
-// validateMoaPenaltyRate validates the MOA penalty rate
+// validateMoaPenaltyRate validates the MOA penalty rate is in [0, 1]
 func validateMoaPenaltyRate(i interface{}) error {
-	return nil
+	return validateFraction(i)
 }
 
-// validateBaseBlockReward validates the base block reward
+// validateBaseBlockReward validates the base block reward is a positive uwrt amount (empty uses the default)
 func validateBaseBlockReward(i interface{}) error {
-	return nil
+	if v, ok := i.(string); ok && v == "" {
+		return nil
+	}
+	_, err := parseParamAmount(i, "uwrt")
+	return err
 }
 
-// validateMoaPenaltyThreshold validates MOA penalty thresholds
+// validateMoaPenaltyThreshold validates MOA penalty thresholds are in (0, 1] (empty uses the default)
 func validateMoaPenaltyThreshold(i interface{}) error {
-	return nil
+	return validatePositiveFraction(i)
 }
 
-// validateActivityFactor validates activity factors
+// validateActivityFactor validates activity factors are in (0, 1] (empty uses the default)
 func validateActivityFactor(i interface{}) error {
-	return nil
+	return validatePositiveFraction(i)
 }
 
-// validateUint64 validates uint64 parameters
+// validateUint64 validates uint64 parameters (zero uses the default)
 func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// parseParamAmount parses a positive integer amount with an optional denom suffix, e.g. "1000000uvx"
+func parseParamAmount(i interface{}, denom string) (math.Int, error) {
+	v, ok := i.(string)
+	if !ok {
+		return math.Int{}, fmt.Errorf("invalid parameter type: %T", i)
+	}
+	amount, ok := math.NewIntFromString(strings.TrimSuffix(v, denom))
+	if !ok || !amount.IsPositive() {
+		return math.Int{}, fmt.Errorf("invalid %s amount %q: must be a positive integer", denom, v)
+	}
+	return amount, nil
+}
+
+// validatePositiveFraction validates fraction parameters in (0, 1] (empty uses the default)
+func validatePositiveFraction(i interface{}) error {
+	if err := validateFraction(i); err != nil {
+		return err
+	}
+	if v := i.(string); v != "" && math.LegacyMustNewDecFromStr(v).IsZero() {
+		return fmt.Errorf("fraction must be positive: %s", v)
+	}
+	return nil
+}
+
+// validateDuration validates duration parameters (empty uses the default)
+func validateDuration(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", v, err)
+	}
+	if d < 0 {
+		return fmt.Errorf("duration must be non-negative: %s", v)
+	}
+	return nil
+}
+
+// validateFraction validates fraction parameters in [0, 1] (empty uses the default)
+func validateFraction(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == "" {
+		return nil
+	}
+	dec, err := math.LegacyNewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid fraction %q: %w", v, err)
+	}
+	if dec.IsNegative() || dec.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fraction must be between 0 and 1: %s", v)
+	}
 	return nil
 }
 
@@ -168,4 +447,16 @@ var (
 	KeyBidHistoryLimit             = []byte("BidHistoryLimit")
 	KeyAuctionHistoryBlocks        = []byte("AuctionHistoryBlocks")
 	KeyRapidBidLimit               = []byte("RapidBidLimit")
+
+	KeySignedBlocksWindow            = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow            = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration          = []byte("DowntimeJailDuration")
+	KeyDoubleSignJailDuration        = []byte("DoubleSignJailDuration")
+	KeySlashFractionDowntime         = []byte("SlashFractionDowntime")
+	KeySlashFractionDoubleSign       = []byte("SlashFractionDoubleSign")
+	KeySlashFractionUnrevealedCommit = []byte("SlashFractionUnrevealedCommit")
+	KeyAuctionCommitBond             = []byte("AuctionCommitBond")
+	KeyAuctionCommitWindow           = []byte("AuctionCommitWindow")
+	KeyAuctionRevealWindow           = []byte("AuctionRevealWindow")
+	KeyRewardEpochLength             = []byte("RewardEpochLength")
 )
@@ -152,6 +152,39 @@ func TestValidateParams(t *testing.T) {
 	}
 }
 
+// TestValidateParams_Ranges tests out of range values are rejected and unset optional values accepted
+func TestValidateParams_Ranges(t *testing.T) {
+	outOfRange := map[string]func(p *types.Params){
+		"negative base block time":      func(p *types.Params) { p.BaseBlockTime = "-5s" },
+		"zero high activity threshold":  func(p *types.Params) { p.HighActivityThreshold, p.LowActivityThreshold = 0, 0 },
+		"non-numeric min burn amount":   func(p *types.Params) { p.MinBurnAmount = "uvx" },
+		"zero max burn amount":          func(p *types.Params) { p.MaxBurnAmount = "0uvx" },
+		"min burn above max burn":       func(p *types.Params) { p.MinBurnAmount = "2000000000uvx" },
+		"zero selection rounds":         func(p *types.Params) { p.BlockCreatorSelectionRounds = 0 },
+		"decay rate above one":          func(p *types.Params) { p.ActivityDecayRate = "1.5" },
+		"negative MOA penalty rate":     func(p *types.Params) { p.MoaPenaltyRate = "-0.1" },
+		"negative base block reward":    func(p *types.Params) { p.BaseBlockReward = "-1uwrt" },
+		"zero MOA penalty threshold":    func(p *types.Params) { p.MoaPenaltyThresholdLow = "0" },
+		"MOA penalty threshold above 1": func(p *types.Params) { p.MoaPenaltyThresholdHigh = "1.1" },
+		"activity factor above one":     func(p *types.Params) { p.ActivityFactorHigh = "2" },
+		"non-decimal activity factor":   func(p *types.Params) { p.ActivityFactorMedium = "fast" },
+	}
+	for name, mutate := range outOfRange {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			mutate(params)
+			require.Error(t, types.ValidateParams(params))
+		})
+	}
+
+	// Empty and zero optional values fall back to the defaults
+	params := types.DefaultParams()
+	params.BaseBlockReward = ""
+	params.ActivityFactorNormal = ""
+	params.BidHistoryLimit = 0
+	require.NoError(t, types.ValidateParams(params))
+}
+
 func TestKeyPrefix(t *testing.T) {
 	key := types.KeyPrefix("test")
 	require.NotNil(t, key)
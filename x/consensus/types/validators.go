@@ -1,12 +1,25 @@
 package types
 
 import (
+	"fmt"
+
 	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
 )
 
+const (
+	// PowerReduction is the amount of activated uLZN per unit of CometBFT voting power (1 LZN)
+	PowerReduction = 1_000_000
+
+	// MaxValidatorPowerPercent caps a validator's voting power at 33% of total power,
+	// matching the lizenz limit on activated LZN per validator
+	MaxValidatorPowerPercent = 33
+)
+
 // InitialValidatorsToAbci converts genesis InitialValidators to abci.ValidatorUpdate slice.
 // Used by consensus module InitGenesis (HasABCIGenesis) for CometBFT handshake.
 func InitialValidatorsToAbci(initial []*consensusv1.InitialValidator) []abci.ValidatorUpdate {
@@ -34,6 +47,8 @@ func InitialValidatorsToAbci(initial []*consensusv1.InitialValidator) []abci.Val
 
 // AbciValidatorsToInitial converts req.Validators (abci) to genesis InitialValidators.
 // Used by app InitChainer when building default consensus genesis.
+// CometBFT names genesis validators by key only, so each is owned by the account of its
+// consensus key: CometBFT and account addresses of a key are the same bytes
 func AbciValidatorsToInitial(validators []abci.ValidatorUpdate) []*consensusv1.InitialValidator {
 	if len(validators) == 0 {
 		return nil
@@ -49,6 +64,26 @@ func AbciValidatorsToInitial(validators []abci.ValidatorUpdate) []*consensusv1.I
 			PubKey: pubKeyBz,
 			Power:  v.Power,
 		}
+		if consAddr, err := ConsAddressFromPubKey(pubKeyBz); err == nil {
+			out[i].Validator = sdk.AccAddress(consAddr).String()
+		}
 	}
 	return out
 }
+
+// ConsAddressFromPubKey returns the CometBFT consensus address of a marshaled CometBFT PublicKey.
+// Misbehavior evidence and commit votes identify validators by this address.
+func ConsAddressFromPubKey(pubKeyBz []byte) ([]byte, error) {
+	if len(pubKeyBz) == 0 {
+		return nil, fmt.Errorf("empty public key")
+	}
+	var pubKey cmtproto.PublicKey
+	if err := pubKey.Unmarshal(pubKeyBz); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal public key: %w", err)
+	}
+	pk, err := cryptoenc.PubKeyFromProto(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return pk.Address(), nil
+}
@@ -7,6 +7,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
 )
@@ -50,6 +51,11 @@ func TestAbciValidatorsToInitial_Valid(t *testing.T) {
 	require.Equal(t, int64(15), out[1].Power)
 	require.NotEmpty(t, out[0].PubKey)
 	require.NotEmpty(t, out[1].PubKey)
+
+	// Genesis validators are owned by the account of their consensus key
+	consAddr, err := ConsAddressFromPubKey(out[0].PubKey)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(consAddr).String(), out[0].Validator)
 }
 
 func TestInitialValidatorsToAbci_RoundTrip(t *testing.T) {
@@ -63,3 +69,14 @@ func TestInitialValidatorsToAbci_RoundTrip(t *testing.T) {
 	require.Equal(t, int64(100), back[0].Power)
 	require.Equal(t, pkBz, back[0].PubKey)
 }
+
+func TestConsAddressFromPubKey(t *testing.T) {
+	pk := &cmtproto.PublicKey{Sum: &cmtproto.PublicKey_Ed25519{Ed25519: make([]byte, 32)}}
+	pkBz, _ := pk.Marshal()
+	addr, err := ConsAddressFromPubKey(pkBz)
+	require.NoError(t, err)
+	require.Len(t, addr, 20)
+
+	_, err = ConsAddressFromPubKey(nil)
+	require.Error(t, err)
+}
@@ -1,7 +1,10 @@
 package governance
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/governance/keeper"
 	"github.com/volnix-protocol/volnix-protocol/x/governance/types"
 )
@@ -9,9 +12,10 @@ import (
 // DefaultGenesis returns default genesis state for governance module
 func DefaultGenesis() *types.GenesisState {
 	return &types.GenesisState{
-		Params:    types.DefaultParams(),
-		Proposals: []interface{}{}, // Empty for now, will be populated after proto generation
-		Votes:     []interface{}{}, // Empty for now, will be populated after proto generation
+		Params:         types.DefaultParams().ToProto(),
+		Proposals:      []*governancev1.Proposal{},
+		Votes:          []*governancev1.Vote{},
+		NextProposalId: 1,
 	}
 }
 
@@ -20,7 +24,85 @@ func ValidateGenesis(genState *types.GenesisState) error {
 	if genState == nil {
 		return nil
 	}
-	return genState.Params.Validate()
+	if err := types.ParamsFromProto(genState.Params).Validate(); err != nil {
+		return err
+	}
+
+	proposals := make(map[uint64]bool, len(genState.Proposals))
+	var maxProposalID uint64
+	for _, proposal := range genState.Proposals {
+		if proposal == nil || proposal.ProposalId == 0 {
+			return fmt.Errorf("proposal without ID")
+		}
+		if proposals[proposal.ProposalId] {
+			return fmt.Errorf("duplicate proposal %d", proposal.ProposalId)
+		}
+		proposals[proposal.ProposalId] = true
+		if proposal.ProposalId > maxProposalID {
+			maxProposalID = proposal.ProposalId
+		}
+	}
+	if len(genState.Proposals) > 0 && genState.NextProposalId <= maxProposalID {
+		return fmt.Errorf("next proposal ID %d must be greater than the highest proposal ID %d", genState.NextProposalId, maxProposalID)
+	}
+
+	votes := make(map[string]bool, len(genState.Votes))
+	for _, vote := range genState.Votes {
+		if vote == nil || vote.Voter == "" {
+			return fmt.Errorf("vote without voter")
+		}
+		if !proposals[vote.ProposalId] {
+			return fmt.Errorf("vote of %s references unknown proposal %d", vote.Voter, vote.ProposalId)
+		}
+		key := string(types.GetVoteKey(vote.ProposalId, vote.Voter))
+		if votes[key] {
+			return fmt.Errorf("duplicate vote of %s on proposal %d", vote.Voter, vote.ProposalId)
+		}
+		votes[key] = true
+	}
+
+	planHeights := make(map[int64]bool, len(genState.UpgradePlans))
+	planNames := make(map[string]bool, len(genState.UpgradePlans))
+	for _, plan := range genState.UpgradePlans {
+		if plan == nil || plan.Name == "" {
+			return fmt.Errorf("upgrade plan without name")
+		}
+		if plan.Height <= 0 {
+			return fmt.Errorf("upgrade plan %s has invalid height %d", plan.Name, plan.Height)
+		}
+		if planHeights[plan.Height] || planNames[plan.Name] {
+			return fmt.Errorf("duplicate upgrade plan %s at height %d", plan.Name, plan.Height)
+		}
+		planHeights[plan.Height] = true
+		planNames[plan.Name] = true
+	}
+
+	doneUpgrades := make(map[string]bool, len(genState.DoneUpgrades))
+	for _, done := range genState.DoneUpgrades {
+		if done == nil || done.Name == "" {
+			return fmt.Errorf("applied upgrade without name")
+		}
+		if doneUpgrades[done.Name] {
+			return fmt.Errorf("duplicate applied upgrade %s", done.Name)
+		}
+		if planNames[done.Name] {
+			return fmt.Errorf("upgrade %s is both applied and scheduled", done.Name)
+		}
+		doneUpgrades[done.Name] = true
+	}
+
+	versions := make(map[string]bool, len(genState.ModuleVersions))
+	for _, version := range genState.ModuleVersions {
+		if version == nil || version.Name == "" {
+			return fmt.Errorf("module version without module name")
+		}
+		if versions[version.Name] {
+			return fmt.Errorf("duplicate module version for %s", version.Name)
+		}
+		versions[version.Name] = true
+	}
+
+	return nil
 }
 
 // InitGenesis initializes the governance module genesis state
@@ -28,26 +110,10 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState *types.GenesisState
 	if genState == nil {
 		genState = DefaultGenesis()
 	}
-	
-	// Set parameters
-	k.SetParams(ctx, genState.Params)
-	
-	// Initialize proposal ID counter
-	k.SetNextProposalID(ctx, 1)
-	
-	// TODO: Restore proposals and votes from genesis state after proto generation
-	// For now, we start with an empty state
+	k.InitGenesis(ctx, genState)
 }
 
 // ExportGenesis exports the governance module genesis state
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
-	params := k.GetParams(ctx)
-	
-	// TODO: Export proposals and votes after proto generation
-	return &types.GenesisState{
-		Params:    params,
-		Proposals: []interface{}{}, // Empty for now, will be populated after proto generation
-		Votes:     []interface{}{}, // Empty for now, will be populated after proto generation
-	}
+	return k.ExportGenesis(ctx)
 }
-
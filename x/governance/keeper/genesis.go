@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/governance/types"
+)
+
+// InitGenesis initializes the governance state from a genesis state
+// Upgrade plans are written without the checks of ScheduleUpgrade, which rejects heights before the current block
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetParams(ctx, types.ParamsFromProto(genState.Params))
+
+	for _, proposal := range genState.Proposals {
+		if err := k.SetProposal(ctx, proposal); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, vote := range genState.Votes {
+		if err := k.SetVote(ctx, vote); err != nil {
+			panic(err)
+		}
+	}
+
+	nextProposalID := genState.NextProposalId
+	if nextProposalID == 0 {
+		nextProposalID = 1
+	}
+	k.SetNextProposalID(ctx, nextProposalID)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, plan := range genState.UpgradePlans {
+		bz, err := k.cdc.Marshal(plan)
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal upgrade plan: %w", err))
+		}
+		store.Set(types.GetUpgradePlanKey(plan.Height), bz)
+	}
+
+	for _, done := range genState.DoneUpgrades {
+		k.SetUpgradeDone(ctx, done.Name, done.Height)
+	}
+
+	vm := make(module.VersionMap, len(genState.ModuleVersions))
+	for _, version := range genState.ModuleVersions {
+		vm[version.Name] = version.Version
+	}
+	k.SetModuleVersionMap(ctx, vm)
+}
+
+// ExportGenesis returns the governance state as a genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params := k.GetParams(ctx)
+	genState := &types.GenesisState{
+		Params:         params.ToProto(),
+		Proposals:      []*Proposal{},
+		Votes:          []*Vote{},
+		NextProposalId: k.GetNextProposalID(ctx),
+		UpgradePlans:   []*UpgradePlan{},
+		DoneUpgrades:   []*governancev1.DoneUpgrade{},
+		ModuleVersions: []*governancev1.ModuleVersion{},
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ProposalKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal Proposal
+		k.mustUnmarshalRecord(iterator.Value(), &proposal)
+		genState.Proposals = append(genState.Proposals, &proposal)
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.VoteKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var vote Vote
+		k.mustUnmarshalRecord(iterator.Value(), &vote)
+		genState.Votes = append(genState.Votes, &vote)
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.UpgradePlanKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var plan UpgradePlan
+		k.mustUnmarshalRecord(iterator.Value(), &plan)
+		genState.UpgradePlans = append(genState.UpgradePlans, &plan)
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.DoneUpgradeKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.DoneUpgradeKeyPrefix):]
+		genState.DoneUpgrades = append(genState.DoneUpgrades, &governancev1.DoneUpgrade{
+			Name:   string(key),
+			Height: int64(sdk.BigEndianToUint64(iterator.Value())),
+		})
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.ModuleVersionKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.ModuleVersionKeyPrefix):]
+		genState.ModuleVersions = append(genState.ModuleVersions, &governancev1.ModuleVersion{
+			Name:    string(key),
+			Version: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	iterator.Close()
+
+	return genState
+}
+
+func (k Keeper) mustUnmarshalRecord(bz []byte, record gogoproto.Message) {
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		panic(fmt.Errorf("failed to unmarshal genesis record: %w", err))
+	}
+}
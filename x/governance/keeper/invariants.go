@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/governance/types"
+)
+
+// RegisterInvariants registers the governance module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "tallies", TallyInvariant(k))
+}
+
+// TallyInvariant checks that the tally of every tallied proposal equals the sum of its stored votes
+// Proposals still in voting are tallied when their voting period ends
+func TallyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		proposals, err := k.GetAllProposals(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "tallies", fmt.Sprintf("\t%v\n", err)), true
+		}
+
+		var msg strings.Builder
+		broken := false
+		tallied := 0
+		for _, proposal := range proposals {
+			switch proposal.Status {
+			case governancev1.ProposalStatus_PROPOSAL_STATUS_PASSED,
+				governancev1.ProposalStatus_PROPOSAL_STATUS_REJECTED,
+				governancev1.ProposalStatus_PROPOSAL_STATUS_EXECUTED:
+			default:
+				continue
+			}
+			tallied++
+
+			votes, err := k.GetVotes(ctx, proposal.ProposalId)
+			if err != nil {
+				broken = true
+				fmt.Fprintf(&msg, "\tproposal %d: %v\n", proposal.ProposalId, err)
+				continue
+			}
+
+			// Counted the way TallyVotes counts them
+			var yes, no, abstain uint64
+			for _, vote := range votes {
+				power, err := strconv.ParseUint(vote.VotingPower, 10, 64)
+				if err != nil {
+					continue
+				}
+				switch vote.Option {
+				case governancev1.VoteOption_VOTE_OPTION_YES:
+					yes += power
+				case governancev1.VoteOption_VOTE_OPTION_NO:
+					no += power
+				case governancev1.VoteOption_VOTE_OPTION_ABSTAIN:
+					abstain += power
+				}
+			}
+
+			want := fmt.Sprintf("yes=%d no=%d abstain=%d total=%d", yes, no, abstain, yes+no+abstain)
+			got := fmt.Sprintf("yes=%s no=%s abstain=%s total=%s", proposal.YesVotes, proposal.NoVotes, proposal.AbstainVotes, proposal.TotalVotes)
+			if got != want {
+				broken = true
+				fmt.Fprintf(&msg, "\tproposal %d: tally %s, %d votes sum to %s\n", proposal.ProposalId, got, len(votes), want)
+			}
+		}
+		if !broken {
+			fmt.Fprintf(&msg, "\t%d tallied proposals match their votes\n", tallied)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "tallies", msg.String()), broken
+	}
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+)
+
+// TestTallyInvariant tests stored tallies are checked against the votes of tallied proposals
+func (suite *KeeperTestSuite) TestTallyInvariant() {
+	invariant := TallyInvariant(*suite.keeper)
+	for id := uint64(1); id <= 2; id++ {
+		require.NoError(suite.T(), suite.keeper.SetProposal(suite.ctx, &governancev1.Proposal{
+			ProposalId:   id,
+			Proposer:     "cosmos1test",
+			Title:        "Test Proposal",
+			Status:       governancev1.ProposalStatus_PROPOSAL_STATUS_VOTING,
+			SubmitTime:   timestamppb.Now(),
+			YesVotes:     "0",
+			NoVotes:      "0",
+			AbstainVotes: "0",
+			TotalVotes:   "0",
+		}))
+	}
+	vote := func(proposalID uint64, voter string, option governancev1.VoteOption, power string) {
+		require.NoError(suite.T(), suite.keeper.SetVote(suite.ctx, &governancev1.Vote{
+			ProposalId:  proposalID,
+			Voter:       voter,
+			Option:      option,
+			VotingPower: power,
+			VoteTime:    timestamppb.Now(),
+		}))
+	}
+	vote(1, "cosmos1alice", governancev1.VoteOption_VOTE_OPTION_YES, "100")
+	vote(1, "cosmos1bob", governancev1.VoteOption_VOTE_OPTION_NO, "40")
+	vote(2, "cosmos1alice", governancev1.VoteOption_VOTE_OPTION_ABSTAIN, "10")
+
+	// Proposal 2 is still voting, its tally is not computed yet
+	require.NoError(suite.T(), suite.keeper.TallyVotes(suite.ctx, 1))
+	msg, broken := invariant(suite.ctx)
+	require.False(suite.T(), broken, msg)
+
+	// A vote stored after the tally is not counted in it
+	vote(1, "cosmos1carol", governancev1.VoteOption_VOTE_OPTION_YES, "5")
+	msg, broken = invariant(suite.ctx)
+	require.True(suite.T(), broken)
+	require.Contains(suite.T(), msg, "proposal 1: tally yes=100 no=40 abstain=0 total=140, 3 votes sum to yes=105 no=40 abstain=0 total=145")
+}
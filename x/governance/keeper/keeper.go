@@ -8,6 +8,8 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -18,7 +20,6 @@ import (
 // Type aliases for convenience
 type Proposal = governancev1.Proposal
 type Vote = governancev1.Vote
-type ParameterChange = governancev1.ParameterChange
 
 // WRT tokenomics constants (from whitepaper)
 const (
@@ -44,9 +45,8 @@ type (
 		storeKey       storetypes.StoreKey
 		paramstore     paramtypes.Subspace
 		bankKeeper     BankKeeperInterface // For getting WRT balances
-		lizenzKeeper  LizenzKeeperForGovernance // Optional: for lizenz parameter updates
-		anteilKeeper   AnteilKeeperForGovernance // Optional: for anteil parameter updates
-		consensusKeeper ConsensusKeeperForGovernance // Optional: for consensus parameter updates
+		router         MessageRouter       // Optional: routes proposal messages to module Msg servers
+		authority      string              // Address allowed to execute MsgUpdateParams
 	}
 )
 
@@ -64,6 +64,7 @@ func NewKeeper(
 		cdc:        cdc,
 		storeKey:   storeKey,
 		paramstore: ps,
+		authority:  authtypes.NewModuleAddress(types.ModuleName).String(),
 	}
 }
 
@@ -72,19 +73,15 @@ func (k *Keeper) SetBankKeeper(bankKeeper BankKeeperInterface) {
 	k.bankKeeper = bankKeeper
 }
 
-// SetLizenzKeeper sets the lizenz keeper interface for parameter updates
-func (k *Keeper) SetLizenzKeeper(lizenzKeeper LizenzKeeperForGovernance) {
-	k.lizenzKeeper = lizenzKeeper
+// SetRouter sets the Msg service router used to execute proposal messages
+func (k *Keeper) SetRouter(router MessageRouter) {
+	k.router = router
 }
 
-// SetAnteilKeeper sets the anteil keeper interface for parameter updates
-func (k *Keeper) SetAnteilKeeper(anteilKeeper AnteilKeeperForGovernance) {
-	k.anteilKeeper = anteilKeeper
-}
-
-// SetConsensusKeeper sets the consensus keeper interface for parameter updates
-func (k *Keeper) SetConsensusKeeper(consensusKeeper ConsensusKeeperForGovernance) {
-	k.consensusKeeper = consensusKeeper
+// GetAuthority returns the governance module address; proposal messages and
+// MsgUpdateParams must be signed by it
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
 // GetParams returns the current parameters for the governance module
@@ -168,6 +165,31 @@ func (k Keeper) GetAllProposals(ctx sdk.Context) ([]*Proposal, error) {
 	return proposals, nil
 }
 
+// GetProposalsPage returns a page of the proposals with the given status (any status if unspecified), in proposal ID order
+func (k Keeper) GetProposalsPage(ctx sdk.Context, status governancev1.ProposalStatus, pageReq *sdkquery.PageRequest) ([]*Proposal, *sdkquery.PageResponse, error) {
+	proposalStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalKeyPrefix)
+
+	var proposals []*Proposal
+	pageRes, err := sdkquery.FilteredPaginate(proposalStore, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var proposal Proposal
+		if err := k.cdc.Unmarshal(value, &proposal); err != nil {
+			return false, fmt.Errorf("failed to unmarshal proposal: %w", err)
+		}
+		if status != governancev1.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED && proposal.Status != status {
+			return false, nil
+		}
+		if accumulate {
+			proposals = append(proposals, &proposal)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proposals, pageRes, nil
+}
+
 // SetVote stores a vote in the store
 func (k Keeper) SetVote(ctx sdk.Context, vote *Vote) error {
 	store := ctx.KVStore(k.storeKey)
@@ -223,6 +245,26 @@ func (k Keeper) GetVotes(ctx sdk.Context, proposalID uint64) ([]*Vote, error) {
 	return votes, nil
 }
 
+// GetVotesPage returns a page of the votes on a proposal, in voter order
+func (k Keeper) GetVotesPage(ctx sdk.Context, proposalID uint64, pageReq *sdkquery.PageRequest) ([]*Vote, *sdkquery.PageResponse, error) {
+	voteStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetProposalVotesPrefix(proposalID))
+
+	var votes []*Vote
+	pageRes, err := sdkquery.Paginate(voteStore, pageReq, func(_ []byte, value []byte) error {
+		var vote Vote
+		if err := k.cdc.Unmarshal(value, &vote); err != nil {
+			return fmt.Errorf("failed to unmarshal vote: %w", err)
+		}
+		votes = append(votes, &vote)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return votes, pageRes, nil
+}
+
 // GetWRTBalance returns the WRT balance for an address
 // According to whitepaper: voting power is based on WRT holdings
 func (k Keeper) GetWRTBalance(ctx sdk.Context, addr sdk.AccAddress) uint64 {
@@ -9,14 +9,17 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/volnix-protocol/volnix-protocol/x/governance/types"
@@ -458,17 +461,8 @@ func (m *MockBankKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
 	return sdk.NewCoin(denom, math.NewIntFromUint64(m.supply))
 }
 
-func (suite *KeeperTestSuite) TestSetLizenzKeeper() {
-	suite.keeper.SetLizenzKeeper(nil)
-	// No assertion; just ensure no panic
-}
-
-func (suite *KeeperTestSuite) TestSetAnteilKeeper() {
-	suite.keeper.SetAnteilKeeper(nil)
-}
-
-func (suite *KeeperTestSuite) TestSetConsensusKeeper() {
-	suite.keeper.SetConsensusKeeper(nil)
+func (suite *KeeperTestSuite) TestGetAuthority() {
+	require.Equal(suite.T(), authtypes.NewModuleAddress(types.ModuleName).String(), suite.keeper.GetAuthority())
 }
 
 func (suite *KeeperTestSuite) TestGetWRTBalance_NoBankKeeper() {
@@ -501,3 +495,49 @@ func (suite *KeeperTestSuite) TestCalculateVotingPower_ValidAddressNoBalance() {
 	require.Equal(suite.T(), "0", power)
 }
 
+
+func (suite *KeeperTestSuite) TestInitGenesis_ExportGenesis_RoundTrip() {
+	submitTime := timestamppb.New(time.Unix(1700000000, 0).UTC())
+	require.NoError(suite.T(), suite.keeper.SetProposal(suite.ctx, &Proposal{
+		ProposalId:  3,
+		Proposer:    "cosmos1test",
+		Title:       "Test Proposal",
+		Description: "This is a test proposal",
+		Status:      governancev1.ProposalStatus_PROPOSAL_STATUS_VOTING,
+		SubmitTime:  submitTime,
+		YesVotes:    "1000000",
+		NoVotes:     "0",
+		TotalVotes:  "1000000",
+	}))
+	require.NoError(suite.T(), suite.keeper.SetVote(suite.ctx, &Vote{
+		ProposalId:  3,
+		Voter:       "cosmos1voter",
+		Option:      governancev1.VoteOption_VOTE_OPTION_YES,
+		VotingPower: "1000000",
+		VoteTime:    submitTime,
+	}))
+	suite.keeper.SetNextProposalID(suite.ctx, 4)
+	require.NoError(suite.T(), suite.keeper.ScheduleUpgrade(suite.ctx, &UpgradePlan{Name: "v2", Height: suite.ctx.BlockHeight() + 100}))
+	suite.keeper.SetUpgradeDone(suite.ctx, "v1", 10)
+	suite.keeper.SetModuleVersionMap(suite.ctx, module.VersionMap{"governance": 2, "ident": 1})
+
+	exported := suite.keeper.ExportGenesis(suite.ctx)
+	require.Len(suite.T(), exported.Proposals, 1)
+	require.Len(suite.T(), exported.Votes, 1)
+	require.Equal(suite.T(), uint64(4), exported.NextProposalId)
+	require.Len(suite.T(), exported.UpgradePlans, 1)
+	require.Len(suite.T(), exported.DoneUpgrades, 1)
+	require.Len(suite.T(), exported.ModuleVersions, 2)
+
+	// Import into a fresh store and export again
+	suite.SetupTest()
+	suite.keeper.InitGenesis(suite.ctx, exported)
+	reexported := suite.keeper.ExportGenesis(suite.ctx)
+	require.True(suite.T(), proto.Equal(exported, reexported))
+
+	height, done := suite.keeper.GetDoneUpgradeHeight(suite.ctx, "v1")
+	require.True(suite.T(), done)
+	require.Equal(suite.T(), int64(10), height)
+	_, found := suite.keeper.GetUpgradePlanByName(suite.ctx, "v2")
+	require.True(suite.T(), found)
+}
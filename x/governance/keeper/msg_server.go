@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -40,6 +41,16 @@ func (s MsgServer) SubmitProposal(ctx context.Context, req *governancev1.MsgSubm
 		return nil, types.ErrEmptyDescription
 	}
 
+	// String key/value changes were replaced by embedded MsgUpdateParams
+	if len(req.ParameterChanges) > 0 { //nolint:staticcheck // rejecting the deprecated field
+		return nil, errors.Wrap(types.ErrInvalidParameterChange, "parameter_changes is no longer supported, submit MsgUpdateParams in messages")
+	}
+
+	// Proposal messages must be executable by the governance module
+	if _, err := s.k.ValidateProposalMessages(req.Messages); err != nil {
+		return nil, err
+	}
+
 	// Get next proposal ID
 	proposalID := s.k.GetNextProposalID(sdkCtx)
 	s.k.SetNextProposalID(sdkCtx, proposalID+1)
@@ -71,25 +82,18 @@ func (s MsgServer) SubmitProposal(ctx context.Context, req *governancev1.MsgSubm
 		Title:           req.Title,
 		Description:     req.Description,
 		Status:          governancev1.ProposalStatus_PROPOSAL_STATUS_SUBMITTED,
-		SubmitTime:      timestamppb.Now(),
+		SubmitTime:      timestamppb.New(sdkCtx.BlockTime()),
 		VotingStartTime: timestamppb.New(votingStartTime),
 		VotingPeriod:    durationpb.New(params.VotingPeriod),
 		VotingEndTime:   timestamppb.New(votingEndTime),
 		TimelockPeriod:  durationpb.New(params.TimelockPeriod),
-		ParameterChanges: req.ParameterChanges,
+		Messages:        req.Messages,
 		YesVotes:        "0",
 		NoVotes:         "0",
 		AbstainVotes:    "0",
 		TotalVotes:      "0",
 	}
 
-	// Validate parameter changes
-	for _, change := range req.ParameterChanges {
-		if !types.IsGovernable(change.Module, change.Parameter) {
-			return nil, types.ErrConstitutionalParameter
-		}
-	}
-
 	// Store proposal
 	if err := s.k.SetProposal(sdkCtx, proposal); err != nil {
 		return nil, err
@@ -164,7 +168,7 @@ func (s MsgServer) Vote(ctx context.Context, req *governancev1.MsgVote) (*govern
 		Voter:       req.Voter,
 		Option:      req.Option,
 		VotingPower: votingPower,
-		VoteTime:    timestamppb.Now(),
+		VoteTime:    timestamppb.New(sdkCtx.BlockTime()),
 	}
 
 	// Store vote
@@ -222,17 +226,14 @@ func (s MsgServer) ExecuteProposal(ctx context.Context, req *governancev1.MsgExe
 		return nil, types.ErrProposalAlreadyExecuted
 	}
 
-	// Execute parameter changes
-	for _, change := range proposal.ParameterChanges {
-		// Validate parameter change
-		if err := s.k.ValidateParameterChange(sdkCtx, change); err != nil {
-			return nil, fmt.Errorf("invalid parameter change: %w", err)
-		}
-		
-		// Apply parameter change
-		if err := s.k.ApplyParameterChange(sdkCtx, change); err != nil {
-			return nil, fmt.Errorf("failed to apply parameter change: %w", err)
-		}
+	// Proposals stored before messages replaced parameter changes cannot be applied
+	if len(proposal.ParameterChanges) > 0 { //nolint:staticcheck // rejecting the deprecated field
+		return nil, errors.Wrap(types.ErrInvalidParameterChange, "legacy parameter changes cannot be executed")
+	}
+
+	// Execute the proposal messages
+	if err := s.k.ExecuteProposalMessages(sdkCtx, proposal.Messages); err != nil {
+		return nil, err
 	}
 
 	// Update proposal status
@@ -255,3 +256,64 @@ func (s MsgServer) ExecuteProposal(ctx context.Context, req *governancev1.MsgExe
 	}, nil
 }
 
+
+// UpdateParams replaces the governance parameters; only the governance authority may execute it
+func (s MsgServer) UpdateParams(ctx context.Context, req *governancev1.MsgUpdateParams) (*governancev1.MsgUpdateParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != s.k.GetAuthority() {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", s.k.GetAuthority(), req.Authority)
+	}
+	if req.Params == nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, "params cannot be nil")
+	}
+
+	params := types.ParamsFromProto(req.Params)
+	if err := params.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	s.k.SetParams(sdkCtx, params)
+	return &governancev1.MsgUpdateParamsResponse{}, nil
+}
+
+// ScheduleUpgrade schedules a software upgrade; only the governance authority may execute it
+func (s MsgServer) ScheduleUpgrade(ctx context.Context, req *governancev1.MsgScheduleUpgrade) (*governancev1.MsgScheduleUpgradeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != s.k.GetAuthority() {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", s.k.GetAuthority(), req.Authority)
+	}
+	if err := s.k.ScheduleUpgrade(sdkCtx, req.Plan); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"governance.upgrade_scheduled",
+			sdk.NewAttribute("name", req.Plan.Name),
+			sdk.NewAttribute("height", fmt.Sprintf("%d", req.Plan.Height)),
+		),
+	)
+	return &governancev1.MsgScheduleUpgradeResponse{}, nil
+}
+
+// CancelUpgrade cancels a scheduled software upgrade; only the governance authority may execute it
+func (s MsgServer) CancelUpgrade(ctx context.Context, req *governancev1.MsgCancelUpgrade) (*governancev1.MsgCancelUpgradeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != s.k.GetAuthority() {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", s.k.GetAuthority(), req.Authority)
+	}
+	if err := s.k.CancelUpgrade(sdkCtx, req.Name); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"governance.upgrade_cancelled",
+			sdk.NewAttribute("name", req.Name),
+		),
+	)
+	return &governancev1.MsgCancelUpgradeResponse{}, nil
+}
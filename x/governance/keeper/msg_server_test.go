@@ -6,10 +6,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/std"
@@ -59,6 +61,19 @@ func (m *MockBankKeeperForGovernance) GetSupply(ctx sdk.Context, denom string) s
 	return sdk.NewCoin(denom, math.NewIntFromUint64(m.supply))
 }
 
+// mockRouter routes proposal messages by type URL
+type mockRouter struct {
+	handlers map[string]baseapp.MsgServiceHandler
+}
+
+func newMockRouter() *mockRouter {
+	return &mockRouter{handlers: make(map[string]baseapp.MsgServiceHandler)}
+}
+
+func (r *mockRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return r.handlers[sdk.MsgTypeURL(msg)]
+}
+
 type MsgServerTestSuite struct {
 	suite.Suite
 
@@ -67,6 +82,7 @@ type MsgServerTestSuite struct {
 	keeper        *Keeper
 	msgServer     governancev1.MsgServer
 	mockBankKeeper *MockBankKeeperForGovernance
+	router        *mockRouter
 	storeKey      storetypes.StoreKey
 	paramStore    paramtypes.Subspace
 }
@@ -79,6 +95,7 @@ func (suite *MsgServerTestSuite) SetupTest() {
 	// Create codec
 	interfaceRegistry := cdctypes.NewInterfaceRegistry()
 	std.RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
 	suite.cdc = codec.NewProtoCodec(interfaceRegistry)
 
 	// Create store keys
@@ -97,6 +114,20 @@ func (suite *MsgServerTestSuite) SetupTest() {
 	suite.keeper = NewKeeper(suite.cdc, suite.storeKey, suite.paramStore)
 	suite.msgServer = NewMsgServer(suite.keeper)
 
+	// Route proposal messages back to this module's msg server
+	suite.router = newMockRouter()
+	suite.router.handlers[sdk.MsgTypeURL(&governancev1.MsgUpdateParams{})] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		// Like baseapp, every message gets its own event manager
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err := suite.msgServer.UpdateParams(ctx, msg.(*governancev1.MsgUpdateParams))
+		if err != nil {
+			return nil, err
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent("proposal_message", sdk.NewAttribute("type", sdk.MsgTypeURL(msg))))
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	}
+	suite.keeper.SetRouter(suite.router)
+
 	// Create and set mock bank keeper
 	suite.mockBankKeeper = NewMockBankKeeperForGovernance()
 	suite.keeper.SetBankKeeper(suite.mockBankKeeper)
@@ -105,6 +136,18 @@ func (suite *MsgServerTestSuite) SetupTest() {
 	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
 }
 
+// updateParamsMsgs packs a governance MsgUpdateParams that changes the voting period
+func (suite *MsgServerTestSuite) updateParamsMsgs(votingPeriod time.Duration) []*anypb.Any {
+	params := types.DefaultParams()
+	params.VotingPeriod = votingPeriod
+	msgs, err := PackProposalMessages(&governancev1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    params.ToProto(),
+	})
+	require.NoError(suite.T(), err)
+	return msgs
+}
+
 // Test SubmitProposal
 func (suite *MsgServerTestSuite) TestSubmitProposal() {
 	proposer := sdk.AccAddress("test_proposer_12345678901234567890").String()
@@ -116,13 +159,7 @@ func (suite *MsgServerTestSuite) TestSubmitProposal() {
 		Description:   "This is a test proposal",
 		ProposalType:  governancev1.ProposalType_PROPOSAL_TYPE_PARAMETER_CHANGE,
 		Deposit:       "2000000", // 2 WRT (above min deposit of 1 WRT)
-		ParameterChanges: []*governancev1.ParameterChange{
-			{
-				Module:    "governance",
-				Parameter: "voting_period",
-				NewValue:  "168h", // 7 days in hours
-			},
-		},
+		Messages:      suite.updateParamsMsgs(5 * 24 * time.Hour),
 	}
 
 	resp, err := suite.msgServer.SubmitProposal(suite.ctx, req)
@@ -291,13 +328,7 @@ func (suite *MsgServerTestSuite) TestExecuteProposal() {
 		Title:       "Test Proposal",
 		Description: "This is a test proposal",
 		Deposit:     "2000000",
-		ParameterChanges: []*governancev1.ParameterChange{
-			{
-				Module:    "governance",
-				Parameter: "voting_period",
-				NewValue:  "168h", // 7 days in hours
-			},
-		},
+		Messages:    suite.updateParamsMsgs(5 * 24 * time.Hour),
 	}
 	_, err := suite.msgServer.SubmitProposal(suite.ctx, submitReq)
 	require.NoError(suite.T(), err)
@@ -371,6 +402,9 @@ func (suite *MsgServerTestSuite) TestExecuteProposal() {
 	require.NoError(suite.T(), err)
 	require.NotNil(suite.T(), resp)
 
+	// The embedded MsgUpdateParams was applied
+	require.Equal(suite.T(), 5*24*time.Hour, suite.keeper.GetParams(suite.ctx).VotingPeriod)
+
 	// Verify proposal status is EXECUTED
 	executedProposal, err := suite.keeper.GetProposal(suite.ctx, 1)
 	require.NoError(suite.T(), err)
@@ -387,3 +421,175 @@ func (suite *MsgServerTestSuite) TestExecuteProposal() {
 	require.True(suite.T(), proposalExecutedFound, "Should have proposal_executed event")
 }
 
+
+func (suite *MsgServerTestSuite) TestSubmitProposal_LegacyParameterChangesRejected() {
+	proposer := sdk.AccAddress("test_proposer_12345678901234567890").String()
+
+	_, err := suite.msgServer.SubmitProposal(suite.ctx, &governancev1.MsgSubmitProposal{
+		Proposer:    proposer,
+		Title:       "Legacy Proposal",
+		Description: "Uses string parameter changes",
+		Deposit:     "2000000",
+		ParameterChanges: []*governancev1.ParameterChange{
+			{Module: "governance", Parameter: "voting_period", NewValue: "168h"},
+		},
+	})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidParameterChange)
+}
+
+func (suite *MsgServerTestSuite) TestSubmitProposal_InvalidSigner() {
+	proposer := sdk.AccAddress("test_proposer_12345678901234567890").String()
+
+	// A proposal message must be signed by the governance module, not the proposer
+	msgs, err := PackProposalMessages(&governancev1.MsgUpdateParams{
+		Authority: proposer,
+		Params:    types.DefaultParams().ToProto(),
+	})
+	require.NoError(suite.T(), err)
+
+	_, err = suite.msgServer.SubmitProposal(suite.ctx, &governancev1.MsgSubmitProposal{
+		Proposer:    proposer,
+		Title:       "Bad Signer",
+		Description: "Message signed by the proposer",
+		Deposit:     "2000000",
+		Messages:    msgs,
+	})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidSigner)
+}
+
+func (suite *MsgServerTestSuite) TestSubmitProposal_UnroutableMessage() {
+	proposer := sdk.AccAddress("test_proposer_12345678901234567890").String()
+	suite.keeper.SetRouter(newMockRouter())
+
+	_, err := suite.msgServer.SubmitProposal(suite.ctx, &governancev1.MsgSubmitProposal{
+		Proposer:    proposer,
+		Title:       "No Handler",
+		Description: "Message without a registered handler",
+		Deposit:     "2000000",
+		Messages:    suite.updateParamsMsgs(5 * 24 * time.Hour),
+	})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidProposalMsg)
+}
+
+func (suite *MsgServerTestSuite) TestUnpackProposalMessages_TypeURL() {
+	msgs := suite.updateParamsMsgs(5 * 24 * time.Hour)
+	require.Equal(suite.T(), "/volnix.governance.v1.MsgUpdateParams", msgs[0].TypeUrl)
+
+	// google.protobuf.Any style URLs resolve to the same message
+	msgs[0].TypeUrl = "type.googleapis.com/volnix.governance.v1.MsgUpdateParams"
+	unpacked, err := suite.keeper.UnpackProposalMessages(msgs)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), unpacked, 1)
+	require.Equal(suite.T(), suite.keeper.GetAuthority(), unpacked[0].(*governancev1.MsgUpdateParams).Authority)
+
+	_, err = suite.keeper.UnpackProposalMessages([]*anypb.Any{{TypeUrl: "/volnix.unknown.v1.Msg"}})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidProposalMsg)
+}
+
+func (suite *MsgServerTestSuite) TestExecuteProposalMessages_Atomic() {
+	invalid := types.DefaultParams()
+	invalid.Quorum = "2"
+	bad, err := PackProposalMessages(&governancev1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    invalid.ToProto(),
+	})
+	require.NoError(suite.T(), err)
+
+	// The first message succeeds but the second fails, so neither is committed nor emits events
+	msgs := append(suite.updateParamsMsgs(5*24*time.Hour), bad...)
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.keeper.ExecuteProposalMessages(ctx, msgs)
+	require.ErrorIs(suite.T(), err, types.ErrInvalidParams)
+	require.Equal(suite.T(), types.DefaultParams().VotingPeriod, suite.keeper.GetParams(suite.ctx).VotingPeriod)
+	require.Empty(suite.T(), ctx.EventManager().Events())
+
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(suite.T(), suite.keeper.ExecuteProposalMessages(ctx, append(suite.updateParamsMsgs(5*24*time.Hour), suite.updateParamsMsgs(6*24*time.Hour)...)))
+	require.Equal(suite.T(), 6*24*time.Hour, suite.keeper.GetParams(suite.ctx).VotingPeriod)
+	require.Len(suite.T(), ctx.EventManager().Events(), 2)
+}
+
+func (suite *MsgServerTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.Quorum = "0.3"
+
+	// Only the governance authority may update params
+	_, err := suite.msgServer.UpdateParams(suite.ctx, &governancev1.MsgUpdateParams{
+		Authority: sdk.AccAddress("not_the_authority___").String(),
+		Params:    params.ToProto(),
+	})
+	require.ErrorIs(suite.T(), err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &governancev1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+	})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidParams)
+
+	invalid := params
+	invalid.MinDeposit = "-1"
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &governancev1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    invalid.ToProto(),
+	})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidParams)
+
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &governancev1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    params.ToProto(),
+	})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "0.3", suite.keeper.GetParams(suite.ctx).Quorum)
+}
+
+func (suite *MsgServerTestSuite) TestScheduleUpgrade() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	plan := &governancev1.UpgradePlan{Name: "v0.4.0", Height: 100, Info: "https://example.com/v0.4.0"}
+
+	// Only the governance authority may schedule upgrades
+	_, err := suite.msgServer.ScheduleUpgrade(ctx, &governancev1.MsgScheduleUpgrade{
+		Authority: sdk.AccAddress("not_the_authority___").String(),
+		Plan:      plan,
+	})
+	require.ErrorIs(suite.T(), err, types.ErrUnauthorized)
+
+	invalid := []*governancev1.UpgradePlan{
+		nil,
+		{Name: "", Height: 100},
+		{Name: "v0.4.0", Height: 10}, // not in the future
+	}
+	for _, p := range invalid {
+		_, err = suite.msgServer.ScheduleUpgrade(ctx, &governancev1.MsgScheduleUpgrade{Authority: suite.keeper.GetAuthority(), Plan: p})
+		require.ErrorIs(suite.T(), err, types.ErrInvalidUpgradePlan)
+	}
+
+	_, err = suite.msgServer.ScheduleUpgrade(ctx, &governancev1.MsgScheduleUpgrade{Authority: suite.keeper.GetAuthority(), Plan: plan})
+	require.NoError(suite.T(), err)
+	stored, found := suite.keeper.GetUpgradePlan(ctx, 100)
+	require.True(suite.T(), found)
+	require.Equal(suite.T(), plan.Info, stored.Info)
+
+	// One plan per height and per name
+	_, err = suite.msgServer.ScheduleUpgrade(ctx, &governancev1.MsgScheduleUpgrade{Authority: suite.keeper.GetAuthority(), Plan: &governancev1.UpgradePlan{Name: "v0.5.0", Height: 100}})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidUpgradePlan)
+	_, err = suite.msgServer.ScheduleUpgrade(ctx, &governancev1.MsgScheduleUpgrade{Authority: suite.keeper.GetAuthority(), Plan: &governancev1.UpgradePlan{Name: "v0.4.0", Height: 200}})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidUpgradePlan)
+
+	// Applied upgrades cannot be scheduled again
+	suite.keeper.SetUpgradeDone(ctx, "v0.3.0", 5)
+	_, err = suite.msgServer.ScheduleUpgrade(ctx, &governancev1.MsgScheduleUpgrade{Authority: suite.keeper.GetAuthority(), Plan: &governancev1.UpgradePlan{Name: "v0.3.0", Height: 300}})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidUpgradePlan)
+}
+
+func (suite *MsgServerTestSuite) TestCancelUpgrade() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	require.NoError(suite.T(), suite.keeper.ScheduleUpgrade(ctx, &governancev1.UpgradePlan{Name: "v0.4.0", Height: 100}))
+
+	_, err := suite.msgServer.CancelUpgrade(ctx, &governancev1.MsgCancelUpgrade{Authority: sdk.AccAddress("not_the_authority___").String(), Name: "v0.4.0"})
+	require.ErrorIs(suite.T(), err, types.ErrUnauthorized)
+	_, err = suite.msgServer.CancelUpgrade(ctx, &governancev1.MsgCancelUpgrade{Authority: suite.keeper.GetAuthority(), Name: "v9.9.9"})
+	require.ErrorIs(suite.T(), err, types.ErrUpgradePlanNotFound)
+
+	_, err = suite.msgServer.CancelUpgrade(ctx, &governancev1.MsgCancelUpgrade{Authority: suite.keeper.GetAuthority(), Name: "v0.4.0"})
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), suite.keeper.GetUpgradePlans(ctx))
+}
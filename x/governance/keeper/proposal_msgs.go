@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"strings"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/volnix-protocol/volnix-protocol/x/governance/types"
+)
+
+// MessageRouter routes proposal messages to the Msg service handler of their module
+// baseapp.MsgServiceRouter satisfies it
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// PackProposalMessages wraps Msgs into the Any form carried by MsgSubmitProposal
+func PackProposalMessages(msgs ...sdk.Msg) ([]*anypb.Any, error) {
+	packed := make([]*anypb.Any, 0, len(msgs))
+	for _, msg := range msgs {
+		m, ok := msg.(proto.Message)
+		if !ok {
+			return nil, errors.Wrapf(types.ErrInvalidProposalMsg, "%T is not a protobuf message", msg)
+		}
+		value, err := proto.Marshal(m)
+		if err != nil {
+			return nil, errors.Wrap(types.ErrInvalidProposalMsg, err.Error())
+		}
+		packed = append(packed, &anypb.Any{
+			TypeUrl: "/" + string(m.ProtoReflect().Descriptor().FullName()),
+			Value:   value,
+		})
+	}
+	return packed, nil
+}
+
+// UnpackProposalMessages decodes the Msgs embedded in a proposal
+func (k Keeper) UnpackProposalMessages(anys []*anypb.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0, len(anys))
+	for i, a := range anys {
+		if a == nil {
+			return nil, errors.Wrapf(types.ErrInvalidProposalMsg, "message %d is empty", i)
+		}
+
+		// Both "/pkg.Msg" and "type.googleapis.com/pkg.Msg" resolve to the registered name
+		typeURL := a.TypeUrl
+		if idx := strings.LastIndex(typeURL, "/"); idx >= 0 {
+			typeURL = typeURL[idx+1:]
+		}
+
+		var msg sdk.Msg
+		if err := k.cdc.UnpackAny(&codectypes.Any{TypeUrl: "/" + typeURL, Value: a.Value}, &msg); err != nil {
+			return nil, errors.Wrapf(types.ErrInvalidProposalMsg, "message %d: %s", i, err)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// ValidateProposalMessages checks that every message decodes, is routable and
+// is signed only by the governance module address
+func (k Keeper) ValidateProposalMessages(anys []*anypb.Any) ([]sdk.Msg, error) {
+	msgs, err := k.UnpackProposalMessages(anys)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, msg := range msgs {
+		signers, err := msgSigners(msg)
+		if err != nil {
+			return nil, errors.Wrapf(types.ErrInvalidProposalMsg, "message %d: %s", i, err)
+		}
+		for _, signer := range signers {
+			if signer != k.authority {
+				return nil, errors.Wrapf(types.ErrInvalidSigner, "message %d: expected %s, got %s", i, k.authority, signer)
+			}
+		}
+
+		if k.router != nil && k.router.Handler(msg) == nil {
+			return nil, errors.Wrapf(types.ErrInvalidProposalMsg, "message %d: no handler for %s", i, sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return msgs, nil
+}
+
+// ExecuteProposalMessages runs the proposal messages atomically: either every
+// message succeeds and its state changes and events are committed, or none are
+func (k Keeper) ExecuteProposalMessages(ctx sdk.Context, anys []*anypb.Any) error {
+	msgs, err := k.ValidateProposalMessages(anys)
+	if err != nil {
+		return err
+	}
+	if len(msgs) > 0 && k.router == nil {
+		return errors.Wrap(types.ErrInvalidProposalMsg, "message router not set")
+	}
+
+	// Events are held back with the state changes, a failed proposal emits none
+	cacheCtx, write := ctx.CacheContext()
+	var events sdk.Events
+	for i, msg := range msgs {
+		res, err := k.router.Handler(msg)(cacheCtx, msg)
+		if err != nil {
+			return errors.Wrapf(err, "proposal message %d (%s) failed", i, sdk.MsgTypeURL(msg))
+		}
+		if res != nil {
+			for _, e := range res.Events {
+				events = append(events, sdk.Event(e))
+			}
+		}
+	}
+	write()
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
+
+// msgSigners returns the values of the fields named by the cosmos.msg.v1.signer option
+func msgSigners(msg sdk.Msg) ([]string, error) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrInvalidProposalMsg, "%T is not a protobuf message", msg)
+	}
+
+	refl := m.ProtoReflect()
+	desc := refl.Descriptor()
+	fields, _ := proto.GetExtension(desc.Options(), msgv1.E_Signer).([]string)
+	if len(fields) == 0 {
+		return nil, errors.Wrapf(types.ErrInvalidProposalMsg, "%s has no signer option", desc.FullName())
+	}
+
+	signers := make([]string, 0, len(fields))
+	for _, name := range fields {
+		fd := desc.Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+			return nil, errors.Wrapf(types.ErrInvalidProposalMsg, "%s: unsupported signer field %q", desc.FullName(), name)
+		}
+		signers = append(signers, refl.Get(fd).String())
+	}
+	return signers, nil
+}
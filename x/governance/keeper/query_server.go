@@ -6,7 +6,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
-	"github.com/volnix-protocol/volnix-protocol/x/governance/types"
 )
 
 type QueryServer struct {
@@ -32,29 +31,18 @@ func (qs QueryServer) Proposal(ctx context.Context, req *governancev1.QueryPropo
 	}, nil
 }
 
-// Proposals queries all proposals (optionally filtered by status)
+// Proposals queries a page of proposals (optionally filtered by status)
 func (qs QueryServer) Proposals(ctx context.Context, req *governancev1.QueryProposalsRequest) (*governancev1.QueryProposalsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	allProposals, err := qs.k.GetAllProposals(sdkCtx)
+	proposals, pageRes, err := qs.k.GetProposalsPage(sdkCtx, req.GetStatus(), req.GetPagination())
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter by status if specified
-	var proposals []*governancev1.Proposal
-	if req.Status == governancev1.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED {
-		proposals = allProposals
-	} else {
-		for _, proposal := range allProposals {
-			if proposal.Status == req.Status {
-				proposals = append(proposals, proposal)
-			}
-		}
-	}
-
 	return &governancev1.QueryProposalsResponse{
-		Proposals: proposals,
+		Proposals:  proposals,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -72,17 +60,18 @@ func (qs QueryServer) Vote(ctx context.Context, req *governancev1.QueryVoteReque
 	}, nil
 }
 
-// Votes queries all votes on a proposal
+// Votes queries a page of the votes on a proposal
 func (qs QueryServer) Votes(ctx context.Context, req *governancev1.QueryVotesRequest) (*governancev1.QueryVotesResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	votes, err := qs.k.GetVotes(sdkCtx, req.ProposalId)
+	votes, pageRes, err := qs.k.GetVotesPage(sdkCtx, req.GetProposalId(), req.GetPagination())
 	if err != nil {
 		return nil, err
 	}
 
 	return &governancev1.QueryVotesResponse{
-		Votes: votes,
+		Votes:      votes,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -90,19 +79,8 @@ func (qs QueryServer) Votes(ctx context.Context, req *governancev1.QueryVotesReq
 func (qs QueryServer) Params(ctx context.Context, req *governancev1.QueryParamsRequest) (*governancev1.QueryParamsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	params := qs.k.GetParams(sdkCtx)
-
-	// Convert types.Params to governancev1.Params
-	govParams := &governancev1.Params{
-		VotingPeriod:  types.DurationToProto(params.VotingPeriod),
-		TimelockPeriod: types.DurationToProto(params.TimelockPeriod),
-		MinDeposit:    params.MinDeposit,
-		Quorum:        params.Quorum,
-		Threshold:     params.Threshold,
-	}
-
 	return &governancev1.QueryParamsResponse{
-		Params: govParams,
+		Params: qs.k.GetParams(sdkCtx).ToProto(),
 	}, nil
 }
 
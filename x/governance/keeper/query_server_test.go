@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -297,6 +298,73 @@ func (suite *QueryServerTestSuite) TestQueryVotes() {
 	require.Len(suite.T(), resp.Votes, 2, "Should have 2 votes")
 }
 
+func (suite *QueryServerTestSuite) TestQueryVotes_Pagination() {
+	// Votes on another proposal share the vote prefix but are not returned
+	for _, proposalID := range []uint64{1, 2} {
+		for i := 0; i < 3; i++ {
+			err := suite.keeper.SetVote(suite.ctx, &governancev1.Vote{
+				ProposalId:  proposalID,
+				Voter:       sdk.AccAddress("test_voter" + string(rune('0'+i)) + "_1234567890123456789012").String(),
+				Option:      governancev1.VoteOption_VOTE_OPTION_YES,
+				VotingPower: "1000000",
+				VoteTime:    timestamppb.Now(),
+			})
+			require.NoError(suite.T(), err)
+		}
+	}
+
+	req := &governancev1.QueryVotesRequest{
+		ProposalId: 2,
+		Pagination: &sdkquery.PageRequest{Limit: 2, CountTotal: true},
+	}
+	resp, err := suite.queryServer.Votes(suite.ctx, req)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.Votes, 2)
+	require.Equal(suite.T(), uint64(3), resp.Pagination.Total)
+	for _, vote := range resp.Votes {
+		require.Equal(suite.T(), uint64(2), vote.ProposalId)
+	}
+
+	req.Pagination = &sdkquery.PageRequest{Key: resp.Pagination.NextKey}
+	resp, err = suite.queryServer.Votes(suite.ctx, req)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.Votes, 1)
+	require.Equal(suite.T(), uint64(2), resp.Votes[0].ProposalId)
+	require.Nil(suite.T(), resp.Pagination.NextKey)
+}
+
+func (suite *QueryServerTestSuite) TestQueryProposals_StatusFilterWithPagination() {
+	for id := uint64(1); id <= 5; id++ {
+		status := governancev1.ProposalStatus_PROPOSAL_STATUS_VOTING
+		if id%2 == 0 {
+			status = governancev1.ProposalStatus_PROPOSAL_STATUS_PASSED
+		}
+		err := suite.keeper.SetProposal(suite.ctx, &governancev1.Proposal{
+			ProposalId:   id,
+			Proposer:     sdk.AccAddress("test_proposer_12345678901234567890").String(),
+			Title:        "Proposal",
+			Description:  "Paginated proposal",
+			Status:       status,
+			SubmitTime:   timestamppb.Now(),
+			YesVotes:     "0",
+			NoVotes:      "0",
+			AbstainVotes: "0",
+			TotalVotes:   "0",
+		})
+		require.NoError(suite.T(), err)
+	}
+
+	// The second page of voting proposals in proposal ID order
+	resp, err := suite.queryServer.Proposals(suite.ctx, &governancev1.QueryProposalsRequest{
+		Status:     governancev1.ProposalStatus_PROPOSAL_STATUS_VOTING,
+		Pagination: &sdkquery.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.Proposals, 1)
+	require.Equal(suite.T(), uint64(3), resp.Proposals[0].ProposalId)
+	require.Equal(suite.T(), uint64(3), resp.Pagination.Total)
+}
+
 // Test QueryParams
 func (suite *QueryServerTestSuite) TestQueryParams() {
 	req := &governancev1.QueryParamsRequest{}
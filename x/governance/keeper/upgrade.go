@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/governance/types"
+)
+
+// UpgradePlan is the software upgrade plan scheduled through governance
+type UpgradePlan = governancev1.UpgradePlan
+
+// ScheduleUpgrade stores an upgrade plan; plans are only scheduled by the governance
+// authority through MsgScheduleUpgrade
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan *UpgradePlan) error {
+	if plan == nil || strings.TrimSpace(plan.Name) == "" {
+		return errors.Wrap(types.ErrInvalidUpgradePlan, "name cannot be empty")
+	}
+	if plan.Height <= ctx.BlockHeight() {
+		return errors.Wrapf(types.ErrInvalidUpgradePlan, "height %d must be after the current height %d", plan.Height, ctx.BlockHeight())
+	}
+	if height, done := k.GetDoneUpgradeHeight(ctx, plan.Name); done {
+		return errors.Wrapf(types.ErrInvalidUpgradePlan, "upgrade %s was already applied at height %d", plan.Name, height)
+	}
+	if existing, found := k.GetUpgradePlan(ctx, plan.Height); found {
+		return errors.Wrapf(types.ErrInvalidUpgradePlan, "upgrade %s is already scheduled at height %d", existing.Name, plan.Height)
+	}
+	if existing, found := k.GetUpgradePlanByName(ctx, plan.Name); found {
+		return errors.Wrapf(types.ErrInvalidUpgradePlan, "upgrade %s is already scheduled at height %d", plan.Name, existing.Height)
+	}
+
+	bz, err := k.cdc.Marshal(plan)
+	if err != nil {
+		return fmt.Errorf("failed to marshal upgrade plan: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetUpgradePlanKey(plan.Height), bz)
+	return nil
+}
+
+// CancelUpgrade removes the scheduled upgrade plan with the given name
+func (k Keeper) CancelUpgrade(ctx sdk.Context, name string) error {
+	plan, found := k.GetUpgradePlanByName(ctx, name)
+	if !found {
+		return errors.Wrapf(types.ErrUpgradePlanNotFound, "no upgrade named %s is scheduled", name)
+	}
+	k.DeleteUpgradePlan(ctx, plan.Height)
+	return nil
+}
+
+// GetUpgradePlan returns the upgrade plan scheduled at a height
+func (k Keeper) GetUpgradePlan(ctx sdk.Context, height int64) (*UpgradePlan, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetUpgradePlanKey(height))
+	if bz == nil {
+		return nil, false
+	}
+	var plan UpgradePlan
+	if err := k.cdc.Unmarshal(bz, &plan); err != nil {
+		return nil, false
+	}
+	return &plan, true
+}
+
+// GetUpgradePlanByName returns the scheduled upgrade plan with the given name
+func (k Keeper) GetUpgradePlanByName(ctx sdk.Context, name string) (*UpgradePlan, bool) {
+	for _, plan := range k.GetUpgradePlans(ctx) {
+		if plan.Name == name {
+			return plan, true
+		}
+	}
+	return nil, false
+}
+
+// GetUpgradePlans returns all scheduled upgrade plans ordered by height
+func (k Keeper) GetUpgradePlans(ctx sdk.Context) []*UpgradePlan {
+	planStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradePlanKeyPrefix)
+
+	var plans []*UpgradePlan
+	iterator := planStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var plan UpgradePlan
+		if err := k.cdc.Unmarshal(iterator.Value(), &plan); err != nil {
+			continue // Skip invalid plans
+		}
+		plans = append(plans, &plan)
+	}
+
+	return plans
+}
+
+// DeleteUpgradePlan removes the upgrade plan scheduled at a height
+func (k Keeper) DeleteUpgradePlan(ctx sdk.Context, height int64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetUpgradePlanKey(height))
+}
+
+// SetUpgradeDone records the height at which an upgrade was applied
+func (k Keeper) SetUpgradeDone(ctx sdk.Context, name string, height int64) {
+	ctx.KVStore(k.storeKey).Set(types.GetDoneUpgradeKey(name), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetDoneUpgradeHeight returns the height at which an upgrade was applied
+func (k Keeper) GetDoneUpgradeHeight(ctx sdk.Context, name string) (int64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDoneUpgradeKey(name))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetModuleVersionMap stores the consensus versions of the app modules, which upgrades migrate from
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
+	store := ctx.KVStore(k.storeKey)
+	for name, version := range vm {
+		store.Set(types.GetModuleVersionKey(name), sdk.Uint64ToBigEndian(version))
+	}
+}
+
+// GetModuleVersionMap returns the stored consensus versions of the app modules
+// The map is empty on chains that started before versions were stored
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) module.VersionMap {
+	versionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ModuleVersionKeyPrefix)
+
+	vm := make(module.VersionMap)
+	iterator := versionStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		vm[string(iterator.Key())] = sdk.BigEndianToUint64(iterator.Value())
+	}
+
+	return vm
+}
@@ -1,6 +1,7 @@
 package governance
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -9,12 +10,16 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
 	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	"github.com/volnix-protocol/volnix-protocol/testutil/simtx"
 	"github.com/volnix-protocol/volnix-protocol/x/governance/client/cli"
 	"github.com/volnix-protocol/volnix-protocol/x/governance/keeper"
+	governancesim "github.com/volnix-protocol/volnix-protocol/x/governance/simulation"
 	"github.com/volnix-protocol/volnix-protocol/x/governance/types"
 )
 
@@ -58,11 +63,11 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 	return ValidateGenesis(&genState)
 }
 
-// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the governance module
+// RegisterGRPCGatewayRoutes registers the REST routes of the query service under /volnix/governance/v1
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	// BaseApp automatically registers routes from proto annotations
-	// If manual registration is needed, it would be done here using:
-	// governancev1.RegisterQueryHandlerClient(context.Background(), mux, governancev1.NewQueryClient(clientCtx))
+	if err := governancev1.RegisterQueryHandlerClient(context.Background(), mux, governancev1.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns the root tx command for the governance module
@@ -78,16 +83,27 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 // AppModule implements the AppModule interface for the governance module
 type AppModule struct {
 	AppModuleBasic
-	keeper *keeper.Keeper
+	cdc           codec.Codec
+	keeper        *keeper.Keeper
+	accountKeeper simulation.AccountKeeper
+	bankKeeper    simulation.BankKeeper
+	identKeeper   simtx.IdentKeeper
 }
 
-var _ module.AppModule = AppModule{}
+var (
+	_ module.AppModule           = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
+)
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(keeper *keeper.Keeper) AppModule {
+func NewAppModule(cdc codec.Codec, keeper *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
-		keeper:          keeper,
+		cdc:            cdc,
+		keeper:         keeper,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		identKeeper:    ik,
 	}
 }
 
@@ -97,9 +113,12 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	governancev1.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
 }
 
+// ConsensusVersion is the consensus version of the governance store, bumped with every store migration
+func (am AppModule) ConsensusVersion() uint64 { return 1 }
+
 // RegisterInvariants registers the governance module invariants
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// Register invariants if needed
+	keeper.RegisterInvariants(ir, *am.keeper)
 }
 
 // InitGenesis performs genesis initialization for the governance module
@@ -121,6 +140,21 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return bz
 }
 
+// GenerateGenesisState creates a randomized GenState of the governance module
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	governancesim.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for governance module's types
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = governancesim.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the all the governance module operations with their respective weights
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return governancesim.WeightedOperations(simState.AppParams, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.identKeeper, am.keeper)
+}
+
 // IsAppModule implements the module.AppModule interface
 func (am AppModule) IsAppModule() {}
 
@@ -0,0 +1,47 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/governance/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding governance type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.ProposalKeyPrefix):
+			var proposalA, proposalB governancev1.Proposal
+			cdc.MustUnmarshal(kvA.Value, &proposalA)
+			cdc.MustUnmarshal(kvB.Value, &proposalB)
+			return fmt.Sprintf("%v\n%v", &proposalA, &proposalB)
+
+		case bytes.HasPrefix(kvA.Key, types.VoteKeyPrefix):
+			var voteA, voteB governancev1.Vote
+			cdc.MustUnmarshal(kvA.Value, &voteA)
+			cdc.MustUnmarshal(kvB.Value, &voteB)
+			return fmt.Sprintf("%v\n%v", &voteA, &voteB)
+
+		case bytes.HasPrefix(kvA.Key, types.UpgradePlanKeyPrefix):
+			var planA, planB governancev1.UpgradePlan
+			cdc.MustUnmarshal(kvA.Value, &planA)
+			cdc.MustUnmarshal(kvB.Value, &planB)
+			return fmt.Sprintf("%v\n%v", &planA, &planB)
+
+		case bytes.Equal(kvA.Key, types.ProposalIDKey),
+			bytes.HasPrefix(kvA.Key, types.DoneUpgradeKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.ModuleVersionKeyPrefix):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
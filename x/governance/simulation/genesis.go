@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/governance/types"
+)
+
+// Simulation parameter keys
+const (
+	VotingPeriod   = "voting_period"
+	TimelockPeriod = "timelock_period"
+	MinDeposit     = "min_deposit"
+	Quorum         = "quorum"
+	Threshold      = "threshold"
+)
+
+// RandomizedGenState generates a random genesis state for the governance module
+// Periods are a few hours and the quorum is low so proposals pass and execute within a simulation
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(VotingPeriod, &params.VotingPeriod, simState.Rand,
+		func(r *rand.Rand) { params.VotingPeriod = time.Duration(r.Intn(48)+2) * time.Hour })
+	simState.AppParams.GetOrGenerate(TimelockPeriod, &params.TimelockPeriod, simState.Rand,
+		func(r *rand.Rand) { params.TimelockPeriod = time.Duration(r.Intn(24)+1) * time.Hour })
+
+	var minDeposit uint64
+	simState.AppParams.GetOrGenerate(MinDeposit, &minDeposit, simState.Rand,
+		func(r *rand.Rand) { minDeposit = uint64(r.Intn(10)+1) * 100000 })
+	params.MinDeposit = strconv.FormatUint(minDeposit, 10)
+
+	var quorumBps, thresholdPct int
+	simState.AppParams.GetOrGenerate(Quorum, &quorumBps, simState.Rand,
+		func(r *rand.Rand) { quorumBps = r.Intn(10) + 1 })
+	params.Quorum = strconv.FormatFloat(float64(quorumBps)/10000, 'f', -1, 64)
+	simState.AppParams.GetOrGenerate(Threshold, &thresholdPct, simState.Rand,
+		func(r *rand.Rand) { thresholdPct = r.Intn(31) + 40 })
+	params.Threshold = strconv.FormatFloat(float64(thresholdPct)/100, 'f', -1, 64)
+
+	genesis := &types.GenesisState{
+		Params:         params.ToProto(),
+		Proposals:      []*governancev1.Proposal{},
+		Votes:          []*governancev1.Vote{},
+		NextProposalId: 1,
+	}
+	bz, err := json.Marshal(genesis)
+	if err != nil {
+		panic(err)
+	}
+	simState.GenState[types.ModuleName] = bz
+}
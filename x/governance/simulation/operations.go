@@ -0,0 +1,192 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	"github.com/volnix-protocol/volnix-protocol/testutil/simtx"
+	"github.com/volnix-protocol/volnix-protocol/x/governance/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/governance/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSubmitProposal  = "op_weight_msg_submit_proposal"
+	OpWeightMsgVote            = "op_weight_msg_vote"
+	OpWeightMsgExecuteProposal = "op_weight_msg_execute_proposal"
+
+	DefaultWeightMsgSubmitProposal  = 10
+	DefaultWeightMsgVote            = 30
+	DefaultWeightMsgExecuteProposal = 10
+)
+
+// WeightedOperations returns all the operations from the governance module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	ik simtx.IdentKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgSubmitProposal, weightMsgVote, weightMsgExecuteProposal int
+	appParams.GetOrGenerate(OpWeightMsgSubmitProposal, &weightMsgSubmitProposal, nil,
+		func(_ *rand.Rand) { weightMsgSubmitProposal = DefaultWeightMsgSubmitProposal })
+	appParams.GetOrGenerate(OpWeightMsgVote, &weightMsgVote, nil,
+		func(_ *rand.Rand) { weightMsgVote = DefaultWeightMsgVote })
+	appParams.GetOrGenerate(OpWeightMsgExecuteProposal, &weightMsgExecuteProposal, nil,
+		func(_ *rand.Rand) { weightMsgExecuteProposal = DefaultWeightMsgExecuteProposal })
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgSubmitProposal, SimulateMsgSubmitProposal(txGen, ak, bk, ik, k)),
+		simulation.NewWeightedOperation(weightMsgVote, SimulateMsgVote(txGen, ak, bk, ik, k)),
+		simulation.NewWeightedOperation(weightMsgExecuteProposal, SimulateMsgExecuteProposal(txGen, ak, bk, ik, k)),
+	}
+}
+
+// SimulateMsgSubmitProposal generates a text MsgSubmitProposal from a random account
+// with a deposit of one to two times the minimum
+func SimulateMsgSubmitProposal(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&governancev1.MsgSubmitProposal{})
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		minDeposit, err := strconv.ParseUint(k.GetParams(ctx).MinDeposit, 10, 64)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid minimum deposit"), nil, err
+		}
+		deposit := minDeposit + uint64(r.Int63n(int64(minDeposit)+1))
+
+		msg := &governancev1.MsgSubmitProposal{
+			Proposer:     simAccount.Address.String(),
+			ProposalType: governancev1.ProposalType_PROPOSAL_TYPE_TEXT,
+			Title:        simtypes.RandStringOfLength(r, 20),
+			Description:  simtypes.RandStringOfLength(r, 100),
+			Deposit:      strconv.FormatUint(deposit, 10),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
+
+// SimulateMsgVote generates a MsgVote with a random option on a proposal open for voting
+// from an account that has not voted on it
+func SimulateMsgVote(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&governancev1.MsgVote{})
+
+		proposals, err := k.GetAllProposals(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read proposals"), nil, err
+		}
+		var open []*governancev1.Proposal
+		for _, proposal := range proposals {
+			switch proposal.Status {
+			case governancev1.ProposalStatus_PROPOSAL_STATUS_VOTING:
+				open = append(open, proposal)
+			case governancev1.ProposalStatus_PROPOSAL_STATUS_SUBMITTED:
+				if ctx.BlockTime().After(proposal.VotingStartTime.AsTime()) {
+					open = append(open, proposal)
+				}
+			}
+		}
+		if len(open) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no proposals open for voting"), nil, nil
+		}
+		proposal := open[r.Intn(len(open))]
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if _, err := k.GetVote(ctx, proposal.ProposalId, simAccount.Address.String()); err == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account already voted"), nil, nil
+		}
+
+		msg := &governancev1.MsgVote{
+			ProposalId: proposal.ProposalId,
+			Voter:      simAccount.Address.String(),
+			Option:     governancev1.VoteOption(r.Intn(3) + 1),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
+
+// SimulateMsgExecuteProposal generates a MsgExecuteProposal from a random account
+// for a passed proposal whose timelock has expired
+func SimulateMsgExecuteProposal(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&governancev1.MsgExecuteProposal{})
+
+		proposals, err := k.GetAllProposals(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to read proposals"), nil, err
+		}
+		var executable []*governancev1.Proposal
+		for _, proposal := range proposals {
+			if canExecute, err := k.CanExecuteProposal(ctx, proposal.ProposalId); err == nil && canExecute {
+				executable = append(executable, proposal)
+			}
+		}
+		if len(executable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no executable proposals"), nil, nil
+		}
+		proposal := executable[r.Intn(len(executable))]
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &governancev1.MsgExecuteProposal{
+			ProposalId: proposal.ProposalId,
+			Executor:   simAccount.Address.String(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
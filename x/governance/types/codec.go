@@ -14,10 +14,16 @@ func RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 		&governancev1.MsgSubmitProposal{},
 		&governancev1.MsgVote{},
 		&governancev1.MsgExecuteProposal{},
+		&governancev1.MsgUpdateParams{},
+		&governancev1.MsgScheduleUpgrade{},
+		&governancev1.MsgCancelUpgrade{},
 	)
 	reg.RegisterImplementations((*txtypes.MsgResponse)(nil),
 		&governancev1.MsgSubmitProposalResponse{},
 		&governancev1.MsgVoteResponse{},
 		&governancev1.MsgExecuteProposalResponse{},
+		&governancev1.MsgUpdateParamsResponse{},
+		&governancev1.MsgScheduleUpgradeResponse{},
+		&governancev1.MsgCancelUpgradeResponse{},
 	)
 }
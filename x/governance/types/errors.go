@@ -55,5 +55,23 @@ var (
 
 	// ErrConstitutionalParameter indicates that the parameter is constitutional and cannot be changed
 	ErrConstitutionalParameter = errors.Register(ModuleName, 16, "parameter is constitutional and cannot be changed via governance")
+
+	// ErrInvalidProposalMsg indicates that a proposal message cannot be executed
+	ErrInvalidProposalMsg = errors.Register(ModuleName, 18, "invalid proposal message")
+
+	// ErrInvalidSigner indicates that a proposal message is not signed by the governance module address
+	ErrInvalidSigner = errors.Register(ModuleName, 19, "proposal message signer is not the governance module address")
+
+	// ErrUnauthorized indicates that the signer is not the module authority
+	ErrUnauthorized = errors.Register(ModuleName, 20, "unauthorized")
+
+	// ErrInvalidParams indicates that the module parameters are invalid
+	ErrInvalidParams = errors.Register(ModuleName, 21, "invalid params")
+
+	// ErrInvalidUpgradePlan indicates that an upgrade plan cannot be scheduled
+	ErrInvalidUpgradePlan = errors.Register(ModuleName, 22, "invalid upgrade plan")
+
+	// ErrUpgradePlanNotFound indicates that no upgrade plan with the name is scheduled
+	ErrUpgradePlanNotFound = errors.Register(ModuleName, 23, "upgrade plan not found")
 )
 
@@ -27,6 +27,15 @@ var (
 
 	// ProposalIDKey defines the key for storing the next proposal ID
 	ProposalIDKey = []byte{0x03}
+
+	// UpgradePlanKeyPrefix defines the prefix for scheduled upgrade plans, keyed by height
+	UpgradePlanKeyPrefix = []byte{0x04}
+
+	// DoneUpgradeKeyPrefix defines the prefix for the heights at which upgrades were applied
+	DoneUpgradeKeyPrefix = []byte{0x05}
+
+	// ModuleVersionKeyPrefix defines the prefix for the consensus versions of the app modules
+	ModuleVersionKeyPrefix = []byte{0x06}
 )
 
 // GetProposalKey returns the key for a proposal
@@ -39,3 +48,23 @@ func GetVoteKey(proposalID uint64, voter string) []byte {
 	return append(VoteKeyPrefix, append(sdk.Uint64ToBigEndian(proposalID), []byte(voter)...)...)
 }
 
+
+// GetProposalVotesPrefix returns the key prefix for the votes on a proposal
+func GetProposalVotesPrefix(proposalID uint64) []byte {
+	return append(append([]byte{}, VoteKeyPrefix...), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetUpgradePlanKey returns the key for the upgrade plan scheduled at a height
+func GetUpgradePlanKey(height int64) []byte {
+	return append(UpgradePlanKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetDoneUpgradeKey returns the key for an applied upgrade
+func GetDoneUpgradeKey(name string) []byte {
+	return append(DoneUpgradeKeyPrefix, []byte(name)...)
+}
+
+// GetModuleVersionKey returns the key for the consensus version of a module
+func GetModuleVersionKey(moduleName string) []byte {
+	return append(ModuleVersionKeyPrefix, []byte(moduleName)...)
+}
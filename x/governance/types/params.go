@@ -2,8 +2,10 @@ package types
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
+	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -71,12 +73,33 @@ func (p Params) Validate() error {
 	if p.MinDeposit == "" {
 		return fmt.Errorf("min deposit cannot be empty")
 	}
+	if _, err := strconv.ParseUint(p.MinDeposit, 10, 64); err != nil {
+		return fmt.Errorf("invalid min deposit %q: %w", p.MinDeposit, err)
+	}
 	if p.Quorum == "" {
 		return fmt.Errorf("quorum cannot be empty")
 	}
+	if err := validateFraction("quorum", p.Quorum); err != nil {
+		return err
+	}
 	if p.Threshold == "" {
 		return fmt.Errorf("threshold cannot be empty")
 	}
+	if err := validateFraction("threshold", p.Threshold); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateFraction checks that a decimal string lies in [0, 1]
+func validateFraction(name, value string) error {
+	dec, err := math.LegacyNewDecFromStr(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s %q: %w", name, value, err)
+	}
+	if dec.IsNegative() || dec.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s must be between 0 and 1, got %s", name, value)
+	}
 	return nil
 }
 
@@ -0,0 +1,30 @@
+package types
+
+import (
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+)
+
+// ToProto converts local Params to protobuf Params
+func (p Params) ToProto() *governancev1.Params {
+	return &governancev1.Params{
+		VotingPeriod:   DurationToProto(p.VotingPeriod),
+		TimelockPeriod: DurationToProto(p.TimelockPeriod),
+		MinDeposit:     p.MinDeposit,
+		Quorum:         p.Quorum,
+		Threshold:      p.Threshold,
+	}
+}
+
+// ParamsFromProto converts protobuf Params to local Params
+func ParamsFromProto(pp *governancev1.Params) Params {
+	if pp == nil {
+		return DefaultParams()
+	}
+	return Params{
+		VotingPeriod:   ProtoToDuration(pp.VotingPeriod),
+		TimelockPeriod: ProtoToDuration(pp.TimelockPeriod),
+		MinDeposit:     pp.MinDeposit,
+		Quorum:         pp.Quorum,
+		Threshold:      pp.Threshold,
+	}
+}
@@ -72,6 +72,39 @@ func TestParams_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "non-numeric min deposit",
+			params: Params{
+				VotingPeriod:  7 * 24 * time.Hour,
+				TimelockPeriod: 14 * 24 * time.Hour,
+				MinDeposit:    "lots",
+				Quorum:        "0.4",
+				Threshold:     "0.5",
+			},
+			wantErr: true,
+		},
+		{
+			name: "quorum above one",
+			params: Params{
+				VotingPeriod:  7 * 24 * time.Hour,
+				TimelockPeriod: 14 * 24 * time.Hour,
+				MinDeposit:    "1000000",
+				Quorum:        "1.5",
+				Threshold:     "0.5",
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-decimal threshold",
+			params: Params{
+				VotingPeriod:  7 * 24 * time.Hour,
+				TimelockPeriod: 14 * 24 * time.Hour,
+				MinDeposit:    "1000000",
+				Quorum:        "0.4",
+				Threshold:     "half",
+			},
+			wantErr: true,
+		},
 		{
 			name: "empty threshold",
 			params: Params{
@@ -1,6 +1,8 @@
 package ident
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/ident/keeper"
@@ -24,7 +26,119 @@ func Validate(gen *identv1.GenesisState) error {
 	if gen == nil {
 		return identtypes.DefaultParams().Validate()
 	}
-	return identtypes.ParamsFromProto(gen.Params).Validate()
+	if err := identtypes.ParamsFromProto(gen.Params).Validate(); err != nil {
+		return err
+	}
+
+	// Verifications and proofs are not stored, only their nullifiers and proof records
+	if len(gen.IdentityVerifications) > 0 || len(gen.ZkpProofs) > 0 {
+		return fmt.Errorf("identity verifications and ZKP proofs are not stored by the ident module")
+	}
+
+	accounts := make(map[string]bool, len(gen.VerifiedAccounts))
+	for _, account := range gen.VerifiedAccounts {
+		if account == nil {
+			return fmt.Errorf("nil verified account")
+		}
+		if err := identtypes.ValidateAccount(account); err != nil {
+			return fmt.Errorf("invalid verified account %s: %w", account.Address, err)
+		}
+		if accounts[account.Address] {
+			return fmt.Errorf("duplicate verified account %s", account.Address)
+		}
+		accounts[account.Address] = true
+	}
+
+	migrations := make(map[string]bool, len(gen.RoleMigrations))
+	for _, migration := range gen.RoleMigrations {
+		if migration == nil || migration.FromAddress == "" || migration.ToAddress == "" {
+			return fmt.Errorf("role migration without addresses")
+		}
+		key := string(identtypes.GetRoleMigrationKey(migration.FromAddress, migration.ToAddress))
+		if migrations[key] {
+			return fmt.Errorf("duplicate role migration from %s to %s", migration.FromAddress, migration.ToAddress)
+		}
+		migrations[key] = true
+	}
+
+	nullifiers := make(map[string]bool, len(gen.Nullifiers))
+	for _, nullifier := range gen.Nullifiers {
+		if nullifier == nil || len(nullifier.Nullifier) == 0 {
+			return fmt.Errorf("empty nullifier")
+		}
+		if nullifiers[string(nullifier.Nullifier)] {
+			return fmt.Errorf("duplicate nullifier %X", nullifier.Nullifier)
+		}
+		nullifiers[string(nullifier.Nullifier)] = true
+	}
+
+	providers := make(map[string]bool, len(gen.VerificationProviders))
+	for _, provider := range gen.VerificationProviders {
+		if provider == nil || provider.ProviderId == "" {
+			return fmt.Errorf("verification provider without ID")
+		}
+		if providers[provider.ProviderId] {
+			return fmt.Errorf("duplicate verification provider %s", provider.ProviderId)
+		}
+		providers[provider.ProviderId] = true
+	}
+
+	accreditations := make(map[string]bool, len(gen.Accreditations))
+	for _, accreditation := range gen.Accreditations {
+		if accreditation == nil || accreditation.AccreditationHash == "" {
+			return fmt.Errorf("accreditation without hash")
+		}
+		if accreditations[accreditation.AccreditationHash] {
+			return fmt.Errorf("duplicate accreditation %s", accreditation.AccreditationHash)
+		}
+		accreditations[accreditation.AccreditationHash] = true
+	}
+
+	records := make(map[string]bool, len(gen.VerificationRecords))
+	for _, record := range gen.VerificationRecords {
+		if record == nil || record.Address == "" {
+			return fmt.Errorf("verification record without address")
+		}
+		if records[record.Address] {
+			return fmt.Errorf("duplicate verification record for %s", record.Address)
+		}
+		records[record.Address] = true
+	}
+
+	proofs := make(map[string]bool, len(gen.ProofRecords))
+	for _, proof := range gen.ProofRecords {
+		if proof == nil || len(proof.ProofHash) == 0 {
+			return fmt.Errorf("proof record without hash")
+		}
+		if proofs[string(proof.ProofHash)] {
+			return fmt.Errorf("duplicate proof record %X", proof.ProofHash)
+		}
+		proofs[string(proof.ProofHash)] = true
+	}
+
+	identityHashes := make(map[string]bool, len(gen.IdentityHashes))
+	for _, identityHash := range gen.IdentityHashes {
+		if identityHash == nil || identityHash.IdentityHash == "" || identityHash.Address == "" {
+			return fmt.Errorf("identity hash without hash or address")
+		}
+		if identityHashes[identityHash.IdentityHash] {
+			return fmt.Errorf("duplicate identity hash %s", identityHash.IdentityHash)
+		}
+		identityHashes[identityHash.IdentityHash] = true
+	}
+
+	usages := make(map[string]bool, len(gen.FreeTxUsages))
+	for _, usage := range gen.FreeTxUsages {
+		if usage == nil || usage.Address == "" {
+			return fmt.Errorf("free tx usage without address")
+		}
+		if usages[usage.Address] {
+			return fmt.Errorf("duplicate free tx usage for %s", usage.Address)
+		}
+		usages[usage.Address] = true
+	}
+
+	return nil
 }
 
 // InitGenesis initializes the module state from protobuf genesis data
@@ -32,19 +146,10 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState *identv1.GenesisSta
 	if genState == nil {
 		genState = DefaultGenesis()
 	}
-	k.SetParams(ctx, identtypes.ParamsFromProto(genState.Params))
-	// accounts population would be implemented later
+	k.InitGenesis(ctx, genState)
 }
 
 // ExportGenesis exports the current state as protobuf genesis
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *identv1.GenesisState {
-	params := k.GetParams(ctx)
-	return &identv1.GenesisState{
-		Params:                 params.ToProto(),
-		VerifiedAccounts:       []*identv1.VerifiedAccount{},
-		IdentityVerifications:  []*identv1.IdentityVerification{},
-		RoleMigrations:         []*identv1.RoleMigration{},
-		VerificationProviders:  []*identv1.VerificationProvider{},
-		ZkpProofs:              []*identv1.ZKPProof{},
-	}
+	return k.ExportGenesis(ctx)
 }
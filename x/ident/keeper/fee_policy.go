@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+// GetFeeRole returns the role whose fee policy applies to an address
+// Addresses without an active verified account are treated as guests
+func (k Keeper) GetFeeRole(ctx sdk.Context, address string) identv1.Role {
+	account, err := k.GetVerifiedAccount(ctx, address)
+	if err != nil || !account.IsActive {
+		return identv1.Role_ROLE_GUEST
+	}
+	return account.Role
+}
+
+// GetFreeTxUsage returns the fee-free transactions an address sent on the current day
+func (k Keeper) GetFreeTxUsage(ctx sdk.Context, address string) (*identv1.FreeTxUsage, error) {
+	day := blockDay(ctx)
+	usage := &identv1.FreeTxUsage{Address: address, Day: day}
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFreeTxUsageKey(address))
+	if bz == nil {
+		return usage, nil
+	}
+	var stored identv1.FreeTxUsage
+	if err := k.cdc.Unmarshal(bz, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal free tx usage: %w", err)
+	}
+	// The quota resets when the day changes
+	if stored.Day == day {
+		usage.Count = stored.Count
+	}
+	return usage, nil
+}
+
+// UseFreeTransaction draws one transaction with the given gas limit from the daily fee-free quota of a citizen
+// It returns false when the address is not an active citizen, the gas limit exceeds MaxFreeTxGas
+// or the quota is used up
+func (k Keeper) UseFreeTransaction(ctx sdk.Context, address string, gas uint64) (bool, error) {
+	params := k.GetParams(ctx)
+	quota := params.CitizenDailyFreeTxs
+	if quota == 0 || gas > params.MaxFreeTxGas || k.GetFeeRole(ctx, address) != identv1.Role_ROLE_CITIZEN {
+		return false, nil
+	}
+
+	usage, err := k.GetFreeTxUsage(ctx, address)
+	if err != nil {
+		return false, err
+	}
+	if usage.Count >= quota {
+		return false, nil
+	}
+
+	usage.Count++
+	bz, err := k.cdc.Marshal(usage)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal free tx usage: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetFreeTxUsageKey(address), bz)
+	return true, nil
+}
+
+// blockDay returns the days since the Unix epoch at the block time
+func blockDay(ctx sdk.Context) uint64 {
+	seconds := ctx.BlockTime().Unix()
+	if seconds < 0 {
+		return 0
+	}
+	return uint64(seconds / secondsPerDay)
+}
@@ -0,0 +1,244 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+// InitGenesis initializes the ident state from a genesis state
+// Accounts are written without the checks of SetVerifiedAccount; the identity hash index is imported as exported.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *identv1.GenesisState) {
+	k.SetParams(ctx, types.ParamsFromProto(genState.Params))
+
+	store := ctx.KVStore(k.storeKey)
+
+	for _, account := range genState.VerifiedAccounts {
+		k.mustSetRecord(store, types.GetVerifiedAccountKey(account.Address), account)
+	}
+
+	for _, migration := range genState.RoleMigrations {
+		k.mustSetRecord(store, types.GetRoleMigrationKey(migration.FromAddress, migration.ToAddress), migration)
+		setRoleMigrationAddressIndex(store, migration)
+	}
+
+	for _, nullifier := range genState.Nullifiers {
+		if err := k.setNullifierRecord(ctx, &nullifierRecord{
+			Address:     nullifier.Address,
+			BlockHeight: nullifier.BlockHeight,
+			Nullifier:   nullifier.Nullifier,
+			Timestamp:   nullifier.Timestamp,
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, provider := range genState.VerificationProviders {
+		if err := k.SetVerificationProvider(ctx, VerificationProviderFromProto(provider)); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, accreditation := range genState.Accreditations {
+		if err := k.SetAccreditationRecord(ctx, accreditation.AccreditationHash, accreditation.Valid); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range genState.VerificationRecords {
+		if err := k.StoreVerificationRecord(ctx, &VerificationRecord{
+			Address:          record.Address,
+			ProviderID:       record.ProviderId,
+			VerificationTime: record.VerificationTime,
+			ExpirationTime:   record.ExpirationTime,
+			Nullifier:        record.Nullifier,
+			IdentityHash:     record.IdentityHash,
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, proof := range genState.ProofRecords {
+		if err := k.setProofRecord(ctx, &proofRecord{
+			Address:     proof.Address,
+			BlockHeight: proof.BlockHeight,
+			ProofHash:   proof.ProofHash,
+			ProviderID:  proof.ProviderId,
+			Timestamp:   proof.Timestamp,
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, identityHash := range genState.IdentityHashes {
+		store.Set(types.GetIdentityHashKey(identityHash.IdentityHash), []byte(identityHash.Address))
+	}
+
+	for _, usage := range genState.FreeTxUsages {
+		k.mustSetRecord(store, types.GetFreeTxUsageKey(usage.Address), usage)
+	}
+}
+
+// ExportGenesis returns the ident state as a genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *identv1.GenesisState {
+	params := k.GetParams(ctx)
+	genState := &identv1.GenesisState{
+		Params:                params.ToProto(),
+		VerifiedAccounts:      []*identv1.VerifiedAccount{},
+		IdentityVerifications: []*identv1.IdentityVerification{},
+		RoleMigrations:        []*identv1.RoleMigration{},
+		VerificationProviders: []*identv1.VerificationProvider{},
+		ZkpProofs:             []*identv1.ZKPProof{},
+		Nullifiers:            []*identv1.Nullifier{},
+		Accreditations:        []*identv1.Accreditation{},
+		VerificationRecords:   []*identv1.VerificationRecord{},
+		ProofRecords:          []*identv1.ProofRecord{},
+		IdentityHashes:        []*identv1.IdentityHash{},
+		FreeTxUsages:          []*identv1.FreeTxUsage{},
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.VerifiedAccountKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var account identv1.VerifiedAccount
+		k.mustUnmarshalRecord(iterator.Value(), &account)
+		genState.VerifiedAccounts = append(genState.VerifiedAccounts, &account)
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.RoleMigrationKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var migration identv1.RoleMigration
+		k.mustUnmarshalRecord(iterator.Value(), &migration)
+		genState.RoleMigrations = append(genState.RoleMigrations, &migration)
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.NullifierKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.NullifierKeyPrefix):]
+		genState.Nullifiers = append(genState.Nullifiers, decodeNullifier(key, iterator.Value()))
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.ProviderKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var provider VerificationProvider
+		mustUnmarshalJSON(iterator.Value(), &provider)
+		genState.VerificationProviders = append(genState.VerificationProviders, provider.ToProto())
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.AccreditationKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.AccreditationKeyPrefix):]
+		var accreditation accreditationRecord
+		mustUnmarshalJSON(iterator.Value(), &accreditation)
+		genState.Accreditations = append(genState.Accreditations, &identv1.Accreditation{
+			AccreditationHash: string(key),
+			Valid:             accreditation.Valid,
+		})
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.VerificationRecordKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var record VerificationRecord
+		mustUnmarshalJSON(iterator.Value(), &record)
+		genState.VerificationRecords = append(genState.VerificationRecords, &identv1.VerificationRecord{
+			Address:          record.Address,
+			ProviderId:       record.ProviderID,
+			VerificationTime: record.VerificationTime,
+			ExpirationTime:   record.ExpirationTime,
+			Nullifier:        record.Nullifier,
+			IdentityHash:     record.IdentityHash,
+		})
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.ProofKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var proof proofRecord
+		mustUnmarshalJSON(iterator.Value(), &proof)
+		genState.ProofRecords = append(genState.ProofRecords, &identv1.ProofRecord{
+			ProofHash:   proof.ProofHash,
+			Address:     proof.Address,
+			ProviderId:  proof.ProviderID,
+			Timestamp:   proof.Timestamp,
+			BlockHeight: proof.BlockHeight,
+		})
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.IdentityHashKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.IdentityHashKeyPrefix):]
+		genState.IdentityHashes = append(genState.IdentityHashes, &identv1.IdentityHash{
+			IdentityHash: string(key),
+			Address:      string(iterator.Value()),
+		})
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.FreeTxUsageKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var usage identv1.FreeTxUsage
+		k.mustUnmarshalRecord(iterator.Value(), &usage)
+		genState.FreeTxUsages = append(genState.FreeTxUsages, &usage)
+	}
+	iterator.Close()
+
+	return genState
+}
+
+// decodeNullifier decodes a stored nullifier record
+// Nullifiers stored by earlier versions of the ZKP verifier hold the nullifier followed by the address
+func decodeNullifier(key, value []byte) *identv1.Nullifier {
+	nullifier := append([]byte{}, key...)
+
+	var record nullifierRecord
+	if err := json.Unmarshal(value, &record); err == nil {
+		return &identv1.Nullifier{
+			Nullifier:   nullifier,
+			Address:     record.Address,
+			Timestamp:   record.Timestamp,
+			BlockHeight: record.BlockHeight,
+		}
+	}
+
+	if !bytes.HasPrefix(value, nullifier) {
+		panic(fmt.Errorf("malformed nullifier record %X", nullifier))
+	}
+	return &identv1.Nullifier{
+		Nullifier: nullifier,
+		Address:   string(value[len(nullifier):]),
+	}
+}
+
+func (k Keeper) mustSetRecord(store storetypes.KVStore, key []byte, record gogoproto.Message) {
+	bz, err := k.cdc.Marshal(record)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal genesis record: %w", err))
+	}
+	store.Set(key, bz)
+}
+
+func (k Keeper) mustUnmarshalRecord(bz []byte, record gogoproto.Message) {
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		panic(fmt.Errorf("failed to unmarshal genesis record: %w", err))
+	}
+}
+
+func mustUnmarshalJSON(bz []byte, record interface{}) {
+	if err := json.Unmarshal(bz, record); err != nil {
+		panic(fmt.Errorf("failed to unmarshal genesis record: %w", err))
+	}
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+// MockIdentHooks records the ident hook calls
+type MockIdentHooks struct {
+	verified    map[string]identv1.Role
+	roleChanges map[string][2]identv1.Role
+}
+
+func NewMockIdentHooks() *MockIdentHooks {
+	return &MockIdentHooks{
+		verified:    make(map[string]identv1.Role),
+		roleChanges: make(map[string][2]identv1.Role),
+	}
+}
+
+func (m *MockIdentHooks) AfterIdentityVerified(ctx sdk.Context, address string, role identv1.Role) error {
+	m.verified[address] = role
+	return nil
+}
+
+func (m *MockIdentHooks) AfterRoleChanged(ctx sdk.Context, address string, oldRole, newRole identv1.Role) error {
+	m.roleChanges[address] = [2]identv1.Role{oldRole, newRole}
+	return nil
+}
+
+// Test ChangeAccountRole calls AfterRoleChanged on every subscriber
+func (suite *KeeperTestSuite) TestHooks_AfterRoleChanged() {
+	first, second := NewMockIdentHooks(), NewMockIdentHooks()
+	suite.keeper.SetHooks(types.NewMultiIdentHooks(first, second))
+
+	account := &identv1.VerifiedAccount{
+		Address:          "cosmos1test",
+		Role:             identv1.Role_ROLE_CITIZEN,
+		VerificationDate: timestamppb.Now(),
+		LastActive:       timestamppb.Now(),
+		IsActive:         true,
+		IdentityHash:     "hash123",
+	}
+	require.NoError(suite.T(), suite.keeper.SetVerifiedAccount(suite.ctx, account))
+	require.NoError(suite.T(), suite.keeper.ChangeAccountRole(suite.ctx, "cosmos1test", identv1.Role_ROLE_VALIDATOR))
+
+	expected := [2]identv1.Role{identv1.Role_ROLE_CITIZEN, identv1.Role_ROLE_VALIDATOR}
+	require.Equal(suite.T(), expected, first.roleChanges["cosmos1test"])
+	require.Equal(suite.T(), expected, second.roleChanges["cosmos1test"])
+}
+
+// Test the inactivity downgrade to guest calls AfterRoleChanged
+func (suite *KeeperTestSuite) TestHooks_AfterRoleChanged_InactivityDowngrade() {
+	hooks := NewMockIdentHooks()
+	suite.keeper.SetHooks(hooks)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.ValidatorActivityPeriod = time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	oldTime := time.Now().Add(-2 * time.Hour)
+	account := &identv1.VerifiedAccount{
+		Address:          "cosmos1validator",
+		Role:             identv1.Role_ROLE_VALIDATOR,
+		VerificationDate: timestamppb.New(oldTime),
+		LastActive:       timestamppb.New(oldTime),
+		IsActive:         true,
+		IdentityHash:     "hash123",
+	}
+	require.NoError(suite.T(), suite.keeper.SetVerifiedAccount(suite.ctx, account))
+
+	require.NoError(suite.T(), suite.keeper.BeginBlocker(suite.ctx.WithBlockTime(time.Now())))
+
+	require.Equal(suite.T(), [2]identv1.Role{identv1.Role_ROLE_VALIDATOR, identv1.Role_ROLE_GUEST}, hooks.roleChanges["cosmos1validator"])
+}
+
+// Test SetHooks panics when hooks are already set
+func (suite *KeeperTestSuite) TestHooks_SetTwice() {
+	suite.keeper.SetHooks(NewMockIdentHooks())
+	require.Panics(suite.T(), func() {
+		suite.keeper.SetHooks(NewMockIdentHooks())
+	})
+}
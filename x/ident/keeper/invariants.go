@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+// RegisterInvariants registers the ident module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "unique-identity-hash", UniqueIdentityHashInvariant(k))
+}
+
+// UniqueIdentityHashInvariant checks that no two verified accounts share an identity hash
+func UniqueIdentityHashInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		accounts, err := k.GetAllVerifiedAccounts(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "unique-identity-hash", fmt.Sprintf("\t%v\n", err)), true
+		}
+
+		owners := make(map[string]string, len(accounts))
+		var msg strings.Builder
+		broken := false
+		for _, account := range accounts {
+			if account.IdentityHash == "" {
+				continue
+			}
+			if owner, found := owners[account.IdentityHash]; found {
+				broken = true
+				fmt.Fprintf(&msg, "\tidentity hash %s is used by %s and %s\n", account.IdentityHash, owner, account.Address)
+				continue
+			}
+			owners[account.IdentityHash] = account.Address
+		}
+		if !broken {
+			fmt.Fprintf(&msg, "\t%d verified accounts with unique identity hashes\n", len(accounts))
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "unique-identity-hash", msg.String()), broken
+	}
+}
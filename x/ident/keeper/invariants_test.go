@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/ident/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+// TestUniqueIdentityHashInvariant tests accounts sharing an identity hash break the invariant
+func (suite *KeeperTestSuite) TestUniqueIdentityHashInvariant() {
+	invariant := keeper.UniqueIdentityHashInvariant(*suite.keeper)
+	account := func(address, hash string) *identv1.VerifiedAccount {
+		return &identv1.VerifiedAccount{
+			Address:              address,
+			Role:                 identv1.Role_ROLE_CITIZEN,
+			VerificationDate:     timestamppb.Now(),
+			LastActive:           timestamppb.Now(),
+			IsActive:             true,
+			IdentityHash:         hash,
+			VerificationProvider: "provider1",
+		}
+	}
+	require.NoError(suite.T(), suite.keeper.SetVerifiedAccount(suite.ctx, account("cosmos1alice", "hash_alice")))
+	require.NoError(suite.T(), suite.keeper.SetVerifiedAccount(suite.ctx, account("cosmos1bob", "hash_bob")))
+
+	msg, broken := invariant(suite.ctx)
+	require.False(suite.T(), broken, msg)
+
+	// SetVerifiedAccount rejects the duplicate, a direct write gets past it
+	bz, err := suite.cdc.Marshal(account("cosmos1mallory", "hash_alice"))
+	require.NoError(suite.T(), err)
+	suite.ctx.KVStore(suite.storeKey).Set(types.GetVerifiedAccountKey("cosmos1mallory"), bz)
+
+	msg, broken = invariant(suite.ctx)
+	require.True(suite.T(), broken)
+	require.Contains(suite.T(), msg, "identity hash hash_alice is used by cosmos1alice and cosmos1mallory")
+}
@@ -8,10 +8,13 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	governancetypes "github.com/volnix-protocol/volnix-protocol/x/governance/types"
 	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
 )
 
@@ -19,7 +22,6 @@ import (
 // This allows ident module to burn ANT when citizens are deactivated
 type AnteilKeeperInterface interface {
 	BurnAntFromUser(ctx sdk.Context, user string) error
-	GetUserPosition(ctx sdk.Context, user string) (interface{}, error)
 }
 
 type (
@@ -28,6 +30,11 @@ type (
 		storeKey   storetypes.StoreKey
 		paramstore paramtypes.Subspace
 		anteilKeeper AnteilKeeperInterface // Optional: for burning ANT on citizen deactivation
+
+		// authority is the governance module address allowed to update params
+		authority string
+
+		hooks types.IdentHooks
 	}
 )
 
@@ -45,9 +52,15 @@ func NewKeeper(
 		cdc:        cdc,
 		storeKey:   storeKey,
 		paramstore: ps,
+		authority:  authtypes.NewModuleAddress(governancetypes.ModuleName).String(),
 	}
 }
 
+// GetAuthority returns the address allowed to execute MsgUpdateParams
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetParams returns the current parameters for the ident module
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var params types.Params
@@ -65,6 +78,14 @@ func (k *Keeper) SetAnteilKeeper(anteilKeeper AnteilKeeperInterface) {
 	k.anteilKeeper = anteilKeeper
 }
 
+// SetHooks sets the ident hooks
+func (k *Keeper) SetHooks(hooks types.IdentHooks) {
+	if k.hooks != nil {
+		panic("cannot set ident hooks twice")
+	}
+	k.hooks = hooks
+}
+
 // ReleaseIdentityHash releases the identity hash mapping for a deactivated account
 // According to whitepaper: "ZKP-идентификатор освобождается для возможной повторной верификации"
 func (k Keeper) ReleaseIdentityHash(ctx sdk.Context, address string) error {
@@ -243,12 +264,17 @@ func (k Keeper) checkAccountActivity(ctx sdk.Context) error {
 			}
 
 			// Downgrade role to guest
+			oldRole := account.Role
 			account.Role = identv1.Role_ROLE_GUEST
 
 			// Update account in store
 			if err := k.UpdateVerifiedAccount(ctx, account); err != nil {
 				return fmt.Errorf("failed to update inactive account: %w", err)
 			}
+
+			if err := k.afterRoleChanged(ctx, account.Address, oldRole, account.Role); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -406,6 +432,48 @@ func (k Keeper) GetAllVerifiedAccounts(ctx sdk.Context) ([]*identv1.VerifiedAcco
 	return accounts, nil
 }
 
+// VerifiedAccountFilter selects accounts in GetVerifiedAccountsPage; zero fields match every account
+type VerifiedAccountFilter struct {
+	Role     identv1.Role
+	IsActive *bool
+}
+
+// Matches reports whether the account passes the filter
+func (f VerifiedAccountFilter) Matches(account *identv1.VerifiedAccount) bool {
+	if f.Role != identv1.Role_ROLE_UNSPECIFIED && account.Role != f.Role {
+		return false
+	}
+	if f.IsActive != nil && account.IsActive != *f.IsActive {
+		return false
+	}
+	return true
+}
+
+// GetVerifiedAccountsPage returns a page of the verified accounts matching the filter, in address order
+func (k Keeper) GetVerifiedAccountsPage(ctx sdk.Context, filter VerifiedAccountFilter, pageReq *sdkquery.PageRequest) ([]*identv1.VerifiedAccount, *sdkquery.PageResponse, error) {
+	accountStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.VerifiedAccountKeyPrefix)
+
+	var accounts []*identv1.VerifiedAccount
+	pageRes, err := sdkquery.FilteredPaginate(accountStore, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var account identv1.VerifiedAccount
+		if err := k.cdc.Unmarshal(value, &account); err != nil {
+			return false, fmt.Errorf("failed to unmarshal account: %w", err)
+		}
+		if !filter.Matches(&account) {
+			return false, nil
+		}
+		if accumulate {
+			accounts = append(accounts, &account)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, pageRes, nil
+}
+
 // IMPROVED: CheckDuplicateIdentityHash checks if an identity hash is already used by another address
 func (k Keeper) CheckDuplicateIdentityHash(ctx sdk.Context, identityHash string, currentAddress string) error {
 	store := ctx.KVStore(k.storeKey)
@@ -480,7 +548,7 @@ func (k Keeper) UpdateAccountActivity(ctx sdk.Context, address string) error {
 		return err
 	}
 
-	types.UpdateAccountActivity(account)
+	account.LastActive = timestamppb.New(ctx.BlockTime())
 	return k.UpdateVerifiedAccount(ctx, account)
 }
 
@@ -503,8 +571,30 @@ func (k Keeper) ChangeAccountRole(ctx sdk.Context, address string, newRole ident
 	}
 
 	// Change role and update activity
-	types.ChangeAccountRole(account, newRole)
-	return k.UpdateVerifiedAccount(ctx, account)
+	oldRole := account.Role
+	account.Role = newRole
+	account.LastActive = timestamppb.New(ctx.BlockTime())
+	if err := k.UpdateVerifiedAccount(ctx, account); err != nil {
+		return err
+	}
+
+	return k.afterRoleChanged(ctx, address, oldRole, newRole)
+}
+
+// afterIdentityVerified calls the AfterIdentityVerified hook if hooks are set
+func (k Keeper) afterIdentityVerified(ctx sdk.Context, address string, role identv1.Role) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterIdentityVerified(ctx, address, role)
+}
+
+// afterRoleChanged calls the AfterRoleChanged hook if hooks are set
+func (k Keeper) afterRoleChanged(ctx sdk.Context, address string, oldRole, newRole identv1.Role) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterRoleChanged(ctx, address, oldRole, newRole)
 }
 
 // validateRoleChange checks if the role change is valid
@@ -606,10 +696,18 @@ func (k Keeper) SetRoleMigration(ctx sdk.Context, migration *identv1.RoleMigrati
 		return err
 	}
 	store.Set(migrationKey, migrationBz)
+	setRoleMigrationAddressIndex(store, migration)
 
 	return nil
 }
 
+// setRoleMigrationAddressIndex writes the address index entries of a role migration
+func setRoleMigrationAddressIndex(store storetypes.KVStore, migration *identv1.RoleMigration) {
+	for _, addr := range []string{migration.FromAddress, migration.ToAddress} {
+		store.Set(types.GetRoleMigrationAddressIndexKey(addr, migration.FromAddress, migration.ToAddress), []byte{})
+	}
+}
+
 // GetRoleMigration retrieves a role migration by addresses
 func (k Keeper) GetRoleMigration(ctx sdk.Context, fromAddress, toAddress string) (*identv1.RoleMigration, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -658,8 +756,8 @@ func (k Keeper) ExecuteRoleMigration(ctx sdk.Context, fromAddress, toAddress str
 	targetAccount := &identv1.VerifiedAccount{
 		Address:              toAddress,
 		Role:                 sourceAccount.Role,
-		VerificationDate:     timestamppb.Now(),
-		LastActive:           timestamppb.Now(),
+		VerificationDate:     timestamppb.New(ctx.BlockTime()),
+		LastActive:           timestamppb.New(ctx.BlockTime()),
 		IsActive:             true,
 		IdentityHash:         migration.MigrationHash,
 		VerificationProvider: sourceAccount.VerificationProvider,
@@ -669,6 +767,9 @@ func (k Keeper) ExecuteRoleMigration(ctx sdk.Context, fromAddress, toAddress str
 	if err := k.SetVerifiedAccount(ctx, targetAccount); err != nil {
 		return err
 	}
+	if err := k.afterIdentityVerified(ctx, targetAccount.Address, targetAccount.Role); err != nil {
+		return err
+	}
 
 	// Deactivate source account
 	sourceAccount.IsActive = false
@@ -678,7 +779,7 @@ func (k Keeper) ExecuteRoleMigration(ctx sdk.Context, fromAddress, toAddress str
 
 	// Update migration status
 	migration.IsCompleted = true
-	migration.MigrationDate = timestamppb.Now()
+	migration.MigrationDate = timestamppb.New(ctx.BlockTime())
 	return k.SetRoleMigration(ctx, migration)
 }
 
@@ -701,3 +802,56 @@ func (k Keeper) GetAllRoleMigrations(ctx sdk.Context) ([]*identv1.RoleMigration,
 
 	return migrations, nil
 }
+
+// RoleMigrationFilter selects migrations in GetRoleMigrationsPage; zero fields match every migration
+type RoleMigrationFilter struct {
+	Address     string
+	IsCompleted *bool
+}
+
+// Matches reports whether the migration passes the filter
+func (f RoleMigrationFilter) Matches(migration *identv1.RoleMigration) bool {
+	if f.Address != "" && migration.FromAddress != f.Address && migration.ToAddress != f.Address {
+		return false
+	}
+	if f.IsCompleted != nil && migration.IsCompleted != *f.IsCompleted {
+		return false
+	}
+	return true
+}
+
+// GetRoleMigrationsPage returns a page of the role migrations matching the filter, in source address order
+// With an address filter only the address index of that address is iterated
+func (k Keeper) GetRoleMigrationsPage(ctx sdk.Context, filter RoleMigrationFilter, pageReq *sdkquery.PageRequest) ([]*identv1.RoleMigration, *sdkquery.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	migrationStore := prefix.NewStore(store, types.RoleMigrationKeyPrefix)
+
+	// Address index keys end with the role migration key, so pages keep the source address order
+	var pageStore storetypes.KVStore = migrationStore
+	if filter.Address != "" {
+		pageStore = prefix.NewStore(store, types.GetRoleMigrationAddressPrefix(filter.Address))
+	}
+
+	var migrations []*identv1.RoleMigration
+	pageRes, err := sdkquery.FilteredPaginate(pageStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if filter.Address != "" {
+			value = migrationStore.Get(key)
+		}
+		var migration identv1.RoleMigration
+		if err := k.cdc.Unmarshal(value, &migration); err != nil {
+			return false, fmt.Errorf("failed to unmarshal role migration: %w", err)
+		}
+		if !filter.Matches(&migration) {
+			return false, nil
+		}
+		if accumulate {
+			migrations = append(migrations, &migration)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return migrations, pageRes, nil
+}
@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -338,6 +340,7 @@ func (suite *KeeperTestSuite) TestChangeAccountRole_InvalidRole() {
 
 // Test UpdateAccountActivity
 func (suite *KeeperTestSuite) TestUpdateAccountActivity() {
+	suite.ctx = suite.ctx.WithBlockTime(time.Now())
 	account := &identv1.VerifiedAccount{
 		Address:          "cosmos1test",
 		Role:             identv1.Role_ROLE_CITIZEN,
@@ -925,10 +928,6 @@ func (m *MockAnteilKeeper) BurnAntFromUser(ctx sdk.Context, user string) error {
 	return nil
 }
 
-func (m *MockAnteilKeeper) GetUserPosition(ctx sdk.Context, user string) (interface{}, error) {
-	return nil, nil
-}
-
 func (m *MockAnteilKeeper) GetBurnedUsers() []string {
 	return m.burnedUsers
 }
@@ -2723,3 +2722,124 @@ func (suite *KeeperTestSuite) TestValidateRoleChange_Downgrade() {
 	require.NoError(suite.T(), err)
 	require.Equal(suite.T(), identv1.Role_ROLE_VALIDATOR, updatedCitizen2.Role)
 }
+
+func (suite *KeeperTestSuite) TestUseFreeTransaction() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CitizenDailyFreeTxs = 2
+	suite.keeper.SetParams(suite.ctx, params)
+	ctx := suite.ctx.WithBlockTime(time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC))
+
+	suite.Require().NoError(suite.keeper.SetVerifiedAccount(ctx, &identv1.VerifiedAccount{
+		Address:      "citizen1",
+		Role:         identv1.Role_ROLE_CITIZEN,
+		IsActive:     true,
+		IdentityHash: "hash1",
+	}))
+	suite.Require().Equal(identv1.Role_ROLE_CITIZEN, suite.keeper.GetFeeRole(ctx, "citizen1"))
+	suite.Require().Equal(identv1.Role_ROLE_GUEST, suite.keeper.GetFeeRole(ctx, "unknown"))
+
+	for i := 0; i < 2; i++ {
+		free, err := suite.keeper.UseFreeTransaction(ctx, "citizen1", 100000)
+		suite.Require().NoError(err)
+		suite.Require().True(free)
+	}
+	free, err := suite.keeper.UseFreeTransaction(ctx, "citizen1", 100000)
+	suite.Require().NoError(err)
+	suite.Require().False(free)
+
+	// The quota resets at the day boundary
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	usage, err := suite.keeper.GetFreeTxUsage(ctx, "citizen1")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), usage.Count)
+	free, err = suite.keeper.UseFreeTransaction(ctx, "citizen1", 100000)
+	suite.Require().NoError(err)
+	suite.Require().True(free)
+
+	// Transactions above MaxFreeTxGas are not free and leave the quota untouched
+	free, err = suite.keeper.UseFreeTransaction(ctx, "citizen1", params.MaxFreeTxGas+1)
+	suite.Require().NoError(err)
+	suite.Require().False(free)
+	usage, err = suite.keeper.GetFreeTxUsage(ctx, "citizen1")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), usage.Count)
+
+	// Guests have no quota
+	free, err = suite.keeper.UseFreeTransaction(ctx, "unknown", 100000)
+	suite.Require().NoError(err)
+	suite.Require().False(free)
+}
+
+// TestInitGenesis_ExportGenesis_RoundTrip exports every stored record type and imports it into a new store
+func (suite *KeeperTestSuite) TestInitGenesis_ExportGenesis_RoundTrip() {
+	suite.ctx = suite.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1700000000, 0))
+
+	require.NoError(suite.T(), suite.keeper.SetVerifiedAccount(suite.ctx, &identv1.VerifiedAccount{
+		Address:          "cosmos1citizen",
+		Role:             identv1.Role_ROLE_CITIZEN,
+		VerificationDate: timestamppb.New(suite.ctx.BlockTime()),
+		LastActive:       timestamppb.New(suite.ctx.BlockTime()),
+		IsActive:         true,
+		IdentityHash:     "hash123",
+	}))
+	require.NoError(suite.T(), suite.keeper.SetRoleMigration(suite.ctx, &identv1.RoleMigration{
+		FromAddress:   "cosmos1citizen",
+		ToAddress:     "cosmos1validator",
+		FromRole:      identv1.Role_ROLE_CITIZEN,
+		ToRole:        identv1.Role_ROLE_VALIDATOR,
+		MigrationHash: "hash123",
+	}))
+	require.NoError(suite.T(), suite.keeper.EnhancedNullifierCheck(suite.ctx, []byte("nullifier1"), "cosmos1citizen"))
+	// Nullifiers stored by earlier versions hold the nullifier followed by the address
+	suite.ctx.KVStore(suite.storeKey).Set(types.GetNullifierKey([]byte("nullifier2")), []byte("nullifier2cosmos1other"))
+	require.NoError(suite.T(), suite.keeper.SetAccreditationRecord(suite.ctx, "accreditation1", true))
+	require.NoError(suite.T(), suite.keeper.SetVerificationProvider(suite.ctx, &keeper.VerificationProvider{
+		ProviderID:        "provider1",
+		ProviderName:      "Provider",
+		AccreditationHash: "accreditation1",
+		IsActive:          true,
+		RegistrationTime:  timestamppb.New(suite.ctx.BlockTime()),
+		ExpirationTime:    timestamppb.New(suite.ctx.BlockTime().Add(24 * time.Hour)),
+	}))
+	require.NoError(suite.T(), suite.keeper.StoreVerificationRecord(suite.ctx, &keeper.VerificationRecord{
+		Address:          "cosmos1citizen",
+		ProviderID:       "provider1",
+		VerificationTime: timestamppb.New(suite.ctx.BlockTime()),
+		Nullifier:        []byte("nullifier1"),
+		IdentityHash:     "hash123",
+	}))
+	require.NoError(suite.T(), suite.keeper.VerifyZKProofIntegrity(suite.ctx, strings.Repeat("p", 64), "provider1", "cosmos1citizen"))
+	used, err := suite.keeper.UseFreeTransaction(suite.ctx, "cosmos1citizen", 100000)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), used)
+
+	exported := suite.keeper.ExportGenesis(suite.ctx)
+	require.Len(suite.T(), exported.VerifiedAccounts, 1)
+	require.Len(suite.T(), exported.RoleMigrations, 1)
+	require.Len(suite.T(), exported.Nullifiers, 2)
+	require.Equal(suite.T(), "cosmos1other", exported.Nullifiers[1].Address)
+	require.Len(suite.T(), exported.VerificationProviders, 1)
+	require.NotNil(suite.T(), exported.VerificationProviders[0].ExpirationTime)
+	require.Len(suite.T(), exported.Accreditations, 1)
+	require.Len(suite.T(), exported.VerificationRecords, 1)
+	require.Len(suite.T(), exported.ProofRecords, 1)
+	require.Len(suite.T(), exported.IdentityHashes, 1)
+	require.Len(suite.T(), exported.FreeTxUsages, 1)
+
+	ctx := suite.ctx
+	suite.SetupTest()
+	suite.ctx = suite.ctx.WithBlockHeight(ctx.BlockHeight()).WithBlockTime(ctx.BlockTime())
+	suite.keeper.InitGenesis(suite.ctx, exported)
+
+	// The ident genesis is JSON encoded: its params embed SDK coins, which protobuf reflection cannot handle
+	exportedBz, err := json.Marshal(exported)
+	require.NoError(suite.T(), err)
+	reexportedBz, err := json.Marshal(suite.keeper.ExportGenesis(suite.ctx))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), string(exportedBz), string(reexportedBz), "genesis changed after import")
+
+	// Imported records keep their behavior
+	require.NoError(suite.T(), suite.keeper.VerifyProvider(suite.ctx, "provider1"))
+	require.NoError(suite.T(), suite.keeper.EnhancedNullifierCheck(suite.ctx, []byte("nullifier1"), "cosmos1citizen"))
+	require.Error(suite.T(), suite.keeper.EnhancedNullifierCheck(suite.ctx, []byte("nullifier2"), "cosmos1citizen"))
+}
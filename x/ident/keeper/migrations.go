@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+// Migrator migrates the ident store between consensus versions
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 migrates the ident store from consensus version 1 to 2
+// Version 2 adds the role-based fee policy params, which start at their defaults,
+// and indexes the stored role migrations by address
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !m.keeper.paramstore.Has(ctx, pair.Key) {
+			m.keeper.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.RoleMigrationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var migration identv1.RoleMigration
+		if err := m.keeper.cdc.Unmarshal(iterator.Value(), &migration); err != nil {
+			return fmt.Errorf("failed to unmarshal role migration: %w", err)
+		}
+		setRoleMigrationAddressIndex(store, &migration)
+	}
+	return nil
+}
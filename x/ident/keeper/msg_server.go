@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -54,7 +55,8 @@ func (s MsgServer) VerifyIdentity(ctx context.Context, req *identv1.MsgVerifyIde
 	}
 
 	// Create verified account with user's chosen role (not default CITIZEN)
-	account := types.NewVerifiedAccount(
+	account := types.NewVerifiedAccountAt(
+		sdkCtx.BlockTime(),
 		req.Address,
 		req.DesiredRole, // Use desired_role from request
 		identityHash,
@@ -66,6 +68,10 @@ func (s MsgServer) VerifyIdentity(ctx context.Context, req *identv1.MsgVerifyIde
 		return nil, err
 	}
 
+	if err := s.k.afterIdentityVerified(sdkCtx, account.Address, account.Role); err != nil {
+		return nil, err
+	}
+
 	return &identv1.MsgVerifyIdentityResponse{
 		Success:        true,
 		VerificationId: fmt.Sprintf("verification-%s", req.Address),
@@ -178,6 +184,10 @@ func (s MsgServer) RegisterVerificationProvider(ctx context.Context, req *identv
 	if req == nil {
 		return nil, fmt.Errorf("request cannot be nil")
 	}
+	// Providers attest identities, so only governance may accredit them
+	if req.Authority != s.k.GetAuthority() {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", s.k.GetAuthority(), req.Authority)
+	}
 	if req.ProviderId == "" {
 		return nil, fmt.Errorf("provider_id cannot be empty")
 	}
@@ -203,7 +213,7 @@ func (s MsgServer) RegisterVerificationProvider(ctx context.Context, req *identv
 		PublicKey:          req.ProviderPublicKey,
 		AccreditationHash:  accreditationHash,
 		IsActive:           true,
-		RegistrationTime:  timestamppb.Now(),
+		RegistrationTime:  timestamppb.New(sdkCtx.BlockTime()),
 		ExpirationTime:     nil,
 	}
 	if err := s.k.SetVerificationProvider(sdkCtx, provider); err != nil {
@@ -215,3 +225,23 @@ func (s MsgServer) RegisterVerificationProvider(ctx context.Context, req *identv
 		AccreditationHash: accreditationHash,
 	}, nil
 }
+
+// UpdateParams replaces the module parameters; only the governance authority may execute it
+func (s MsgServer) UpdateParams(ctx context.Context, req *identv1.MsgUpdateParams) (*identv1.MsgUpdateParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != s.k.GetAuthority() {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", s.k.GetAuthority(), req.Authority)
+	}
+	if req.Params == nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, "params cannot be nil")
+	}
+
+	params := types.ParamsFromProto(req.Params)
+	if err := params.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	s.k.SetParams(sdkCtx, params)
+	return &identv1.MsgUpdateParamsResponse{}, nil
+}
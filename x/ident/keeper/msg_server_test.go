@@ -133,6 +133,38 @@ func (suite *MsgServerTestSuite) TestVerifyIdentity() {
 	require.Error(suite.T(), err)
 }
 
+// verifiedRecorder records AfterIdentityVerified calls
+type verifiedRecorder struct {
+	verified map[string]identv1.Role
+}
+
+func (r *verifiedRecorder) AfterIdentityVerified(ctx sdk.Context, address string, role identv1.Role) error {
+	r.verified[address] = role
+	return nil
+}
+
+func (r *verifiedRecorder) AfterRoleChanged(ctx sdk.Context, address string, oldRole, newRole identv1.Role) error {
+	return nil
+}
+
+func (suite *MsgServerTestSuite) TestVerifyIdentity_CallsHooks() {
+	recorder := &verifiedRecorder{verified: make(map[string]identv1.Role)}
+	suite.keeper.SetHooks(recorder)
+
+	coin := sdk.NewCoin("uvx", math.NewInt(1000000))
+	msg := &identv1.MsgVerifyIdentity{
+		Address:              "cosmos1test",
+		ZkpProof:             "valid_zkp_proof_data_1234567890123456789012345678901234567890123456789012345678901234",
+		VerificationProvider: "provider123",
+		VerificationCost:     &coin,
+		DesiredRole:          identv1.Role_ROLE_VALIDATOR,
+	}
+
+	_, err := suite.msgServer.VerifyIdentity(suite.ctx, msg)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), identv1.Role_ROLE_VALIDATOR, recorder.verified["cosmos1test"])
+}
+
 func (suite *MsgServerTestSuite) TestVerifyIdentity_RoleChoice() {
 	coin := sdk.NewCoin("uvx", math.NewInt(1000000))
 	// ZKP proof must be at least 64 bytes
@@ -360,6 +392,7 @@ func (suite *MsgServerTestSuite) TestRegisterVerificationProvider() {
 		ProviderName:        "New Provider",
 		ProviderPublicKey:   "pubkey123",
 		AccreditationProof:  "proof-data",
+		Authority:           suite.keeper.GetAuthority(),
 	}
 
 	resp, err := suite.msgServer.RegisterVerificationProvider(suite.ctx, msg)
@@ -371,11 +404,48 @@ func (suite *MsgServerTestSuite) TestRegisterVerificationProvider() {
 	require.Len(suite.T(), resp.AccreditationHash, 64)
 
 	// Empty provider_id should fail
-	_, err = suite.msgServer.RegisterVerificationProvider(suite.ctx, &identv1.MsgRegisterVerificationProvider{})
+	_, err = suite.msgServer.RegisterVerificationProvider(suite.ctx, &identv1.MsgRegisterVerificationProvider{Authority: suite.keeper.GetAuthority()})
 	require.Error(suite.T(), err)
+
+	// Only the governance authority may register providers
+	msg.Authority = "cosmos1notgovernance"
+	_, err = suite.msgServer.RegisterVerificationProvider(suite.ctx, msg)
+	require.ErrorIs(suite.T(), err, types.ErrUnauthorized)
 }
 
 func TestMsgServerTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgServerTestSuite))
 }
 
+
+func (suite *MsgServerTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.MaxIdentitiesPerAddress = 3
+
+	// Only the governance authority may update params
+	_, err := suite.msgServer.UpdateParams(suite.ctx, &identv1.MsgUpdateParams{
+		Authority: "cosmos1notauthority",
+		Params:    params.ToProto(),
+	})
+	require.ErrorIs(suite.T(), err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &identv1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+	})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidParams)
+
+	invalid := params
+	invalid.CitizenActivityPeriod = 0
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &identv1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    invalid.ToProto(),
+	})
+	require.ErrorIs(suite.T(), err, types.ErrInvalidParams)
+
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &identv1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    params.ToProto(),
+	})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(3), suite.keeper.GetParams(suite.ctx).MaxIdentitiesPerAddress)
+}
@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
 )
@@ -44,76 +47,98 @@ func (s QueryServer) VerifiedAccount(ctx context.Context, req *identv1.QueryVeri
 	return &identv1.QueryVerifiedAccountResponse{VerifiedAccount: account}, nil
 }
 
+// VerifiedAccounts returns a page of verified accounts, filtered by role and active flag
 func (s QueryServer) VerifiedAccounts(ctx context.Context, req *identv1.QueryVerifiedAccountsRequest) (*identv1.QueryVerifiedAccountsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	accounts, err := s.k.GetAllVerifiedAccounts(sdkCtx)
-	if err != nil {
-		return nil, err
+	filter := VerifiedAccountFilter{Role: req.GetRole()}
+	if req != nil {
+		filter.IsActive = req.IsActive
 	}
 
-	// Handle pagination
-	var pagination *sdkquery.PageResponse
-	if req.Pagination != nil {
-		// Simple pagination implementation
-		offset := req.Pagination.Offset
-		limit := req.Pagination.Limit
-
-		if limit > 0 && offset < uint64(len(accounts)) {
-			end := offset + limit
-			if end > uint64(len(accounts)) {
-				end = uint64(len(accounts))
-			}
-			accounts = accounts[offset:end]
-		}
-
-		pagination = &sdkquery.PageResponse{
-			NextKey: nil, // Simplified pagination
-		}
+	accounts, pageRes, err := s.k.GetVerifiedAccountsPage(sdkCtx, filter, req.GetPagination())
+	if err != nil {
+		return nil, err
 	}
 
 	return &identv1.QueryVerifiedAccountsResponse{
 		VerifiedAccounts: accounts,
-		Pagination:       pagination,
+		Pagination:       pageRes,
 	}, nil
 }
 
-// VerifiedAccountsByRole method removed - not in current protobuf definition
+// IdentityVerification returns the verification state of an address
+func (s QueryServer) IdentityVerification(ctx context.Context, req *identv1.QueryIdentityVerificationRequest) (*identv1.QueryIdentityVerificationResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.GetAddress() == "" {
+		return nil, types.ErrEmptyAddress
+	}
+
+	verification, err := s.k.GetIdentityVerification(sdkCtx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identv1.QueryIdentityVerificationResponse{IdentityVerification: verification}, nil
+}
 
+// VerificationProviders returns a page of verification providers
 func (s QueryServer) VerificationProviders(ctx context.Context, req *identv1.QueryVerificationProvidersRequest) (*identv1.QueryVerificationProvidersResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	list, err := s.k.GetAllVerificationProviders(sdkCtx)
+
+	list, pageRes, err := s.k.GetVerificationProvidersPage(sdkCtx, req.GetPagination())
 	if err != nil {
 		return nil, err
 	}
+
 	// Convert keeper VerificationProvider to proto
 	protoList := make([]*identv1.VerificationProvider, 0, len(list))
 	for _, p := range list {
-		protoList = append(protoList, &identv1.VerificationProvider{
-			ProviderId:        p.ProviderID,
-			ProviderName:      p.ProviderName,
-			ProviderPublicKey: p.PublicKey,
-			IsAccredited:      p.IsActive,
-			AccreditationDate: p.RegistrationTime,
-			AccreditationHash: p.AccreditationHash,
-		})
-	}
-	// Simple pagination if requested
-	if req.Pagination != nil {
-		offset := req.Pagination.Offset
-		limit := req.Pagination.Limit
-		if limit > 0 && offset < uint64(len(protoList)) {
-			end := offset + limit
-			if end > uint64(len(protoList)) {
-				end = uint64(len(protoList))
-			}
-			protoList = protoList[offset:end]
-		}
+		protoList = append(protoList, p.ToProto())
 	}
+
 	return &identv1.QueryVerificationProvidersResponse{
 		VerificationProviders: protoList,
-		Pagination:            nil,
+		Pagination:            pageRes,
+	}, nil
+}
+
+// RoleMigrations returns a page of role migrations, filtered by address and completion flag
+func (s QueryServer) RoleMigrations(ctx context.Context, req *identv1.QueryRoleMigrationsRequest) (*identv1.QueryRoleMigrationsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	filter := RoleMigrationFilter{Address: req.GetAddress()}
+	if req != nil {
+		filter.IsCompleted = req.IsCompleted
+	}
+
+	migrations, pageRes, err := s.k.GetRoleMigrationsPage(sdkCtx, filter, req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	return &identv1.QueryRoleMigrationsResponse{
+		RoleMigrations: migrations,
+		Pagination:     pageRes,
 	}, nil
 }
 
+// Nullifier returns the recorded use of a hex-encoded nullifier
+func (s QueryServer) Nullifier(ctx context.Context, req *identv1.QueryNullifierRequest) (*identv1.QueryNullifierResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	nullifier, err := hex.DecodeString(req.GetNullifier())
+	if err != nil || len(nullifier) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "nullifier must be a non-empty hex string")
+	}
+
+	record, err := s.k.GetNullifier(sdkCtx, nullifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identv1.QueryNullifierResponse{Nullifier: record}, nil
+}
+
 func (s QueryServer) mustEmbedUnimplementedQueryServer() {}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -16,6 +17,8 @@ import (
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
@@ -207,6 +210,65 @@ func (suite *QueryServerTestSuite) TestVerifiedAccounts_WithPagination() {
 	require.LessOrEqual(suite.T(), len(resp.VerifiedAccounts), 2)
 }
 
+func (suite *QueryServerTestSuite) TestVerifiedAccounts_WithFilters() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxIdentitiesPerAddress = 10
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// Citizens at even indexes, validators at odd ones; index 4 is inactive
+	for i := 0; i < 5; i++ {
+		role := identv1.Role_ROLE_CITIZEN
+		if i%2 == 1 {
+			role = identv1.Role_ROLE_VALIDATOR
+		}
+		account := &identv1.VerifiedAccount{
+			Address:              "cosmos1filter" + string(rune('0'+i)),
+			Role:                 role,
+			IsActive:             i != 4,
+			VerificationDate:     timestamppb.Now(),
+			VerificationProvider: "test-provider",
+			ZkpProof:             "test_proof_1234567890123456789012345678901234567890123456789012345678901234",
+			IdentityHash:         "filter_hash" + string(rune('0'+i)),
+		}
+		require.NoError(suite.T(), suite.keeper.SetVerifiedAccount(suite.ctx, account))
+	}
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// Role filter with a page size of one follows the next key through the citizens in address order
+	req := &identv1.QueryVerifiedAccountsRequest{
+		Role:       identv1.Role_ROLE_CITIZEN,
+		Pagination: &sdkquery.PageRequest{Limit: 1, CountTotal: true},
+	}
+	resp, err := suite.queryServer.VerifiedAccounts(ctx, req)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.VerifiedAccounts, 1)
+	require.Equal(suite.T(), "cosmos1filter0", resp.VerifiedAccounts[0].Address)
+	require.Equal(suite.T(), uint64(3), resp.Pagination.Total)
+	require.NotNil(suite.T(), resp.Pagination.NextKey)
+
+	req.Pagination = &sdkquery.PageRequest{Key: resp.Pagination.NextKey, Limit: 1}
+	resp, err = suite.queryServer.VerifiedAccounts(ctx, req)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.VerifiedAccounts, 1)
+	require.Equal(suite.T(), "cosmos1filter2", resp.VerifiedAccounts[0].Address)
+
+	// Active flag filter
+	active := false
+	resp, err = suite.queryServer.VerifiedAccounts(ctx, &identv1.QueryVerifiedAccountsRequest{IsActive: &active})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.VerifiedAccounts, 1)
+	require.Equal(suite.T(), "cosmos1filter4", resp.VerifiedAccounts[0].Address)
+
+	active = true
+	resp, err = suite.queryServer.VerifiedAccounts(ctx, &identv1.QueryVerifiedAccountsRequest{
+		Role:     identv1.Role_ROLE_CITIZEN,
+		IsActive: &active,
+	})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.VerifiedAccounts, 2)
+}
+
 func (suite *QueryServerTestSuite) TestVerificationProviders() {
 	// Add two providers via keeper
 	for i, id := range []string{"qp-a", "qp-b"} {
@@ -266,3 +328,106 @@ func (suite *QueryServerTestSuite) TestVerificationProviders_WithPagination() {
 	require.LessOrEqual(suite.T(), len(resp.VerificationProviders), 2)
 }
 
+
+func (suite *QueryServerTestSuite) TestIdentityVerification() {
+	err := suite.keeper.SetVerifiedAccount(suite.ctx, &identv1.VerifiedAccount{
+		Address:              "cosmos1verified",
+		Role:                 identv1.Role_ROLE_CITIZEN,
+		IsActive:             true,
+		VerificationDate:     timestamppb.Now(),
+		VerificationProvider: "account-provider",
+		IdentityHash:         "verified_hash",
+	})
+	require.NoError(suite.T(), err)
+	err = suite.keeper.StoreVerificationRecord(suite.ctx, &VerificationRecord{
+		Address:      "cosmos1verified",
+		ProviderID:   "record-provider",
+		Nullifier:    []byte{0xab, 0xcd},
+		IdentityHash: "verified_hash",
+	})
+	require.NoError(suite.T(), err)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	resp, err := suite.queryServer.IdentityVerification(ctx, &identv1.QueryIdentityVerificationRequest{Address: "cosmos1verified"})
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), resp.IdentityVerification.IsVerified)
+	require.Equal(suite.T(), "record-provider", resp.IdentityVerification.VerificationProvider)
+	require.Equal(suite.T(), "abcd", resp.IdentityVerification.VerificationId)
+	require.Equal(suite.T(), "verified_hash", resp.IdentityVerification.VerificationHash)
+
+	// An expired verification is no longer verified
+	err = suite.keeper.StoreVerificationRecord(suite.ctx, &VerificationRecord{
+		Address:        "cosmos1verified",
+		ProviderID:     "record-provider",
+		ExpirationTime: timestamppb.New(suite.ctx.BlockTime().Add(-time.Hour)),
+		IdentityHash:   "verified_hash",
+	})
+	require.NoError(suite.T(), err)
+	resp, err = suite.queryServer.IdentityVerification(ctx, &identv1.QueryIdentityVerificationRequest{Address: "cosmos1verified"})
+	require.NoError(suite.T(), err)
+	require.False(suite.T(), resp.IdentityVerification.IsVerified)
+
+	_, err = suite.queryServer.IdentityVerification(ctx, &identv1.QueryIdentityVerificationRequest{Address: "cosmos1unknown"})
+	require.ErrorIs(suite.T(), err, types.ErrAccountNotFound)
+
+	_, err = suite.queryServer.IdentityVerification(ctx, &identv1.QueryIdentityVerificationRequest{})
+	require.ErrorIs(suite.T(), err, types.ErrEmptyAddress)
+}
+
+func (suite *QueryServerTestSuite) TestRoleMigrations() {
+	migrations := []*identv1.RoleMigration{
+		{FromAddress: "cosmos1a", ToAddress: "cosmos1b", FromRole: identv1.Role_ROLE_CITIZEN, ToRole: identv1.Role_ROLE_CITIZEN, IsCompleted: true},
+		{FromAddress: "cosmos1b", ToAddress: "cosmos1c", FromRole: identv1.Role_ROLE_CITIZEN, ToRole: identv1.Role_ROLE_CITIZEN},
+		{FromAddress: "cosmos1d", ToAddress: "cosmos1e", FromRole: identv1.Role_ROLE_VALIDATOR, ToRole: identv1.Role_ROLE_VALIDATOR},
+	}
+	for _, migration := range migrations {
+		require.NoError(suite.T(), suite.keeper.SetRoleMigration(suite.ctx, migration))
+	}
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	resp, err := suite.queryServer.RoleMigrations(ctx, &identv1.QueryRoleMigrationsRequest{})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.RoleMigrations, 3)
+
+	// Migrations from and to the address
+	resp, err = suite.queryServer.RoleMigrations(ctx, &identv1.QueryRoleMigrationsRequest{Address: "cosmos1b"})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.RoleMigrations, 2)
+	require.Equal(suite.T(), "cosmos1a", resp.RoleMigrations[0].FromAddress)
+	require.Equal(suite.T(), "cosmos1b", resp.RoleMigrations[1].FromAddress)
+
+	// An address extending another's does not match its migrations
+	resp, err = suite.queryServer.RoleMigrations(ctx, &identv1.QueryRoleMigrationsRequest{Address: "cosmos1bb"})
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), resp.RoleMigrations)
+
+	pending := false
+	resp, err = suite.queryServer.RoleMigrations(ctx, &identv1.QueryRoleMigrationsRequest{
+		IsCompleted: &pending,
+		Pagination:  &sdkquery.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.RoleMigrations, 1)
+	require.Equal(suite.T(), "cosmos1b", resp.RoleMigrations[0].FromAddress)
+	require.Equal(suite.T(), uint64(2), resp.Pagination.Total)
+}
+
+func (suite *QueryServerTestSuite) TestNullifier() {
+	nullifier := []byte{0x01, 0x02, 0x03}
+	require.NoError(suite.T(), suite.keeper.EnhancedNullifierCheck(suite.ctx, nullifier, "cosmos1owner"))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	resp, err := suite.queryServer.Nullifier(ctx, &identv1.QueryNullifierRequest{Nullifier: "010203"})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), nullifier, resp.Nullifier.Nullifier)
+	require.Equal(suite.T(), "cosmos1owner", resp.Nullifier.Address)
+
+	_, err = suite.queryServer.Nullifier(ctx, &identv1.QueryNullifierRequest{Nullifier: "040506"})
+	require.ErrorIs(suite.T(), err, types.ErrNullifierNotFound)
+
+	_, err = suite.queryServer.Nullifier(ctx, &identv1.QueryNullifierRequest{Nullifier: "not-hex"})
+	require.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.queryServer.Nullifier(ctx, &identv1.QueryNullifierRequest{})
+	require.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+}
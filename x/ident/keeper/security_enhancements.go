@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
@@ -34,6 +36,56 @@ type VerificationRecord struct {
 	IdentityHash      string    `json:"identity_hash"`
 }
 
+// ToProto converts the provider to its proto form
+func (p *VerificationProvider) ToProto() *identv1.VerificationProvider {
+	return &identv1.VerificationProvider{
+		ProviderId:        p.ProviderID,
+		ProviderName:      p.ProviderName,
+		ProviderPublicKey: p.PublicKey,
+		IsAccredited:      p.IsActive,
+		AccreditationDate: p.RegistrationTime,
+		AccreditationHash: p.AccreditationHash,
+		ExpirationTime:    p.ExpirationTime,
+	}
+}
+
+// VerificationProviderFromProto converts a proto provider to its stored form
+func VerificationProviderFromProto(p *identv1.VerificationProvider) *VerificationProvider {
+	return &VerificationProvider{
+		ProviderID:        p.ProviderId,
+		ProviderName:      p.ProviderName,
+		PublicKey:         p.ProviderPublicKey,
+		AccreditationHash: p.AccreditationHash,
+		IsActive:          p.IsAccredited,
+		RegistrationTime:  p.AccreditationDate,
+		ExpirationTime:    p.ExpirationTime,
+	}
+}
+
+// accreditationRecord is the stored form of an accreditation
+type accreditationRecord struct {
+	Valid bool `json:"valid"`
+}
+
+// nullifierRecord is the stored form of a used nullifier
+// Fields are in alphabetical order, matching records written as JSON maps
+type nullifierRecord struct {
+	Address     string `json:"address"`
+	BlockHeight int64  `json:"block_height"`
+	Nullifier   []byte `json:"nullifier"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+// proofRecord is the stored form of a used ZKP proof
+// Fields are in alphabetical order, matching records written as JSON maps
+type proofRecord struct {
+	Address     string `json:"address"`
+	BlockHeight int64  `json:"block_height"`
+	ProofHash   []byte `json:"proof_hash"`
+	ProviderID  string `json:"provider_id"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
 // VerifyProvider validates a verification provider
 // According to whitepaper: "через аккредитованных провайдеров"
 func (k Keeper) VerifyProvider(ctx sdk.Context, providerID string) error {
@@ -67,8 +119,7 @@ func (k Keeper) VerifyProvider(ctx sdk.Context, providerID string) error {
 func (k Keeper) SetAccreditationRecord(ctx sdk.Context, accreditationHash string, valid bool) error {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetAccreditationKey(accreditationHash)
-	data := map[string]interface{}{"valid": valid}
-	bz, err := json.Marshal(data)
+	bz, err := json.Marshal(accreditationRecord{Valid: valid})
 	if err != nil {
 		return fmt.Errorf("failed to marshal accreditation: %w", err)
 	}
@@ -159,6 +210,29 @@ func (k Keeper) GetAllVerificationProviders(ctx sdk.Context) ([]*VerificationPro
 	return list, nil
 }
 
+// GetVerificationProvidersPage returns a page of the verification providers, in provider ID order
+// Undecodable providers are skipped, as in GetAllVerificationProviders
+func (k Keeper) GetVerificationProvidersPage(ctx sdk.Context, pageReq *sdkquery.PageRequest) ([]*VerificationProvider, *sdkquery.PageResponse, error) {
+	providerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProviderKeyPrefix)
+
+	var list []*VerificationProvider
+	pageRes, err := sdkquery.FilteredPaginate(providerStore, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var p VerificationProvider
+		if err := json.Unmarshal(value, &p); err != nil {
+			return false, nil
+		}
+		if accumulate {
+			list = append(list, &p)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return list, pageRes, nil
+}
+
 // CheckVerificationExpiration checks if a verification has expired
 // According to whitepaper: verifications should have expiration times
 func (k Keeper) CheckVerificationExpiration(ctx sdk.Context, address string) error {
@@ -199,6 +273,60 @@ func (k Keeper) StoreVerificationRecord(ctx sdk.Context, record *VerificationRec
 	return nil
 }
 
+// GetVerificationRecord retrieves the verification record of an address
+func (k Keeper) GetVerificationRecord(ctx sdk.Context, address string) (*VerificationRecord, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetVerificationRecordKey(address))
+	if bz == nil {
+		return nil, types.ErrAccountNotFound
+	}
+
+	var record VerificationRecord
+	if err := json.Unmarshal(bz, &record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal verification record: %w", err)
+	}
+
+	return &record, nil
+}
+
+// GetIdentityVerification combines the verified account and the verification record of an address.
+// The identity counts as verified while the account is active and the verification has not expired.
+func (k Keeper) GetIdentityVerification(ctx sdk.Context, address string) (*identv1.IdentityVerification, error) {
+	account, err := k.GetVerifiedAccount(ctx, address)
+	if err != nil && !types.ErrAccountNotFound.Is(err) {
+		return nil, err
+	}
+	record, err := k.GetVerificationRecord(ctx, address)
+	if err != nil && !types.ErrAccountNotFound.Is(err) {
+		return nil, err
+	}
+	if account == nil && record == nil {
+		return nil, types.ErrAccountNotFound
+	}
+
+	verification := &identv1.IdentityVerification{Address: address}
+	if account != nil {
+		verification.VerificationProvider = account.VerificationProvider
+		verification.VerificationDate = account.VerificationDate
+		verification.VerificationHash = account.IdentityHash
+		verification.IsVerified = account.IsActive
+	}
+	if record != nil {
+		verification.VerificationProvider = record.ProviderID
+		verification.VerificationId = hex.EncodeToString(record.Nullifier)
+		if record.VerificationTime != nil {
+			verification.VerificationDate = record.VerificationTime
+		}
+		if verification.VerificationHash == "" {
+			verification.VerificationHash = record.IdentityHash
+		}
+		if record.ExpirationTime != nil && ctx.BlockTime().After(record.ExpirationTime.AsTime()) {
+			verification.IsVerified = false
+		}
+	}
+
+	return verification, nil
+}
+
 // EnhancedNullifierCheck provides enhanced protection against nullifier reuse
 // According to whitepaper: "один человек — одна верифицированная роль"
 func (k Keeper) EnhancedNullifierCheck(ctx sdk.Context, nullifier []byte, address string) error {
@@ -225,22 +353,35 @@ func (k Keeper) EnhancedNullifierCheck(ctx sdk.Context, nullifier []byte, addres
 	}
 
 	// Store nullifier with address and timestamp
-	nullifierRecord := map[string]interface{}{
-		"address":    address,
-		"nullifier":  nullifier,
-		"timestamp":  ctx.BlockTime().Unix(),
-		"block_height": ctx.BlockHeight(),
-	}
+	return k.setNullifierRecord(ctx, &nullifierRecord{
+		Address:     address,
+		BlockHeight: ctx.BlockHeight(),
+		Nullifier:   nullifier,
+		Timestamp:   ctx.BlockTime().Unix(),
+	})
+}
 
-	nullifierBz, err := json.Marshal(nullifierRecord)
+// setNullifierRecord stores the record of a used nullifier
+func (k Keeper) setNullifierRecord(ctx sdk.Context, record *nullifierRecord) error {
+	bz, err := json.Marshal(record)
 	if err != nil {
 		return fmt.Errorf("failed to marshal nullifier record: %w", err)
 	}
 
-	store.Set(nullifierKey, nullifierBz)
+	ctx.KVStore(k.storeKey).Set(types.GetNullifierKey(record.Nullifier), bz)
 	return nil
 }
 
+// GetNullifier returns the recorded use of a nullifier
+func (k Keeper) GetNullifier(ctx sdk.Context, nullifier []byte) (*identv1.Nullifier, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetNullifierKey(nullifier))
+	if bz == nil {
+		return nil, types.ErrNullifierNotFound
+	}
+
+	return decodeNullifier(nullifier, bz), nil
+}
+
 // VerifyZKProofIntegrity provides enhanced protection against ZKP proof forgery
 func (k Keeper) VerifyZKProofIntegrity(ctx sdk.Context, proof string, providerID string, address string) error {
 	// 1. Verify proof format
@@ -285,20 +426,23 @@ func (k Keeper) VerifyZKProofIntegrity(ctx sdk.Context, proof string, providerID
 	}
 
 	// Store proof record
-	proofRecord := map[string]interface{}{
-		"address":     address,
-		"provider_id": providerID,
-		"proof_hash":  proofHash[:],
-		"timestamp":   ctx.BlockTime().Unix(),
-		"block_height": ctx.BlockHeight(),
-	}
+	return k.setProofRecord(ctx, &proofRecord{
+		Address:     address,
+		BlockHeight: ctx.BlockHeight(),
+		ProofHash:   proofHash[:],
+		ProviderID:  providerID,
+		Timestamp:   ctx.BlockTime().Unix(),
+	})
+}
 
-	proofBz, err := json.Marshal(proofRecord)
+// setProofRecord stores the record of a used proof
+func (k Keeper) setProofRecord(ctx sdk.Context, record *proofRecord) error {
+	bz, err := json.Marshal(record)
 	if err != nil {
 		return fmt.Errorf("failed to marshal proof record: %w", err)
 	}
 
-	store.Set(proofKey, proofBz)
+	ctx.KVStore(k.storeKey).Set(types.GetProofKey(record.ProofHash), bz)
 	return nil
 }
 
@@ -215,15 +215,14 @@ func (zkp *ZKPVerifier) verifyMerkleProof(merkleProof, nullifier []byte) error {
 }
 
 // storeNullifier stores a used nullifier to prevent reuse
+// The record is the one EnhancedNullifierCheck reads, so the same address can reuse its nullifier
 func (zkp *ZKPVerifier) storeNullifier(ctx sdk.Context, nullifier []byte, address string) error {
-	store := ctx.KVStore(zkp.keeper.storeKey)
-	nullifierKey := types.GetNullifierKey(nullifier)
-
-	// Simple encoding for nullifier data
-	bz := append(nullifier, []byte(address)...)
-
-	store.Set(nullifierKey, bz)
-	return nil
+	return zkp.keeper.setNullifierRecord(ctx, &nullifierRecord{
+		Address:     address,
+		BlockHeight: ctx.BlockHeight(),
+		Nullifier:   nullifier,
+		Timestamp:   ctx.BlockTime().Unix(),
+	})
 }
 
 // GenerateIdentityProof generates a ZK proof for identity verification (for testing)
@@ -1,7 +1,9 @@
 package ident
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
 	storetypes "cosmossdk.io/store/types"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -10,11 +12,14 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 	gatewayruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/ident/keeper"
+	identsim "github.com/volnix-protocol/volnix-protocol/x/ident/simulation"
 	identtypes "github.com/volnix-protocol/volnix-protocol/x/ident/types"
 )
 
@@ -36,45 +41,91 @@ func (AppModuleBasic) DefaultGenesis(_ codec.JSONCodec) json.RawMessage {
 	return bz
 }
 
-func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+// ValidateGenesis validates the ident genesis state
+// The genesis uses JSON marshaling (not proto): the params embed SDK coins, which protojson cannot encode
+func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
 	var gen identv1.GenesisState
-	if err := cdc.UnmarshalJSON(bz, &gen); err != nil {
-		return err
+	if err := json.Unmarshal(bz, &gen); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", identtypes.ModuleName, err)
 	}
 	return Validate(&gen)
 }
 
-// Required by module.AppModuleBasic in Cosmos SDK v0.53
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *gatewayruntime.ServeMux) {}
+// RegisterGRPCGatewayRoutes registers the REST routes of the query service under /volnix/ident/v1
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gatewayruntime.ServeMux) {
+	if err := identv1.RegisterQueryHandlerClient(context.Background(), mux, identv1.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // AppModule implements an application module for the ident module.
 type AppModule struct {
 	AppModuleBasic
 
-	keeper *keeper.Keeper
+	cdc           codec.Codec
+	keeper        *keeper.Keeper
+	accountKeeper simulation.AccountKeeper
+	bankKeeper    simulation.BankKeeper
 }
 
-var _ module.AppModule = AppModule{}
+var (
+	_ module.AppModule           = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
+)
 
-func NewAppModule(k *keeper.Keeper) AppModule {
-	return AppModule{keeper: k}
+func NewAppModule(cdc codec.Codec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) AppModule {
+	return AppModule{cdc: cdc, keeper: k, accountKeeper: ak, bankKeeper: bk}
 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	identv1.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	identv1.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(*am.keeper)
+	if err := cfg.RegisterMigration(identtypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", identtypes.ModuleName, err))
+	}
 }
 
-func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+// RegisterInvariants registers the ident module invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, *am.keeper)
+}
+
+// ConsensusVersion is the consensus version of the ident store, bumped with every store migration
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
 	var gen identv1.GenesisState
-	cdc.MustUnmarshalJSON(data, &gen)
+	if err := json.Unmarshal(data, &gen); err != nil {
+		panic(fmt.Errorf("failed to unmarshal ident genesis state: %w", err))
+	}
 	InitGenesis(ctx, am.keeper, &gen)
 	return []abci.ValidatorUpdate{}
 }
 
-func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
 	gen := ExportGenesis(ctx, am.keeper)
-	return cdc.MustMarshalJSON(gen)
+	bz, err := json.Marshal(gen)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal ident genesis state: %w", err))
+	}
+	return bz
+}
+
+// GenerateGenesisState creates a randomized GenState of the ident module
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	identsim.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for ident module's types
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[identtypes.StoreKey] = identsim.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the all the ident module operations with their respective weights
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return identsim.WeightedOperations(simState.AppParams, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper)
 }
 
 // Marker method required by module.AppModule in Cosmos SDK v0.53
@@ -0,0 +1,56 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding ident type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.VerifiedAccountKeyPrefix):
+			var accountA, accountB identv1.VerifiedAccount
+			cdc.MustUnmarshal(kvA.Value, &accountA)
+			cdc.MustUnmarshal(kvB.Value, &accountB)
+			return fmt.Sprintf("%v\n%v", &accountA, &accountB)
+
+		case bytes.HasPrefix(kvA.Key, types.RoleMigrationKeyPrefix):
+			var migrationA, migrationB identv1.RoleMigration
+			cdc.MustUnmarshal(kvA.Value, &migrationA)
+			cdc.MustUnmarshal(kvB.Value, &migrationB)
+			return fmt.Sprintf("%v\n%v", &migrationA, &migrationB)
+
+		case bytes.HasPrefix(kvA.Key, types.RoleMigrationAddressIndexKeyPrefix):
+			// Index entries have no value, the addresses are in the key
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		case bytes.HasPrefix(kvA.Key, types.FreeTxUsageKeyPrefix):
+			var usageA, usageB identv1.FreeTxUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", &usageA, &usageB)
+
+		case bytes.HasPrefix(kvA.Key, types.NullifierKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.IdentityHashKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.ProviderKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.AccreditationKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.VerificationRecordKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.ProofKeyPrefix):
+			// JSON records
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid ident key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package simulation
+
+import (
+	"encoding/json"
+	"math/rand"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+// Simulation parameter keys
+const (
+	CitizenActivityPeriod   = "citizen_activity_period"
+	ValidatorActivityPeriod = "validator_activity_period"
+	MaxIdentitiesPerAddress = "max_identities_per_address"
+	CitizenDailyFreeTxs     = "citizen_daily_free_txs"
+	MaxFreeTxGas            = "max_free_tx_gas"
+	GuestMinGasPrice        = "guest_min_gas_price"
+	CitizenMinGasPrice      = "citizen_min_gas_price"
+	ValidatorMinGasPrice    = "validator_min_gas_price"
+)
+
+// genActivityPeriod returns an inactivity period between one and thirty days
+func genActivityPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(30)+1) * 24 * time.Hour
+}
+
+// genMinGasPrice returns a gas price between 0.001 and 0.05
+func genMinGasPrice(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(r.Intn(50)+1), 3)
+}
+
+// RandomizedGenState generates a random genesis state for the ident module
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(CitizenActivityPeriod, &params.CitizenActivityPeriod, simState.Rand,
+		func(r *rand.Rand) { params.CitizenActivityPeriod = genActivityPeriod(r) })
+	simState.AppParams.GetOrGenerate(ValidatorActivityPeriod, &params.ValidatorActivityPeriod, simState.Rand,
+		func(r *rand.Rand) { params.ValidatorActivityPeriod = genActivityPeriod(r) })
+	// The limit applies per role across all accounts, leave room for most simulation accounts
+	simState.AppParams.GetOrGenerate(MaxIdentitiesPerAddress, &params.MaxIdentitiesPerAddress, simState.Rand,
+		func(r *rand.Rand) {
+			params.MaxIdentitiesPerAddress = uint64(len(simState.Accounts)/2 + r.Intn(len(simState.Accounts)+1) + 1)
+		})
+	simState.AppParams.GetOrGenerate(CitizenDailyFreeTxs, &params.CitizenDailyFreeTxs, simState.Rand,
+		func(r *rand.Rand) { params.CitizenDailyFreeTxs = uint64(r.Intn(21)) })
+	// Around half of the runs allow free transactions with the simulation gas limit
+	simState.AppParams.GetOrGenerate(MaxFreeTxGas, &params.MaxFreeTxGas, simState.Rand,
+		func(r *rand.Rand) { params.MaxFreeTxGas = uint64(r.Int63n(2*simtestutil.DefaultGenTxGas) + 1) })
+
+	var guestPrice, citizenPrice, validatorPrice sdkmath.LegacyDec
+	simState.AppParams.GetOrGenerate(GuestMinGasPrice, &guestPrice, simState.Rand,
+		func(r *rand.Rand) { guestPrice = genMinGasPrice(r) })
+	simState.AppParams.GetOrGenerate(CitizenMinGasPrice, &citizenPrice, simState.Rand,
+		func(r *rand.Rand) { citizenPrice = genMinGasPrice(r) })
+	simState.AppParams.GetOrGenerate(ValidatorMinGasPrice, &validatorPrice, simState.Rand,
+		func(r *rand.Rand) { validatorPrice = genMinGasPrice(r) })
+	params.GuestMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(simState.BondDenom, guestPrice))
+	params.CitizenMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(simState.BondDenom, citizenPrice))
+	params.ValidatorMinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(simState.BondDenom, validatorPrice))
+
+	genesis := &identv1.GenesisState{
+		Params:                params.ToProto(),
+		VerifiedAccounts:      []*identv1.VerifiedAccount{},
+		IdentityVerifications: []*identv1.IdentityVerification{},
+		RoleMigrations:        []*identv1.RoleMigration{},
+		VerificationProviders: []*identv1.VerificationProvider{},
+		ZkpProofs:             []*identv1.ZKPProof{},
+	}
+	bz, err := json.Marshal(genesis)
+	if err != nil {
+		panic(err)
+	}
+	simState.GenState[types.ModuleName] = bz
+}
@@ -0,0 +1,240 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	"github.com/volnix-protocol/volnix-protocol/testutil/simtx"
+	"github.com/volnix-protocol/volnix-protocol/x/ident/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgVerifyIdentity = "op_weight_msg_verify_identity"
+	OpWeightMsgMigrateRole    = "op_weight_msg_migrate_role"
+	OpWeightMsgChangeRole     = "op_weight_msg_change_role"
+
+	DefaultWeightMsgVerifyIdentity = 40
+	DefaultWeightMsgMigrateRole    = 5
+	DefaultWeightMsgChangeRole     = 10
+)
+
+// WeightedOperations returns all the operations from the ident module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgVerifyIdentity, weightMsgMigrateRole, weightMsgChangeRole int
+	appParams.GetOrGenerate(OpWeightMsgVerifyIdentity, &weightMsgVerifyIdentity, nil,
+		func(_ *rand.Rand) { weightMsgVerifyIdentity = DefaultWeightMsgVerifyIdentity })
+	appParams.GetOrGenerate(OpWeightMsgMigrateRole, &weightMsgMigrateRole, nil,
+		func(_ *rand.Rand) { weightMsgMigrateRole = DefaultWeightMsgMigrateRole })
+	appParams.GetOrGenerate(OpWeightMsgChangeRole, &weightMsgChangeRole, nil,
+		func(_ *rand.Rand) { weightMsgChangeRole = DefaultWeightMsgChangeRole })
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgVerifyIdentity, SimulateMsgVerifyIdentity(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgMigrateRole, SimulateMsgMigrateRole(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgChangeRole, SimulateMsgChangeRole(txGen, ak, bk, k)),
+	}
+}
+
+// SimulateMsgVerifyIdentity generates a MsgVerifyIdentity for a random unverified account
+// with a fresh proof and a random citizen or validator role
+func SimulateMsgVerifyIdentity(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&identv1.MsgVerifyIdentity{})
+
+		var candidates []simtypes.Account
+		for _, acc := range accs {
+			if _, err := k.GetVerifiedAccount(ctx, acc.Address.String()); err != nil {
+				candidates = append(candidates, acc)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unverified accounts"), nil, nil
+		}
+		simAccount := candidates[r.Intn(len(candidates))]
+
+		role := identv1.Role_ROLE_CITIZEN
+		if r.Intn(2) == 0 {
+			role = identv1.Role_ROLE_VALIDATOR
+		}
+		full, err := roleLimitReached(ctx, k, role)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to count verified accounts"), nil, err
+		}
+		if full {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "role identity limit reached"), nil, nil
+		}
+
+		// A hex encoded random digest is long enough to pass the proof format check
+		digest := sha256.Sum256([]byte(simtypes.RandStringOfLength(r, 32)))
+		proof := hex.EncodeToString(digest[:])
+		if err := k.CheckDuplicateIdentityHash(ctx, fmt.Sprintf("hash-%s", proof[:16]), simAccount.Address.String()); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "identity hash already used"), nil, nil
+		}
+
+		msg := &identv1.MsgVerifyIdentity{
+			Address:     simAccount.Address.String(),
+			ZkpProof:    proof,
+			DesiredRole: role,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, k)
+	}
+}
+
+// SimulateMsgMigrateRole generates a MsgMigrateRole moving the identity of a random active
+// verified account to a random unverified account
+func SimulateMsgMigrateRole(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&identv1.MsgMigrateRole{})
+
+		var verified, unverified []simtypes.Account
+		for _, acc := range accs {
+			account, err := k.GetVerifiedAccount(ctx, acc.Address.String())
+			switch {
+			case err != nil:
+				unverified = append(unverified, acc)
+			case account.IsActive:
+				verified = append(verified, acc)
+			}
+		}
+		if len(verified) == 0 || len(unverified) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no account to migrate from or to"), nil, nil
+		}
+		from := verified[r.Intn(len(verified))]
+		to := unverified[r.Intn(len(unverified))]
+
+		// The migrated identity counts towards the role limit and is indexed under the migration hash
+		fromAccount, err := k.GetVerifiedAccount(ctx, from.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account to migrate from not found"), nil, err
+		}
+		full, err := roleLimitReached(ctx, k, fromAccount.Role)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to count verified accounts"), nil, err
+		}
+		if full {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "role identity limit reached"), nil, nil
+		}
+		migrationHash := fmt.Sprintf("migration-%s-%s", from.Address, to.Address)
+		if err := k.CheckDuplicateIdentityHash(ctx, migrationHash, to.Address.String()); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "migration hash already used"), nil, nil
+		}
+
+		msg := &identv1.MsgMigrateRole{
+			FromAddress: from.Address.String(),
+			ToAddress:   to.Address.String(),
+			ZkpProof:    simtypes.RandStringOfLength(r, 32),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      from,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, k)
+	}
+}
+
+// SimulateMsgChangeRole generates a MsgChangeRole switching a random verified account
+// between the citizen and validator roles
+func SimulateMsgChangeRole(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&identv1.MsgChangeRole{})
+
+		var candidates []simtypes.Account
+		for _, acc := range accs {
+			if account, err := k.GetVerifiedAccount(ctx, acc.Address.String()); err == nil && account.IdentityHash != "" {
+				candidates = append(candidates, acc)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no verified accounts"), nil, nil
+		}
+		simAccount := candidates[r.Intn(len(candidates))]
+
+		// The role limit applies to the new role even when it does not change
+		newRole := identv1.Role_ROLE_CITIZEN
+		if r.Intn(2) == 0 {
+			newRole = identv1.Role_ROLE_VALIDATOR
+		}
+		full, err := roleLimitReached(ctx, k, newRole)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to count verified accounts"), nil, err
+		}
+		if full {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "role identity limit reached"), nil, nil
+		}
+
+		msg := &identv1.MsgChangeRole{
+			Address:  simAccount.Address.String(),
+			NewRole:  newRole,
+			ZkpProof: simtypes.RandStringOfLength(r, 32),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, k)
+	}
+}
+
+// roleLimitReached reports whether role holds MaxIdentitiesPerAddress verified accounts, so
+// that no account can be verified as, migrated to or changed to role
+func roleLimitReached(ctx sdk.Context, k *keeper.Keeper, role identv1.Role) (bool, error) {
+	accounts, err := k.GetVerifiedAccountsByRole(ctx, role)
+	if err != nil {
+		return false, err
+	}
+	return uint64(len(accounts)) >= k.GetParams(ctx).MaxIdentitiesPerAddress, nil
+}
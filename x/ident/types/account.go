@@ -9,7 +9,13 @@ import (
 
 // NewVerifiedAccount creates a new VerifiedAccount instance
 func NewVerifiedAccount(address string, role identv1.Role, identityHash string) *identv1.VerifiedAccount {
-	now := timestamppb.Now()
+	return NewVerifiedAccountAt(time.Now(), address, role, identityHash)
+}
+
+// NewVerifiedAccountAt creates a new VerifiedAccount instance last active at verifiedAt
+// Message handlers pass the block time so every node stores the same account
+func NewVerifiedAccountAt(verifiedAt time.Time, address string, role identv1.Role, identityHash string) *identv1.VerifiedAccount {
+	now := timestamppb.New(verifiedAt)
 	return &identv1.VerifiedAccount{
 		Address:      address,
 		Role:         role,
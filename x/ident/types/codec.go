@@ -17,6 +17,7 @@ func RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 		&identv1.MsgMigrateRole{},
 		&identv1.MsgChangeRole{},
 		&identv1.MsgRegisterVerificationProvider{},
+		&identv1.MsgUpdateParams{},
 	)
 
 	// tx.MsgResponse responses
@@ -25,5 +26,6 @@ func RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 		&identv1.MsgMigrateRoleResponse{},
 		&identv1.MsgChangeRoleResponse{},
 		&identv1.MsgRegisterVerificationProviderResponse{},
+		&identv1.MsgUpdateParamsResponse{},
 	)
 }
@@ -36,4 +36,13 @@ var (
 	
 	// IMPROVED: Duplicate identity hash prevention
 	ErrDuplicateIdentityHash = errors.Register(ModuleName, 12, "identity hash already exists for another address")
+
+	// ErrUnauthorized indicates that the signer is not the module authority
+	ErrUnauthorized = errors.Register(ModuleName, 13, "unauthorized")
+
+	// ErrInvalidParams indicates that the module parameters are invalid
+	ErrInvalidParams = errors.Register(ModuleName, 14, "invalid params")
+
+	// ErrNullifierNotFound indicates that the nullifier has not been used
+	ErrNullifierNotFound = errors.Register(ModuleName, 15, "nullifier not found")
 )
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+)
+
+// IdentHooks defines the hooks other modules can subscribe to for identity changes
+type IdentHooks interface {
+	// AfterIdentityVerified is called after an account has been verified with a role
+	AfterIdentityVerified(ctx sdk.Context, address string, role identv1.Role) error
+
+	// AfterRoleChanged is called after the role of a verified account has changed
+	AfterRoleChanged(ctx sdk.Context, address string, oldRole, newRole identv1.Role) error
+}
+
+var _ IdentHooks = MultiIdentHooks{}
+
+// MultiIdentHooks combines multiple ident hooks, all hook functions are run in array sequence
+type MultiIdentHooks []IdentHooks
+
+// NewMultiIdentHooks creates a new MultiIdentHooks
+func NewMultiIdentHooks(hooks ...IdentHooks) MultiIdentHooks {
+	return hooks
+}
+
+// AfterIdentityVerified runs AfterIdentityVerified of every hook, stopping at the first error
+func (h MultiIdentHooks) AfterIdentityVerified(ctx sdk.Context, address string, role identv1.Role) error {
+	for i := range h {
+		if err := h[i].AfterIdentityVerified(ctx, address, role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterRoleChanged runs AfterRoleChanged of every hook, stopping at the first error
+func (h MultiIdentHooks) AfterRoleChanged(ctx sdk.Context, address string, oldRole, newRole identv1.Role) error {
+	for i := range h {
+		if err := h[i].AfterRoleChanged(ctx, address, oldRole, newRole); err != nil {
+			return err
+		}
+	}
+	return nil
+}
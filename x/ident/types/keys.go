@@ -1,5 +1,7 @@
 package types
 
+import "github.com/cosmos/cosmos-sdk/types/address"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "ident"
@@ -38,6 +40,13 @@ var (
 	
 	// IdentityHashKeyPrefix defines the prefix for identity hash keys (duplicate prevention)
 	IdentityHashKeyPrefix = []byte{0x08}
+
+	// FreeTxUsageKeyPrefix defines the prefix for the daily fee-free transaction usage of citizens
+	FreeTxUsageKeyPrefix = []byte{0x09}
+
+	// RoleMigrationAddressIndexKeyPrefix defines the prefix for the index of role migrations
+	// by source and destination address
+	RoleMigrationAddressIndexKeyPrefix = []byte{0x0A}
 )
 
 // GetVerifiedAccountKey returns the key for a verified account
@@ -50,6 +59,18 @@ func GetRoleMigrationKey(fromAddress, toAddress string) []byte {
 	return append(RoleMigrationKeyPrefix, []byte(fromAddress+"_"+toAddress)...)
 }
 
+// GetRoleMigrationAddressPrefix returns the prefix of the address index entries of an address
+// The address is length-prefixed so that no address's prefix is a prefix of another's
+func GetRoleMigrationAddressPrefix(addr string) []byte {
+	return append(append([]byte{}, RoleMigrationAddressIndexKeyPrefix...), address.MustLengthPrefix([]byte(addr))...)
+}
+
+// GetRoleMigrationAddressIndexKey returns the address index key of a role migration
+// The key ends with the role migration key without its prefix
+func GetRoleMigrationAddressIndexKey(addr, fromAddress, toAddress string) []byte {
+	return append(GetRoleMigrationAddressPrefix(addr), GetRoleMigrationKey(fromAddress, toAddress)[len(RoleMigrationKeyPrefix):]...)
+}
+
 // GetNullifierKey returns the key for a nullifier
 func GetNullifierKey(nullifier []byte) []byte {
 	return append(NullifierKeyPrefix, nullifier...)
@@ -79,3 +100,8 @@ func GetProofKey(proofHash []byte) []byte {
 func GetIdentityHashKey(identityHash string) []byte {
 	return append(IdentityHashKeyPrefix, []byte(identityHash)...)
 }
+
+// GetFreeTxUsageKey returns the key for the fee-free transaction usage of an address
+func GetFreeTxUsageKey(address string) []byte {
+	return append(FreeTxUsageKeyPrefix, []byte(address)...)
+}
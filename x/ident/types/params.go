@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmath "cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
 )
 
 var (
@@ -18,6 +20,12 @@ var (
 	KeyVerificationCost             = []byte("VerificationCost")
 	KeyMigrationFee                 = []byte("MigrationFee")
 	KeyRoleChangeFee                = []byte("RoleChangeFee")
+	KeyGuestMinGasPrices            = []byte("GuestMinGasPrices")
+	KeyCitizenMinGasPrices          = []byte("CitizenMinGasPrices")
+	KeyValidatorMinGasPrices        = []byte("ValidatorMinGasPrices")
+	KeyCitizenDailyFreeTxs          = []byte("CitizenDailyFreeTxs")
+	KeyGuestTxRate                  = []byte("GuestTxRate")
+	KeyMaxFreeTxGas                 = []byte("MaxFreeTxGas")
 )
 
 // Ensure Params implements ParamSet
@@ -33,6 +41,17 @@ type Params struct {
 	VerificationCost             sdk.Coin      `json:"verification_cost"`
 	MigrationFee                 sdk.Coin      `json:"migration_fee"`
 	RoleChangeFee                sdk.Coin      `json:"role_change_fee"`
+
+	// Transaction fee policy by the role of the fee payer
+	GuestMinGasPrices     sdk.DecCoins `json:"guest_min_gas_prices"`
+	CitizenMinGasPrices   sdk.DecCoins `json:"citizen_min_gas_prices"`
+	ValidatorMinGasPrices sdk.DecCoins `json:"validator_min_gas_prices"`
+	// CitizenDailyFreeTxs is the number of fee-free transactions per citizen per day
+	CitizenDailyFreeTxs uint64 `json:"citizen_daily_free_txs"`
+	// GuestTxRate is the per-address transaction rate limit for guests (tx/sec)
+	GuestTxRate sdkmath.LegacyDec `json:"guest_tx_rate"`
+	// MaxFreeTxGas is the gas limit up to which a citizen transaction can use the free quota
+	MaxFreeTxGas uint64 `json:"max_free_tx_gas"`
 }
 
 // ParamKeyTable for ident module
@@ -51,6 +70,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyVerificationCost, &p.VerificationCost, validateCoin),
 		paramtypes.NewParamSetPair(KeyMigrationFee, &p.MigrationFee, validateCoin),
 		paramtypes.NewParamSetPair(KeyRoleChangeFee, &p.RoleChangeFee, validateCoin),
+		paramtypes.NewParamSetPair(KeyGuestMinGasPrices, &p.GuestMinGasPrices, validateDecCoins),
+		paramtypes.NewParamSetPair(KeyCitizenMinGasPrices, &p.CitizenMinGasPrices, validateDecCoins),
+		paramtypes.NewParamSetPair(KeyValidatorMinGasPrices, &p.ValidatorMinGasPrices, validateDecCoins),
+		paramtypes.NewParamSetPair(KeyCitizenDailyFreeTxs, &p.CitizenDailyFreeTxs, validateQuota),
+		paramtypes.NewParamSetPair(KeyGuestTxRate, &p.GuestTxRate, validateRate),
+		paramtypes.NewParamSetPair(KeyMaxFreeTxGas, &p.MaxFreeTxGas, validateUint64),
 	}
 }
 
@@ -65,6 +90,12 @@ func DefaultParams() Params {
 		VerificationCost:             sdk.NewCoin("uvx", sdkmath.NewInt(1000000)),
 		MigrationFee:                 sdk.NewCoin("uvx", sdkmath.NewInt(500000)),
 		RoleChangeFee:                sdk.NewCoin("uvx", sdkmath.NewInt(100000)),
+		GuestMinGasPrices:            sdk.NewDecCoins(sdk.NewDecCoinFromDec("uwrt", sdkmath.LegacyMustNewDecFromStr("0.025"))),
+		CitizenMinGasPrices:          sdk.NewDecCoins(sdk.NewDecCoinFromDec("uwrt", sdkmath.LegacyMustNewDecFromStr("0.01"))),
+		ValidatorMinGasPrices:        sdk.NewDecCoins(sdk.NewDecCoinFromDec("uwrt", sdkmath.LegacyMustNewDecFromStr("0.01"))),
+		CitizenDailyFreeTxs:          10,
+		GuestTxRate:                  sdkmath.LegacyNewDec(2), // stricter than the default 10 tx/sec per address
+		MaxFreeTxGas:                 200000,
 	}
 }
 
@@ -94,9 +125,40 @@ func (p Params) Validate() error {
 	if err := validateCoin(p.RoleChangeFee); err != nil {
 		return fmt.Errorf("invalid RoleChangeFee: %w", err)
 	}
+	if err := validateDecCoins(p.GuestMinGasPrices); err != nil {
+		return fmt.Errorf("invalid GuestMinGasPrices: %w", err)
+	}
+	if err := validateDecCoins(p.CitizenMinGasPrices); err != nil {
+		return fmt.Errorf("invalid CitizenMinGasPrices: %w", err)
+	}
+	if err := validateDecCoins(p.ValidatorMinGasPrices); err != nil {
+		return fmt.Errorf("invalid ValidatorMinGasPrices: %w", err)
+	}
+	if err := validateQuota(p.CitizenDailyFreeTxs); err != nil {
+		return fmt.Errorf("invalid CitizenDailyFreeTxs: %w", err)
+	}
+	if err := validateRate(p.GuestTxRate); err != nil {
+		return fmt.Errorf("invalid GuestTxRate: %w", err)
+	}
+	if err := validateUint64(p.MaxFreeTxGas); err != nil {
+		return fmt.Errorf("invalid MaxFreeTxGas: %w", err)
+	}
 	return nil
 }
 
+// MinGasPrices returns the minimum gas prices for fee payers with the given role
+// Unverified accounts pay the guest prices
+func (p Params) MinGasPrices(role identv1.Role) sdk.DecCoins {
+	switch role {
+	case identv1.Role_ROLE_CITIZEN:
+		return p.CitizenMinGasPrices
+	case identv1.Role_ROLE_VALIDATOR:
+		return p.ValidatorMinGasPrices
+	default:
+		return p.GuestMinGasPrices
+	}
+}
+
 func validateDuration(i interface{}) error {
 	d, ok := i.(time.Duration)
 	if !ok {
@@ -146,3 +208,34 @@ func validateCoin(i interface{}) error {
 	}
 	return nil
 }
+
+func validateDecCoins(i interface{}) error {
+	coins, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("expected sdk.DecCoins, got %T", i)
+	}
+	// Empty prices are allowed and mean no minimum
+	if err := coins.Validate(); err != nil {
+		return fmt.Errorf("invalid gas prices: %w", err)
+	}
+	return nil
+}
+
+func validateQuota(i interface{}) error {
+	// Zero disables the quota
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("expected uint64, got %T", i)
+	}
+	return nil
+}
+
+func validateRate(i interface{}) error {
+	r, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("expected sdkmath.LegacyDec, got %T", i)
+	}
+	if r.IsNil() || !r.IsPositive() {
+		return fmt.Errorf("rate must be positive")
+	}
+	return nil
+}
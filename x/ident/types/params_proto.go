@@ -1,6 +1,9 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -16,10 +19,17 @@ func (p Params) ToProto() *identv1.Params {
 		VerificationCost:             &p.VerificationCost,
 		MigrationFee:                 &p.MigrationFee,
 		RoleChangeFee:                &p.RoleChangeFee,
+		GuestMinGasPrices:            decCoinsToProto(p.GuestMinGasPrices),
+		CitizenMinGasPrices:          decCoinsToProto(p.CitizenMinGasPrices),
+		ValidatorMinGasPrices:        decCoinsToProto(p.ValidatorMinGasPrices),
+		CitizenDailyFreeTxs:          p.CitizenDailyFreeTxs,
+		GuestTxRate:                  decToProto(p.GuestTxRate),
+		MaxFreeTxGas:                 p.MaxFreeTxGas,
 	}
 }
 
 // ParamsFromProto converts protobuf Params to local Params
+// Unset fees convert to zero coins, which Validate rejects
 func ParamsFromProto(pp *identv1.Params) Params {
 	if pp == nil {
 		return DefaultParams()
@@ -30,8 +40,55 @@ func ParamsFromProto(pp *identv1.Params) Params {
 		MaxIdentitiesPerAddress:      pp.MaxIdentitiesPerAddress,
 		RequireIdentityVerification:  pp.RequireIdentityVerification,
 		DefaultVerificationProvider:  pp.DefaultVerificationProvider,
-		VerificationCost:             *pp.VerificationCost,
-		MigrationFee:                 *pp.MigrationFee,
-		RoleChangeFee:                *pp.RoleChangeFee,
+		VerificationCost:             coinFromProto(pp.VerificationCost),
+		MigrationFee:                 coinFromProto(pp.MigrationFee),
+		RoleChangeFee:                coinFromProto(pp.RoleChangeFee),
+		GuestMinGasPrices:            decCoinsFromProto(pp.GuestMinGasPrices),
+		CitizenMinGasPrices:          decCoinsFromProto(pp.CitizenMinGasPrices),
+		ValidatorMinGasPrices:        decCoinsFromProto(pp.ValidatorMinGasPrices),
+		CitizenDailyFreeTxs:          pp.CitizenDailyFreeTxs,
+		GuestTxRate:                  decFromProto(pp.GuestTxRate),
+		MaxFreeTxGas:                 pp.MaxFreeTxGas,
+	}
+}
+
+func coinFromProto(coin *sdk.Coin) sdk.Coin {
+	if coin == nil {
+		return sdk.Coin{}
+	}
+	return *coin
+}
+
+func decCoinsToProto(coins sdk.DecCoins) []*sdk.DecCoin {
+	out := make([]*sdk.DecCoin, len(coins))
+	for i := range coins {
+		out[i] = &coins[i]
+	}
+	return out
+}
+
+func decCoinsFromProto(coins []*sdk.DecCoin) sdk.DecCoins {
+	var out sdk.DecCoins
+	for _, coin := range coins {
+		if coin != nil {
+			out = append(out, *coin)
+		}
+	}
+	return out
+}
+
+func decToProto(d sdkmath.LegacyDec) string {
+	if d.IsNil() {
+		return ""
+	}
+	return d.String()
+}
+
+// decFromProto returns a nil Dec for unset or malformed values, which Validate rejects
+func decFromProto(s string) sdkmath.LegacyDec {
+	d, err := sdkmath.LegacyNewDecFromStr(s)
+	if err != nil {
+		return sdkmath.LegacyDec{}
 	}
+	return d
 }
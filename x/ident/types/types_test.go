@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/ident/types"
@@ -30,6 +31,39 @@ func TestParamsValidate(t *testing.T) {
 			params:  types.DefaultParams(),
 			wantErr: false,
 		},
+		{
+			name: "no minimum gas prices",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.GuestMinGasPrices = nil
+				p.CitizenDailyFreeTxs = 0
+				return p
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "zero guest tx rate",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.GuestTxRate = sdkmath.LegacyZeroDec()
+				return p
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "zero max free tx gas",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxFreeTxGas = 0
+				return p
+			}(),
+			wantErr: true,
+		},
+		{
+			name:    "proto round trip",
+			params:  types.ParamsFromProto(types.DefaultParams().ToProto()),
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
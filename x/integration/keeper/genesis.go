@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/integration/types"
+)
+
+// InitGenesis initializes the integration state from a genesis state
+// Events are stored at the height and index of their ID, so the log keeps its order
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, integration := range genState.ValidatorIntegrations {
+		if err := k.SetValidatorIntegration(ctx, integration); err != nil {
+			panic(err)
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, event := range genState.CrossModuleEvents {
+		height, index, err := types.ParseCrossModuleEventID(event.EventId)
+		if err != nil {
+			panic(err)
+		}
+		bz, err := k.cdc.Marshal(event)
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal cross-module event: %w", err))
+		}
+		store.Set(types.GetCrossModuleEventKey(height, index), bz)
+	}
+}
+
+// ExportGenesis returns the integration state as a genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	integrations, err := k.GetAllValidatorIntegrations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	events, err := k.GetAllCrossModuleEvents(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.DefaultGenesis()
+	genState.ValidatorIntegrations = append([]*lizenzv1.ValidatorIntegration{}, integrations...)
+	genState.CrossModuleEvents = append([]*lizenzv1.CrossModuleEvent{}, events...)
+	return genState
+}
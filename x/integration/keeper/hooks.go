@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	consensustypes "github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+	identtypes "github.com/volnix-protocol/volnix-protocol/x/ident/types"
+	"github.com/volnix-protocol/volnix-protocol/x/integration/types"
+	lizenztypes "github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// The integration keeper subscribes to the hooks of every module it tracks
+var (
+	_ identtypes.IdentHooks         = Keeper{}
+	_ lizenztypes.LizenzHooks       = Keeper{}
+	_ consensustypes.ConsensusHooks = Keeper{}
+)
+
+// AfterIdentityVerified records an identity verification
+func (k Keeper) AfterIdentityVerified(ctx sdk.Context, address string, role identv1.Role) error {
+	return k.ProcessCrossModuleEvent(ctx, &lizenzv1.CrossModuleEvent{
+		EventType:    types.EventTypeIdentityVerified,
+		SourceModule: "ident",
+		TargetModule: "integration",
+		EventData:    fmt.Sprintf("Identity verified with role %s", role),
+		Validator:    address,
+	})
+}
+
+// AfterRoleChanged records a role change of a verified account
+func (k Keeper) AfterRoleChanged(ctx sdk.Context, address string, oldRole, newRole identv1.Role) error {
+	return k.ProcessCrossModuleEvent(ctx, &lizenzv1.CrossModuleEvent{
+		EventType:    types.EventTypeRoleChanged,
+		SourceModule: "ident",
+		TargetModule: "integration",
+		EventData:    fmt.Sprintf("Role changed from %s to %s", oldRole, newRole),
+		Validator:    address,
+	})
+}
+
+// AfterLizenzActivated records an LZN activation
+func (k Keeper) AfterLizenzActivated(ctx sdk.Context, validator string, amount string) error {
+	return k.ProcessCrossModuleEvent(ctx, &lizenzv1.CrossModuleEvent{
+		EventType:    types.EventTypeLizenzActivated,
+		SourceModule: "lizenz",
+		TargetModule: "integration",
+		EventData:    fmt.Sprintf("LZN activated: %s", amount),
+		Validator:    validator,
+	})
+}
+
+// AfterDeactivationStarted records an LZN deactivation
+func (k Keeper) AfterDeactivationStarted(ctx sdk.Context, validator string, amount string) error {
+	return k.ProcessCrossModuleEvent(ctx, &lizenzv1.CrossModuleEvent{
+		EventType:    types.EventTypeLizenzDeactivationStarted,
+		SourceModule: "lizenz",
+		TargetModule: "integration",
+		EventData:    fmt.Sprintf("LZN deactivated: %s", amount),
+		Validator:    validator,
+	})
+}
+
+// AfterBlockCreatorSelected records the block creator's consensus participation
+func (k Keeper) AfterBlockCreatorSelected(ctx sdk.Context, height uint64, creator string) error {
+	return k.ProcessCrossModuleEvent(ctx, &lizenzv1.CrossModuleEvent{
+		EventType:    types.EventTypeConsensusParticipation,
+		SourceModule: "consensus",
+		TargetModule: "integration",
+		EventData:    fmt.Sprintf("Block creator selected for height %d", height),
+		Validator:    creator,
+	})
+}
+
+// AfterBidCommitted is a no-op: block creation is the consensus participation tracked by integration
+func (k Keeper) AfterBidCommitted(ctx sdk.Context, height uint64, validator string) error {
+	return nil
+}
+
+// AfterBidRevealed is a no-op: block creation is the consensus participation tracked by integration
+func (k Keeper) AfterBidRevealed(ctx sdk.Context, height uint64, validator string) error {
+	return nil
+}
+
+// AfterValidatorSlashed records a slashing of the validator's activated LZN
+func (k Keeper) AfterValidatorSlashed(ctx sdk.Context, validator string, reason string) error {
+	return k.ProcessCrossModuleEvent(ctx, &lizenzv1.CrossModuleEvent{
+		EventType:    types.EventTypeValidatorSlashed,
+		SourceModule: "consensus",
+		TargetModule: "integration",
+		EventData:    fmt.Sprintf("Validator slashed for %s", reason),
+		Validator:    validator,
+	})
+}
+
+// AfterValidatorJailed records the validator leaving consensus participation
+func (k Keeper) AfterValidatorJailed(ctx sdk.Context, validator string, reason string) error {
+	return k.ProcessCrossModuleEvent(ctx, &lizenzv1.CrossModuleEvent{
+		EventType:    types.EventTypeValidatorJailed,
+		SourceModule: "consensus",
+		TargetModule: "integration",
+		EventData:    fmt.Sprintf("Validator jailed for %s", reason),
+		Validator:    validator,
+	})
+}
+
+// AfterValidatorUnjailed records the validator returning to consensus participation
+func (k Keeper) AfterValidatorUnjailed(ctx sdk.Context, validator string) error {
+	return k.ProcessCrossModuleEvent(ctx, &lizenzv1.CrossModuleEvent{
+		EventType:    types.EventTypeValidatorUnjailed,
+		SourceModule: "consensus",
+		TargetModule: "integration",
+		EventData:    "Validator unjailed",
+		Validator:    validator,
+	})
+}
@@ -3,258 +3,289 @@ package keeper
 import (
 	"fmt"
 
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
-	anteilkeeper "github.com/volnix-protocol/volnix-protocol/x/anteil/keeper"
-	consensuskeeper "github.com/volnix-protocol/volnix-protocol/x/consensus/keeper"
-	identkeeper "github.com/volnix-protocol/volnix-protocol/x/ident/keeper"
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/integration/types"
-	lizenzkeeper "github.com/volnix-protocol/volnix-protocol/x/lizenz/keeper"
 )
 
-// Keeper manages the integration between modules
+// IdentKeeperInterface defines the ident state read by the integration keeper
+type IdentKeeperInterface interface {
+	GetVerifiedAccount(ctx sdk.Context, address string) (*identv1.VerifiedAccount, error)
+}
+
+// LizenzKeeperInterface defines the lizenz state read by the integration keeper
+type LizenzKeeperInterface interface {
+	GetActivatedLizenz(ctx sdk.Context, validator string) (*lizenzv1.ActivatedLizenz, error)
+	GetDeactivatingLizenz(ctx sdk.Context, validator string) (*lizenzv1.DeactivatingLizenz, error)
+}
+
+// AnteilKeeperInterface defines the anteil state read by the integration keeper
+type AnteilKeeperInterface interface {
+	GetUserPosition(ctx sdk.Context, user string) (*anteilv1.UserPosition, error)
+}
+
+// ConsensusKeeperInterface defines the consensus state read by the integration keeper
+type ConsensusKeeperInterface interface {
+	GetValidator(ctx sdk.Context, validator string) (*consensusv1.Validator, error)
+}
+
+// Keeper records cross-module events and the integration status of validators
 type Keeper struct {
-	identKeeper     identkeeper.Keeper
-	lizenzKeeper    lizenzkeeper.Keeper
-	anteilKeeper    anteilkeeper.Keeper
-	consensusKeeper consensuskeeper.Keeper
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
 
-	integrationManager *types.IntegrationManager
+	identKeeper     IdentKeeperInterface
+	lizenzKeeper    LizenzKeeperInterface
+	anteilKeeper    AnteilKeeperInterface
+	consensusKeeper ConsensusKeeperInterface
 }
 
 // NewKeeper creates a new integration keeper
 func NewKeeper(
-	identKeeper identkeeper.Keeper,
-	lizenzKeeper lizenzkeeper.Keeper,
-	anteilKeeper anteilkeeper.Keeper,
-	consensusKeeper consensuskeeper.Keeper,
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	identKeeper IdentKeeperInterface,
+	lizenzKeeper LizenzKeeperInterface,
+	anteilKeeper AnteilKeeperInterface,
+	consensusKeeper ConsensusKeeperInterface,
 ) *Keeper {
+	return &Keeper{
+		cdc:             cdc,
+		storeKey:        storeKey,
+		identKeeper:     identKeeper,
+		lizenzKeeper:    lizenzKeeper,
+		anteilKeeper:    anteilKeeper,
+		consensusKeeper: consensusKeeper,
+	}
+}
 
-	im := types.NewIntegrationManager()
+// EndBlocker prunes cross-module events that fell out of the retention window
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	k.PruneCrossModuleEvents(ctx)
+	return nil
+}
 
-	// Register all modules with their dependencies
-	im.RegisterModule("ident", []string{})
-	im.RegisterModule("lizenz", []string{"ident"})
-	im.RegisterModule("anteil", []string{"ident", "lizenz"})
-	im.RegisterModule("consensus", []string{"ident", "lizenz", "anteil"})
+// getModuleStatus collects the records of a validator from every integrated module
+func (k Keeper) getModuleStatus(ctx sdk.Context, validator string) types.ModuleStatus {
+	var status types.ModuleStatus
+	// Missing records are part of the status, so lookup errors are not propagated
+	if account, err := k.identKeeper.GetVerifiedAccount(ctx, validator); err == nil {
+		status.IdentAccount = account
+	}
+	if lizenz, err := k.lizenzKeeper.GetActivatedLizenz(ctx, validator); err == nil {
+		status.ActivatedLizenz = lizenz
+	}
+	if lizenz, err := k.lizenzKeeper.GetDeactivatingLizenz(ctx, validator); err == nil {
+		status.DeactivatingLizenz = lizenz
+	}
+	if position, err := k.anteilKeeper.GetUserPosition(ctx, validator); err == nil {
+		status.AnteilPosition = position
+	}
+	if consensusValidator, err := k.consensusKeeper.GetValidator(ctx, validator); err == nil {
+		status.ConsensusValidator = consensusValidator
+	}
+	return status
+}
 
-	return &Keeper{
-		identKeeper:        identKeeper,
-		lizenzKeeper:       lizenzKeeper,
-		anteilKeeper:       anteilKeeper,
-		consensusKeeper:    consensusKeeper,
-		integrationManager: im,
+// UpdateValidatorIntegration recomputes and stores the integration status of a validator
+func (k Keeper) UpdateValidatorIntegration(ctx sdk.Context, validator string) (*lizenzv1.ValidatorIntegration, error) {
+	if validator == "" {
+		return nil, types.ErrEmptyValidator
 	}
+
+	integration := types.ComputeValidatorIntegration(validator, k.getModuleStatus(ctx, validator), ctx.BlockTime())
+	if err := k.SetValidatorIntegration(ctx, integration); err != nil {
+		return nil, err
+	}
+	return integration, nil
 }
 
-// GetValidatorIntegrationStatus gets the complete integration status for a validator
-func (k Keeper) GetValidatorIntegrationStatus(ctx sdk.Context, validator string) (*types.ValidatorIntegrationStatus, error) {
+// SetValidatorIntegration stores the integration status of a validator
+func (k Keeper) SetValidatorIntegration(ctx sdk.Context, integration *lizenzv1.ValidatorIntegration) error {
+	if integration.Validator == "" {
+		return types.ErrEmptyValidator
+	}
 
-	// Get status from each module
-	identAccount, err := k.identKeeper.GetVerifiedAccount(ctx, validator)
+	bz, err := k.cdc.Marshal(integration)
 	if err != nil {
-		// Log error but continue
-		k.integrationManager.UpdateModuleHealth("ident", 50, err.Error())
+		return fmt.Errorf("failed to marshal validator integration: %w", err)
 	}
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorIntegrationKey(integration.Validator), bz)
+	return nil
+}
 
-	lizenzLicense, err := k.lizenzKeeper.GetActivatedLizenz(ctx, validator)
-	if err != nil {
-		k.integrationManager.UpdateModuleHealth("lizenz", 50, err.Error())
+// GetValidatorIntegration returns the stored integration status of a validator
+func (k Keeper) GetValidatorIntegration(ctx sdk.Context, validator string) (*lizenzv1.ValidatorIntegration, error) {
+	if validator == "" {
+		return nil, types.ErrEmptyValidator
 	}
 
-	// Get user position from anteil module
-	anteilPosition, err := k.getAnteilUserPosition(ctx, validator)
-	if err != nil {
-		// Log error but continue with nil position
-		ctx.Logger().Error("failed to get anteil position", "validator", validator, "error", err)
-		anteilPosition = nil
+	bz := ctx.KVStore(k.storeKey).Get(types.GetValidatorIntegrationKey(validator))
+	if bz == nil {
+		return nil, types.ErrValidatorIntegrationNotFound
 	}
-	// anteilPosition, err := k.anteilKeeper.GetUserPosition(ctx, validator)
-	// if err != nil {
-	//	k.integrationManager.UpdateModuleHealth("anteil", 50, err.Error())
-	// }
-
-	consensusValidator, err := k.consensusKeeper.GetValidator(ctx, validator)
-	if consensusValidator == nil {
-		k.integrationManager.UpdateModuleHealth("consensus", 50, "validator not found")
+
+	var integration lizenzv1.ValidatorIntegration
+	if err := k.cdc.Unmarshal(bz, &integration); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal validator integration: %w", err)
 	}
+	return &integration, nil
+}
 
-	// Create integration status
-	status := types.GetValidatorIntegrationStatus(
-		validator,
-		identAccount,
-		lizenzLicense,
-		anteilPosition, // User position from anteil module
-		consensusValidator,
-	)
-
-	// Log cross-module event
-	k.integrationManager.AddCrossModuleEvent(
-		"validator_status_check",
-		"integration",
-		"all",
-		fmt.Sprintf("Status check for validator %s", validator),
-		validator,
-	)
-
-	return status, nil
+// GetAllValidatorIntegrations returns the integration status of every tracked validator
+func (k Keeper) GetAllValidatorIntegrations(ctx sdk.Context) ([]*lizenzv1.ValidatorIntegration, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ValidatorIntegrationKeyPrefix
+	iterator := store.Iterator(prefix, append(append([]byte{}, prefix...), 0xFF))
+	defer iterator.Close()
+
+	var integrations []*lizenzv1.ValidatorIntegration
+	for ; iterator.Valid(); iterator.Next() {
+		var integration lizenzv1.ValidatorIntegration
+		if err := k.cdc.Unmarshal(iterator.Value(), &integration); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal validator integration: %w", err)
+		}
+		integrations = append(integrations, &integration)
+	}
+	return integrations, nil
 }
 
 // ValidateCrossModuleOperation validates operations that affect multiple modules
 func (k Keeper) ValidateCrossModuleOperation(ctx sdk.Context, operation string, validator string) error {
+	status := k.getModuleStatus(ctx, validator)
+	identActive := status.IdentAccount != nil && status.IdentAccount.IsActive
 
-	// Get current integration status
-	status, err := k.GetValidatorIntegrationStatus(ctx, validator)
-	if err != nil {
-		return fmt.Errorf("failed to get integration status: %w", err)
-	}
-
-	// Validate based on operation type
 	switch operation {
-	case "consensus_participation":
-		if status.IdentStatus == nil || !status.IdentStatus.IsActive {
-			return fmt.Errorf("validator %s must have active identity verification", validator)
-		}
-		if status.LizenzStatus == nil {
-			return fmt.Errorf("validator %s must have activated LZN license", validator)
-		}
-
-	case "ant_market_access":
-		if status.IdentStatus == nil || !status.IdentStatus.IsActive {
-			return fmt.Errorf("validator %s must have active identity verification", validator)
+	case types.OperationConsensusParticipation, types.OperationAntMarketAccess:
+		if !identActive {
+			return fmt.Errorf("%w: validator %s must have active identity verification", types.ErrIntegrationRequirement, validator)
 		}
-		if status.LizenzStatus == nil {
-			return fmt.Errorf("validator %s must have activated LZN license", validator)
+		if status.ActivatedLizenz == nil {
+			return fmt.Errorf("%w: validator %s must have activated LZN license", types.ErrIntegrationRequirement, validator)
 		}
 
-	case "role_migration":
-		if status.IdentStatus == nil || !status.IdentStatus.IsActive {
-			return fmt.Errorf("validator %s must have active identity verification", validator)
+	case types.OperationRoleMigration:
+		if !identActive {
+			return fmt.Errorf("%w: validator %s must have active identity verification", types.ErrIntegrationRequirement, validator)
 		}
 
 	default:
-		return fmt.Errorf("unknown operation type: %s", operation)
+		return fmt.Errorf("%w: %s", types.ErrUnknownOperation, operation)
 	}
 
 	return nil
 }
 
-// ProcessCrossModuleEvent processes events that affect multiple modules
-func (k Keeper) ProcessCrossModuleEvent(ctx sdk.Context, event *types.CrossModuleEvent) error {
-
-	// Log the event
-	k.integrationManager.AddCrossModuleEvent(
-		event.EventType,
-		event.SourceModule,
-		event.TargetModule,
-		event.EventData,
-		event.Validator,
-	)
-
-	// Process based on event type
+// ProcessCrossModuleEvent records a cross-module event and refreshes the affected validator's status
+func (k Keeper) ProcessCrossModuleEvent(ctx sdk.Context, event *lizenzv1.CrossModuleEvent) error {
 	switch event.EventType {
-	case "identity_verified":
-		// Update related modules when identity is verified
-		return k.handleIdentityVerified(ctx, event.Validator)
-
-	case "lzn_activated":
-		// Update related modules when LZN is activated
-		return k.handleLizenzActivated(ctx, event.Validator)
-
-	case "consensus_participation":
-		// Update related modules when consensus participation changes
-		return k.handleConsensusParticipation(ctx, event.Validator)
-
+	case types.EventTypeIdentityVerified,
+		types.EventTypeRoleChanged,
+		types.EventTypeLizenzActivated,
+		types.EventTypeLizenzDeactivationStarted,
+		types.EventTypeConsensusParticipation,
+		types.EventTypeValidatorSlashed,
+		types.EventTypeValidatorJailed,
+		types.EventTypeValidatorUnjailed:
 	default:
-		// Unknown event type, just log it
+		// Unknown event types are not tracked
 		return nil
 	}
-}
-
-// handleIdentityVerified handles identity verification events
-func (k Keeper) handleIdentityVerified(ctx sdk.Context, validator string) error {
 
-	// Update integration manager health
-	k.integrationManager.UpdateModuleHealth("ident", 100, "")
-
-	// Log cross-module event
-	k.integrationManager.AddCrossModuleEvent(
-		"identity_verified_processed",
-		"integration",
-		"ident",
-		"Identity verification processed",
-		validator,
-	)
-
-	return nil
+	if err := k.AppendCrossModuleEvent(ctx, event); err != nil {
+		return err
+	}
+	if event.Validator == "" {
+		return nil
+	}
+	_, err := k.UpdateValidatorIntegration(ctx, event.Validator)
+	return err
 }
 
-// handleLizenzActivated handles LZN activation events
-func (k Keeper) handleLizenzActivated(ctx sdk.Context, validator string) error {
-
-	// Update integration manager health
-	k.integrationManager.UpdateModuleHealth("lizenz", 100, "")
+// AppendCrossModuleEvent stores an event in the log of the current height
+// The event ID and timestamp are derived from the block, so every node records the same log
+// Events beyond MaxEventsPerBlock in one block are dropped
+func (k Keeper) AppendCrossModuleEvent(ctx sdk.Context, event *lizenzv1.CrossModuleEvent) error {
+	height := uint64(ctx.BlockHeight())
+	index := k.nextCrossModuleEventIndex(ctx, height)
+	if index >= types.MaxEventsPerBlock {
+		ctx.Logger().Debug("cross-module event log full for block", "height", height, "event_type", event.EventType)
+		return nil
+	}
 
-	// Log cross-module event
-	k.integrationManager.AddCrossModuleEvent(
-		"lzn_activated_processed",
-		"integration",
-		"lizenz",
-		"LZN activation processed",
-		validator,
-	)
+	event.EventId = fmt.Sprintf("%d-%d", height, index)
+	event.Timestamp = timestamppb.New(ctx.BlockTime())
 
+	bz, err := k.cdc.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cross-module event: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetCrossModuleEventKey(height, index), bz)
 	return nil
 }
 
-// handleConsensusParticipation handles consensus participation events
-func (k Keeper) handleConsensusParticipation(ctx sdk.Context, validator string) error {
-
-	// Update integration manager health
-	k.integrationManager.UpdateModuleHealth("consensus", 100, "")
+// nextCrossModuleEventIndex returns the index of the next event at a height
+func (k Keeper) nextCrossModuleEventIndex(ctx sdk.Context, height uint64) uint64 {
+	prefix := types.GetCrossModuleEventHeightPrefix(height)
+	iterator := ctx.KVStore(k.storeKey).ReverseIterator(prefix, append(append([]byte{}, prefix...), 0xFF))
+	defer iterator.Close()
 
-	// Log cross-module event
-	k.integrationManager.AddCrossModuleEvent(
-		"consensus_participation_processed",
-		"integration",
-		"consensus",
-		"Consensus participation processed",
-		validator,
-	)
+	if !iterator.Valid() {
+		return 0
+	}
+	return sdk.BigEndianToUint64(iterator.Key()[len(prefix):]) + 1
+}
 
-	return nil
+// GetCrossModuleEvents returns the recorded events of a height in order
+func (k Keeper) GetCrossModuleEvents(ctx sdk.Context, height uint64) ([]*lizenzv1.CrossModuleEvent, error) {
+	prefix := types.GetCrossModuleEventHeightPrefix(height)
+	return k.iterateCrossModuleEvents(ctx, prefix, append(append([]byte{}, prefix...), 0xFF))
 }
 
-// GetIntegrationManager returns the integration manager
-func (k Keeper) GetIntegrationManager() *types.IntegrationManager {
-	return k.integrationManager
+// GetAllCrossModuleEvents returns every event still in the retention window, oldest first
+func (k Keeper) GetAllCrossModuleEvents(ctx sdk.Context) ([]*lizenzv1.CrossModuleEvent, error) {
+	prefix := types.CrossModuleEventKeyPrefix
+	return k.iterateCrossModuleEvents(ctx, prefix, append(append([]byte{}, prefix...), 0xFF))
 }
 
-// GetModuleHealth returns the health status of a specific module
-func (k Keeper) GetModuleHealth(moduleName string) (*types.ModuleIntegration, error) {
-	if module, exists := k.integrationManager.Modules[moduleName]; exists {
-		return module, nil
+func (k Keeper) iterateCrossModuleEvents(ctx sdk.Context, start, end []byte) ([]*lizenzv1.CrossModuleEvent, error) {
+	iterator := ctx.KVStore(k.storeKey).Iterator(start, end)
+	defer iterator.Close()
+
+	var events []*lizenzv1.CrossModuleEvent
+	for ; iterator.Valid(); iterator.Next() {
+		var event lizenzv1.CrossModuleEvent
+		if err := k.cdc.Unmarshal(iterator.Value(), &event); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal cross-module event: %w", err)
+		}
+		events = append(events, &event)
 	}
-	return nil, fmt.Errorf("module %s not found", moduleName)
+	return events, nil
 }
 
-// GetAllModulesHealth returns the health status of all modules
-func (k Keeper) GetAllModulesHealth() map[string]*types.ModuleIntegration {
-	return k.integrationManager.Modules
-}
+// PruneCrossModuleEvents deletes events older than EventRetentionBlocks
+func (k Keeper) PruneCrossModuleEvents(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	if height <= types.EventRetentionBlocks {
+		return
+	}
 
-// getAnteilUserPosition retrieves user position from anteil module
-func (k Keeper) getAnteilUserPosition(ctx sdk.Context, userAddress string) (*anteilv1.UserPosition, error) {
-	// Create a mock user position for now
-	// In production, this would query the actual anteil keeper
-	position := &anteilv1.UserPosition{
-		Owner:        userAddress,
-		AntBalance:   "1000.0",
-		LockedAnt:    "100.0",
-		AvailableAnt: "900.0",
-		TotalTrades:  "5",
-		TotalVolume:  "5000.0",
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.CrossModuleEventKeyPrefix, types.GetCrossModuleEventHeightPrefix(height-types.EventRetentionBlocks))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
 	}
+	iterator.Close()
 
-	return position, nil
+	for _, key := range keys {
+		store.Delete(key)
+	}
 }
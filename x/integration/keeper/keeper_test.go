@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	consensusv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/consensus/v1"
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/integration/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/integration/types"
+)
+
+// MockModules serves the module records read by the integration keeper
+type MockModules struct {
+	accounts   map[string]*identv1.VerifiedAccount
+	lizenz     map[string]*lizenzv1.ActivatedLizenz
+	positions  map[string]*anteilv1.UserPosition
+	validators map[string]*consensusv1.Validator
+}
+
+func NewMockModules() *MockModules {
+	return &MockModules{
+		accounts:   make(map[string]*identv1.VerifiedAccount),
+		lizenz:     make(map[string]*lizenzv1.ActivatedLizenz),
+		positions:  make(map[string]*anteilv1.UserPosition),
+		validators: make(map[string]*consensusv1.Validator),
+	}
+}
+
+func (m *MockModules) GetVerifiedAccount(ctx sdk.Context, address string) (*identv1.VerifiedAccount, error) {
+	if account, ok := m.accounts[address]; ok {
+		return account, nil
+	}
+	return nil, fmt.Errorf("account not found")
+}
+
+func (m *MockModules) GetActivatedLizenz(ctx sdk.Context, validator string) (*lizenzv1.ActivatedLizenz, error) {
+	if lizenz, ok := m.lizenz[validator]; ok {
+		return lizenz, nil
+	}
+	return nil, fmt.Errorf("lizenz not found")
+}
+
+func (m *MockModules) GetDeactivatingLizenz(ctx sdk.Context, validator string) (*lizenzv1.DeactivatingLizenz, error) {
+	return nil, fmt.Errorf("deactivating lizenz not found")
+}
+
+func (m *MockModules) GetUserPosition(ctx sdk.Context, user string) (*anteilv1.UserPosition, error) {
+	if position, ok := m.positions[user]; ok {
+		return position, nil
+	}
+	return nil, fmt.Errorf("position not found")
+}
+
+func (m *MockModules) GetValidator(ctx sdk.Context, validator string) (*consensusv1.Validator, error) {
+	if v, ok := m.validators[validator]; ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("validator not found")
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+	ctx     sdk.Context
+	keeper  *keeper.Keeper
+	modules *MockModules
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	interfaceRegistry := cdctypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	suite.ctx = testutil.DefaultContext(storeKey, tKey).
+		WithBlockHeight(10).
+		WithBlockTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.modules = NewMockModules()
+	suite.keeper = keeper.NewKeeper(cdc, storeKey, suite.modules, suite.modules, suite.modules, suite.modules)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// Test events get deterministic IDs and block time timestamps
+func (suite *KeeperTestSuite) TestAppendCrossModuleEvent() {
+	for i := 0; i < 2; i++ {
+		require.NoError(suite.T(), suite.keeper.AppendCrossModuleEvent(suite.ctx, &lizenzv1.CrossModuleEvent{
+			EventType: types.EventTypeLizenzActivated,
+			Validator: "cosmos1validator",
+		}))
+	}
+
+	events, err := suite.keeper.GetCrossModuleEvents(suite.ctx, 10)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), events, 2)
+	require.Equal(suite.T(), "10-0", events[0].EventId)
+	require.Equal(suite.T(), "10-1", events[1].EventId)
+	require.Equal(suite.T(), suite.ctx.BlockTime(), events[0].Timestamp.AsTime())
+}
+
+// Test the event log of one block is bounded
+func (suite *KeeperTestSuite) TestAppendCrossModuleEvent_MaxEventsPerBlock() {
+	for i := uint64(0); i < types.MaxEventsPerBlock+5; i++ {
+		require.NoError(suite.T(), suite.keeper.AppendCrossModuleEvent(suite.ctx, &lizenzv1.CrossModuleEvent{
+			EventType: types.EventTypeConsensusParticipation,
+		}))
+	}
+
+	events, err := suite.keeper.GetCrossModuleEvents(suite.ctx, 10)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), events, int(types.MaxEventsPerBlock))
+}
+
+// Test events older than the retention window are pruned
+func (suite *KeeperTestSuite) TestPruneCrossModuleEvents() {
+	oldCtx := suite.ctx.WithBlockHeight(5)
+	recentCtx := suite.ctx.WithBlockHeight(int64(5 + types.EventRetentionBlocks))
+	for _, ctx := range []sdk.Context{oldCtx, recentCtx} {
+		require.NoError(suite.T(), suite.keeper.AppendCrossModuleEvent(ctx, &lizenzv1.CrossModuleEvent{
+			EventType: types.EventTypeIdentityVerified,
+		}))
+	}
+
+	require.NoError(suite.T(), suite.keeper.EndBlocker(suite.ctx.WithBlockHeight(int64(6+types.EventRetentionBlocks))))
+
+	events, err := suite.keeper.GetAllCrossModuleEvents(suite.ctx)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), events, 1)
+	require.Equal(suite.T(), fmt.Sprintf("%d-0", 5+types.EventRetentionBlocks), events[0].EventId)
+}
+
+// Test hook events record the event and store the validator's integration status
+func (suite *KeeperTestSuite) TestHooks_UpdateValidatorIntegration() {
+	validator := "cosmos1validator"
+	suite.modules.accounts[validator] = &identv1.VerifiedAccount{Address: validator, IsActive: true}
+	suite.modules.lizenz[validator] = &lizenzv1.ActivatedLizenz{Validator: validator, Amount: "1000000"}
+	suite.modules.positions[validator] = &anteilv1.UserPosition{Owner: validator, AntBalance: "0"}
+
+	require.NoError(suite.T(), suite.keeper.AfterLizenzActivated(suite.ctx, validator, "1000000"))
+
+	integration, err := suite.keeper.GetValidatorIntegration(suite.ctx, validator)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "75", integration.IntegrationScore)
+	require.Equal(suite.T(), types.StatusVerified, integration.IdentVerificationStatus)
+	require.Equal(suite.T(), types.StatusActive, integration.LznLicenseStatus)
+	require.Equal(suite.T(), types.StatusEnabled, integration.AntMarketAccess)
+	require.Equal(suite.T(), types.StatusNone, integration.ConsensusParticipation)
+	require.Equal(suite.T(), []string{"ident", "lizenz", "anteil"}, integration.ActiveIntegrations)
+
+	// Block creation by an active consensus validator completes the integration
+	suite.modules.validators[validator] = &consensusv1.Validator{
+		Validator: validator,
+		Status:    consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE,
+	}
+	require.NoError(suite.T(), suite.keeper.AfterBlockCreatorSelected(suite.ctx, 10, validator))
+
+	integration, err = suite.keeper.GetValidatorIntegration(suite.ctx, validator)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "100", integration.IntegrationScore)
+	require.Equal(suite.T(), types.StatusActive, integration.ConsensusParticipation)
+
+	events, err := suite.keeper.GetCrossModuleEvents(suite.ctx, 10)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), events, 2)
+	require.Equal(suite.T(), types.EventTypeConsensusParticipation, events[1].EventType)
+}
+
+// Test slashing and jailing refresh the validator's integration status
+func (suite *KeeperTestSuite) TestHooks_SlashingRefreshesIntegration() {
+	validator := "cosmos1validator"
+	suite.modules.accounts[validator] = &identv1.VerifiedAccount{Address: validator, IsActive: true}
+	suite.modules.lizenz[validator] = &lizenzv1.ActivatedLizenz{Validator: validator, Amount: "1000000"}
+	suite.modules.validators[validator] = &consensusv1.Validator{
+		Validator: validator,
+		Status:    consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE,
+	}
+	require.NoError(suite.T(), suite.keeper.AfterBlockCreatorSelected(suite.ctx, 10, validator))
+
+	integration, err := suite.keeper.GetValidatorIntegration(suite.ctx, validator)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "75", integration.IntegrationScore)
+
+	// A jailed validator no longer participates in consensus
+	suite.modules.validators[validator].Status = consensusv1.ValidatorStatus_VALIDATOR_STATUS_JAILED
+	require.NoError(suite.T(), suite.keeper.AfterValidatorSlashed(suite.ctx, validator, "double_sign"))
+	require.NoError(suite.T(), suite.keeper.AfterValidatorJailed(suite.ctx, validator, "double_sign"))
+
+	integration, err = suite.keeper.GetValidatorIntegration(suite.ctx, validator)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "50", integration.IntegrationScore)
+	require.Equal(suite.T(), "jailed", integration.ConsensusParticipation)
+	require.Equal(suite.T(), []string{"ident", "lizenz"}, integration.ActiveIntegrations)
+
+	suite.modules.validators[validator].Status = consensusv1.ValidatorStatus_VALIDATOR_STATUS_ACTIVE
+	require.NoError(suite.T(), suite.keeper.AfterValidatorUnjailed(suite.ctx, validator))
+
+	integration, err = suite.keeper.GetValidatorIntegration(suite.ctx, validator)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "75", integration.IntegrationScore)
+
+	events, err := suite.keeper.GetCrossModuleEvents(suite.ctx, 10)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), events, 4)
+	require.Equal(suite.T(), types.EventTypeValidatorSlashed, events[1].EventType)
+	require.Equal(suite.T(), types.EventTypeValidatorJailed, events[2].EventType)
+	require.Equal(suite.T(), types.EventTypeValidatorUnjailed, events[3].EventType)
+}
+
+// Test an unknown validator has no integration status
+func (suite *KeeperTestSuite) TestGetValidatorIntegration_NotFound() {
+	_, err := suite.keeper.GetValidatorIntegration(suite.ctx, "cosmos1unknown")
+	require.ErrorIs(suite.T(), err, types.ErrValidatorIntegrationNotFound)
+}
+
+// Test cross-module operations require an active identity and LZN
+func (suite *KeeperTestSuite) TestValidateCrossModuleOperation() {
+	validator := "cosmos1validator"
+	err := suite.keeper.ValidateCrossModuleOperation(suite.ctx, types.OperationRoleMigration, validator)
+	require.ErrorIs(suite.T(), err, types.ErrIntegrationRequirement)
+
+	suite.modules.accounts[validator] = &identv1.VerifiedAccount{Address: validator, IsActive: true}
+	require.NoError(suite.T(), suite.keeper.ValidateCrossModuleOperation(suite.ctx, types.OperationRoleMigration, validator))
+	err = suite.keeper.ValidateCrossModuleOperation(suite.ctx, types.OperationConsensusParticipation, validator)
+	require.ErrorIs(suite.T(), err, types.ErrIntegrationRequirement)
+
+	suite.modules.lizenz[validator] = &lizenzv1.ActivatedLizenz{Validator: validator, Amount: "1000000"}
+	require.NoError(suite.T(), suite.keeper.ValidateCrossModuleOperation(suite.ctx, types.OperationAntMarketAccess, validator))
+
+	err = suite.keeper.ValidateCrossModuleOperation(suite.ctx, "unknown", validator)
+	require.ErrorIs(suite.T(), err, types.ErrUnknownOperation)
+}
@@ -2,6 +2,7 @@ package integration
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -12,10 +13,13 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/volnix-protocol/volnix-protocol/x/integration/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/integration/types"
 )
 
 var (
 	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule      = AppModule{}
+	_ module.HasGenesis     = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the integration module.
@@ -23,7 +27,7 @@ type AppModuleBasic struct{}
 
 // Name returns the integration module's name.
 func (AppModuleBasic) Name() string {
-	return "integration"
+	return types.ModuleName
 }
 
 // RegisterLegacyAminoCodec registers the integration module's types on the LegacyAmino codec.
@@ -38,12 +42,16 @@ func (AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 // DefaultGenesis returns default genesis state as raw bytes for the integration module.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return []byte("{}")
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
 }
 
 // ValidateGenesis performs genesis state validation for the integration module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-	return nil
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	return types.ValidateGenesis(&genState)
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the integration module.
@@ -79,6 +87,9 @@ func NewAppModule(keeper keeper.Keeper) AppModule {
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// IsOnePerModuleType implements the module.AppModule interface.
+func (am AppModule) IsOnePerModuleType() {}
+
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// Register services here when needed
@@ -91,12 +102,14 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 
 // InitGenesis performs genesis initialization for the integration module.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
-	// Initialize genesis here when needed
+	var genState types.GenesisState
+	cdc.MustUnmarshalJSON(data, &genState)
+	am.keeper.InitGenesis(ctx, &genState)
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the integration module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	return []byte("{}")
+	return cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
 }
 
 // ConsensusVersion implements ConsensusVersion.
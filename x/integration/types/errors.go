@@ -0,0 +1,13 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// integration module sentinel errors
+var (
+	ErrEmptyValidator               = errors.Register(ModuleName, 1, "empty validator address")
+	ErrValidatorIntegrationNotFound = errors.Register(ModuleName, 2, "validator integration not found")
+	ErrUnknownOperation             = errors.Register(ModuleName, 3, "unknown cross-module operation")
+	ErrIntegrationRequirement       = errors.Register(ModuleName, 4, "cross-module requirement not met")
+)
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+)
+
+// GenesisState is the integration module's genesis state
+// The integration records are defined in the lizenz proto package
+type GenesisState = lizenzv1.IntegrationGenesisState
+
+// DefaultGenesis returns the default integration genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		ValidatorIntegrations: []*lizenzv1.ValidatorIntegration{},
+		CrossModuleEvents:     []*lizenzv1.CrossModuleEvent{},
+	}
+}
+
+// ParseCrossModuleEventID returns the height and index encoded in a cross-module event ID
+func ParseCrossModuleEventID(eventID string) (height, index uint64, err error) {
+	heightStr, indexStr, found := strings.Cut(eventID, "-")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid cross-module event ID %q", eventID)
+	}
+	if height, err = strconv.ParseUint(heightStr, 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid cross-module event ID %q: %w", eventID, err)
+	}
+	if index, err = strconv.ParseUint(indexStr, 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid cross-module event ID %q: %w", eventID, err)
+	}
+	return height, index, nil
+}
+
+// ValidateGenesis validates the integration genesis state
+func ValidateGenesis(gs *GenesisState) error {
+	if gs == nil {
+		return nil
+	}
+
+	integrations := make(map[string]bool, len(gs.ValidatorIntegrations))
+	for _, integration := range gs.ValidatorIntegrations {
+		if integration == nil || integration.Validator == "" {
+			return ErrEmptyValidator
+		}
+		if integrations[integration.Validator] {
+			return fmt.Errorf("duplicate integration status for validator %s", integration.Validator)
+		}
+		integrations[integration.Validator] = true
+	}
+
+	events := make(map[string]bool, len(gs.CrossModuleEvents))
+	for _, event := range gs.CrossModuleEvents {
+		if event == nil {
+			return fmt.Errorf("nil cross-module event")
+		}
+		_, index, err := ParseCrossModuleEventID(event.EventId)
+		if err != nil {
+			return err
+		}
+		if index >= MaxEventsPerBlock {
+			return fmt.Errorf("cross-module event %s exceeds %d events per block", event.EventId, MaxEventsPerBlock)
+		}
+		if events[event.EventId] {
+			return fmt.Errorf("duplicate cross-module event %s", event.EventId)
+		}
+		events[event.EventId] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "integration"
+	StoreKey   = ModuleName
+)
+
+const (
+	// EventRetentionBlocks is the number of blocks cross-module events are kept for
+	EventRetentionBlocks uint64 = 1000
+
+	// MaxEventsPerBlock bounds the number of cross-module events recorded in one block
+	MaxEventsPerBlock uint64 = 256
+)
+
+var (
+	// CrossModuleEventKeyPrefix defines the prefix for cross-module events indexed by height
+	CrossModuleEventKeyPrefix = []byte{0x01}
+
+	// ValidatorIntegrationKeyPrefix defines the prefix for validator integration status keys
+	ValidatorIntegrationKeyPrefix = []byte{0x02}
+)
+
+// GetCrossModuleEventHeightPrefix returns the prefix for all cross-module events of a height
+func GetCrossModuleEventHeightPrefix(height uint64) []byte {
+	return append(append([]byte{}, CrossModuleEventKeyPrefix...), sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetCrossModuleEventKey returns the key for the index-th cross-module event of a height
+func GetCrossModuleEventKey(height, index uint64) []byte {
+	return append(GetCrossModuleEventHeightPrefix(height), sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetValidatorIntegrationKey returns the key for a validator's integration status
+func GetValidatorIntegrationKey(validator string) []byte {
+	return append(append([]byte{}, ValidatorIntegrationKeyPrefix...), []byte(validator)...)
+}
@@ -1,6 +1,8 @@
 package lizenz
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/lizenz/keeper"
@@ -23,21 +25,85 @@ func Validate(gen *lizenzv1.GenesisState) error {
 	if err != nil {
 		return err
 	}
-	return p.Validate()
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	// Integration records belong to the integration module's genesis
+	if len(gen.ValidatorIntegrations) > 0 || len(gen.CrossModuleEvents) > 0 || len(gen.ModuleDependencies) > 0 {
+		return fmt.Errorf("integration records are not stored by the lizenz module")
+	}
+
+	activated := make(map[string]bool, len(gen.ActivatedLizenz))
+	for _, lizenz := range gen.ActivatedLizenz {
+		if lizenz == nil || lizenz.Validator == "" {
+			return fmt.Errorf("activated LZN without validator")
+		}
+		if activated[lizenz.Validator] {
+			return fmt.Errorf("duplicate activated LZN for validator %s", lizenz.Validator)
+		}
+		activated[lizenz.Validator] = true
+	}
+
+	deactivating := make(map[string]bool, len(gen.DeactivatingLizenz))
+	for _, lizenz := range gen.DeactivatingLizenz {
+		if lizenz == nil || lizenz.Validator == "" {
+			return fmt.Errorf("deactivating LZN without validator")
+		}
+		if deactivating[lizenz.Validator] {
+			return fmt.Errorf("duplicate deactivating LZN for validator %s", lizenz.Validator)
+		}
+		deactivating[lizenz.Validator] = true
+	}
+
+	statuses := make(map[string]bool, len(gen.MoaStatuses))
+	for _, status := range gen.MoaStatuses {
+		if status == nil || status.Validator == "" {
+			return fmt.Errorf("MOA status without validator")
+		}
+		if statuses[status.Validator] {
+			return fmt.Errorf("duplicate MOA status for validator %s", status.Validator)
+		}
+		statuses[status.Validator] = true
+	}
+
+	rewards := make(map[string]bool, len(gen.RewardRecords))
+	for _, reward := range gen.RewardRecords {
+		if reward == nil || reward.Validator == "" || reward.Record == nil {
+			return fmt.Errorf("reward record without validator or record")
+		}
+		// Reward keys length-prefix the validator in a single byte
+		if len(reward.Validator) > 255 {
+			return fmt.Errorf("reward record validator %s is too long", reward.Validator)
+		}
+		key := fmt.Sprintf("%s/%d", reward.Validator, reward.Record.Epoch)
+		if rewards[key] {
+			return fmt.Errorf("duplicate reward record for validator %s in epoch %d", reward.Validator, reward.Record.Epoch)
+		}
+		rewards[key] = true
+	}
+
+	epochActivities := make(map[string]bool, len(gen.MoaEpochActivities))
+	for _, activity := range gen.MoaEpochActivities {
+		if activity == nil || activity.Validator == "" {
+			return fmt.Errorf("MOA epoch activity without validator")
+		}
+		if epochActivities[activity.Validator] {
+			return fmt.Errorf("duplicate MOA epoch activity for validator %s", activity.Validator)
+		}
+		epochActivities[activity.Validator] = true
+	}
+
+	return nil
 }
 
 func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState *lizenzv1.GenesisState) {
 	if genState == nil {
 		genState = DefaultGenesis()
 	}
-	p, _ := lztypes.ParamsFromProto(genState.Params)
-	k.SetParams(ctx, p)
+	k.InitGenesis(ctx, genState)
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *lizenzv1.GenesisState {
-	params := k.GetParams(ctx)
-	return &lizenzv1.GenesisState{
-		Params:          params.ToProto(),
-		ActivatedLizenz: []*lizenzv1.ActivatedLizenz{},
-	}
+	return k.ExportGenesis(ctx)
 }
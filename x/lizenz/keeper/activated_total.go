@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// GetTotalActivated returns the LZN locked by activations, net of the LZN unlocked and burned since
+func (k Keeper) GetTotalActivated(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.TotalActivatedKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetTotalActivated sets the LZN locked by activations
+func (k Keeper) SetTotalActivated(ctx sdk.Context, total uint64) {
+	ctx.KVStore(k.storeKey).Set(types.TotalActivatedKey, sdk.Uint64ToBigEndian(total))
+}
+
+// lockTotalActivated adds newly activated LZN to the total
+func (k Keeper) lockTotalActivated(ctx sdk.Context, amount uint64) {
+	k.SetTotalActivated(ctx, k.GetTotalActivated(ctx)+amount)
+}
+
+// unlockTotalActivated removes unlocked or burned LZN from the total
+// Removing more than the total means LZN was activated without being locked and fails
+func (k Keeper) unlockTotalActivated(ctx sdk.Context, amount uint64) error {
+	total := k.GetTotalActivated(ctx)
+	if total < amount {
+		return fmt.Errorf("cannot unlock %d LZN: only %d LZN activated", amount, total)
+	}
+	k.SetTotalActivated(ctx, total-amount)
+	return nil
+}
+
+// parseActivatedAmount parses an activated LZN amount, records are validated before they are stored
+func parseActivatedAmount(amount string) (uint64, error) {
+	amountInt, err := strconv.ParseUint(amount, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", types.ErrInvalidAmount, amount)
+	}
+	return amountInt, nil
+}
+
+// exceedsValidatorCap reports whether amount is more than maxValidatorSharePercent of total
+func exceedsValidatorCap(amount, total int64) bool {
+	return math.NewInt(amount).MulRaw(100).GT(math.NewInt(total).MulRaw(maxValidatorSharePercent))
+}
+
+// validatorCap returns the largest amount a validator may keep so that no capped validator
+// holds more than maxValidatorSharePercent of the activated LZN left after capping.
+// Validators are capped from the largest down: with c capped validators and rest LZN
+// left to the others, the cap is the largest a with a <= 33% of (c*a + rest).
+// From capMinValidators validators on this always leaves some LZN to every validator
+func validatorCap(amounts []int64, total int64) int64 {
+	sorted := append([]int64(nil), amounts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	rest := math.NewInt(total)
+	for capped := 0; capped < len(sorted); capped++ {
+		denominator := 100 - maxValidatorSharePercent*int64(capped)
+		if denominator <= 0 {
+			break
+		}
+		maxAllowed := rest.MulRaw(maxValidatorSharePercent).QuoRaw(denominator).Int64()
+		if sorted[capped] <= maxAllowed {
+			return maxAllowed
+		}
+		rest = rest.SubRaw(sorted[capped])
+	}
+	return sorted[len(sorted)-1]
+}
+
+// enforceValidatorCap returns activated LZN above the 33% cap to its validators
+// Activations are checked against the cap, but deactivations, slashing and the pool growing
+// to capMinValidators validators can leave others above it, so every change of the pool ends here
+func (k Keeper) enforceValidatorCap(ctx sdk.Context) error {
+	lizenzs, err := k.GetAllActivatedLizenz(ctx)
+	if err != nil {
+		return err
+	}
+	if len(lizenzs) < capMinValidators {
+		return nil
+	}
+
+	amounts := make([]int64, len(lizenzs))
+	var total int64
+	for i, lizenz := range lizenzs {
+		amounts[i], err = strconv.ParseInt(lizenz.Amount, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: validator %s has activated %s", types.ErrInvalidAmount, lizenz.Validator, lizenz.Amount)
+		}
+		total += amounts[i]
+	}
+
+	maxAllowed := validatorCap(amounts, total)
+	for i, lizenz := range lizenzs {
+		if amounts[i] > maxAllowed {
+			if err := k.releaseActivatedLizenz(ctx, lizenz, uint64(amounts[i]-maxAllowed)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// releaseActivatedLizenz reduces an activation and unlocks the released LZN to the validator
+func (k Keeper) releaseActivatedLizenz(ctx sdk.Context, lizenz *lizenzv1.ActivatedLizenz, released uint64) error {
+	amount, err := parseActivatedAmount(lizenz.Amount)
+	if err != nil {
+		return err
+	}
+	if err := k.unlockTotalActivated(ctx, released); err != nil {
+		return err
+	}
+
+	lizenz.Amount = strconv.FormatUint(amount-released, 10)
+	lizenzBz, err := k.cdc.Marshal(lizenz)
+	if err != nil {
+		return fmt.Errorf("failed to marshal activated lizenz: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetActivatedLizenzKey(lizenz.Validator), lizenzBz)
+
+	// Unlock the released LZN the same way deactivation does
+	if k.bankKeeper != nil {
+		if validatorAddr, err := sdk.AccAddressFromBech32(lizenz.Validator); err == nil {
+			lznCoins := sdk.NewCoins(sdk.NewCoin("ulzn", math.NewIntFromUint64(released)))
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, validatorAddr, lznCoins); err != nil {
+				ctx.Logger().Error("failed to unlock capped LZN", "error", err, "validator", lizenz.Validator, "amount", released)
+			}
+		}
+	}
+
+	// Keep the consensus weight in line with the remaining activated LZN
+	if k.consensusKeeper != nil {
+		if err := k.consensusKeeper.SetValidatorWeight(ctx, lizenz.Validator, lizenz.Amount); err != nil {
+			ctx.Logger().Error("failed to update validator weight after capping", "error", err, "validator", lizenz.Validator)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLizenzCapped,
+			sdk.NewAttribute(types.AttributeKeyValidator, lizenz.Validator),
+			sdk.NewAttribute(types.AttributeKeyReleasedAmount, strconv.FormatUint(released, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, lizenz.Amount),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 type MockBankKeeperForLizenz struct {
 	lockedCoins   map[string]sdk.Coins   // validator -> coins (locked)
 	unlockedCoins map[string]sdk.Coins    // validator -> coins (unlocked)
+	burnedCoins   sdk.Coins              // coins burned from the module account
 	errors        map[string]error       // operation -> error
 }
 
@@ -71,6 +72,14 @@ func (m *MockBankKeeperForLizenz) GetBalance(ctx sdk.Context, addr sdk.AccAddres
 	return sdk.NewCoin(denom, math.ZeroInt())
 }
 
+func (m *MockBankKeeperForLizenz) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	if err, ok := m.errors["BurnCoins"]; ok {
+		return err
+	}
+	m.burnedCoins = m.burnedCoins.Add(amt...)
+	return nil
+}
+
 func (m *MockBankKeeperForLizenz) GetLockedCoins(validator string) sdk.Coins {
 	return m.lockedCoins[validator]
 }
@@ -260,3 +269,36 @@ func (suite *LizenzBankKeeperTestSuite) TestLZNLockingNoBankKeeper() {
 	require.NoError(suite.T(), err)
 }
 
+
+// TestSlashActivatedLizenz tests that slashing burns a fraction of activated LZN
+func (suite *LizenzBankKeeperTestSuite) TestSlashActivatedLizenz() {
+	validator := sdk.AccAddress("validator1_______________").String()
+
+	activatedLizenz := &lizenzv1.ActivatedLizenz{
+		Validator:            validator,
+		Amount:               "1000000",
+		ActivationTime:       timestamppb.Now(),
+		LastActivity:         timestamppb.Now(),
+		IdentityHash:         "test_identity_hash_123",
+		IsEligibleForRewards: true,
+	}
+	require.NoError(suite.T(), suite.keeper.SetActivatedLizenz(suite.ctx, activatedLizenz))
+
+	burned, err := suite.keeper.SlashActivatedLizenz(suite.ctx, validator, math.LegacyMustNewDecFromStr("0.05"))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), math.NewInt(50000), burned)
+
+	// Remaining activation is reduced and the slashed LZN is burned
+	lizenz, err := suite.keeper.GetActivatedLizenz(suite.ctx, validator)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "950000", lizenz.Amount)
+	require.Equal(suite.T(), math.NewInt(50000), suite.mockBankKeeper.burnedCoins.AmountOf("ulzn"))
+
+	// Invalid fraction
+	_, err = suite.keeper.SlashActivatedLizenz(suite.ctx, validator, math.LegacyMustNewDecFromStr("1.5"))
+	require.Error(suite.T(), err)
+
+	// Unknown validator
+	_, err = suite.keeper.SlashActivatedLizenz(suite.ctx, "cosmos1unknown", math.LegacyMustNewDecFromStr("0.05"))
+	require.ErrorIs(suite.T(), err, types.ErrLizenzNotFound)
+}
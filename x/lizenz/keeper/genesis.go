@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// InitGenesis initializes the lizenz state from a genesis state
+// The total activated amount is imported as exported rather than summed from the activated LZN.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *lizenzv1.GenesisState) {
+	params, err := types.ParamsFromProto(genState.Params)
+	if err != nil {
+		panic(fmt.Errorf("invalid lizenz params: %w", err))
+	}
+	k.SetParams(ctx, params)
+
+	store := ctx.KVStore(k.storeKey)
+
+	for _, lizenz := range genState.ActivatedLizenz {
+		k.mustSetRecord(store, types.GetActivatedLizenzKey(lizenz.Validator), lizenz)
+	}
+
+	for _, lizenz := range genState.DeactivatingLizenz {
+		k.mustSetRecord(store, types.GetDeactivatingLizenzKey(lizenz.Validator), lizenz)
+	}
+
+	for _, status := range genState.MoaStatuses {
+		k.mustSetRecord(store, types.GetMOAStatusKey(status.Validator), status)
+	}
+
+	for _, reward := range genState.RewardRecords {
+		k.mustSetRecord(store, types.GetRewardEpochKey(reward.Validator, reward.Record.Epoch), reward.Record)
+	}
+
+	for _, activity := range genState.MoaEpochActivities {
+		k.mustSetRecord(store, types.GetMOAEpochActivityKey(activity.Validator), activity)
+	}
+
+	k.SetTotalActivated(ctx, genState.TotalActivated)
+
+	if genState.MoaEpochStart != nil {
+		if err := k.SetMOAEpochStart(ctx, genState.MoaEpochStart.AsTime()); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the lizenz state as a genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *lizenzv1.GenesisState {
+	params := k.GetParams(ctx)
+	genState := &lizenzv1.GenesisState{
+		Params:             params.ToProto(),
+		ActivatedLizenz:    []*lizenzv1.ActivatedLizenz{},
+		DeactivatingLizenz: []*lizenzv1.DeactivatingLizenz{},
+		MoaStatuses:        []*lizenzv1.MOAStatus{},
+		RewardRecords:      []*lizenzv1.ValidatorRewardRecord{},
+		MoaEpochActivities: []*lizenzv1.MOAEpochActivity{},
+		TotalActivated:     k.GetTotalActivated(ctx),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ActivatedLizenzKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var lizenz lizenzv1.ActivatedLizenz
+		k.mustUnmarshalRecord(iterator.Value(), &lizenz)
+		genState.ActivatedLizenz = append(genState.ActivatedLizenz, &lizenz)
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.DeactivatingLizenzKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var lizenz lizenzv1.DeactivatingLizenz
+		k.mustUnmarshalRecord(iterator.Value(), &lizenz)
+		genState.DeactivatingLizenz = append(genState.DeactivatingLizenz, &lizenz)
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.MOAStatusKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var status lizenzv1.MOAStatus
+		k.mustUnmarshalRecord(iterator.Value(), &status)
+		genState.MoaStatuses = append(genState.MoaStatuses, &status)
+	}
+	iterator.Close()
+
+	// Reward keys are a length-prefixed validator followed by the big endian epoch, which the record repeats
+	iterator = storetypes.KVStorePrefixIterator(store, types.RewardEpochKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.RewardEpochKeyPrefix):]
+		if len(key) < 1 || len(key) != 1+int(key[0])+8 {
+			panic(fmt.Errorf("malformed reward record key %X", key))
+		}
+		var record lizenzv1.RewardRecord
+		k.mustUnmarshalRecord(iterator.Value(), &record)
+		genState.RewardRecords = append(genState.RewardRecords, &lizenzv1.ValidatorRewardRecord{
+			Validator: string(key[1 : 1+int(key[0])]),
+			Record:    &record,
+		})
+	}
+	iterator.Close()
+
+	iterator = storetypes.KVStorePrefixIterator(store, types.MOAEpochActivityKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var activity lizenzv1.MOAEpochActivity
+		k.mustUnmarshalRecord(iterator.Value(), &activity)
+		genState.MoaEpochActivities = append(genState.MoaEpochActivities, &activity)
+	}
+	iterator.Close()
+
+	if store.Has(types.MOAEpochStartKey) {
+		epochStart, err := k.GetMOAEpochStart(ctx)
+		if err != nil {
+			panic(err)
+		}
+		genState.MoaEpochStart = timestamppb.New(epochStart)
+	}
+
+	return genState
+}
+
+func (k Keeper) mustSetRecord(store storetypes.KVStore, key []byte, record gogoproto.Message) {
+	bz, err := k.cdc.Marshal(record)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal genesis record: %w", err))
+	}
+	store.Set(key, bz)
+}
+
+func (k Keeper) mustUnmarshalRecord(bz []byte, record gogoproto.Message) {
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		panic(fmt.Errorf("failed to unmarshal genesis record: %w", err))
+	}
+}
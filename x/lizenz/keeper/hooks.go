@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+)
+
+// ConsensusHooks records block creation and blind auction bids as validator activity
+type ConsensusHooks struct {
+	k *Keeper
+}
+
+// ConsensusHooks returns the consensus hooks of the lizenz keeper
+func (k *Keeper) ConsensusHooks() ConsensusHooks {
+	return ConsensusHooks{k}
+}
+
+// AfterBlockCreatorSelected updates the activity of the block creator's activated LZN,
+// so validators creating blocks are not deactivated for inactivity, and counts the block for MOA
+func (h ConsensusHooks) AfterBlockCreatorSelected(ctx sdk.Context, height uint64, creator string) error {
+	if err := h.k.UpdateLizenzActivity(ctx, creator); err != nil {
+		return err
+	}
+	return h.k.countMOAEpochActivity(ctx, creator, func(activity *lizenzv1.MOAEpochActivity) {
+		activity.BlocksCreated++
+	})
+}
+
+// AfterBidCommitted counts a blind auction commit for MOA
+func (h ConsensusHooks) AfterBidCommitted(ctx sdk.Context, height uint64, validator string) error {
+	return h.k.countMOAEpochActivity(ctx, validator, func(activity *lizenzv1.MOAEpochActivity) {
+		activity.AuctionCommits++
+	})
+}
+
+// AfterBidRevealed counts a blind auction reveal for MOA
+func (h ConsensusHooks) AfterBidRevealed(ctx sdk.Context, height uint64, validator string) error {
+	return h.k.countMOAEpochActivity(ctx, validator, func(activity *lizenzv1.MOAEpochActivity) {
+		activity.AuctionReveals++
+	})
+}
+
+// AfterValidatorSlashed is a no-op: the slashed LZN is burned through the lizenz keeper
+func (h ConsensusHooks) AfterValidatorSlashed(ctx sdk.Context, validator string, reason string) error {
+	return nil
+}
+
+// AfterValidatorJailed is a no-op: jailing does not change the activated LZN
+func (h ConsensusHooks) AfterValidatorJailed(ctx sdk.Context, validator string, reason string) error {
+	return nil
+}
+
+// AfterValidatorUnjailed is a no-op: unjailing does not change the activated LZN
+func (h ConsensusHooks) AfterValidatorUnjailed(ctx sdk.Context, validator string) error {
+	return nil
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+)
+
+// MockLizenzHooks records the lizenz hook calls
+type MockLizenzHooks struct {
+	activated   map[string]string
+	deactivated map[string]string
+}
+
+func NewMockLizenzHooks() *MockLizenzHooks {
+	return &MockLizenzHooks{
+		activated:   make(map[string]string),
+		deactivated: make(map[string]string),
+	}
+}
+
+func (m *MockLizenzHooks) AfterLizenzActivated(ctx sdk.Context, validator string, amount string) error {
+	m.activated[validator] = amount
+	return nil
+}
+
+func (m *MockLizenzHooks) AfterDeactivationStarted(ctx sdk.Context, validator string, amount string) error {
+	m.deactivated[validator] = amount
+	return nil
+}
+
+func (suite *KeeperTestSuite) activatedLizenz(lastActivity time.Time) *lizenzv1.ActivatedLizenz {
+	return &lizenzv1.ActivatedLizenz{
+		Validator:            "cosmos1validator",
+		Amount:               "1000000",
+		ActivationTime:       timestamppb.New(lastActivity),
+		LastActivity:         timestamppb.New(lastActivity),
+		IsEligibleForRewards: true,
+		IdentityHash:         "hash123",
+	}
+}
+
+// Test LZN activation calls AfterLizenzActivated
+func (suite *KeeperTestSuite) TestHooks_AfterLizenzActivated() {
+	hooks := NewMockLizenzHooks()
+	suite.keeper.SetHooks(hooks)
+
+	require.NoError(suite.T(), suite.keeper.SetActivatedLizenz(suite.ctx, suite.activatedLizenz(time.Now())))
+
+	require.Equal(suite.T(), "1000000", hooks.activated["cosmos1validator"])
+}
+
+// Test deactivation for inactivity calls AfterDeactivationStarted
+func (suite *KeeperTestSuite) TestHooks_AfterDeactivationStarted() {
+	hooks := NewMockLizenzHooks()
+	suite.keeper.SetHooks(hooks)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.InactivityPeriod = time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+
+	require.NoError(suite.T(), suite.keeper.SetActivatedLizenz(suite.ctx, suite.activatedLizenz(time.Now().Add(-2*time.Hour))))
+	require.NoError(suite.T(), suite.keeper.CheckInactiveLizenz(suite.ctx.WithBlockTime(time.Now())))
+
+	require.Equal(suite.T(), "1000000", hooks.deactivated["cosmos1validator"])
+}
+
+// Test block creation counts as LZN activity
+func (suite *KeeperTestSuite) TestConsensusHooks_AfterBlockCreatorSelected() {
+	suite.ctx = suite.ctx.WithBlockTime(time.Now())
+	oldTime := time.Now().Add(-2 * time.Hour)
+	require.NoError(suite.T(), suite.keeper.SetActivatedLizenz(suite.ctx, suite.activatedLizenz(oldTime)))
+
+	require.NoError(suite.T(), suite.keeper.ConsensusHooks().AfterBlockCreatorSelected(suite.ctx, 10, "cosmos1validator"))
+
+	lizenz, err := suite.keeper.GetActivatedLizenz(suite.ctx, "cosmos1validator")
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), lizenz.LastActivity.AsTime().After(oldTime))
+}
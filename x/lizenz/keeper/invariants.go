@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// capMinValidators is the smallest number of activated validators for which every
+// validator can stay within maxValidatorShare; smaller pools are still bootstrapping
+const capMinValidators = 4
+
+// RegisterInvariants registers the lizenz module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "activated-total", ActivatedTotalInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "validator-cap", ValidatorCapInvariant(k))
+}
+
+// ActivatedTotalInvariant checks that every activated LZN amount is valid and that the
+// total activated LZN, which only changes when LZN is locked, unlocked or burned, equals their sum
+func ActivatedTotalInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		lizenzs, err := k.GetAllActivatedLizenz(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "activated-total", fmt.Sprintf("\t%v\n", err)), true
+		}
+
+		var sum int64
+		var msg strings.Builder
+		broken := false
+		for _, lizenz := range lizenzs {
+			amount, err := strconv.ParseInt(lizenz.Amount, 10, 64)
+			if err != nil || amount <= 0 {
+				broken = true
+				fmt.Fprintf(&msg, "\tvalidator %s has an invalid activated amount %q\n", lizenz.Validator, lizenz.Amount)
+				continue
+			}
+			sum += amount
+		}
+
+		total := k.GetTotalActivated(ctx)
+		if sum < 0 || uint64(sum) != total {
+			broken = true
+		}
+		fmt.Fprintf(&msg, "\tsum of %d activated LZN: %d\n\ttotal activated LZN: %d\n", len(lizenzs), sum, total)
+
+		return sdk.FormatInvariant(types.ModuleName, "activated-total", msg.String()), broken
+	}
+}
+
+// ValidatorCapInvariant checks that no validator holds more than 33% of the activated LZN
+// The cap applies once capMinValidators validators are activated
+func ValidatorCapInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		lizenzs, err := k.GetAllActivatedLizenz(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "validator-cap", fmt.Sprintf("\t%v\n", err)), true
+		}
+		if len(lizenzs) < capMinValidators {
+			return sdk.FormatInvariant(types.ModuleName, "validator-cap", fmt.Sprintf(
+				"\t%d activated validators, the cap applies from %d\n", len(lizenzs), capMinValidators)), false
+		}
+
+		amounts := make([]int64, len(lizenzs))
+		var total int64
+		for i, lizenz := range lizenzs {
+			// Invalid amounts are reported by the activated-total invariant
+			amounts[i], _ = strconv.ParseInt(lizenz.Amount, 10, 64)
+			total += amounts[i]
+		}
+
+		var msg strings.Builder
+		broken := false
+		for i, lizenz := range lizenzs {
+			if exceedsValidatorCap(amounts[i], total) {
+				broken = true
+				fmt.Fprintf(&msg, "\tvalidator %s holds %d of %d activated LZN, more than %d%%\n",
+					lizenz.Validator, amounts[i], total, maxValidatorSharePercent)
+			}
+		}
+		if !broken {
+			fmt.Fprintf(&msg, "\t%d validators within %d%% of %d activated LZN\n", len(lizenzs), maxValidatorSharePercent, total)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator-cap", msg.String()), broken
+	}
+}
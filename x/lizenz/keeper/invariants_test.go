@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// setActivatedLizenzRaw stores an activated LZN without the activation checks
+func (suite *KeeperTestSuite) setActivatedLizenzRaw(validator, amount string) {
+	bz, err := suite.cdc.Marshal(&lizenzv1.ActivatedLizenz{
+		Validator:      validator,
+		Amount:         amount,
+		ActivationTime: timestamppb.Now(),
+		LastActivity:   timestamppb.Now(),
+		IdentityHash:   "hash_" + validator,
+	})
+	require.NoError(suite.T(), err)
+	suite.ctx.KVStore(suite.storeKey).Set(types.GetActivatedLizenzKey(validator), bz)
+}
+
+// TestActivatedTotalInvariant tests the activated amounts must add up to the total activated LZN
+func (suite *KeeperTestSuite) TestActivatedTotalInvariant() {
+	invariant := keeper.ActivatedTotalInvariant(*suite.keeper)
+	suite.setActivatedLizenzRaw("validator1", "1000000")
+	suite.setActivatedLizenzRaw("validator2", "2000000")
+
+	// Records written without locking their LZN
+	msg, broken := invariant(suite.ctx)
+	require.True(suite.T(), broken)
+	require.Contains(suite.T(), msg, "sum of 2 activated LZN: 3000000\n\ttotal activated LZN: 0")
+
+	suite.keeper.SetTotalActivated(suite.ctx, 3000000)
+	msg, broken = invariant(suite.ctx)
+	require.False(suite.T(), broken, msg)
+
+	suite.setActivatedLizenzRaw("validator3", "lots")
+	msg, broken = invariant(suite.ctx)
+	require.True(suite.T(), broken)
+	require.Contains(suite.T(), msg, `validator validator3 has an invalid activated amount "lots"`)
+}
+
+// TestValidatorCapInvariant tests the 33% cap applies once enough validators are activated
+func (suite *KeeperTestSuite) TestValidatorCapInvariant() {
+	invariant := keeper.ValidatorCapInvariant(*suite.keeper)
+
+	// Bootstrapping pools are exempt
+	suite.setActivatedLizenzRaw("validator1", "9000000")
+	suite.setActivatedLizenzRaw("validator2", "1000000")
+	msg, broken := invariant(suite.ctx)
+	require.False(suite.T(), broken)
+	require.Contains(suite.T(), msg, "2 activated validators, the cap applies from 4")
+
+	for i := 3; i <= 5; i++ {
+		suite.setActivatedLizenzRaw(fmt.Sprintf("validator%d", i), "1000000")
+	}
+	msg, broken = invariant(suite.ctx)
+	require.True(suite.T(), broken)
+	require.Contains(suite.T(), msg, "validator validator1 holds 9000000 of 13000000 activated LZN, more than 33%")
+
+	suite.setActivatedLizenzRaw("validator1", "1500000")
+	msg, broken = invariant(suite.ctx)
+	require.False(suite.T(), broken, msg)
+	require.Contains(suite.T(), msg, "5 validators within 33% of 5500000 activated LZN")
+}
+
+// TestDeactivationKeepsValidatorCap tests LZN above the cap is released when a deactivation shrinks the pool
+func (suite *KeeperTestSuite) TestDeactivationKeepsValidatorCap() {
+	suite.setActivatedLizenzRaw("validator1", "4000000")
+	for i := 2; i <= 4; i++ {
+		suite.setActivatedLizenzRaw(fmt.Sprintf("validator%d", i), "2000000")
+	}
+	suite.setActivatedLizenzRaw("validator5", "3000000")
+	suite.keeper.SetTotalActivated(suite.ctx, 13000000)
+
+	// validator1 would hold 40% of the remaining 10000000
+	require.NoError(suite.T(), suite.keeper.DeleteActivatedLizenz(suite.ctx, "validator5"))
+
+	lizenz, err := suite.keeper.GetActivatedLizenz(suite.ctx, "validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "2955223", lizenz.Amount)
+	require.Equal(suite.T(), uint64(8955223), suite.keeper.GetTotalActivated(suite.ctx))
+
+	msg, broken := keeper.ValidatorCapInvariant(*suite.keeper)(suite.ctx)
+	require.False(suite.T(), broken, msg)
+	msg, broken = keeper.ActivatedTotalInvariant(*suite.keeper)(suite.ctx)
+	require.False(suite.T(), broken, msg)
+}
@@ -9,10 +9,16 @@ import (
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
 	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
 	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	consensustypes "github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+	governancetypes "github.com/volnix-protocol/volnix-protocol/x/governance/types"
 	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -24,18 +30,32 @@ type IdentKeeperInterface interface {
 }
 
 // ConsensusKeeperInterface defines the interface for interacting with consensus module
-// This allows lizenz module to register validators in consensus after LZN activation
-// Note: We use interface{} to avoid circular dependencies
+// This allows lizenz module to update validator weights after slashing and to measure MOA
+// Validator registration after LZN activation goes through LizenzHooks
 type ConsensusKeeperInterface interface {
-	SetValidator(ctx sdk.Context, validator interface{}) error
 	SetValidatorWeight(ctx sdk.Context, validator, weight string) error
+
+	// MOA warnings use the consensus penalty thresholds; consensus activity is counted through ConsensusHooks
+	GetParams(ctx sdk.Context) consensustypes.Params
 }
 
 // AnteilKeeperInterface defines the interface for interacting with anteil module
-// This allows lizenz module to create initial ANT position for validators
-// Note: We use interface{} to avoid circular dependencies
+// This allows lizenz module to measure ANT market activity of a validator for MOA
 type AnteilKeeperInterface interface {
-	SetUserPosition(ctx sdk.Context, position interface{}) error
+	GetOrdersByOwner(ctx sdk.Context, owner string) ([]*anteilv1.Order, error)
+}
+
+// GovernanceKeeperInterface defines the interface for interacting with governance module
+// This allows lizenz module to measure governance participation for MOA
+type GovernanceKeeperInterface interface {
+	GetAllProposals(ctx sdk.Context) ([]*governancev1.Proposal, error)
+	GetVote(ctx sdk.Context, proposalID uint64, voter string) (*governancev1.Vote, error)
+}
+
+// IntegrationKeeperInterface defines the interface for interacting with integration module
+// This allows lizenz module to serve the validator integration status recorded by integration
+type IntegrationKeeperInterface interface {
+	GetValidatorIntegration(ctx sdk.Context, validator string) (*lizenzv1.ValidatorIntegration, error)
 }
 
 // BankKeeperInterface defines the interface for interacting with bank module
@@ -45,17 +65,25 @@ type BankKeeperInterface interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
 type (
 	Keeper struct {
-		cdc             codec.BinaryCodec
-		storeKey        storetypes.StoreKey
-		paramstore      paramtypes.Subspace
-		identKeeper     IdentKeeperInterface     // Optional: for identity verification
-		consensusKeeper ConsensusKeeperInterface // Optional: for validator registration
-		anteilKeeper    AnteilKeeperInterface    // Optional: for initial ANT position
-		bankKeeper      BankKeeperInterface      // Optional: for locking/unlocking LZN tokens
+		cdc               codec.BinaryCodec
+		storeKey          storetypes.StoreKey
+		paramstore        paramtypes.Subspace
+		identKeeper       IdentKeeperInterface       // Optional: for identity verification
+		consensusKeeper   ConsensusKeeperInterface   // Optional: for validator registration
+		anteilKeeper      AnteilKeeperInterface      // Optional: for initial ANT position
+		bankKeeper        BankKeeperInterface        // Optional: for locking/unlocking LZN tokens
+		governanceKeeper  GovernanceKeeperInterface  // Optional: for MOA governance participation
+		integrationKeeper IntegrationKeeperInterface // Optional: for validator integration queries
+
+		// authority is the governance module address allowed to update params
+		authority string
+
+		hooks types.LizenzHooks
 	}
 )
 
@@ -73,9 +101,15 @@ func NewKeeper(
 		cdc:        cdc,
 		storeKey:   storeKey,
 		paramstore: ps,
+		authority:  authtypes.NewModuleAddress(governancetypes.ModuleName).String(),
 	}
 }
 
+// GetAuthority returns the address allowed to execute MsgUpdateParams
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetIdentKeeper sets the ident keeper interface for identity verification
 func (k *Keeper) SetIdentKeeper(identKeeper IdentKeeperInterface) {
 	k.identKeeper = identKeeper
@@ -96,6 +130,24 @@ func (k *Keeper) SetBankKeeper(bankKeeper BankKeeperInterface) {
 	k.bankKeeper = bankKeeper
 }
 
+// SetGovernanceKeeper sets the governance keeper interface for MOA governance participation
+func (k *Keeper) SetGovernanceKeeper(governanceKeeper GovernanceKeeperInterface) {
+	k.governanceKeeper = governanceKeeper
+}
+
+// SetIntegrationKeeper sets the integration keeper interface for validator integration queries
+func (k *Keeper) SetIntegrationKeeper(integrationKeeper IntegrationKeeperInterface) {
+	k.integrationKeeper = integrationKeeper
+}
+
+// SetHooks sets the lizenz hooks
+func (k *Keeper) SetHooks(hooks types.LizenzHooks) {
+	if k.hooks != nil {
+		panic("cannot set lizenz hooks twice")
+	}
+	k.hooks = hooks
+}
+
 // GetParams returns the current parameters for the lizenz module
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var params types.Params
@@ -169,6 +221,16 @@ func (k Keeper) SetActivatedLizenz(ctx sdk.Context, lizenz *lizenzv1.ActivatedLi
 	}
 
 	store.Set(lizenzKey, lizenzBz)
+	activatedAmount, err := parseActivatedAmount(lizenz.Amount)
+	if err != nil {
+		return err
+	}
+	k.lockTotalActivated(ctx, activatedAmount)
+
+	// A new validator can leave others above the cap once the pool reaches capMinValidators
+	if err := k.enforceValidatorCap(ctx); err != nil {
+		return err
+	}
 	
 	// Emit LZN activation event
 	ctx.EventManager().EmitEvent(
@@ -193,11 +255,13 @@ func (k Keeper) SetActivatedLizenz(ctx sdk.Context, lizenz *lizenzv1.ActivatedLi
 		)
 	}
 	
-	// After successful LZN activation, register validator in consensus module
+	// After successful LZN activation, subscribers register the validator in consensus
 	// This is the automatic registration step according to validator registration logic
-	if err := k.registerValidatorInConsensus(ctx, lizenz); err != nil {
+	if k.hooks == nil {
+		return nil
+	}
+	if err := k.hooks.AfterLizenzActivated(ctx, lizenz.Validator, lizenz.Amount); err != nil {
 		// Log error but don't fail the activation - validator can be registered later
-		// In production, you might want to fail here or use event system
 		ctx.Logger().Error("failed to register validator in consensus after LZN activation", "error", err, "validator", lizenz.Validator)
 	} else {
 		// Emit validator registration event
@@ -246,14 +310,35 @@ func (k Keeper) UpdateActivatedLizenz(ctx sdk.Context, lizenz *lizenzv1.Activate
 	lizenzKey := types.GetActivatedLizenzKey(lizenz.Validator)
 
 	// Check if LZN exists
-	if !store.Has(lizenzKey) {
+	existing, err := k.GetActivatedLizenz(ctx, lizenz.Validator)
+	if err != nil {
 		return types.ErrLizenzNotFound
 	}
 
-	// Validate 33% limit when updating amount
-	if err := k.ValidateMaxLznActivationLimit(ctx, lizenz.Validator, lizenz.Amount); err != nil {
+	oldAmount, err := parseActivatedAmount(existing.Amount)
+	if err != nil {
 		return err
 	}
+	newAmount, err := parseActivatedAmount(lizenz.Amount)
+	if err != nil {
+		return err
+	}
+
+	// Validate 33% limit when the amount grows; activity and reward updates of a validator
+	// that others' deactivations left above the cap keep the amount and must still succeed
+	if newAmount > oldAmount {
+		if err := k.ValidateMaxLznActivationLimit(ctx, lizenz.Validator, lizenz.Amount); err != nil {
+			return err
+		}
+	}
+
+	if newAmount < oldAmount {
+		if err := k.unlockTotalActivated(ctx, oldAmount-newAmount); err != nil {
+			return err
+		}
+	} else {
+		k.lockTotalActivated(ctx, newAmount-oldAmount)
+	}
 
 	// Store the updated LZN
 	lizenzBz, err := k.cdc.Marshal(lizenz)
@@ -262,7 +347,7 @@ func (k Keeper) UpdateActivatedLizenz(ctx sdk.Context, lizenz *lizenzv1.Activate
 	}
 
 	store.Set(lizenzKey, lizenzBz)
-	return nil
+	return k.enforceValidatorCap(ctx)
 }
 
 // DeleteActivatedLizenz removes an activated LZN license
@@ -317,6 +402,18 @@ func (k Keeper) DeleteActivatedLizenz(ctx sdk.Context, validator string) error {
 	}
 
 	store.Delete(lizenzKey)
+	deactivatedAmount, err := parseActivatedAmount(lizenz.Amount)
+	if err != nil {
+		return err
+	}
+	if err := k.unlockTotalActivated(ctx, deactivatedAmount); err != nil {
+		return err
+	}
+
+	// The remaining validators hold a larger share of the smaller pool
+	if err := k.enforceValidatorCap(ctx); err != nil {
+		return err
+	}
 	
 	// Emit event for LZN deactivation
 	ctx.EventManager().EmitEvent(
@@ -324,7 +421,7 @@ func (k Keeper) DeleteActivatedLizenz(ctx sdk.Context, validator string) error {
 			types.EventTypeLizenzDeactivated,
 			sdk.NewAttribute(types.AttributeKeyValidator, validator),
 			sdk.NewAttribute(types.AttributeKeyAmount, lizenz.Amount),
-			sdk.NewAttribute(types.AttributeKeyDeactivationTime, timestamppb.Now().String()),
+			sdk.NewAttribute(types.AttributeKeyDeactivationTime, timestamppb.New(ctx.BlockTime()).String()),
 			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
 		),
 	)
@@ -358,34 +455,43 @@ func (k Keeper) GetAllActivatedLizenz(ctx sdk.Context) ([]*lizenzv1.ActivatedLiz
 	return lizenzs, nil
 }
 
-// GetTotalActivatedLizenz calculates the total amount of activated LZN across all validators
-// Returns the sum as a string to handle large numbers
-func (k Keeper) GetTotalActivatedLizenz(ctx sdk.Context) (string, error) {
-	allLizenzs, err := k.GetAllActivatedLizenz(ctx)
-	if err != nil {
-		return "0", err
-	}
+// GetActivatedLizenzPage returns a page of the activated LZN, in validator order
+func (k Keeper) GetActivatedLizenzPage(ctx sdk.Context, pageReq *sdkquery.PageRequest) ([]*lizenzv1.ActivatedLizenz, *sdkquery.PageResponse, error) {
+	lizenzStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ActivatedLizenzKeyPrefix)
 
-	total := int64(0)
-	for _, lizenz := range allLizenzs {
-		amount, err := strconv.ParseInt(lizenz.Amount, 10, 64)
-		if err != nil {
-			// Skip invalid amounts, but log the error
-			continue
+	var lizenzs []*lizenzv1.ActivatedLizenz
+	pageRes, err := sdkquery.Paginate(lizenzStore, pageReq, func(_ []byte, value []byte) error {
+		var lizenz lizenzv1.ActivatedLizenz
+		if err := k.cdc.Unmarshal(value, &lizenz); err != nil {
+			return fmt.Errorf("failed to unmarshal activated lizenz: %w", err)
 		}
-		total += amount
+		lizenzs = append(lizenzs, &lizenz)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return strconv.FormatInt(total, 10), nil
+	return lizenzs, pageRes, nil
+}
+
+// GetTotalActivatedLizenz returns the total amount of activated LZN across all validators
+// Returns the total as a string to handle large numbers
+func (k Keeper) GetTotalActivatedLizenz(ctx sdk.Context) (string, error) {
+	return strconv.FormatUint(k.GetTotalActivated(ctx), 10), nil
 }
 
+// maxValidatorSharePercent is the percentage of the activated LZN pool a single validator may hold
+// 33% limit from whitepaper: "не более 33% на один кошелек"
+// This is a hardcoded constant as per whitepaper, but could be made configurable via governance in the future
+const maxValidatorSharePercent = 33
+
+// maxValidatorShare is maxValidatorSharePercent as a fraction
+const maxValidatorShare = maxValidatorSharePercent / 100.0
+
 // ValidateMaxLznActivationLimit checks if a validator's activation would exceed 33% of total pool
 // According to whitepaper: "Максимум 33% от общего пула LZN может быть активировано одним валидатором"
 func (k Keeper) ValidateMaxLznActivationLimit(ctx sdk.Context, validator string, newAmount string) error {
-	// 33% limit from whitepaper: "не более 33% на один кошелек"
-	// This is a hardcoded constant as per whitepaper, but could be made configurable via governance in the future
-	const maxValidatorShare = 0.33 // 33% limit from whitepaper
-	
 	// Validate share is between 0.0 and 1.0 (safety check)
 	if maxValidatorShare <= 0.0 || maxValidatorShare > 1.0 {
 		return fmt.Errorf("invalid maxValidatorShare: must be between 0.0 and 1.0, got %f", maxValidatorShare)
@@ -451,6 +557,45 @@ func (k Keeper) ValidateMaxLznActivationLimit(ctx sdk.Context, validator string,
 	return nil
 }
 
+// MaxValidatorShare returns the share of the activated LZN pool a single validator may hold
+func MaxValidatorShare() math.LegacyDec {
+	return math.LegacyMustNewDecFromStr(strconv.FormatFloat(maxValidatorShare, 'f', -1, 64))
+}
+
+// GetActivatedLizenzSharesPage returns the total activated LZN and a page of the validators' shares of it, in validator order
+func (k Keeper) GetActivatedLizenzSharesPage(ctx sdk.Context, pageReq *sdkquery.PageRequest) (string, []*lizenzv1.ValidatorLizenzShare, *sdkquery.PageResponse, error) {
+	totalActivated := k.GetTotalActivated(ctx)
+
+	lizenzs, pageRes, err := k.GetActivatedLizenzPage(ctx, pageReq)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	maxShare := MaxValidatorShare()
+	shares := make([]*lizenzv1.ValidatorLizenzShare, 0, len(lizenzs))
+	for _, lizenz := range lizenzs {
+		// Invalid amounts are left out of the total, so they hold no share either
+		amount, err := parseActivatedAmount(lizenz.Amount)
+		if err != nil {
+			amount = 0
+		}
+
+		share := math.LegacyZeroDec()
+		if totalActivated > 0 {
+			share = math.LegacyNewDecFromInt(math.NewIntFromUint64(amount)).Quo(math.LegacyNewDecFromInt(math.NewIntFromUint64(totalActivated)))
+		}
+
+		shares = append(shares, &lizenzv1.ValidatorLizenzShare{
+			Validator:   lizenz.Validator,
+			Amount:      strconv.FormatUint(amount, 10),
+			Share:       share.String(),
+			CapHeadroom: maxShare.Sub(share).String(),
+		})
+	}
+
+	return strconv.FormatUint(totalActivated, 10), shares, pageRes, nil
+}
+
 // SetLizenz is an alias for SetActivatedLizenz for backward compatibility
 func (k Keeper) SetLizenz(ctx sdk.Context, lizenz *lizenzv1.ActivatedLizenz) error {
 	return k.SetActivatedLizenz(ctx, lizenz)
@@ -525,12 +670,12 @@ func (k Keeper) CheckMOA(ctx sdk.Context, validator string) (bool, error) {
 	}
 
 	// Parse MOA values for comparison
-	currentMoa, err := strconv.ParseInt(status.CurrentMoa, 10, 64)
+	currentMoa, err := strconv.ParseFloat(status.CurrentMoa, 64)
 	if err != nil {
 		return false, fmt.Errorf("invalid current MOA: %w", err)
 	}
 
-	requiredMoa, err := strconv.ParseInt(status.RequiredMoa, 10, 64)
+	requiredMoa, err := strconv.ParseFloat(status.RequiredMoa, 64)
 	if err != nil {
 		return false, fmt.Errorf("invalid required MOA: %w", err)
 	}
@@ -586,6 +731,33 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 	return nil
 }
 
+// EndBlocker measures MOA of all validators with activated LZN at the end of every MOA epoch
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	epochLength := params.MoaEpochLength
+	if epochLength == 0 {
+		epochLength = types.DefaultMoaEpochLength // Default fallback
+	}
+
+	height := uint64(ctx.BlockHeight())
+	if height == 0 || height%epochLength != 0 {
+		return nil
+	}
+
+	// The epoch is measured on a cache so that a failure leaves no MOA status half written
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.ProcessMOAEpoch(cacheCtx); err != nil {
+		// Don't fail the block: the validators keep their last MOA status and the failed epoch's
+		// activity is dropped, so the next epoch starts now and does not count it again
+		ctx.Logger().Error("failed to process MOA epoch", "error", err, "height", height)
+		k.clearMOAEpochActivities(ctx)
+		return k.SetMOAEpochStart(ctx, ctx.BlockTime())
+	}
+	write()
+
+	return nil
+}
+
 // SetDeactivatingLizenz stores a deactivating LZN license
 func (k Keeper) SetDeactivatingLizenz(ctx sdk.Context, lizenz *lizenzv1.DeactivatingLizenz) error {
 	if err := types.IsDeactivatingLizenzValid(lizenz); err != nil {
@@ -746,21 +918,16 @@ func (k Keeper) GetAllMOAStatus(ctx sdk.Context) ([]*lizenzv1.MOAStatus, error)
 func (k Keeper) UpdateLizenzActivity(ctx sdk.Context, validator string) error {
 	// Update activated LZN activity
 	if activatedLizenz, err := k.GetActivatedLizenz(ctx, validator); err == nil {
-		types.UpdateActivatedLizenzActivity(activatedLizenz)
+		activatedLizenz.LastActivity = timestamppb.New(ctx.BlockTime())
 		if err := k.UpdateActivatedLizenz(ctx, activatedLizenz); err != nil {
 			return err
 		}
 	}
 
 	// Update MOA status activity
+	// The MOA value itself is recalculated from on-chain activity at the end of each MOA epoch
 	if moaStatus, err := k.GetMOAStatus(ctx, validator); err == nil {
-		// Calculate new MOA based on activity
-		params := k.GetParams(ctx)
-		newMOA, err := types.CalculateMOA("activity_data", params)
-		if err != nil {
-			return err
-		}
-		types.UpdateMOAStatusActivity(moaStatus, newMOA)
+		moaStatus.LastActivity = timestamppb.New(ctx.BlockTime())
 		if err := k.SetMOAStatus(ctx, moaStatus); err != nil {
 			return err
 		}
@@ -769,58 +936,6 @@ func (k Keeper) UpdateLizenzActivity(ctx sdk.Context, validator string) error {
 	return nil
 }
 
-// registerValidatorInConsensus registers a validator in consensus module after LZN activation
-// This creates the validator record, sets weight, and creates initial ANT position
-// The actual type conversion happens in the adapter in app.go
-func (k Keeper) registerValidatorInConsensus(ctx sdk.Context, lizenz *lizenzv1.ActivatedLizenz) error {
-	// Skip if consensus keeper is not set
-	if k.consensusKeeper == nil {
-		return nil
-	}
-
-	// Create validator data structure
-	// The adapter in app.go will convert this to the correct type
-	validatorData := map[string]interface{}{
-		"validator":           lizenz.Validator,
-		"ant_balance":        "0",
-		"status":             1, // VALIDATOR_STATUS_ACTIVE
-		"last_active":        lizenz.ActivationTime,
-		"last_block_height":  uint64(0),
-		"moa_score":          "0",
-		"activity_score":     "0",
-		"total_blocks_created": uint64(0),
-		"total_burn_amount":  "0",
-	}
-
-	// Register validator in consensus module
-	// The adapter will handle type conversion
-	if err := k.consensusKeeper.SetValidator(ctx, validatorData); err != nil {
-		return fmt.Errorf("failed to set validator in consensus: %w", err)
-	}
-
-	// Set validator weight based on activated LZN amount
-	if err := k.consensusKeeper.SetValidatorWeight(ctx, lizenz.Validator, lizenz.Amount); err != nil {
-		return fmt.Errorf("failed to set validator weight: %w", err)
-	}
-
-	// Create initial ANT position for validator
-	if k.anteilKeeper != nil {
-		positionData := map[string]interface{}{
-			"owner":        lizenz.Validator,
-			"ant_balance":  "0",
-			"locked_ant":   "0",
-			"available_ant": "0",
-			"order_count":  uint32(0),
-		}
-		if err := k.anteilKeeper.SetUserPosition(ctx, positionData); err != nil {
-			// Log but don't fail - position can be created later
-			ctx.Logger().Error("failed to create initial ANT position for validator", "error", err, "validator", lizenz.Validator)
-		}
-	}
-
-	return nil
-}
-
 // validateIdentityAndRole validates that validator has verified identity and VALIDATOR role
 // According to whitepaper: only validators with verified identity can activate LZN
 func (k Keeper) validateIdentityAndRole(ctx sdk.Context, validator string) error {
@@ -894,7 +1009,8 @@ func (k Keeper) CheckInactiveLizenz(ctx sdk.Context) error {
 	for _, lizenz := range activatedLizenzs {
 		if lizenz.LastActivity.AsTime().Before(inactivityThreshold) {
 			// Move to deactivating state
-			deactivatingLizenz := types.NewDeactivatingLizenz(
+			deactivatingLizenz := types.NewDeactivatingLizenzAt(
+				ctx.BlockTime(),
 				lizenz.Validator,
 				lizenz.Amount,
 				"inactivity",
@@ -907,12 +1023,24 @@ func (k Keeper) CheckInactiveLizenz(ctx sdk.Context) error {
 			if err := k.DeleteActivatedLizenz(ctx, lizenz.Validator); err != nil {
 				return err
 			}
+
+			if err := k.afterDeactivationStarted(ctx, lizenz.Validator, lizenz.Amount); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+// afterDeactivationStarted calls the AfterDeactivationStarted hook if hooks are set
+func (k Keeper) afterDeactivationStarted(ctx sdk.Context, validator string, amount string) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterDeactivationStarted(ctx, validator, amount)
+}
+
 // ProcessDeactivatingLizenz processes deactivating LZN licenses that have completed their period
 func (k Keeper) ProcessDeactivatingLizenz(ctx sdk.Context) error {
 	params := k.GetParams(ctx)
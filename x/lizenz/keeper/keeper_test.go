@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	storetypes "cosmossdk.io/store/types"
@@ -516,6 +517,7 @@ func (suite *KeeperTestSuite) TestProcessDeactivatingLizenz() {
 
 // Test UpdateLizenzActivity
 func (suite *KeeperTestSuite) TestUpdateLizenzActivity() {
+	suite.ctx = suite.ctx.WithBlockTime(time.Now())
 	lizenz := &lizenzv1.ActivatedLizenz{
 		Validator:            "cosmos1validator",
 		Amount:               "1000000",
@@ -778,6 +780,7 @@ func (suite *KeeperTestSuite) TestUpdateLizenzActivity_NotFound() {
 }
 
 func (suite *KeeperTestSuite) TestUpdateLizenzActivity_Success() {
+	suite.ctx = suite.ctx.WithBlockTime(time.Now())
 	// Create activated lizenz
 	lizenz := &lizenzv1.ActivatedLizenz{
 		Validator:            "cosmos1validator",
@@ -1040,3 +1043,145 @@ func (suite *KeeperTestSuite) TestValidateMaxLznActivationLimit_UpdateExisting()
 	require.Error(suite.T(), err)
 	require.Contains(suite.T(), err.Error(), "exceeds maximum LZN activation limit")
 }
+
+// Test UpdateActivatedLizenz only checks the 33% limit when the amount grows
+func (suite *KeeperTestSuite) TestUpdateActivatedLizenz_AboveLimitKeepsAmount() {
+	// The first validator holds the whole pool, then a second one joins within the limit
+	// and leaves the first one above 33%
+	lizenz1 := &lizenzv1.ActivatedLizenz{
+		Validator:            "cosmos1validator1",
+		Amount:               "10000000",
+		ActivationTime:       timestamppb.Now(),
+		LastActivity:         timestamppb.Now(),
+		IsEligibleForRewards: true,
+		IdentityHash:         "hash1",
+	}
+	require.NoError(suite.T(), suite.keeper.SetActivatedLizenz(suite.ctx, lizenz1))
+	lizenz2 := &lizenzv1.ActivatedLizenz{
+		Validator:            "cosmos1validator2",
+		Amount:               "3000000",
+		ActivationTime:       timestamppb.Now(),
+		LastActivity:         timestamppb.Now(),
+		IsEligibleForRewards: true,
+		IdentityHash:         "hash2",
+	}
+	require.NoError(suite.T(), suite.keeper.SetActivatedLizenz(suite.ctx, lizenz2))
+	require.Error(suite.T(), suite.keeper.ValidateMaxLznActivationLimit(suite.ctx, lizenz1.Validator, lizenz1.Amount))
+
+	// Activity updates keep the amount and succeed
+	activity := suite.ctx.BlockTime().Add(time.Hour)
+	lizenz1.LastActivity = timestamppb.New(activity)
+	require.NoError(suite.T(), suite.keeper.UpdateActivatedLizenz(suite.ctx, lizenz1))
+	stored, err := suite.keeper.GetActivatedLizenz(suite.ctx, lizenz1.Validator)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), stored.LastActivity.AsTime().Equal(activity))
+
+	// Lowering the amount is allowed, growing it above the limit is not
+	lizenz1.Amount = "9000000"
+	require.NoError(suite.T(), suite.keeper.UpdateActivatedLizenz(suite.ctx, lizenz1))
+	lizenz1.Amount = "9500000"
+	err = suite.keeper.UpdateActivatedLizenz(suite.ctx, lizenz1)
+	require.Error(suite.T(), err)
+	require.Contains(suite.T(), err.Error(), "exceeds maximum LZN activation limit")
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	msgServer := keeper.NewMsgServer(suite.keeper)
+	params := types.DefaultParams()
+	params.MaxActivatedPerValidator = 7
+
+	// Only the governance authority may update params
+	_, err := msgServer.UpdateParams(suite.ctx, &lizenzv1.MsgUpdateParams{
+		Authority: "cosmos1notauthority",
+		Params:    params.ToProto(),
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = msgServer.UpdateParams(suite.ctx, &lizenzv1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	invalid := params
+	invalid.MaxActivatedPerValidator = 0
+	_, err = msgServer.UpdateParams(suite.ctx, &lizenzv1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    invalid.ToProto(),
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	// The LZN denomination is constitutional
+	renamed := params
+	renamed.LznDenom = "ulzn2"
+	_, err = msgServer.UpdateParams(suite.ctx, &lizenzv1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    renamed.ToProto(),
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	_, err = msgServer.UpdateParams(suite.ctx, &lizenzv1.MsgUpdateParams{
+		Authority: suite.keeper.GetAuthority(),
+		Params:    params.ToProto(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint32(7), suite.keeper.GetParams(suite.ctx).MaxActivatedPerValidator)
+}
+
+func (suite *KeeperTestSuite) TestInitGenesis_ExportGenesis_RoundTrip() {
+	start := time.Unix(1700000000, 0).UTC()
+
+	suite.Require().NoError(suite.keeper.SetActivatedLizenz(suite.ctx, &lizenzv1.ActivatedLizenz{
+		Validator:            "cosmos1validator",
+		Amount:               "1000000",
+		ActivationTime:       timestamppb.New(start),
+		LastActivity:         timestamppb.New(start),
+		IsEligibleForRewards: true,
+		IdentityHash:         "hash123",
+	}))
+	suite.Require().NoError(suite.keeper.SetDeactivatingLizenz(suite.ctx, &lizenzv1.DeactivatingLizenz{
+		Validator:         "cosmos1leaving",
+		Amount:            "500000",
+		DeactivationStart: timestamppb.New(start),
+		DeactivationEnd:   timestamppb.New(start.Add(time.Hour)),
+		Reason:            "voluntary",
+	}))
+	suite.Require().NoError(suite.keeper.SetMOAStatus(suite.ctx, &lizenzv1.MOAStatus{
+		Validator:    "cosmos1validator",
+		CurrentMoa:   "0.9",
+		RequiredMoa:  "0.8",
+		IsCompliant:  true,
+		LastActivity: timestamppb.New(start),
+	}))
+	suite.Require().NoError(suite.keeper.RecordEpochReward(suite.ctx, "cosmos1validator", &lizenzv1.RewardRecord{
+		BlockHeight:  200,
+		RewardAmount: "1000",
+		Epoch:        10,
+		StartHeight:  101,
+	}))
+	suite.Require().NoError(suite.keeper.SetMOAEpochStart(suite.ctx, start))
+	suite.Require().NoError(suite.keeper.SetMOAEpochActivity(suite.ctx, &lizenzv1.MOAEpochActivity{
+		Validator:      "cosmos1validator",
+		BlocksCreated:  3,
+		AuctionCommits: 2,
+		AuctionReveals: 1,
+	}))
+
+	exported := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(exported.ActivatedLizenz, 1)
+	suite.Require().Len(exported.DeactivatingLizenz, 1)
+	suite.Require().Len(exported.MoaStatuses, 1)
+	suite.Require().Len(exported.MoaEpochActivities, 1)
+	suite.Require().Equal(uint64(3), exported.MoaEpochActivities[0].BlocksCreated)
+	suite.Require().Len(exported.RewardRecords, 1)
+	suite.Require().Equal("cosmos1validator", exported.RewardRecords[0].Validator)
+	suite.Require().Equal(uint64(10), exported.RewardRecords[0].Record.Epoch)
+	suite.Require().NotNil(exported.MoaEpochStart)
+
+	// Import into a fresh store and export again
+	suite.SetupTest()
+	suite.keeper.InitGenesis(suite.ctx, exported)
+	reexported := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().True(proto.Equal(exported, reexported))
+
+	suite.Require().Equal(uint64(1), suite.keeper.GetRewardRecordCount(suite.ctx, "cosmos1validator"))
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// Migrator migrates the lizenz store between consensus versions
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 migrates the lizenz store from consensus version 1 to 2
+// Version 2 adds the MOA epoch length param, which starts at its default, and the
+// total activated LZN, which starts at the sum of the activations. The per-block reward
+// history under RewardHistoryKeyPrefix, superseded by the epoch records, is deleted.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !m.keeper.paramstore.Has(ctx, pair.Key) {
+			m.keeper.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	lizenzs, err := m.keeper.GetAllActivatedLizenz(ctx)
+	if err != nil {
+		return err
+	}
+	var total uint64
+	for _, lizenz := range lizenzs {
+		amount, err := parseActivatedAmount(lizenz.Amount)
+		if err != nil {
+			return fmt.Errorf("validator %s: %w", lizenz.Validator, err)
+		}
+		total += amount
+	}
+	m.keeper.SetTotalActivated(ctx, total)
+
+	m.deleteRewardHistory(ctx)
+	return nil
+}
+
+// deleteRewardHistory deletes the deprecated per-block reward history of every validator
+func (m Migrator) deleteRewardHistory(ctx sdk.Context) {
+	historyStore := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.RewardHistoryKeyPrefix)
+	iterator := historyStore.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		historyStore.Delete(key)
+	}
+}
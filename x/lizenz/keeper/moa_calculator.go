@@ -0,0 +1,318 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// ProcessMOAEpoch measures MOA (Minimum Obligation of Activity) for the epoch that started at the stored
+// MOA epoch start and ends at the current block time
+// According to whitepaper: validators must actively participate in consensus, governance and the ANT market.
+// For every validator with activated LZN the calculator collects:
+//   - blocks created and blind auction commits and reveals, counted by the consensus hooks during the epoch
+//   - governance votes on proposals open during the epoch
+//   - ANT market orders placed during the epoch
+//
+// The results are stored in MOAStatus and are used by consensus for reward penalties.
+func (k Keeper) ProcessMOAEpoch(ctx sdk.Context) error {
+	lizenzs, err := k.GetAllActivatedLizenz(ctx)
+	if err != nil {
+		return err
+	}
+
+	epochStart, err := k.GetMOAEpochStart(ctx)
+	if err != nil {
+		return err
+	}
+	epochEnd := ctx.BlockTime()
+
+	if len(lizenzs) > 0 {
+		activities, totals, err := k.collectMOAActivity(ctx, lizenzs, epochStart, epochEnd)
+		if err != nil {
+			return err
+		}
+
+		params := k.GetParams(ctx)
+		requiredMOA, err := types.CalculateRequiredMOA(params)
+		if err != nil {
+			return err
+		}
+
+		for _, lizenz := range lizenzs {
+			score := types.CalculateMOAScore(activities[lizenz.Validator], totals)
+			if err := k.applyMOAScore(ctx, lizenz.Validator, score, requiredMOA, epochStart); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The next epoch starts now
+	k.clearMOAEpochActivities(ctx)
+	return k.SetMOAEpochStart(ctx, epochEnd)
+}
+
+// collectMOAActivity gathers the epoch activity of every validator and the network-wide totals
+func (k Keeper) collectMOAActivity(
+	ctx sdk.Context,
+	lizenzs []*lizenzv1.ActivatedLizenz,
+	epochStart, epochEnd time.Time,
+) (map[string]types.MOAActivity, types.MOAEpochTotals, error) {
+	activities := make(map[string]types.MOAActivity, len(lizenzs))
+	for _, lizenz := range lizenzs {
+		activities[lizenz.Validator] = types.MOAActivity{}
+	}
+
+	// Consensus contribution: counted as blocks are created and bids committed and revealed
+	for _, lizenz := range lizenzs {
+		counted, err := k.GetMOAEpochActivity(ctx, lizenz.Validator)
+		if err != nil {
+			return nil, types.MOAEpochTotals{}, err
+		}
+		activity := activities[lizenz.Validator]
+		activity.BlocksCreated = counted.BlocksCreated
+		activity.AuctionCommits = counted.AuctionCommits
+		activity.AuctionReveals = counted.AuctionReveals
+		activities[lizenz.Validator] = activity
+	}
+
+	// Market participation: orders placed on the ANT market during the epoch
+	if k.anteilKeeper != nil {
+		for _, lizenz := range lizenzs {
+			orders, err := k.anteilKeeper.GetOrdersByOwner(ctx, lizenz.Validator)
+			if err != nil {
+				return nil, types.MOAEpochTotals{}, fmt.Errorf("failed to get ANT orders for %s: %w", lizenz.Validator, err)
+			}
+			activity := activities[lizenz.Validator]
+			for _, order := range orders {
+				if order.CreatedAt != nil && inMOAEpoch(order.CreatedAt.AsTime(), epochStart, epochEnd) {
+					activity.MarketActions++
+				}
+			}
+			activities[lizenz.Validator] = activity
+		}
+	}
+
+	var totals types.MOAEpochTotals
+
+	// Governance participation: votes on proposals open for voting during the epoch
+	if k.governanceKeeper != nil {
+		proposals, err := k.governanceKeeper.GetAllProposals(ctx)
+		if err != nil {
+			return nil, types.MOAEpochTotals{}, fmt.Errorf("failed to get proposals: %w", err)
+		}
+		for _, proposal := range proposals {
+			if !isProposalOpenDuringEpoch(proposal, epochStart, epochEnd) {
+				continue
+			}
+			totals.Proposals++
+			for _, lizenz := range lizenzs {
+				if vote, err := k.governanceKeeper.GetVote(ctx, proposal.ProposalId, lizenz.Validator); err == nil && vote != nil {
+					activity := activities[lizenz.Validator]
+					activity.GovernanceVotes++
+					activities[lizenz.Validator] = activity
+				}
+			}
+		}
+	}
+
+	for _, lizenz := range lizenzs {
+		totals.Add(activities[lizenz.Validator])
+	}
+
+	return activities, totals, nil
+}
+
+// GetMOAEpochActivity returns the consensus activity of a validator counted in the current MOA epoch
+// A validator without counted activity has an empty record
+func (k Keeper) GetMOAEpochActivity(ctx sdk.Context, validator string) (*lizenzv1.MOAEpochActivity, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetMOAEpochActivityKey(validator))
+	if bz == nil {
+		return &lizenzv1.MOAEpochActivity{Validator: validator}, nil
+	}
+
+	var activity lizenzv1.MOAEpochActivity
+	if err := k.cdc.Unmarshal(bz, &activity); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal MOA epoch activity: %w", err)
+	}
+	return &activity, nil
+}
+
+// SetMOAEpochActivity stores the consensus activity of a validator counted in the current MOA epoch
+func (k Keeper) SetMOAEpochActivity(ctx sdk.Context, activity *lizenzv1.MOAEpochActivity) error {
+	if activity.Validator == "" {
+		return types.ErrEmptyValidator
+	}
+
+	bz, err := k.cdc.Marshal(activity)
+	if err != nil {
+		return fmt.Errorf("failed to marshal MOA epoch activity: %w", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetMOAEpochActivityKey(activity.Validator), bz)
+	return nil
+}
+
+// countMOAEpochActivity adds consensus activity of a validator with activated LZN to the current MOA epoch
+// Auctions are pruned long before an epoch ends, so the activity is counted when it happens
+func (k Keeper) countMOAEpochActivity(ctx sdk.Context, validator string, count func(*lizenzv1.MOAEpochActivity)) error {
+	if _, err := k.GetActivatedLizenz(ctx, validator); err != nil {
+		return nil
+	}
+
+	activity, err := k.GetMOAEpochActivity(ctx, validator)
+	if err != nil {
+		return err
+	}
+	count(activity)
+	return k.SetMOAEpochActivity(ctx, activity)
+}
+
+// clearMOAEpochActivities removes the activity counted in the finished MOA epoch
+func (k Keeper) clearMOAEpochActivities(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.MOAEpochActivityKeyPrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// applyMOAScore stores the MOA score of a validator and emits events
+func (k Keeper) applyMOAScore(ctx sdk.Context, validator string, score types.MOAScore, requiredMOA float64, epochStart time.Time) error {
+	status, err := k.GetMOAStatus(ctx, validator)
+	if errors.Is(err, types.ErrLizenzNotFound) {
+		status = &lizenzv1.MOAStatus{
+			Validator:    validator,
+			LastActivity: timestamppb.New(ctx.BlockTime()),
+		}
+	} else if err != nil {
+		return err
+	}
+
+	compliance := 1.0
+	if requiredMOA > 0 {
+		compliance = score.OverallScore / requiredMOA
+	}
+
+	status.IsActive = true
+	status.CurrentMoa = types.FormatMOAValue(score.OverallScore)
+	status.RequiredMoa = types.FormatMOAValue(requiredMOA)
+	status.IsCompliant = score.OverallScore >= requiredMOA
+	status.ConsensusContribution = types.FormatMOAValue(score.ConsensusContribution)
+	status.MarketParticipation = types.FormatMOAValue(score.MarketParticipation)
+	status.OverallScore = types.FormatMOAValue(score.OverallScore)
+
+	if err := k.SetMOAStatus(ctx, status); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMOAChecked,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(types.AttributeKeyMOAScore, status.CurrentMoa),
+			sdk.NewAttribute(types.AttributeKeyRequiredMOA, status.RequiredMoa),
+			sdk.NewAttribute(types.AttributeKeyMOACompliance, types.FormatMOAValue(compliance)),
+			sdk.NewAttribute(types.AttributeKeyEpochStart, epochStart.UTC().Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	level := k.getMOAWarningLevel(ctx, compliance)
+	if level != types.MOAWarningLevelNone {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMOAWarning,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator),
+				sdk.NewAttribute(types.AttributeKeyWarningLevel, level),
+				sdk.NewAttribute(types.AttributeKeyMOACompliance, types.FormatMOAValue(compliance)),
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			),
+		)
+		ctx.Logger().Info("validator MOA below threshold", "validator", validator, "level", level, "compliance", compliance)
+	}
+
+	return nil
+}
+
+// getMOAWarningLevel returns the warning level for a compliance ratio
+// Thresholds come from consensus params so warnings match the reward penalties applied there
+func (k Keeper) getMOAWarningLevel(ctx sdk.Context, compliance float64) string {
+	// Default fallback thresholds (same as consensus defaults)
+	thresholdHigh, thresholdWarning, thresholdMedium, thresholdLow := 1.0, 0.9, 0.7, 0.5
+
+	if k.consensusKeeper != nil {
+		params := k.consensusKeeper.GetParams(ctx)
+		if v, err := strconv.ParseFloat(params.MoaPenaltyThresholdHigh, 64); err == nil && v > 0 {
+			thresholdHigh = v
+		}
+		if v, err := strconv.ParseFloat(params.MoaPenaltyThresholdWarning, 64); err == nil && v > 0 {
+			thresholdWarning = v
+		}
+		if v, err := strconv.ParseFloat(params.MoaPenaltyThresholdMedium, 64); err == nil && v > 0 {
+			thresholdMedium = v
+		}
+		if v, err := strconv.ParseFloat(params.MoaPenaltyThresholdLow, 64); err == nil && v > 0 {
+			thresholdLow = v
+		}
+	}
+
+	return types.GetMOAWarningLevel(compliance, thresholdHigh, thresholdWarning, thresholdMedium, thresholdLow)
+}
+
+// GetMOAEpochStart returns the start time of the current MOA epoch
+// Returns zero time before the first epoch has been processed
+func (k Keeper) GetMOAEpochStart(ctx sdk.Context) (time.Time, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MOAEpochStartKey)
+	if bz == nil {
+		return time.Time{}, nil
+	}
+
+	var epochStart time.Time
+	if err := epochStart.UnmarshalBinary(bz); err != nil {
+		return time.Time{}, fmt.Errorf("failed to unmarshal MOA epoch start: %w", err)
+	}
+
+	return epochStart, nil
+}
+
+// SetMOAEpochStart sets the start time of the current MOA epoch
+// The time is stored in UTC, so the encoding does not depend on the node's time zone
+func (k Keeper) SetMOAEpochStart(ctx sdk.Context, t time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := t.UTC().MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to marshal MOA epoch start: %w", err)
+	}
+
+	store.Set(types.MOAEpochStartKey, bz)
+	return nil
+}
+
+// inMOAEpoch checks if t falls within the epoch (start is exclusive, end inclusive)
+func inMOAEpoch(t, epochStart, epochEnd time.Time) bool {
+	return t.After(epochStart) && !t.After(epochEnd)
+}
+
+// isProposalOpenDuringEpoch checks if a proposal's voting period overlaps the epoch
+func isProposalOpenDuringEpoch(proposal *governancev1.Proposal, epochStart, epochEnd time.Time) bool {
+	if proposal.VotingStartTime == nil || proposal.VotingEndTime == nil {
+		return false
+	}
+	return !proposal.VotingStartTime.AsTime().After(epochEnd) && proposal.VotingEndTime.AsTime().After(epochStart)
+}
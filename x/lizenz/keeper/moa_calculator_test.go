@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anteilv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/anteil/v1"
+	governancev1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/governance/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	consensustypes "github.com/volnix-protocol/volnix-protocol/x/consensus/types"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// MockConsensusKeeperForMOA is a mock consensus keeper exposing the MOA penalty thresholds
+type MockConsensusKeeperForMOA struct{}
+
+func (m *MockConsensusKeeperForMOA) SetValidatorWeight(ctx sdk.Context, validator, weight string) error {
+	return nil
+}
+
+func (m *MockConsensusKeeperForMOA) GetParams(ctx sdk.Context) consensustypes.Params {
+	return *consensustypes.DefaultParams()
+}
+
+// MockAnteilKeeperForMOA is a mock anteil keeper exposing ANT market orders
+type MockAnteilKeeperForMOA struct {
+	orders map[string][]*anteilv1.Order
+}
+
+func (m *MockAnteilKeeperForMOA) GetOrdersByOwner(ctx sdk.Context, owner string) ([]*anteilv1.Order, error) {
+	return m.orders[owner], nil
+}
+
+// MockGovernanceKeeperForMOA is a mock governance keeper exposing proposals and votes
+type MockGovernanceKeeperForMOA struct {
+	proposals []*governancev1.Proposal
+	votes     map[string]*governancev1.Vote
+}
+
+func (m *MockGovernanceKeeperForMOA) GetAllProposals(ctx sdk.Context) ([]*governancev1.Proposal, error) {
+	return m.proposals, nil
+}
+
+func (m *MockGovernanceKeeperForMOA) GetVote(ctx sdk.Context, proposalID uint64, voter string) (*governancev1.Vote, error) {
+	vote, ok := m.votes[fmt.Sprintf("%d/%s", proposalID, voter)]
+	if !ok {
+		return nil, fmt.Errorf("vote not found")
+	}
+	return vote, nil
+}
+
+func (suite *KeeperTestSuite) setupMOAValidators() {
+	for i, amount := range []string{"10000000", "3000000"} {
+		lizenz := &lizenzv1.ActivatedLizenz{
+			Validator:            fmt.Sprintf("cosmos1validator%d", i),
+			Amount:               amount,
+			ActivationTime:       timestamppb.Now(),
+			LastActivity:         timestamppb.Now(),
+			IsEligibleForRewards: true,
+			IdentityHash:         fmt.Sprintf("hash%d", i),
+		}
+		require.NoError(suite.T(), suite.keeper.SetActivatedLizenz(suite.ctx, lizenz))
+	}
+}
+
+// Test EndBlocker only measures MOA at epoch boundaries
+func (suite *KeeperTestSuite) TestEndBlocker_MOAEpochBoundary() {
+	suite.setupMOAValidators()
+
+	params := types.DefaultParams()
+	params.MoaEpochLength = 10
+	suite.keeper.SetParams(suite.ctx, params)
+
+	ctx := suite.ctx.WithBlockHeight(9)
+	require.NoError(suite.T(), suite.keeper.EndBlocker(ctx))
+	_, err := suite.keeper.GetMOAStatus(ctx, "cosmos1validator0")
+	require.Error(suite.T(), err)
+
+	ctx = suite.ctx.WithBlockHeight(10)
+	require.NoError(suite.T(), suite.keeper.EndBlocker(ctx))
+	status, err := suite.keeper.GetMOAStatus(ctx, "cosmos1validator0")
+	require.NoError(suite.T(), err)
+
+	// No activity in the network: all components are neutral
+	require.Equal(suite.T(), "1.0000", status.OverallScore)
+	require.True(suite.T(), status.IsCompliant)
+}
+
+// Test a failed MOA epoch leaves no MOA status behind and its activity is not counted again
+func (suite *KeeperTestSuite) TestEndBlocker_MOAEpochFailure() {
+	suite.setupMOAValidators()
+
+	params := types.DefaultParams()
+	params.MoaEpochLength = 10
+	suite.keeper.SetParams(suite.ctx, params)
+
+	epochStart := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	blockTime := epochStart.Add(time.Hour)
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+	require.NoError(suite.T(), suite.keeper.SetMOAEpochStart(ctx, epochStart))
+
+	suite.keeper.SetConsensusKeeper(&MockConsensusKeeperForMOA{})
+	require.NoError(suite.T(), suite.keeper.ConsensusHooks().AfterBlockCreatorSelected(ctx, 10, "cosmos1validator0"))
+
+	// The stored MOA status of the second validator cannot be decoded, so applying its score fails
+	ctx.KVStore(suite.storeKey).Set(types.GetMOAStatusKey("cosmos1validator1"), []byte{0xff})
+
+	require.NoError(suite.T(), suite.keeper.EndBlocker(ctx))
+
+	// The first validator's score was discarded with the failed epoch
+	_, err := suite.keeper.GetMOAStatus(ctx, "cosmos1validator0")
+	require.ErrorIs(suite.T(), err, types.ErrLizenzNotFound)
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(suite.T(), types.EventTypeMOAChecked, event.Type)
+	}
+
+	// The next epoch starts now without the failed epoch's activity
+	counted, err := suite.keeper.GetMOAEpochActivity(ctx, "cosmos1validator0")
+	require.NoError(suite.T(), err)
+	require.Zero(suite.T(), counted.BlocksCreated)
+	nextStart, err := suite.keeper.GetMOAEpochStart(ctx)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), nextStart.Equal(blockTime))
+}
+
+// Test MOA is calculated from consensus, governance and market activity
+func (suite *KeeperTestSuite) TestProcessMOAEpoch_FromActivity() {
+	suite.setupMOAValidators()
+
+	epochStart := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	blockTime := epochStart.Add(time.Hour)
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+	require.NoError(suite.T(), suite.keeper.SetMOAEpochStart(ctx, epochStart))
+
+	// validator0 creates every block, commits and reveals in every auction
+	suite.keeper.SetConsensusKeeper(&MockConsensusKeeperForMOA{})
+	hooks := suite.keeper.ConsensusHooks()
+	for h := uint64(1); h <= 10; h++ {
+		require.NoError(suite.T(), hooks.AfterBlockCreatorSelected(ctx, h, "cosmos1validator0"))
+		require.NoError(suite.T(), hooks.AfterBidCommitted(ctx, h, "cosmos1validator0"))
+		require.NoError(suite.T(), hooks.AfterBidRevealed(ctx, h, "cosmos1validator0"))
+	}
+	// Activity of accounts without activated LZN is not counted
+	require.NoError(suite.T(), hooks.AfterBlockCreatorSelected(ctx, 11, "cosmos1outsider"))
+	outsider, err := suite.keeper.GetMOAEpochActivity(ctx, "cosmos1outsider")
+	require.NoError(suite.T(), err)
+	require.Zero(suite.T(), outsider.BlocksCreated)
+
+	// Both validators trade on the ANT market during the epoch
+	orderTime := timestamppb.New(epochStart.Add(time.Minute))
+	suite.keeper.SetAnteilKeeper(&MockAnteilKeeperForMOA{orders: map[string][]*anteilv1.Order{
+		"cosmos1validator0": {{OrderId: "o1", CreatedAt: orderTime}},
+		"cosmos1validator1": {{OrderId: "o2", CreatedAt: orderTime}},
+	}})
+
+	// Only validator0 votes on the single open proposal
+	suite.keeper.SetGovernanceKeeper(&MockGovernanceKeeperForMOA{
+		proposals: []*governancev1.Proposal{{
+			ProposalId:      1,
+			VotingStartTime: timestamppb.New(epochStart.Add(-time.Hour)),
+			VotingEndTime:   timestamppb.New(epochStart.Add(2 * time.Hour)),
+		}},
+		votes: map[string]*governancev1.Vote{
+			"1/cosmos1validator0": {ProposalId: 1, Voter: "cosmos1validator0"},
+		},
+	})
+
+	require.NoError(suite.T(), suite.keeper.ProcessMOAEpoch(ctx))
+
+	// The next epoch counts from zero
+	counted, err := suite.keeper.GetMOAEpochActivity(ctx, "cosmos1validator0")
+	require.NoError(suite.T(), err)
+	require.Zero(suite.T(), counted.BlocksCreated)
+	require.Zero(suite.T(), counted.AuctionReveals)
+
+	active, err := suite.keeper.GetMOAStatus(ctx, "cosmos1validator0")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "1.0000", active.ConsensusContribution)
+	require.Equal(suite.T(), "1.0000", active.MarketParticipation)
+	require.Equal(suite.T(), "1.0000", active.OverallScore)
+	require.Equal(suite.T(), "0.5000", active.RequiredMoa)
+	require.True(suite.T(), active.IsCompliant)
+
+	idle, err := suite.keeper.GetMOAStatus(ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "0.0000", idle.ConsensusContribution)
+	require.Equal(suite.T(), "1.0000", idle.MarketParticipation)
+	require.Equal(suite.T(), "0.2500", idle.OverallScore)
+	require.False(suite.T(), idle.IsCompliant)
+
+	// Compliance feeds consensus reward penalties
+	compliance, err := suite.keeper.GetMOACompliance(ctx, "cosmos1validator1")
+	require.NoError(suite.T(), err)
+	require.InDelta(suite.T(), 0.5, compliance, 1e-9)
+
+	// A warning is emitted for the idle validator only
+	// and every MOA check names the start of the measured epoch
+	warnings := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMOAChecked {
+			attr, ok := event.GetAttribute(types.AttributeKeyEpochStart)
+			require.True(suite.T(), ok)
+			require.Equal(suite.T(), "2025-01-01T00:00:00Z", attr.Value)
+		}
+		if event.Type != types.EventTypeMOAWarning {
+			continue
+		}
+		warnings++
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyValidator {
+				require.Equal(suite.T(), "cosmos1validator1", attr.Value)
+			}
+			if attr.Key == types.AttributeKeyWarningLevel {
+				require.Equal(suite.T(), types.MOAWarningLevelLow, attr.Value)
+			}
+		}
+	}
+	require.Equal(suite.T(), 1, warnings)
+
+	// The next epoch starts at the current block time
+	nextStart, err := suite.keeper.GetMOAEpochStart(ctx)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), nextStart.Equal(blockTime))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -45,8 +46,8 @@ func (s MsgServer) ActivateLZN(ctx context.Context, req *lizenzv1.MsgActivateLZN
 	activatedLizenz := &lizenzv1.ActivatedLizenz{
 		Validator:      req.Validator,
 		Amount:         req.Amount,
-		ActivationTime: timestamppb.Now(),
-		LastActivity:   timestamppb.Now(),
+		ActivationTime: timestamppb.New(sdkCtx.BlockTime()),
+		LastActivity:   timestamppb.New(sdkCtx.BlockTime()),
 		IdentityHash:   req.IdentityHash,
 		IsEligibleForRewards: true,
 	}
@@ -82,7 +83,7 @@ func (s MsgServer) DeactivateLZN(ctx context.Context, req *lizenzv1.MsgDeactivat
 	}
 
 	// Check if LZN exists (validation)
-	_, err := s.k.GetActivatedLizenz(sdkCtx, req.Validator)
+	lizenz, err := s.k.GetActivatedLizenz(sdkCtx, req.Validator)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +94,10 @@ func (s MsgServer) DeactivateLZN(ctx context.Context, req *lizenzv1.MsgDeactivat
 		return nil, err
 	}
 
+	if err := s.k.afterDeactivationStarted(sdkCtx, req.Validator, lizenz.Amount); err != nil {
+		return nil, err
+	}
+
 	// Generate deactivation ID
 	deactivationId := fmt.Sprintf("deactivation-%s-%d", req.Validator, sdkCtx.BlockHeight())
 
@@ -101,3 +106,30 @@ func (s MsgServer) DeactivateLZN(ctx context.Context, req *lizenzv1.MsgDeactivat
 		DeactivationId: deactivationId,
 	}, nil
 }
+
+// UpdateParams replaces the module parameters; only the governance authority may execute it
+// The LZN denomination is constitutional and cannot be changed
+func (s MsgServer) UpdateParams(ctx context.Context, req *lizenzv1.MsgUpdateParams) (*lizenzv1.MsgUpdateParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != s.k.GetAuthority() {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", s.k.GetAuthority(), req.Authority)
+	}
+	if req.Params == nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, "params cannot be nil")
+	}
+
+	params, err := types.ParamsFromProto(req.Params)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	if err := params.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	if current := s.k.GetParams(sdkCtx); params.LznDenom != current.LznDenom {
+		return nil, errors.Wrapf(types.ErrInvalidParams, "lzn_denom is constitutional: cannot change %s to %s", current.LznDenom, params.LznDenom)
+	}
+
+	s.k.SetParams(sdkCtx, params)
+	return &lizenzv1.MsgUpdateParamsResponse{}, nil
+}
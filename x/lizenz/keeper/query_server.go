@@ -2,13 +2,14 @@ package keeper
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
 )
 
 var _ lizenzv1.QueryServer = (*QueryServer)(nil)
@@ -22,37 +23,120 @@ func NewQueryServer(k *Keeper) QueryServer {
 	return QueryServer{k: k}
 }
 
-// GetRewardHistory returns reward history for a validator
-func (q QueryServer) GetRewardHistory(ctx context.Context, req *lizenzv1.QueryRewardHistoryRequest) (*lizenzv1.QueryRewardHistoryResponse, error) {
+// Params returns the lizenz module parameters
+func (q QueryServer) Params(ctx context.Context, _ *lizenzv1.QueryParamsRequest) (*lizenzv1.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &lizenzv1.QueryParamsResponse{Params: q.k.GetParams(sdkCtx).ToProto()}, nil
+}
+
+// ActivatedLizenz returns the activated LZN of a validator
+func (q QueryServer) ActivatedLizenz(ctx context.Context, req *lizenzv1.QueryActivatedLizenzRequest) (*lizenzv1.QueryActivatedLizenzResponse, error) {
+	if req == nil || req.Validator == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	lizenz, err := q.k.GetActivatedLizenz(sdkCtx, req.Validator)
+	if err != nil {
+		return nil, lookupError(err)
+	}
+
+	return &lizenzv1.QueryActivatedLizenzResponse{ActivatedLizenz: lizenz}, nil
+}
+
+// AllActivatedLizenz returns a page of the activated LZN of all validators
+func (q QueryServer) AllActivatedLizenz(ctx context.Context, req *lizenzv1.QueryAllActivatedLizenzRequest) (*lizenzv1.QueryAllActivatedLizenzResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	// Get reward history
-	history, err := q.k.GetRewardHistory(sdkCtx, req.Validator)
+	lizenzs, pageRes, err := q.k.GetActivatedLizenzPage(sdkCtx, req.Pagination)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// Convert RewardRecord to proto format
-	var protoRecords []*lizenzv1.RewardRecord
-	for _, record := range history {
-		protoRecords = append(protoRecords, &lizenzv1.RewardRecord{
-			BlockHeight:    record.BlockHeight,
-			RewardAmount:   record.RewardAmount,
-			Timestamp:      record.Timestamp,
-			MoaCompliance:  fmt.Sprintf("%.4f", record.MOACompliance),
-			PenaltyApplied: fmt.Sprintf("%.4f", record.PenaltyApplied),
-			BaseReward:     record.BaseReward,
-		})
+	return &lizenzv1.QueryAllActivatedLizenzResponse{
+		ActivatedLizenz: lizenzs,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// TotalActivatedLizenz returns the activated LZN pool and a page of the validators' shares of it,
+// so that the distance of each validator to the 33% cap can be followed
+func (q QueryServer) TotalActivatedLizenz(ctx context.Context, req *lizenzv1.QueryTotalActivatedLizenzRequest) (*lizenzv1.QueryTotalActivatedLizenzResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	total, shares, pageRes, err := q.k.GetActivatedLizenzSharesPage(sdkCtx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &lizenzv1.QueryTotalActivatedLizenzResponse{
+		TotalAmount:       total,
+		MaxValidatorShare: MaxValidatorShare().String(),
+		Shares:            shares,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// DeactivatingLizenz returns the LZN a validator is deactivating
+func (q QueryServer) DeactivatingLizenz(ctx context.Context, req *lizenzv1.QueryDeactivatingLizenzRequest) (*lizenzv1.QueryDeactivatingLizenzResponse, error) {
+	if req == nil || req.Validator == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	lizenz, err := q.k.GetDeactivatingLizenz(sdkCtx, req.Validator)
+	if err != nil {
+		return nil, lookupError(err)
+	}
+
+	return &lizenzv1.QueryDeactivatingLizenzResponse{DeactivatingLizenz: lizenz}, nil
+}
+
+// MOAStatus returns the last measured MOA status of a validator
+func (q QueryServer) MOAStatus(ctx context.Context, req *lizenzv1.QueryMOAStatusRequest) (*lizenzv1.QueryMOAStatusResponse, error) {
+	if req == nil || req.Validator == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	moaStatus, err := q.k.GetMOAStatus(sdkCtx, req.Validator)
+	if err != nil {
+		return nil, lookupError(err)
+	}
+
+	return &lizenzv1.QueryMOAStatusResponse{MoaStatus: moaStatus}, nil
+}
+
+// GetRewardHistory returns a page of the per-epoch reward history of a validator
+func (q QueryServer) GetRewardHistory(ctx context.Context, req *lizenzv1.QueryRewardHistoryRequest) (*lizenzv1.QueryRewardHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Get reward history
+	history, pageRes, err := q.k.GetRewardHistory(sdkCtx, req.Validator, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &lizenzv1.QueryRewardHistoryResponse{
 		Validator:     req.Validator,
-		RewardHistory:  protoRecords,
-		TotalRecords:   uint64(len(protoRecords)),
+		RewardHistory: history,
+		TotalRecords:  q.k.GetRewardRecordCount(sdkCtx, req.Validator),
+		Pagination:    pageRes,
 	}, nil
 }
 
@@ -65,29 +149,44 @@ func (q QueryServer) GetRewardStats(ctx context.Context, req *lizenzv1.QueryRewa
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	// Get reward stats
-	stats, err := q.k.GetRewardStats(sdkCtx, req.Validator)
+	stats, err := q.k.GetRewardStats(sdkCtx, req.Validator, req.Pagination)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// Convert RewardRecord to proto format
-	var protoRecords []*lizenzv1.RewardRecord
-	for _, record := range stats.RewardHistory {
-		protoRecords = append(protoRecords, &lizenzv1.RewardRecord{
-			BlockHeight:    record.BlockHeight,
-			RewardAmount:   record.RewardAmount,
-			Timestamp:      record.Timestamp,
-			MoaCompliance:  fmt.Sprintf("%.4f", record.MOACompliance),
-			PenaltyApplied: fmt.Sprintf("%.4f", record.PenaltyApplied),
-			BaseReward:     record.BaseReward,
-		})
-	}
-
 	return &lizenzv1.QueryRewardStatsResponse{
 		TotalRewardsEarned: stats.TotalRewardsEarned,
 		LastRewardBlock:    stats.LastRewardBlock,
 		LastRewardTime:     stats.LastRewardTime,
-		RewardHistory:      protoRecords,
-		TotalRewardsCount:  uint64(stats.TotalRewardsCount),
+		RewardHistory:      stats.RewardHistory,
+		TotalRewardsCount:  stats.TotalRewardsCount,
+		Pagination:         stats.Pagination,
 	}, nil
 }
+
+// ValidatorIntegration returns the cross-module integration status of a validator
+func (q QueryServer) ValidatorIntegration(ctx context.Context, req *lizenzv1.QueryValidatorIntegrationRequest) (*lizenzv1.QueryValidatorIntegrationResponse, error) {
+	if req == nil || req.Validator == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if q.k.integrationKeeper == nil {
+		return nil, status.Error(codes.Unavailable, "integration keeper not set")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	integration, err := q.k.integrationKeeper.GetValidatorIntegration(sdkCtx, req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &lizenzv1.QueryValidatorIntegrationResponse{ValidatorIntegration: integration}, nil
+}
+
+// lookupError maps a keeper lookup error to a gRPC status
+func lookupError(err error) error {
+	if errors.Is(err, types.ErrLizenzNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -12,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -51,6 +55,177 @@ func TestQueryServerTestSuite(t *testing.T) {
 	suite.Run(t, new(QueryServerTestSuite))
 }
 
+func (suite *QueryServerTestSuite) TestAllActivatedLizenz_Pagination() {
+	// Seeded through genesis: equal activations would exceed the 33% cap one by one
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	for _, validator := range []string{"cosmos1validator3", "cosmos1validator1", "cosmos1validator2"} {
+		genState.ActivatedLizenz = append(genState.ActivatedLizenz, &lizenzv1.ActivatedLizenz{
+			Validator:      validator,
+			Amount:         "1000000",
+			ActivationTime: timestamppb.Now(),
+			IdentityHash:   "hash_" + validator,
+		})
+	}
+	suite.keeper.InitGenesis(suite.ctx, genState)
+
+	// Pages follow validator order, not insertion order
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	req := &lizenzv1.QueryAllActivatedLizenzRequest{
+		Pagination: &sdkquery.PageRequest{Limit: 2, CountTotal: true},
+	}
+	resp, err := suite.queryServer.AllActivatedLizenz(ctx, req)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.ActivatedLizenz, 2)
+	require.Equal(suite.T(), "cosmos1validator1", resp.ActivatedLizenz[0].Validator)
+	require.Equal(suite.T(), "cosmos1validator2", resp.ActivatedLizenz[1].Validator)
+	require.Equal(suite.T(), uint64(3), resp.Pagination.Total)
+
+	req.Pagination = &sdkquery.PageRequest{Key: resp.Pagination.NextKey}
+	resp, err = suite.queryServer.AllActivatedLizenz(ctx, req)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), resp.ActivatedLizenz, 1)
+	require.Equal(suite.T(), "cosmos1validator3", resp.ActivatedLizenz[0].Validator)
+
+	_, err = suite.queryServer.AllActivatedLizenz(ctx, nil)
+	require.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *QueryServerTestSuite) TestParams() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	resp, err := suite.queryServer.Params(ctx, &lizenzv1.QueryParamsRequest{})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), types.DefaultParams().ToProto().LznDenom, resp.Params.LznDenom)
+	require.Equal(suite.T(), types.DefaultParams().MaxActivatedPerValidator, resp.Params.MaxActivatedPerValidator)
+}
+
+func (suite *QueryServerTestSuite) TestActivatedLizenz() {
+	require.NoError(suite.T(), suite.keeper.SetActivatedLizenz(suite.ctx, &lizenzv1.ActivatedLizenz{
+		Validator:      "cosmos1validator",
+		Amount:         "1000000",
+		ActivationTime: timestamppb.Now(),
+		IdentityHash:   "test_identity_hash",
+	}))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	resp, err := suite.queryServer.ActivatedLizenz(ctx, &lizenzv1.QueryActivatedLizenzRequest{Validator: "cosmos1validator"})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "1000000", resp.ActivatedLizenz.Amount)
+
+	_, err = suite.queryServer.ActivatedLizenz(ctx, &lizenzv1.QueryActivatedLizenzRequest{Validator: "cosmos1unknown"})
+	require.Equal(suite.T(), codes.NotFound, status.Code(err))
+
+	_, err = suite.queryServer.ActivatedLizenz(ctx, &lizenzv1.QueryActivatedLizenzRequest{})
+	require.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *QueryServerTestSuite) TestTotalActivatedLizenz() {
+	// Seeded through genesis: a validator above the 33% cap cannot be reached by activations
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	for validator, amount := range map[string]string{
+		"cosmos1validator1": "500",
+		"cosmos1validator2": "300",
+		"cosmos1validator3": "200",
+	} {
+		genState.ActivatedLizenz = append(genState.ActivatedLizenz, &lizenzv1.ActivatedLizenz{
+			Validator:      validator,
+			Amount:         amount,
+			ActivationTime: timestamppb.Now(),
+			IdentityHash:   "hash_" + validator,
+		})
+	}
+	genState.TotalActivated = 1000
+	suite.keeper.InitGenesis(suite.ctx, genState)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	req := &lizenzv1.QueryTotalActivatedLizenzRequest{Pagination: &sdkquery.PageRequest{Limit: 2}}
+
+	resp, err := suite.queryServer.TotalActivatedLizenz(ctx, req)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "1000", resp.TotalAmount)
+	require.Equal(suite.T(), "0.330000000000000000", resp.MaxValidatorShare)
+	require.Len(suite.T(), resp.Shares, 2)
+	require.Equal(suite.T(), "cosmos1validator1", resp.Shares[0].Validator)
+	require.Equal(suite.T(), "0.500000000000000000", resp.Shares[0].Share)
+	require.Equal(suite.T(), "-0.170000000000000000", resp.Shares[0].CapHeadroom)
+	require.Equal(suite.T(), "0.300000000000000000", resp.Shares[1].Share)
+	require.Equal(suite.T(), "0.030000000000000000", resp.Shares[1].CapHeadroom)
+
+	// The total covers every validator, not only the page
+	req.Pagination = &sdkquery.PageRequest{Key: resp.Pagination.NextKey}
+	resp, err = suite.queryServer.TotalActivatedLizenz(ctx, req)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "1000", resp.TotalAmount)
+	require.Len(suite.T(), resp.Shares, 1)
+	require.Equal(suite.T(), "200", resp.Shares[0].Amount)
+	require.Equal(suite.T(), "0.130000000000000000", resp.Shares[0].CapHeadroom)
+
+	_, err = suite.queryServer.TotalActivatedLizenz(ctx, nil)
+	require.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *QueryServerTestSuite) TestTotalActivatedLizenz_AboveInt64() {
+	// The total is a uint64 and may pass the int64 range that the amounts stay within
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	for _, validator := range []string{"cosmos1validator1", "cosmos1validator2"} {
+		genState.ActivatedLizenz = append(genState.ActivatedLizenz, &lizenzv1.ActivatedLizenz{
+			Validator:      validator,
+			Amount:         "6000000000000000000",
+			ActivationTime: timestamppb.Now(),
+			IdentityHash:   "hash_" + validator,
+		})
+	}
+	genState.TotalActivated = 12000000000000000000
+	suite.keeper.InitGenesis(suite.ctx, genState)
+
+	resp, err := suite.queryServer.TotalActivatedLizenz(sdk.WrapSDKContext(suite.ctx), &lizenzv1.QueryTotalActivatedLizenzRequest{})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "12000000000000000000", resp.TotalAmount)
+	require.Len(suite.T(), resp.Shares, 2)
+	require.Equal(suite.T(), "6000000000000000000", resp.Shares[0].Amount)
+	require.Equal(suite.T(), "0.500000000000000000", resp.Shares[0].Share)
+}
+
+func (suite *QueryServerTestSuite) TestDeactivatingLizenz() {
+	require.NoError(suite.T(), suite.keeper.SetDeactivatingLizenz(suite.ctx, &lizenzv1.DeactivatingLizenz{
+		Validator:         "cosmos1validator",
+		Amount:            "1000000",
+		DeactivationStart: timestamppb.Now(),
+		Reason:            "voluntary",
+	}))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	resp, err := suite.queryServer.DeactivatingLizenz(ctx, &lizenzv1.QueryDeactivatingLizenzRequest{Validator: "cosmos1validator"})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "voluntary", resp.DeactivatingLizenz.Reason)
+
+	_, err = suite.queryServer.DeactivatingLizenz(ctx, &lizenzv1.QueryDeactivatingLizenzRequest{Validator: "cosmos1unknown"})
+	require.Equal(suite.T(), codes.NotFound, status.Code(err))
+}
+
+func (suite *QueryServerTestSuite) TestMOAStatus() {
+	require.NoError(suite.T(), suite.keeper.SetMOAStatus(suite.ctx, &lizenzv1.MOAStatus{
+		Validator:   "cosmos1validator",
+		CurrentMoa:  "0.9",
+		RequiredMoa: "0.8",
+		IsCompliant: true,
+	}))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	resp, err := suite.queryServer.MOAStatus(ctx, &lizenzv1.QueryMOAStatusRequest{Validator: "cosmos1validator"})
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), resp.MoaStatus.IsCompliant)
+
+	_, err = suite.queryServer.MOAStatus(ctx, &lizenzv1.QueryMOAStatusRequest{Validator: "cosmos1unknown"})
+	require.Equal(suite.T(), codes.NotFound, status.Code(err))
+
+	_, err = suite.queryServer.MOAStatus(ctx, &lizenzv1.QueryMOAStatusRequest{})
+	require.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+}
+
 func (suite *QueryServerTestSuite) TestGetRewardHistory() {
 	validator := "cosmos1validator"
 	
@@ -67,24 +242,27 @@ func (suite *QueryServerTestSuite) TestGetRewardHistory() {
 	err := suite.keeper.SetActivatedLizenz(suite.ctx, activatedLizenz)
 	require.NoError(suite.T(), err)
 
-	// Add some reward history using UpdateRewardStats
-	err = suite.keeper.UpdateRewardStats(suite.ctx, validator, 1000000, 1000, 0.95, 1.0, 1000000)
+	// Add some reward history using RecordEpochReward
+	err = suite.keeper.RecordEpochReward(suite.ctx, validator, &lizenzv1.RewardRecord{BlockHeight: 1000, RewardAmount: "1000000", BaseReward: "1000000", Epoch: 10})
 	require.NoError(suite.T(), err)
-	err = suite.keeper.UpdateRewardStats(suite.ctx, validator, 2000000, 2000, 1.0, 1.0, 2000000)
+	err = suite.keeper.RecordEpochReward(suite.ctx, validator, &lizenzv1.RewardRecord{BlockHeight: 2000, RewardAmount: "2000000", BaseReward: "2000000", Epoch: 20})
 	require.NoError(suite.T(), err)
 
 	// Query reward history
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	req := &lizenzv1.QueryRewardHistoryRequest{
-		Validator: validator,
+		Validator:  validator,
+		Pagination: &sdkquery.PageRequest{Limit: 1},
 	}
-	
+
 	resp, err := suite.queryServer.GetRewardHistory(ctx, req)
 	require.NoError(suite.T(), err)
 	require.NotNil(suite.T(), resp)
 	require.Equal(suite.T(), validator, resp.Validator)
-	require.GreaterOrEqual(suite.T(), len(resp.RewardHistory), 2)
+	require.Len(suite.T(), resp.RewardHistory, 1)
+	require.Equal(suite.T(), uint64(10), resp.RewardHistory[0].Epoch)
 	require.Equal(suite.T(), uint64(2), resp.TotalRecords)
+	require.NotNil(suite.T(), resp.Pagination.NextKey)
 }
 
 func (suite *QueryServerTestSuite) TestGetRewardHistory_NilRequest() {
@@ -110,8 +288,8 @@ func (suite *QueryServerTestSuite) TestGetRewardStats() {
 	err := suite.keeper.SetActivatedLizenz(suite.ctx, activatedLizenz)
 	require.NoError(suite.T(), err)
 
-	// Add reward history using UpdateRewardStats
-	err = suite.keeper.UpdateRewardStats(suite.ctx, validator, 1000000, 1000, 0.95, 1.0, 1000000)
+	// Add reward history using RecordEpochReward
+	err = suite.keeper.RecordEpochReward(suite.ctx, validator, &lizenzv1.RewardRecord{BlockHeight: 1000, RewardAmount: "1000000", BaseReward: "1000000", Epoch: 10})
 	require.NoError(suite.T(), err)
 
 	// Query reward stats
@@ -123,7 +301,7 @@ func (suite *QueryServerTestSuite) TestGetRewardStats() {
 	resp, err := suite.queryServer.GetRewardStats(ctx, req)
 	require.NoError(suite.T(), err)
 	require.NotNil(suite.T(), resp)
-	// TotalRewardsEarned should be 5000000 (initial) + 1000000 (from UpdateRewardStats) = 6000000
+	// TotalRewardsEarned should be 5000000 (initial) + 1000000 (from RecordEpochReward) = 6000000
 	require.Equal(suite.T(), "6000000", resp.TotalRewardsEarned)
 	require.Equal(suite.T(), "1000", resp.LastRewardBlock)
 	require.NotNil(suite.T(), resp.LastRewardTime)
@@ -138,3 +316,36 @@ func (suite *QueryServerTestSuite) TestGetRewardStats_NilRequest() {
 	require.Error(suite.T(), err)
 }
 
+
+// mockIntegrationKeeper serves fixed validator integration records
+type mockIntegrationKeeper struct {
+	integrations map[string]*lizenzv1.ValidatorIntegration
+}
+
+func (m mockIntegrationKeeper) GetValidatorIntegration(ctx sdk.Context, validator string) (*lizenzv1.ValidatorIntegration, error) {
+	integration, ok := m.integrations[validator]
+	if !ok {
+		return nil, fmt.Errorf("validator integration not found")
+	}
+	return integration, nil
+}
+
+func (suite *QueryServerTestSuite) TestValidatorIntegration() {
+	// Without an integration keeper the query is unavailable
+	_, err := suite.queryServer.ValidatorIntegration(suite.ctx, &lizenzv1.QueryValidatorIntegrationRequest{Validator: "cosmos1validator"})
+	require.Equal(suite.T(), codes.Unavailable, status.Code(err))
+
+	suite.keeper.SetIntegrationKeeper(mockIntegrationKeeper{integrations: map[string]*lizenzv1.ValidatorIntegration{
+		"cosmos1validator": {Validator: "cosmos1validator", IntegrationScore: "75"},
+	}})
+
+	resp, err := suite.queryServer.ValidatorIntegration(suite.ctx, &lizenzv1.QueryValidatorIntegrationRequest{Validator: "cosmos1validator"})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "75", resp.ValidatorIntegration.IntegrationScore)
+
+	_, err = suite.queryServer.ValidatorIntegration(suite.ctx, &lizenzv1.QueryValidatorIntegrationRequest{Validator: "cosmos1unknown"})
+	require.Equal(suite.T(), codes.NotFound, status.Code(err))
+
+	_, err = suite.queryServer.ValidatorIntegration(suite.ctx, &lizenzv1.QueryValidatorIntegrationRequest{})
+	require.Equal(suite.T(), codes.InvalidArgument, status.Code(err))
+}
@@ -1,128 +1,99 @@
 package keeper
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
 
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
 	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
 )
 
-// RewardRecord represents a single reward distribution record
-type RewardRecord struct {
-	BlockHeight    uint64  `json:"block_height"`
-	RewardAmount   string  `json:"reward_amount"` // in micro WRT
-	Timestamp      int64   `json:"timestamp"`
-	MOACompliance  float64 `json:"moa_compliance"`
-	PenaltyApplied float64 `json:"penalty_applied"`
-	BaseReward     string  `json:"base_reward"` // Base reward before penalty
-}
+// RecordEpochReward stores the rewards a validator accrued over one reward epoch
+// and adds them to the reward statistics of its activated LZN
+// This is called by the consensus module at the end of every reward epoch
+func (k Keeper) RecordEpochReward(ctx sdk.Context, validator string, record *lizenzv1.RewardRecord) error {
+	rewardAmount, err := strconv.ParseUint(record.RewardAmount, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid reward amount %q: %w", record.RewardAmount, err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	recordBz, err := k.cdc.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reward record: %w", err)
+	}
+	store.Set(types.GetRewardEpochKey(validator, record.Epoch), recordBz)
 
-// UpdateRewardStats updates the reward statistics for a validator
-// This is called after reward distribution in consensus module
-func (k Keeper) UpdateRewardStats(ctx sdk.Context, validator string, rewardAmount uint64, blockHeight uint64, moaCompliance float64, penaltyMultiplier float64, baseReward uint64) error {
-	// Get current activated LZN
+	// Validators that deactivated during the epoch keep the record but have no statistics to update
 	activatedLizenz, err := k.GetActivatedLizenz(ctx, validator)
 	if err != nil {
-		return fmt.Errorf("validator has no activated LZN: %w", err)
+		return nil
 	}
 
 	// Update total rewards earned
 	var currentTotal uint64 = 0
 	if activatedLizenz.TotalRewardsEarned != "" {
-		var err error
 		currentTotal, err = strconv.ParseUint(activatedLizenz.TotalRewardsEarned, 10, 64)
 		if err != nil {
 			currentTotal = 0
 		}
 	}
-	
+
 	newTotal := currentTotal + rewardAmount
 	activatedLizenz.TotalRewardsEarned = fmt.Sprintf("%d", newTotal)
-	activatedLizenz.LastRewardBlock = fmt.Sprintf("%d", blockHeight)
-	activatedLizenz.LastRewardTime = timestamppb.Now()
+	activatedLizenz.LastRewardBlock = fmt.Sprintf("%d", record.BlockHeight)
+	activatedLizenz.LastRewardTime = timestamppb.New(ctx.BlockTime())
 
 	// Store updated activated LZN (use UpdateActivatedLizenz since it already exists)
 	if err := k.UpdateActivatedLizenz(ctx, activatedLizenz); err != nil {
 		return fmt.Errorf("failed to update activated LZN: %w", err)
 	}
 
-	// Record reward in history
-	rewardRecord := RewardRecord{
-		BlockHeight:    blockHeight,
-		RewardAmount:   fmt.Sprintf("%d", rewardAmount),
-		Timestamp:      ctx.BlockTime().Unix(),
-		MOACompliance:  moaCompliance,
-		PenaltyApplied: penaltyMultiplier,
-		BaseReward:     fmt.Sprintf("%d", baseReward),
-	}
-
-	if err := k.RecordRewardHistory(ctx, validator, rewardRecord); err != nil {
-		ctx.Logger().Error("failed to record reward history", "error", err, "validator", validator)
-		// Don't fail if history recording fails
-	}
-
-	ctx.Logger().Info("reward stats updated",
+	ctx.Logger().Info("epoch reward recorded",
 		"validator", validator,
+		"epoch", record.Epoch,
 		"reward_amount", rewardAmount,
-		"total_rewards", newTotal,
-		"block_height", blockHeight)
+		"total_rewards", newTotal)
 
 	return nil
 }
 
-// RecordRewardHistory records a reward in the validator's reward history
-func (k Keeper) RecordRewardHistory(ctx sdk.Context, validator string, record RewardRecord) error {
-	store := ctx.KVStore(k.storeKey)
-	historyKey := types.GetRewardHistoryKey(validator)
-
-	// Get existing history
-	var history []RewardRecord
-	bz := store.Get(historyKey)
-	if bz != nil {
-		if err := json.Unmarshal(bz, &history); err != nil {
-			// If unmarshal fails, start with empty history
-			history = []RewardRecord{}
-		}
-	}
-
-	// Add new record
-	history = append(history, record)
+// GetRewardHistory returns a page of the validator's per-epoch reward records, oldest first
+func (k Keeper) GetRewardHistory(ctx sdk.Context, validator string, pageReq *sdkquery.PageRequest) ([]*lizenzv1.RewardRecord, *sdkquery.PageResponse, error) {
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRewardEpochPrefix(validator))
 
-	// Keep only last 1000 records (to prevent unbounded growth)
-	if len(history) > 1000 {
-		history = history[len(history)-1000:]
-	}
-
-	// Store updated history
-	historyBz, err := json.Marshal(history)
+	var history []*lizenzv1.RewardRecord
+	pageRes, err := sdkquery.Paginate(historyStore, pageReq, func(_ []byte, value []byte) error {
+		var record lizenzv1.RewardRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return fmt.Errorf("failed to unmarshal reward record: %w", err)
+		}
+		history = append(history, &record)
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to marshal reward history: %w", err)
+		return nil, nil, err
 	}
 
-	store.Set(historyKey, historyBz)
-	return nil
+	return history, pageRes, nil
 }
 
-// GetRewardHistory retrieves reward history for a validator
-func (k Keeper) GetRewardHistory(ctx sdk.Context, validator string) ([]RewardRecord, error) {
-	store := ctx.KVStore(k.storeKey)
-	historyKey := types.GetRewardHistoryKey(validator)
-
-	bz := store.Get(historyKey)
-	if bz == nil {
-		return []RewardRecord{}, nil // Return empty history if not found
-	}
+// GetRewardRecordCount returns the number of epochs recorded for a validator
+func (k Keeper) GetRewardRecordCount(ctx sdk.Context, validator string) uint64 {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetRewardEpochPrefix(validator))
+	defer iterator.Close()
 
-	var history []RewardRecord
-	if err := json.Unmarshal(bz, &history); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal reward history: %w", err)
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
 	}
-
-	return history, nil
+	return count
 }
 
 // GetTotalRewardsEarned returns the total rewards earned by a validator
@@ -137,23 +108,23 @@ func (k Keeper) GetTotalRewardsEarned(ctx sdk.Context, validator string) (string
 
 // GetRewardStats returns comprehensive reward statistics for a validator
 type RewardStats struct {
-	TotalRewardsEarned string         `json:"total_rewards_earned"`
-	LastRewardBlock    string         `json:"last_reward_block"`
-	LastRewardTime     *timestamppb.Timestamp `json:"last_reward_time"`
-	RewardHistory      []RewardRecord `json:"reward_history"`
-	TotalRewardsCount  int            `json:"total_rewards_count"`
+	TotalRewardsEarned string                   `json:"total_rewards_earned"`
+	LastRewardBlock    string                   `json:"last_reward_block"`
+	LastRewardTime     *timestamppb.Timestamp   `json:"last_reward_time"`
+	RewardHistory      []*lizenzv1.RewardRecord `json:"reward_history"`
+	TotalRewardsCount  uint64                   `json:"total_rewards_count"`
+	Pagination         *sdkquery.PageResponse   `json:"pagination"`
 }
 
-func (k Keeper) GetRewardStats(ctx sdk.Context, validator string) (*RewardStats, error) {
+func (k Keeper) GetRewardStats(ctx sdk.Context, validator string, pageReq *sdkquery.PageRequest) (*RewardStats, error) {
 	activatedLizenz, err := k.GetActivatedLizenz(ctx, validator)
 	if err != nil {
 		return nil, fmt.Errorf("validator has no activated LZN: %w", err)
 	}
 
-	history, err := k.GetRewardHistory(ctx, validator)
+	history, pageRes, err := k.GetRewardHistory(ctx, validator, pageReq)
 	if err != nil {
-		// If history retrieval fails, continue with empty history
-		history = []RewardRecord{}
+		return nil, err
 	}
 
 	return &RewardStats{
@@ -161,7 +132,7 @@ func (k Keeper) GetRewardStats(ctx sdk.Context, validator string) (*RewardStats,
 		LastRewardBlock:    activatedLizenz.LastRewardBlock,
 		LastRewardTime:     activatedLizenz.LastRewardTime,
 		RewardHistory:      history,
-		TotalRewardsCount:  len(history),
+		TotalRewardsCount:  k.GetRewardRecordCount(ctx, validator),
+		Pagination:         pageRes,
 	}, nil
 }
-
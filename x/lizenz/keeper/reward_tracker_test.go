@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -49,25 +50,37 @@ func TestRewardTrackerTestSuite(t *testing.T) {
 	suite.Run(t, new(RewardTrackerTestSuite))
 }
 
-func (suite *RewardTrackerTestSuite) TestUpdateRewardStats() {
+func epochRecord(epoch uint64, amount string) *lizenzv1.RewardRecord {
+	return &lizenzv1.RewardRecord{
+		BlockHeight:    epoch * 100,
+		RewardAmount:   amount,
+		MoaCompliance:  "0.950000000000000000",
+		PenaltyApplied: "1.000000000000000000",
+		BaseReward:     amount,
+		Epoch:          epoch,
+		StartHeight:    (epoch-1)*100 + 1,
+	}
+}
+
+func (suite *RewardTrackerTestSuite) TestRecordEpochReward() {
 	validator := "cosmos1validator"
-	
+
 	// Create activated lizenz
 	activatedLizenz := &lizenzv1.ActivatedLizenz{
-		Validator:         validator,
-		Amount:            "1000000",
-		ActivationTime:    timestamppb.Now(),
-		IdentityHash:      "test_identity_hash",
+		Validator:          validator,
+		Amount:             "1000000",
+		ActivationTime:     timestamppb.Now(),
+		IdentityHash:       "test_identity_hash",
 		TotalRewardsEarned: "0",
-		LastRewardBlock:   "0",
-		LastRewardTime:    timestamppb.Now(),
+		LastRewardBlock:    "0",
+		LastRewardTime:     timestamppb.Now(),
 	}
 	// First create the activated lizenz
 	err := suite.keeper.SetActivatedLizenz(suite.ctx, activatedLizenz)
 	require.NoError(suite.T(), err)
 
-	// Update reward stats
-	err = suite.keeper.UpdateRewardStats(suite.ctx, validator, 1000000, 1000, 0.95, 1.0, 1000000)
+	// Record an epoch reward
+	err = suite.keeper.RecordEpochReward(suite.ctx, validator, epochRecord(10, "1000000"))
 	require.NoError(suite.T(), err)
 
 	// Verify stats were updated
@@ -75,35 +88,49 @@ func (suite *RewardTrackerTestSuite) TestUpdateRewardStats() {
 	require.NoError(suite.T(), err)
 	require.Equal(suite.T(), "1000000", updated.TotalRewardsEarned)
 	require.Equal(suite.T(), "1000", updated.LastRewardBlock)
+
+	// Verify the record was stored
+	history, _, err := suite.keeper.GetRewardHistory(suite.ctx, validator, nil)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), history, 1)
+	require.Equal(suite.T(), uint64(10), history[0].Epoch)
+	require.Equal(suite.T(), uint64(901), history[0].StartHeight)
+	require.Equal(suite.T(), "1000000", history[0].RewardAmount)
 }
 
-func (suite *RewardTrackerTestSuite) TestUpdateRewardStats_NoActivatedLizenz() {
+func (suite *RewardTrackerTestSuite) TestRecordEpochReward_NoActivatedLizenz() {
 	validator := "cosmos1nonexistent"
-	
-	err := suite.keeper.UpdateRewardStats(suite.ctx, validator, 1000000, 1000, 0.95, 1.0, 1000000)
+
+	// Deactivated validators keep their record without statistics
+	err := suite.keeper.RecordEpochReward(suite.ctx, validator, epochRecord(1, "1000000"))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(1), suite.keeper.GetRewardRecordCount(suite.ctx, validator))
+}
+
+func (suite *RewardTrackerTestSuite) TestRecordEpochReward_InvalidAmount() {
+	err := suite.keeper.RecordEpochReward(suite.ctx, "cosmos1validator", epochRecord(1, "invalid"))
 	require.Error(suite.T(), err)
-	require.Contains(suite.T(), err.Error(), "no activated LZN")
 }
 
-func (suite *RewardTrackerTestSuite) TestUpdateRewardStats_Accumulation() {
+func (suite *RewardTrackerTestSuite) TestRecordEpochReward_Accumulation() {
 	validator := "cosmos1validator"
-	
+
 	// Create activated lizenz
 	activatedLizenz := &lizenzv1.ActivatedLizenz{
-		Validator:         validator,
-		Amount:            "1000000",
-		ActivationTime:    timestamppb.Now(),
-		IdentityHash:      "test_identity_hash",
+		Validator:          validator,
+		Amount:             "1000000",
+		ActivationTime:     timestamppb.Now(),
+		IdentityHash:       "test_identity_hash",
 		TotalRewardsEarned: "5000000",
-		LastRewardBlock:   "1000",
-		LastRewardTime:    timestamppb.Now(),
+		LastRewardBlock:    "1000",
+		LastRewardTime:     timestamppb.Now(),
 	}
 	// First create the activated lizenz
 	err := suite.keeper.SetActivatedLizenz(suite.ctx, activatedLizenz)
 	require.NoError(suite.T(), err)
 
-	// Update reward stats (should accumulate)
-	err = suite.keeper.UpdateRewardStats(suite.ctx, validator, 2000000, 2000, 1.0, 1.0, 2000000)
+	// Record an epoch reward (should accumulate)
+	err = suite.keeper.RecordEpochReward(suite.ctx, validator, epochRecord(20, "2000000"))
 	require.NoError(suite.T(), err)
 
 	// Verify accumulation
@@ -113,163 +140,85 @@ func (suite *RewardTrackerTestSuite) TestUpdateRewardStats_Accumulation() {
 	require.Equal(suite.T(), "2000", updated.LastRewardBlock)
 }
 
-func (suite *RewardTrackerTestSuite) TestRecordRewardHistory() {
+func (suite *RewardTrackerTestSuite) TestGetRewardHistory_Pagination() {
 	validator := "cosmos1validator"
-	
-	// Create activated lizenz
-	activatedLizenz := &lizenzv1.ActivatedLizenz{
-		Validator:         validator,
-		Amount:            "1000000",
-		ActivationTime:    timestamppb.Now(),
-		IdentityHash:      "test_identity_hash",
-		TotalRewardsEarned: "0",
-		LastRewardBlock:   "0",
-		LastRewardTime:    timestamppb.Now(),
-	}
-	// First create the activated lizenz
-	err := suite.keeper.SetActivatedLizenz(suite.ctx, activatedLizenz)
-	require.NoError(suite.T(), err)
-
-	// Record reward history
-	record := RewardRecord{
-		BlockHeight:    1000,
-		RewardAmount:   "1000000",
-		Timestamp:      suite.ctx.BlockTime().Unix(),
-		MOACompliance:  0.95,
-		PenaltyApplied: 1.0,
-		BaseReward:     "1000000",
-	}
-	err = suite.keeper.RecordRewardHistory(suite.ctx, validator, record)
-	require.NoError(suite.T(), err)
-
-	// Verify history was recorded
-	history, err := suite.keeper.GetRewardHistory(suite.ctx, validator)
-	require.NoError(suite.T(), err)
-	require.Len(suite.T(), history, 1)
-	require.Equal(suite.T(), uint64(1000), history[0].BlockHeight)
-	require.Equal(suite.T(), "1000000", history[0].RewardAmount)
-}
 
-func (suite *RewardTrackerTestSuite) TestRecordRewardHistory_Multiple() {
-	validator := "cosmos1validator"
-	
-	// Create activated lizenz
-	activatedLizenz := &lizenzv1.ActivatedLizenz{
-		Validator:         validator,
-		Amount:            "1000000",
-		ActivationTime:    timestamppb.Now(),
-		IdentityHash:      "test_identity_hash",
-		TotalRewardsEarned: "0",
-		LastRewardBlock:   "0",
-		LastRewardTime:    timestamppb.Now(),
-	}
-	// First create the activated lizenz
-	err := suite.keeper.SetActivatedLizenz(suite.ctx, activatedLizenz)
-	require.NoError(suite.T(), err)
-
-	// Record multiple rewards
-	for i := 0; i < 5; i++ {
-		record := RewardRecord{
-			BlockHeight:    uint64(1000 + i),
-			RewardAmount:   "1000000",
-			Timestamp:      suite.ctx.BlockTime().Unix(),
-			MOACompliance:  0.95,
-			PenaltyApplied: 1.0,
-			BaseReward:     "1000000",
-		}
-		err = suite.keeper.RecordRewardHistory(suite.ctx, validator, record)
+	// Record multiple epochs out of order
+	for _, epoch := range []uint64{3, 1, 5, 2, 4} {
+		err := suite.keeper.RecordEpochReward(suite.ctx, validator, epochRecord(epoch, "1000000"))
 		require.NoError(suite.T(), err)
 	}
+	// Records of other validators are not returned
+	err := suite.keeper.RecordEpochReward(suite.ctx, "cosmos1validator2", epochRecord(1, "1000000"))
+	require.NoError(suite.T(), err)
 
-	// Verify all records were saved
-	history, err := suite.keeper.GetRewardHistory(suite.ctx, validator)
+	// Verify all records were saved in epoch order
+	history, _, err := suite.keeper.GetRewardHistory(suite.ctx, validator, nil)
 	require.NoError(suite.T(), err)
 	require.Len(suite.T(), history, 5)
-}
-
-func (suite *RewardTrackerTestSuite) TestGetRewardHistory() {
-	validator := "cosmos1validator"
-	
-	// Create activated lizenz
-	activatedLizenz := &lizenzv1.ActivatedLizenz{
-		Validator:         validator,
-		Amount:            "1000000",
-		ActivationTime:    timestamppb.Now(),
-		IdentityHash:      "test_identity_hash",
-		TotalRewardsEarned: "0",
-		LastRewardBlock:   "0",
-		LastRewardTime:    timestamppb.Now(),
+	for i, record := range history {
+		require.Equal(suite.T(), uint64(i+1), record.Epoch)
 	}
-	// First create the activated lizenz
-	err := suite.keeper.SetActivatedLizenz(suite.ctx, activatedLizenz)
-	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), uint64(5), suite.keeper.GetRewardRecordCount(suite.ctx, validator))
 
-	// Get empty history
-	history, err := suite.keeper.GetRewardHistory(suite.ctx, validator)
+	// Page through the history
+	page, pageRes, err := suite.keeper.GetRewardHistory(suite.ctx, validator, &sdkquery.PageRequest{Limit: 2})
 	require.NoError(suite.T(), err)
-	require.Empty(suite.T(), history)
+	require.Len(suite.T(), page, 2)
+	require.Equal(suite.T(), uint64(1), page[0].Epoch)
+	require.NotNil(suite.T(), pageRes.NextKey)
 
-	// Record a reward
-	record := RewardRecord{
-		BlockHeight:    1000,
-		RewardAmount:   "1000000",
-		Timestamp:      suite.ctx.BlockTime().Unix(),
-		MOACompliance:  0.95,
-		PenaltyApplied: 1.0,
-		BaseReward:     "1000000",
-	}
-	err = suite.keeper.RecordRewardHistory(suite.ctx, validator, record)
+	page, pageRes, err = suite.keeper.GetRewardHistory(suite.ctx, validator, &sdkquery.PageRequest{Key: pageRes.NextKey, Limit: 3})
 	require.NoError(suite.T(), err)
+	require.Len(suite.T(), page, 3)
+	require.Equal(suite.T(), uint64(3), page[0].Epoch)
+	require.Nil(suite.T(), pageRes.NextKey)
+}
 
-	// Get history
-	history, err = suite.keeper.GetRewardHistory(suite.ctx, validator)
+func (suite *RewardTrackerTestSuite) TestGetRewardHistory_Empty() {
+	history, _, err := suite.keeper.GetRewardHistory(suite.ctx, "cosmos1validator", nil)
 	require.NoError(suite.T(), err)
-	require.Len(suite.T(), history, 1)
+	require.Empty(suite.T(), history)
 }
 
 func (suite *RewardTrackerTestSuite) TestGetRewardStats() {
 	validator := "cosmos1validator"
-	
+
 	// Create activated lizenz
 	activatedLizenz := &lizenzv1.ActivatedLizenz{
-		Validator:         validator,
-		Amount:            "1000000",
-		ActivationTime:    timestamppb.Now(),
-		IdentityHash:      "test_identity_hash",
+		Validator:          validator,
+		Amount:             "1000000",
+		ActivationTime:     timestamppb.Now(),
+		IdentityHash:       "test_identity_hash",
 		TotalRewardsEarned: "5000000",
-		LastRewardBlock:   "1000",
-		LastRewardTime:    timestamppb.Now(),
+		LastRewardBlock:    "1000",
+		LastRewardTime:     timestamppb.Now(),
 	}
 	// First create the activated lizenz
 	err := suite.keeper.SetActivatedLizenz(suite.ctx, activatedLizenz)
 	require.NoError(suite.T(), err)
 
 	// Record some reward history
-	record := RewardRecord{
-		BlockHeight:    1000,
-		RewardAmount:   "1000000",
-		Timestamp:      suite.ctx.BlockTime().Unix(),
-		MOACompliance:  0.95,
-		PenaltyApplied: 1.0,
-		BaseReward:     "1000000",
+	for epoch := uint64(1); epoch <= 3; epoch++ {
+		err = suite.keeper.RecordEpochReward(suite.ctx, validator, epochRecord(epoch, "1000000"))
+		require.NoError(suite.T(), err)
 	}
-	err = suite.keeper.RecordRewardHistory(suite.ctx, validator, record)
-	require.NoError(suite.T(), err)
 
 	// Get reward stats
-	stats, err := suite.keeper.GetRewardStats(suite.ctx, validator)
+	stats, err := suite.keeper.GetRewardStats(suite.ctx, validator, &sdkquery.PageRequest{Limit: 1})
 	require.NoError(suite.T(), err)
 	require.NotNil(suite.T(), stats)
-	require.Equal(suite.T(), "5000000", stats.TotalRewardsEarned)
-	require.Equal(suite.T(), "1000", stats.LastRewardBlock)
+	require.Equal(suite.T(), "8000000", stats.TotalRewardsEarned)
+	require.Equal(suite.T(), "300", stats.LastRewardBlock)
 	require.Len(suite.T(), stats.RewardHistory, 1)
-	require.Equal(suite.T(), 1, stats.TotalRewardsCount)
+	require.Equal(suite.T(), uint64(3), stats.TotalRewardsCount)
+	require.NotNil(suite.T(), stats.Pagination.NextKey)
 }
 
 func (suite *RewardTrackerTestSuite) TestGetRewardStats_NoActivatedLizenz() {
 	validator := "cosmos1nonexistent"
-	
-	_, err := suite.keeper.GetRewardStats(suite.ctx, validator)
+
+	_, err := suite.keeper.GetRewardStats(suite.ctx, validator, nil)
 	require.Error(suite.T(), err)
 	require.Contains(suite.T(), err.Error(), "no activated LZN")
 }
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// SlashActivatedLizenz burns a fraction of a validator's activated LZN
+// Called by the consensus module for double-signing, downtime and unrevealed auction commits.
+// The burned LZN is removed from the activation and burned from the lizenz module account,
+// where it has been locked since activation. Returns the burned amount.
+func (k Keeper) SlashActivatedLizenz(ctx sdk.Context, validator string, fraction math.LegacyDec) (math.Int, error) {
+	if fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+		return math.ZeroInt(), fmt.Errorf("slash fraction must be between 0 and 1, got %s", fraction)
+	}
+
+	lizenz, err := k.GetActivatedLizenz(ctx, validator)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	amount, ok := math.NewIntFromString(lizenz.Amount)
+	if !ok {
+		return math.ZeroInt(), fmt.Errorf("invalid activated LZN amount: %s", lizenz.Amount)
+	}
+
+	burned := fraction.MulInt(amount).TruncateInt()
+	if !burned.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+	remaining := amount.Sub(burned)
+
+	// Store the reduced activation directly: slashing must not be blocked by activation limits
+	store := ctx.KVStore(k.storeKey)
+	lizenzKey := types.GetActivatedLizenzKey(validator)
+	if remaining.IsPositive() {
+		lizenz.Amount = remaining.String()
+		lizenzBz, err := k.cdc.Marshal(lizenz)
+		if err != nil {
+			return math.ZeroInt(), fmt.Errorf("failed to marshal activated lizenz: %w", err)
+		}
+		store.Set(lizenzKey, lizenzBz)
+	} else {
+		store.Delete(lizenzKey)
+	}
+	if err := k.unlockTotalActivated(ctx, burned.Uint64()); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// Burn the slashed LZN locked in the lizenz module account
+	if k.bankKeeper != nil {
+		burnCoins := sdk.NewCoins(sdk.NewCoin("ulzn", burned))
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+			return math.ZeroInt(), fmt.Errorf("failed to burn slashed LZN: %w", err)
+		}
+	}
+
+	// Keep the consensus weight in line with the remaining activated LZN
+	if k.consensusKeeper != nil {
+		if err := k.consensusKeeper.SetValidatorWeight(ctx, validator, remaining.String()); err != nil {
+			ctx.Logger().Error("failed to update validator weight after slashing", "error", err, "validator", validator)
+		}
+	}
+
+	// The other validators hold a larger share of the smaller pool
+	if err := k.enforceValidatorCap(ctx); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLizenzSlashed,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnedAmount, burned.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, remaining.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
+	return burned, nil
+}
@@ -1,7 +1,9 @@
 package lizenz
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
 	storetypes "cosmossdk.io/store/types"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -10,11 +12,15 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 	gatewayruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/testutil/simtx"
 	"github.com/volnix-protocol/volnix-protocol/x/lizenz/keeper"
+	lizenzsim "github.com/volnix-protocol/volnix-protocol/x/lizenz/simulation"
 	lztypes "github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
 )
 
@@ -41,22 +47,49 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 	return Validate(&gen)
 }
 
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *gatewayruntime.ServeMux) {}
+// RegisterGRPCGatewayRoutes registers the REST routes of the query service under /volnix/lizenz/v1
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gatewayruntime.ServeMux) {
+	if err := lizenzv1.RegisterQueryHandlerClient(context.Background(), mux, lizenzv1.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 type AppModule struct {
 	AppModuleBasic
-	keeper *keeper.Keeper
+	cdc           codec.Codec
+	keeper        *keeper.Keeper
+	accountKeeper simulation.AccountKeeper
+	bankKeeper    simulation.BankKeeper
+	identKeeper   simtx.IdentKeeper
 }
 
-var _ module.AppModule = AppModule{}
+var (
+	_ module.AppModule           = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
+)
 
-func NewAppModule(k *keeper.Keeper) AppModule { return AppModule{keeper: k} }
+func NewAppModule(cdc codec.Codec, k *keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper) AppModule {
+	return AppModule{cdc: cdc, keeper: k, accountKeeper: ak, bankKeeper: bk, identKeeper: ik}
+}
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	lizenzv1.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	lizenzv1.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(*am.keeper)
+	if err := cfg.RegisterMigration(lztypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", lztypes.ModuleName, err))
+	}
+}
+
+// RegisterInvariants registers the lizenz module invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, *am.keeper)
 }
 
+// ConsensusVersion is the consensus version of the lizenz store, bumped with every store migration
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
 	var gen lizenzv1.GenesisState
 	cdc.MustUnmarshalJSON(data, &gen)
@@ -69,6 +102,21 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gen)
 }
 
+// GenerateGenesisState creates a randomized GenState of the lizenz module
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	lizenzsim.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for lizenz module's types
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[lztypes.StoreKey] = lizenzsim.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the all the lizenz module operations with their respective weights
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return lizenzsim.WeightedOperations(simState.AppParams, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.identKeeper, am.keeper)
+}
+
 func (AppModule) IsAppModule()        {}
 func (AppModule) IsOnePerModuleType() {}
 
@@ -0,0 +1,62 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding lizenz type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.ActivatedLizenzKeyPrefix):
+			var lizenzA, lizenzB lizenzv1.ActivatedLizenz
+			cdc.MustUnmarshal(kvA.Value, &lizenzA)
+			cdc.MustUnmarshal(kvB.Value, &lizenzB)
+			return fmt.Sprintf("%v\n%v", &lizenzA, &lizenzB)
+
+		case bytes.HasPrefix(kvA.Key, types.DeactivatingLizenzKeyPrefix):
+			var lizenzA, lizenzB lizenzv1.DeactivatingLizenz
+			cdc.MustUnmarshal(kvA.Value, &lizenzA)
+			cdc.MustUnmarshal(kvB.Value, &lizenzB)
+			return fmt.Sprintf("%v\n%v", &lizenzA, &lizenzB)
+
+		case bytes.HasPrefix(kvA.Key, types.MOAStatusKeyPrefix):
+			var statusA, statusB lizenzv1.MOAStatus
+			cdc.MustUnmarshal(kvA.Value, &statusA)
+			cdc.MustUnmarshal(kvB.Value, &statusB)
+			return fmt.Sprintf("%v\n%v", &statusA, &statusB)
+
+		case bytes.HasPrefix(kvA.Key, types.RewardEpochKeyPrefix):
+			var recordA, recordB lizenzv1.RewardRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", &recordA, &recordB)
+
+		case bytes.Equal(kvA.Key, types.MOAEpochStartKey):
+			var startA, startB time.Time
+			if err := startA.UnmarshalBinary(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := startB.UnmarshalBinary(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", startA, startB)
+
+		case bytes.HasPrefix(kvA.Key, types.RewardHistoryKeyPrefix):
+			// Deprecated records, deleted by the v1 to v2 store migration
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid lizenz key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// Simulation parameter keys
+const (
+	DeactivationPeriod = "deactivation_period"
+	InactivityPeriod   = "inactivity_period"
+	MinLznAmount       = "min_lzn_amount"
+	MaxLznAmount       = "max_lzn_amount"
+	MoaEpochLength     = "moa_epoch_length"
+)
+
+// RandomizedGenState generates a random genesis state for the lizenz module
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(DeactivationPeriod, &params.DeactivationPeriod, simState.Rand,
+		func(r *rand.Rand) { params.DeactivationPeriod = time.Duration(r.Intn(48)+1) * time.Hour })
+	simState.AppParams.GetOrGenerate(InactivityPeriod, &params.InactivityPeriod, simState.Rand,
+		func(r *rand.Rand) { params.InactivityPeriod = time.Duration(r.Intn(14)+1) * 24 * time.Hour })
+
+	var minAmount, maxAmount int64
+	simState.AppParams.GetOrGenerate(MinLznAmount, &minAmount, simState.Rand,
+		func(r *rand.Rand) { minAmount = int64(r.Intn(1000000) + 1) })
+	simState.AppParams.GetOrGenerate(MaxLznAmount, &maxAmount, simState.Rand,
+		func(r *rand.Rand) { maxAmount = minAmount * int64(r.Intn(1000)+1) })
+	params.MinLznAmount = strconv.FormatInt(minAmount, 10)
+	params.MaxLznAmount = strconv.FormatInt(maxAmount, 10)
+
+	// Short epochs so MOA evaluation runs within a simulation
+	simState.AppParams.GetOrGenerate(MoaEpochLength, &params.MoaEpochLength, simState.Rand,
+		func(r *rand.Rand) { params.MoaEpochLength = uint64(r.Intn(91) + 10) })
+
+	genesis := &lizenzv1.GenesisState{
+		Params:          params.ToProto(),
+		ActivatedLizenz: []*lizenzv1.ActivatedLizenz{},
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,153 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	identv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/ident/v1"
+	lizenzv1 "github.com/volnix-protocol/volnix-protocol/proto/gen/go/volnix/lizenz/v1"
+	"github.com/volnix-protocol/volnix-protocol/testutil/simtx"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/keeper"
+	"github.com/volnix-protocol/volnix-protocol/x/lizenz/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgActivateLZN   = "op_weight_msg_activate_lzn"
+	OpWeightMsgDeactivateLZN = "op_weight_msg_deactivate_lzn"
+
+	DefaultWeightMsgActivateLZN   = 30
+	DefaultWeightMsgDeactivateLZN = 10
+)
+
+// WeightedOperations returns all the operations from the lizenz module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	ik simtx.IdentKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgActivateLZN, weightMsgDeactivateLZN int
+	appParams.GetOrGenerate(OpWeightMsgActivateLZN, &weightMsgActivateLZN, nil,
+		func(_ *rand.Rand) { weightMsgActivateLZN = DefaultWeightMsgActivateLZN })
+	appParams.GetOrGenerate(OpWeightMsgDeactivateLZN, &weightMsgDeactivateLZN, nil,
+		func(_ *rand.Rand) { weightMsgDeactivateLZN = DefaultWeightMsgDeactivateLZN })
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgActivateLZN, SimulateMsgActivateLZN(txGen, ak, bk, ik, k)),
+		simulation.NewWeightedOperation(weightMsgDeactivateLZN, SimulateMsgDeactivateLZN(txGen, ak, bk, ik, k)),
+	}
+}
+
+// SimulateMsgActivateLZN generates a MsgActivateLZN for a random verified validator without an activation
+func SimulateMsgActivateLZN(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&lizenzv1.MsgActivateLZN{})
+
+		simAccount, found := simtx.RandomAccountWithRole(r, ctx, ik, accs, identv1.Role_ROLE_VALIDATOR)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no verified validators"), nil, nil
+		}
+		validator := simAccount.Address.String()
+		if _, err := k.GetActivatedLizenz(ctx, validator); err == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "LZN already activated"), nil, nil
+		}
+		account, err := ik.GetVerifiedAccount(ctx, validator)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "validator identity not found"), nil, nil
+		}
+
+		params := k.GetParams(ctx)
+		minAmount, err := strconv.ParseInt(params.MinLznAmount, 10, 64)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid minimum LZN amount"), nil, err
+		}
+		maxAmount, err := strconv.ParseInt(params.MaxLznAmount, 10, 64)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid maximum LZN amount"), nil, err
+		}
+
+		// Fall back to the minimum amount when a random one would exceed the validator's share of the pool
+		amount := strconv.FormatInt(minAmount+r.Int63n(maxAmount-minAmount+1), 10)
+		if err := k.ValidateMaxLznActivationLimit(ctx, validator, amount); err != nil {
+			amount = params.MinLznAmount
+			if err := k.ValidateMaxLznActivationLimit(ctx, validator, amount); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "activation exceeds the validator share"), nil, nil
+			}
+		}
+
+		msg := &lizenzv1.MsgActivateLZN{
+			Validator:    validator,
+			Amount:       amount,
+			IdentityHash: account.IdentityHash,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
+
+// SimulateMsgDeactivateLZN generates a MsgDeactivateLZN for a random validator with an activated LZN
+func SimulateMsgDeactivateLZN(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, ik simtx.IdentKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&lizenzv1.MsgDeactivateLZN{})
+
+		var candidates []simtypes.Account
+		for _, acc := range accs {
+			validator := acc.Address.String()
+			if _, err := k.GetActivatedLizenz(ctx, validator); err != nil {
+				continue
+			}
+			if _, err := k.GetDeactivatingLizenz(ctx, validator); err == nil {
+				continue
+			}
+			candidates = append(candidates, acc)
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no activated LZN"), nil, nil
+		}
+		simAccount := candidates[r.Intn(len(candidates))]
+
+		msg := &lizenzv1.MsgDeactivateLZN{
+			Validator: simAccount.Address.String(),
+			Reason:    simtypes.RandStringOfLength(r, 16),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simtx.GenAndDeliverTx(txCtx, ik)
+	}
+}
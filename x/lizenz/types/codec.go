@@ -12,9 +12,11 @@ func RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 	reg.RegisterImplementations((*sdk.Msg)(nil),
 		&lizenzv1.MsgActivateLZN{},
 		&lizenzv1.MsgDeactivateLZN{},
+		&lizenzv1.MsgUpdateParams{},
 	)
 	reg.RegisterImplementations((*txtypes.MsgResponse)(nil),
 		&lizenzv1.MsgActivateLZNResponse{},
 		&lizenzv1.MsgDeactivateLZNResponse{},
+		&lizenzv1.MsgUpdateParamsResponse{},
 	)
 }
@@ -46,4 +46,10 @@ var (
 	
 	// ErrInvalidRoleForLizenz indicates that validator does not have VALIDATOR role
 	ErrInvalidRoleForLizenz = errors.Register(ModuleName, 14, "only validators with ROLE_VALIDATOR can activate LZN")
+
+	// ErrUnauthorized indicates that the signer is not the module authority
+	ErrUnauthorized = errors.Register(ModuleName, 15, "unauthorized")
+
+	// ErrInvalidParams indicates that the module parameters are invalid
+	ErrInvalidParams = errors.Register(ModuleName, 16, "invalid params")
 )
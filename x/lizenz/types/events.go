@@ -18,6 +18,15 @@ const (
 	
 	// EventTypeValidatorRegistered defines the event type for automatic validator registration
 	EventTypeValidatorRegistered = "lizenz.validator_registered"
+
+	// EventTypeMOAWarning defines the event type for MOA compliance below consensus thresholds
+	EventTypeMOAWarning = "lizenz.moa_warning"
+
+	// EventTypeLizenzSlashed defines the event type for activated LZN burned by consensus slashing
+	EventTypeLizenzSlashed = "lizenz.lizenz_slashed"
+
+	// EventTypeLizenzCapped defines the event type for activated LZN above the 33% cap returned to the validator
+	EventTypeLizenzCapped = "lizenz.lizenz_capped"
 	
 	// Attribute keys
 	AttributeKeyValidator      = "validator"
@@ -30,5 +39,12 @@ const (
 	AttributeKeyLZNBalance      = "lzn_balance"
 	AttributeKeyBlockHeight     = "block_height"
 	AttributeKeyValidatorWeight  = "validator_weight"
+	AttributeKeyMOAScore         = "moa_score"
+	AttributeKeyRequiredMOA      = "required_moa"
+	AttributeKeyWarningLevel     = "warning_level"
+	AttributeKeyEpochStart       = "epoch_start"
+	AttributeKeySlashFraction    = "slash_fraction"
+	AttributeKeyBurnedAmount     = "burned_amount"
+	AttributeKeyReleasedAmount   = "released_amount"
 )
 
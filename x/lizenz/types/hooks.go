@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LizenzHooks defines the hooks other modules can subscribe to for LZN activation changes
+type LizenzHooks interface {
+	// AfterLizenzActivated is called after a validator has activated LZN
+	AfterLizenzActivated(ctx sdk.Context, validator string, amount string) error
+
+	// AfterDeactivationStarted is called after a validator's activated LZN has been deactivated,
+	// either by MsgDeactivateLZN or for inactivity
+	AfterDeactivationStarted(ctx sdk.Context, validator string, amount string) error
+}
+
+var _ LizenzHooks = MultiLizenzHooks{}
+
+// MultiLizenzHooks combines multiple lizenz hooks, all hook functions are run in array sequence
+type MultiLizenzHooks []LizenzHooks
+
+// NewMultiLizenzHooks creates a new MultiLizenzHooks
+func NewMultiLizenzHooks(hooks ...LizenzHooks) MultiLizenzHooks {
+	return hooks
+}
+
+// AfterLizenzActivated runs AfterLizenzActivated of every hook, stopping at the first error
+func (h MultiLizenzHooks) AfterLizenzActivated(ctx sdk.Context, validator string, amount string) error {
+	for i := range h {
+		if err := h[i].AfterLizenzActivated(ctx, validator, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterDeactivationStarted runs AfterDeactivationStarted of every hook, stopping at the first error
+func (h MultiLizenzHooks) AfterDeactivationStarted(ctx sdk.Context, validator string, amount string) error {
+	for i := range h {
+		if err := h[i].AfterDeactivationStarted(ctx, validator, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
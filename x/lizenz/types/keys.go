@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	ModuleName   = "lizenz"
 	StoreKey     = ModuleName
@@ -15,10 +21,23 @@ var (
 	DeactivatingLizenzKeyPrefix = []byte{0x02}
 	
 	// RewardHistoryKeyPrefix defines the prefix for reward history keys
+	// Deprecated: reward history is stored per epoch under RewardEpochKeyPrefix
 	RewardHistoryKeyPrefix = []byte{0x04}
 
 	// MOAStatusKeyPrefix defines the prefix for MOA status keys
 	MOAStatusKeyPrefix = []byte{0x03}
+
+	// MOAEpochStartKey defines the key for the start time of the current MOA epoch
+	MOAEpochStartKey = []byte{0x05}
+
+	// RewardEpochKeyPrefix defines the prefix for per-epoch reward records
+	RewardEpochKeyPrefix = []byte{0x06}
+
+	// MOAEpochActivityKeyPrefix defines the prefix for the consensus activity counted in the current MOA epoch
+	MOAEpochActivityKeyPrefix = []byte{0x07}
+
+	// TotalActivatedKey defines the key for the LZN locked by activations, net of unlocks and burns
+	TotalActivatedKey = []byte{0x08}
 )
 
 // GetActivatedLizenzKey returns the key for an activated LZN
@@ -27,10 +46,23 @@ func GetActivatedLizenzKey(validator string) []byte {
 }
 
 // GetRewardHistoryKey returns the key for a validator's reward history
+// Deprecated: use GetRewardEpochKey
 func GetRewardHistoryKey(validator string) []byte {
 	return append(RewardHistoryKeyPrefix, []byte(validator)...)
 }
 
+// GetRewardEpochPrefix returns the prefix of all reward records of a validator
+// The validator is length-prefixed so that no validator's prefix is a prefix of another's
+func GetRewardEpochPrefix(validator string) []byte {
+	return append(append([]byte{}, RewardEpochKeyPrefix...), address.MustLengthPrefix([]byte(validator))...)
+}
+
+// GetRewardEpochKey returns the key for a validator's reward record of an epoch
+// Epochs are big endian so that records iterate in epoch order
+func GetRewardEpochKey(validator string, epoch uint64) []byte {
+	return binary.BigEndian.AppendUint64(GetRewardEpochPrefix(validator), epoch)
+}
+
 // GetDeactivatingLizenzKey returns the key for a deactivating LZN
 func GetDeactivatingLizenzKey(validator string) []byte {
 	return append(DeactivatingLizenzKeyPrefix, []byte(validator)...)
@@ -40,3 +72,8 @@ func GetDeactivatingLizenzKey(validator string) []byte {
 func GetMOAStatusKey(validator string) []byte {
 	return append(MOAStatusKeyPrefix, []byte(validator)...)
 }
+
+// GetMOAEpochActivityKey returns the key for a validator's consensus activity in the current MOA epoch
+func GetMOAEpochActivityKey(validator string) []byte {
+	return append(MOAEpochActivityKeyPrefix, []byte(validator)...)
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// MOA (Minimum Obligation of Activity) scoring
+// According to whitepaper: validators must stay active in consensus, governance and the ANT market
+// to keep receiving rewards for their activated LZN.
+
+const (
+	// MOAWeightConsensus is the share of the overall score taken by consensus contribution
+	MOAWeightConsensus = 0.5
+
+	// MOAWeightGovernance is the share of the overall score taken by governance participation
+	MOAWeightGovernance = 0.25
+
+	// MOAWeightMarket is the share of the overall score taken by ANT market participation
+	MOAWeightMarket = 0.25
+
+	// MOABaseRequiredScore is the overall score required with ActivityCoefficient = 1.0
+	// A validator doing half of its fair share of the network activity is fully compliant
+	MOABaseRequiredScore = 0.5
+)
+
+// MOA warning levels emitted when compliance falls below the consensus thresholds
+const (
+	MOAWarningLevelNone     = ""
+	MOAWarningLevelWarning  = "warning"  // below threshold_high: no penalty yet
+	MOAWarningLevelMedium   = "medium"   // below threshold_warning: 25% penalty
+	MOAWarningLevelLow      = "low"      // below threshold_medium: 50% penalty
+	MOAWarningLevelCritical = "critical" // below threshold_low: no rewards
+)
+
+// MOAActivity is the on-chain activity of a single validator during one MOA epoch
+type MOAActivity struct {
+	BlocksCreated   uint64 // Blocks created (BlockCreator records)
+	AuctionCommits  uint64 // Blind auction commits
+	AuctionReveals  uint64 // Blind auction reveals
+	GovernanceVotes uint64 // Votes cast on proposals open during the epoch
+	MarketActions   uint64 // Orders placed on the ANT market
+}
+
+// MOAEpochTotals is the network-wide activity of all measured validators during one MOA epoch
+// It is used as the fair-share baseline for every validator
+type MOAEpochTotals struct {
+	Validators     uint64 // Number of validators measured in the epoch
+	BlocksCreated  uint64
+	AuctionCommits uint64
+	AuctionReveals uint64
+	MarketActions  uint64
+	Proposals      uint64 // Proposals open for voting during the epoch
+}
+
+// Add accumulates a validator's activity into the epoch totals
+func (t *MOAEpochTotals) Add(activity MOAActivity) {
+	t.Validators++
+	t.BlocksCreated += activity.BlocksCreated
+	t.AuctionCommits += activity.AuctionCommits
+	t.AuctionReveals += activity.AuctionReveals
+	t.MarketActions += activity.MarketActions
+}
+
+// MOAScore is the result of the MOA calculation for one validator (all values 0.0 to 1.0)
+type MOAScore struct {
+	ConsensusContribution   float64
+	GovernanceParticipation float64
+	MarketParticipation     float64
+	OverallScore            float64
+}
+
+// CalculateMOAScore calculates the MOA score of a validator from its epoch activity
+// Every component compares the validator with its fair share (network total / validators)
+// and is capped at 1.0. A component with no network-wide activity is neutral (1.0),
+// so validators are never penalized for activity nobody could perform.
+func CalculateMOAScore(activity MOAActivity, totals MOAEpochTotals) MOAScore {
+	blockScore := fairShareRatio(activity.BlocksCreated, totals.BlocksCreated, totals.Validators)
+	commitScore := fairShareRatio(activity.AuctionCommits, totals.AuctionCommits, totals.Validators)
+	revealScore := fairShareRatio(activity.AuctionReveals, totals.AuctionReveals, totals.Validators)
+
+	score := MOAScore{
+		ConsensusContribution: (blockScore + (commitScore+revealScore)/2) / 2,
+		MarketParticipation:   fairShareRatio(activity.MarketActions, totals.MarketActions, totals.Validators),
+	}
+
+	// Governance participation is absolute: share of open proposals the validator voted on
+	score.GovernanceParticipation = 1.0
+	if totals.Proposals > 0 {
+		score.GovernanceParticipation = min(float64(activity.GovernanceVotes)/float64(totals.Proposals), 1.0)
+	}
+
+	score.OverallScore = score.ConsensusContribution*MOAWeightConsensus +
+		score.GovernanceParticipation*MOAWeightGovernance +
+		score.MarketParticipation*MOAWeightMarket
+
+	return score
+}
+
+// CalculateRequiredMOA returns the overall score a validator must reach to be compliant
+// The governable ActivityCoefficient scales the base requirement
+func CalculateRequiredMOA(params Params) (float64, error) {
+	coefficient := 1.0 // Default fallback
+	if params.ActivityCoefficient != "" {
+		parsed, err := strconv.ParseFloat(params.ActivityCoefficient, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid activity coefficient: %w", err)
+		}
+		coefficient = parsed
+	}
+	if coefficient < 0 {
+		return 0, fmt.Errorf("activity coefficient must be >= 0, got %f", coefficient)
+	}
+	return MOABaseRequiredScore * coefficient, nil
+}
+
+// GetMOAWarningLevel maps a compliance ratio to a warning level using the consensus thresholds
+func GetMOAWarningLevel(compliance, thresholdHigh, thresholdWarning, thresholdMedium, thresholdLow float64) string {
+	switch {
+	case compliance >= thresholdHigh:
+		return MOAWarningLevelNone
+	case compliance >= thresholdWarning:
+		return MOAWarningLevelWarning
+	case compliance >= thresholdMedium:
+		return MOAWarningLevelMedium
+	case compliance >= thresholdLow:
+		return MOAWarningLevelLow
+	default:
+		return MOAWarningLevelCritical
+	}
+}
+
+// FormatMOAValue formats an MOA value for storage in MOAStatus
+func FormatMOAValue(value float64) string {
+	return fmt.Sprintf("%.4f", value)
+}
+
+// fairShareRatio returns own / (total / validators), capped at 1.0
+func fairShareRatio(own, total, validators uint64) float64 {
+	if total == 0 || validators == 0 {
+		return 1.0
+	}
+	fairShare := float64(total) / float64(validators)
+	return min(float64(own)/fairShare, 1.0)
+}
@@ -32,8 +32,14 @@ var (
 
 	// KeyLznDenom defines the key for LZN denomination
 	KeyLznDenom = []byte("LznDenom")
+
+	// KeyMoaEpochLength defines the key for MOA epoch length
+	KeyMoaEpochLength = []byte("MoaEpochLength")
 )
 
+// DefaultMoaEpochLength is the default number of blocks in one MOA measurement epoch
+const DefaultMoaEpochLength = uint64(1000)
+
 // ParamKeyTable returns the parameter key table
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -49,6 +55,7 @@ type Params struct {
 	MaxLznAmount                string        `json:"max_lzn_amount"`
 	RequireIdentityVerification bool          `json:"require_identity_verification"`
 	LznDenom                    string        `json:"lzn_denom"`
+	MoaEpochLength              uint64        `json:"moa_epoch_length"`
 }
 
 // ParamSetPairs returns the parameter set pairs
@@ -62,6 +69,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxLznAmount, &p.MaxLznAmount, validateString),
 		paramtypes.NewParamSetPair(KeyRequireIdentityVerification, &p.RequireIdentityVerification, validateBool),
 		paramtypes.NewParamSetPair(KeyLznDenom, &p.LznDenom, validateString),
+		paramtypes.NewParamSetPair(KeyMoaEpochLength, &p.MoaEpochLength, validateUint64),
 	}
 }
 
@@ -76,6 +84,7 @@ func DefaultParams() Params {
 		MaxLznAmount:                "1000000000",       // 1000 LZN in micro units
 		RequireIdentityVerification: true,
 		LznDenom:                    "ulzn",
+		MoaEpochLength:              DefaultMoaEpochLength, // MOA is measured every 1000 blocks
 	}
 }
 
@@ -153,6 +162,14 @@ func validateString(i interface{}) error {
 	return nil
 }
 
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("expected uint64, got %T", i)
+	}
+	return nil
+}
+
 func validateDuration(i interface{}) error {
 	_, ok := i.(time.Duration)
 	if !ok {
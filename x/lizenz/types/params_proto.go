@@ -15,6 +15,7 @@ func (p Params) ToProto() *lizenzv1.Params {
 		MaxLznAmount:                p.MaxLznAmount,
 		RequireIdentityVerification: p.RequireIdentityVerification,
 		LznDenom:                    p.LznDenom,
+		MoaEpochLength:              p.MoaEpochLength,
 	}
 }
 
@@ -31,5 +32,6 @@ func ParamsFromProto(pp *lizenzv1.Params) (Params, error) {
 		MaxLznAmount:                pp.MaxLznAmount,
 		RequireIdentityVerification: pp.RequireIdentityVerification,
 		LznDenom:                    pp.LznDenom,
+		MoaEpochLength:              pp.MoaEpochLength,
 	}, nil
 }
@@ -27,7 +27,13 @@ func NewActivatedLizenz(validator string, amount string, identityHash string) *l
 
 // NewDeactivatingLizenz creates a new DeactivatingLizenz instance
 func NewDeactivatingLizenz(validator string, amount string, reason string) *lizenzv1.DeactivatingLizenz {
-	now := timestamppb.Now()
+	return NewDeactivatingLizenzAt(time.Now(), validator, amount, reason)
+}
+
+// NewDeactivatingLizenzAt creates a new DeactivatingLizenz instance whose deactivation starts at start
+// The keeper passes the block time so every node stores the same deactivation period
+func NewDeactivatingLizenzAt(start time.Time, validator string, amount string, reason string) *lizenzv1.DeactivatingLizenz {
+	now := timestamppb.New(start)
 	deactivationEnd := timestamppb.New(now.AsTime().Add(24 * time.Hour)) // Default 24h deactivation period
 
 	return &lizenzv1.DeactivatingLizenz{
@@ -110,23 +116,6 @@ func UpdateMOAStatusActivity(status *lizenzv1.MOAStatus, currentMOA string) {
 	status.IsCompliant = currentMOA >= status.RequiredMoa
 }
 
-// CalculateMOA calculates the MOA value based on activity data
-func CalculateMOA(activityData string, params Params) (string, error) {
-	// This is a simplified MOA calculation
-	// In real implementation, this would use complex algorithms based on:
-	// - Block production rate
-	// - Transaction validation
-	// - Network participation
-	// - Time-based factors
-
-	// For now, return a simplified MOA value based on activity data length
-	if len(activityData) > 10 {
-		return "85.5", nil // High activity
-	} else if len(activityData) > 5 {
-		return "65.0", nil // Medium activity
-	}
-	return "45.0", nil // Low activity
-}
 // CalculateLizenzPrice calculates the price of a license based on various factors
 func CalculateLizenzPrice(licenseType string, duration int64) (string, error) {
 	// Calculate license price based on multiple factors
@@ -265,12 +265,78 @@ func TestUpdateMOAStatusActivity(t *testing.T) {
 	require.Equal(t, newMOA, status.CurrentMoa)
 }
 
-func TestCalculateMOA(t *testing.T) {
+func TestCalculateMOAScore_FairShare(t *testing.T) {
+	totals := types.MOAEpochTotals{
+		Validators:     2,
+		BlocksCreated:  10,
+		AuctionCommits: 8,
+		AuctionReveals: 8,
+		MarketActions:  4,
+		Proposals:      2,
+	}
+
+	// Validator doing its full fair share in every area
+	full := types.CalculateMOAScore(types.MOAActivity{
+		BlocksCreated:   5,
+		AuctionCommits:  4,
+		AuctionReveals:  4,
+		GovernanceVotes: 2,
+		MarketActions:   2,
+	}, totals)
+	require.InDelta(t, 1.0, full.ConsensusContribution, 1e-9)
+	require.InDelta(t, 1.0, full.MarketParticipation, 1e-9)
+	require.InDelta(t, 1.0, full.GovernanceParticipation, 1e-9)
+	require.InDelta(t, 1.0, full.OverallScore, 1e-9)
+
+	// Validator with no activity at all
+	idle := types.CalculateMOAScore(types.MOAActivity{}, totals)
+	require.InDelta(t, 0.0, idle.OverallScore, 1e-9)
+
+	// Validator creating blocks but skipping governance and market
+	partial := types.CalculateMOAScore(types.MOAActivity{
+		BlocksCreated:  5,
+		AuctionCommits: 4,
+		AuctionReveals: 4,
+	}, totals)
+	require.InDelta(t, 1.0, partial.ConsensusContribution, 1e-9)
+	require.InDelta(t, 0.0, partial.MarketParticipation, 1e-9)
+	require.InDelta(t, types.MOAWeightConsensus, partial.OverallScore, 1e-9)
+}
+
+func TestCalculateMOAScore_NoNetworkActivity(t *testing.T) {
+	// Nothing happened in the epoch: every component is neutral
+	score := types.CalculateMOAScore(types.MOAActivity{}, types.MOAEpochTotals{Validators: 3})
+	require.InDelta(t, 1.0, score.OverallScore, 1e-9)
+}
+
+func TestCalculateMOAScore_CappedAtOne(t *testing.T) {
+	totals := types.MOAEpochTotals{Validators: 2, BlocksCreated: 10}
+	score := types.CalculateMOAScore(types.MOAActivity{BlocksCreated: 10}, totals)
+	require.InDelta(t, 1.0, score.ConsensusContribution, 1e-9)
+}
+
+func TestCalculateRequiredMOA(t *testing.T) {
 	params := types.DefaultParams()
+	required, err := types.CalculateRequiredMOA(params)
+	require.NoError(t, err)
+	require.InDelta(t, types.MOABaseRequiredScore, required, 1e-9)
 
-	moa, err := types.CalculateMOA("test_data", params)
+	params.ActivityCoefficient = "1.5"
+	required, err = types.CalculateRequiredMOA(params)
 	require.NoError(t, err)
-	require.NotEmpty(t, moa)
+	require.InDelta(t, 0.75, required, 1e-9)
+
+	params.ActivityCoefficient = "invalid"
+	_, err = types.CalculateRequiredMOA(params)
+	require.Error(t, err)
+}
+
+func TestGetMOAWarningLevel(t *testing.T) {
+	require.Equal(t, types.MOAWarningLevelNone, types.GetMOAWarningLevel(1.2, 1.0, 0.9, 0.7, 0.5))
+	require.Equal(t, types.MOAWarningLevelWarning, types.GetMOAWarningLevel(0.95, 1.0, 0.9, 0.7, 0.5))
+	require.Equal(t, types.MOAWarningLevelMedium, types.GetMOAWarningLevel(0.8, 1.0, 0.9, 0.7, 0.5))
+	require.Equal(t, types.MOAWarningLevelLow, types.GetMOAWarningLevel(0.6, 1.0, 0.9, 0.7, 0.5))
+	require.Equal(t, types.MOAWarningLevelCritical, types.GetMOAWarningLevel(0.1, 1.0, 0.9, 0.7, 0.5))
 }
 
 func TestCalculateLizenzPrice(t *testing.T) {